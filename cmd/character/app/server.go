@@ -21,6 +21,10 @@ type CharacterServerContext struct {
 	*config.ServerContext
 	CharacterService service.CharacterService
 	InventoryService service.InventoryService
+	GuildService     service.GuildService
+	MailService      service.MailService
+	TradeService     service.TradeService
+	ItemService      service.ItemService
 }
 
 func NewServerContext(ctx context.Context, conf *config.GlobalConfig, tracer trace.Tracer) (*CharacterServerContext, error) {
@@ -40,12 +44,38 @@ func NewServerContext(ctx context.Context, conf *config.GlobalConfig, tracer tra
 	if err != nil {
 		return nil, fmt.Errorf("postgres: %w", err)
 	}
-	characterService, err := service.NewCharacterService(ctx, characterRepo)
+	characterService, err := service.NewCharacterService(
+		ctx,
+		characterRepo,
+		conf.Character.Progression,
+		conf.Character.Creation,
+		conf.Character.Slots,
+		conf.Character.Rename,
+	)
 	if err != nil {
 		return nil, fmt.Errorf("character service: %w", err)
 	}
 	server.CharacterService = characterService
 
+	guildService, err := service.NewGuildService(ctx, repository.NewGuildRepository(postgres))
+	if err != nil {
+		return nil, fmt.Errorf("guild service: %w", err)
+	}
+	server.GuildService = guildService
+
+	itemRepo := repository.NewItemRepository(postgres)
+	itemService, err := service.NewItemService(ctx, itemRepo)
+	if err != nil {
+		return nil, fmt.Errorf("item service: %w", err)
+	}
+	if conf.Character.Inventory.ItemCatalogFile != "" {
+		err = itemService.LoadFile(ctx, conf.Character.Inventory.ItemCatalogFile)
+		if err != nil {
+			return nil, fmt.Errorf("loading item catalog: %w", err)
+		}
+	}
+	server.ItemService = itemService
+
 	opts := options.Client()
 	opts.Monitor = otelmongo.NewMonitor()
 	opts.ApplyURI(server.GlobalConfig.Character.Mongo.Master.MongoDSN())
@@ -53,8 +83,38 @@ func NewServerContext(ctx context.Context, conf *config.GlobalConfig, tracer tra
 	if err != nil {
 		return nil, fmt.Errorf("connecting to mongo database: %w", err)
 	}
-	invRepo := repository.NewInventoryRepository(mongoDb.Database(server.GlobalConfig.Character.Mongo.Master.Name))
-	server.InventoryService = service.NewInventoryService(invRepo)
+	mongoDatabase := mongoDb.Database(server.GlobalConfig.Character.Mongo.Master.Name)
+	invRepo := repository.NewInventoryRepository(mongoDatabase)
+	inventoryService, err := service.NewInventoryService(ctx, invRepo, itemRepo, conf.Character.Inventory)
+	if err != nil {
+		return nil, fmt.Errorf("inventory service: %w", err)
+	}
+	server.InventoryService = inventoryService
+
+	mailService, err := service.NewMailService(
+		ctx,
+		repository.NewMailRepository(mongoDatabase),
+		invRepo,
+		itemRepo,
+		conf.Character.Inventory,
+		server.GlobalConfig.Character.Mail.Expiry,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("mail service: %w", err)
+	}
+	server.MailService = mailService
+
+	tradeService, err := service.NewTradeService(
+		ctx,
+		repository.NewTradeRepository(mongoDatabase),
+		invRepo,
+		itemRepo,
+		conf.Character.Inventory,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("trade service: %w", err)
+	}
+	server.TradeService = tradeService
 
 	return server, nil
 }
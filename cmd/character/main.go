@@ -80,6 +80,54 @@ func main() {
 		return
 	}
 
+	gss, err := srv.NewGuildServiceServer(ctx, server)
+	if err != nil {
+		log.Logger.WithContext(ctx).Errorf("create guild service server: %v", err)
+		return
+	}
+	pb.RegisterGuildServiceServer(grpcServer, gss)
+	err = pb.RegisterGuildServiceHandlerFromEndpoint(ctx, gwmux, address, opts)
+	if err != nil {
+		log.Logger.WithContext(ctx).Errorf("registering guild service handler endpoint: %v", err)
+		return
+	}
+
+	mss, err := srv.NewMailServiceServer(ctx, server)
+	if err != nil {
+		log.Logger.WithContext(ctx).Errorf("create mail service server: %v", err)
+		return
+	}
+	pb.RegisterMailServiceServer(grpcServer, mss)
+	err = pb.RegisterMailServiceHandlerFromEndpoint(ctx, gwmux, address, opts)
+	if err != nil {
+		log.Logger.WithContext(ctx).Errorf("registering mail service handler endpoint: %v", err)
+		return
+	}
+
+	tss, err := srv.NewTradeServiceServer(ctx, server)
+	if err != nil {
+		log.Logger.WithContext(ctx).Errorf("create trade service server: %v", err)
+		return
+	}
+	pb.RegisterTradeServiceServer(grpcServer, tss)
+	err = pb.RegisterTradeServiceHandlerFromEndpoint(ctx, gwmux, address, opts)
+	if err != nil {
+		log.Logger.WithContext(ctx).Errorf("registering trade service handler endpoint: %v", err)
+		return
+	}
+
+	iss, err := srv.NewItemServiceServer(ctx, server)
+	if err != nil {
+		log.Logger.WithContext(ctx).Errorf("create item service server: %v", err)
+		return
+	}
+	pb.RegisterItemServiceServer(grpcServer, iss)
+	err = pb.RegisterItemServiceHandlerFromEndpoint(ctx, gwmux, address, opts)
+	if err != nil {
+		log.Logger.WithContext(ctx).Errorf("registering item service handler endpoint: %v", err)
+		return
+	}
+
 	span.End()
 	srvErr := make(chan error, 1)
 	go func() {
@@ -3,12 +3,17 @@ package chat
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/ShatteredRealms/go-backend/pkg/config"
+	"github.com/ShatteredRealms/go-backend/pkg/log"
+	"github.com/ShatteredRealms/go-backend/pkg/messagebus"
+	"github.com/ShatteredRealms/go-backend/pkg/ratelimit"
 	"github.com/ShatteredRealms/go-backend/pkg/repository"
 	"github.com/ShatteredRealms/go-backend/pkg/service"
 	"github.com/WilSimpson/gocloak/v13"
 	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/protobuf/types/known/emptypb"
 )
 
 var (
@@ -18,6 +23,7 @@ var (
 type ChatServerContext struct {
 	*config.ServerContext
 	ChatService service.ChatService
+	MessageBus  messagebus.Bus
 }
 
 func NewServerContext(ctx context.Context, conf *config.GlobalConfig, tracer trace.Tracer) (*ChatServerContext, error) {
@@ -35,12 +41,92 @@ func NewServerContext(ctx context.Context, conf *config.GlobalConfig, tracer tra
 		return nil, fmt.Errorf("connecting to postgres database: %w", err)
 	}
 
+	// Rate limits are shared between replicas using redis except when running locally
+	limiter := ratelimit.NewMemoryLimiter()
+	if conf.Chat.Mode != config.LocalMode {
+		rdb, err := repository.ConnectRedis(ctx, conf.Redis)
+		if err != nil {
+			return nil, fmt.Errorf("connecting to redis: %w", err)
+		}
+		limiter = ratelimit.NewRedisLimiter(rdb)
+	}
+
+	// Messages are delivered between replicas using kafka except when running locally
+	bus := messagebus.NewMemoryBus()
+	if conf.Chat.Mode != config.LocalMode {
+		conn, err := repository.ConnectKafka(conf.Chat.Kafka)
+		if err != nil {
+			return nil, fmt.Errorf("connecting to kafka: %w", err)
+		}
+		bus, err = messagebus.NewKafkaBus(conn, conf.Chat.Writers)
+		if err != nil {
+			return nil, fmt.Errorf("creating kafka message bus: %w", err)
+		}
+	}
+
 	repo := repository.NewChatRepository(db)
-	chatService, err := service.NewChatService(ctx, repo, conf.Chat.Kafka)
+	chatService, err := service.NewChatService(ctx, repo, bus, limiter, conf.Chat)
 	if err != nil {
 		return nil, fmt.Errorf("creating chat service: %w", err)
 	}
 	server.ChatService = chatService
+	server.MessageBus = bus
 
 	return server, nil
 }
+
+// ReconcileTopics periodically reconciles the chat topics with the channels and characters until the context is done
+func (server *ChatServerContext) ReconcileTopics(ctx context.Context) {
+	interval := server.GlobalConfig.Chat.Topics.ReconcileInterval
+	if interval <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+
+		case <-ticker.C:
+			server.reconcileTopics(ctx)
+		}
+	}
+}
+
+func (server *ChatServerContext) reconcileTopics(ctx context.Context) {
+	ctx, span := server.Tracer.Start(ctx, "chat.topics.reconcile")
+	defer span.End()
+
+	err := server.ChatService.ReconcileTopics(ctx, server.characterNames)
+	if err != nil {
+		log.Logger.WithContext(ctx).Errorf("reconcile topics: %v", err)
+	}
+}
+
+// characterNames gets the names of all characters using the chat service credentials
+func (server *ChatServerContext) characterNames(ctx context.Context) ([]string, error) {
+	charClient, err := server.GetCharacterClient()
+	if err != nil {
+		return nil, err
+	}
+
+	outCtx, err := server.OutgoingClientAuth(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("client auth: %w", err)
+	}
+
+	resp, err := charClient.GetCharacters(outCtx, &emptypb.Empty{})
+	if err != nil {
+		return nil, fmt.Errorf("get characters: %w", err)
+	}
+
+	names := make([]string, len(resp.Characters))
+	for idx, character := range resp.Characters {
+		names[idx] = character.Name
+	}
+
+	return names, nil
+}
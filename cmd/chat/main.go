@@ -57,6 +57,12 @@ func main() {
 		log.Logger.WithContext(ctx).Errorf("creating server context: %v", err)
 		return
 	}
+	defer func() {
+		// Flush messages that have not been delivered yet
+		if err := server.MessageBus.Close(); err != nil {
+			log.Logger.Warnf("Error closing message bus: %v", err)
+		}
+	}()
 	grpcServer, gwmux := helpers.InitServerDefaults(server.KeycloakClient, server.GlobalConfig.Keycloak.Realm)
 	address := server.GlobalConfig.Chat.Local.Address()
 	opts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
@@ -81,6 +87,8 @@ func main() {
 	}
 
 	span.End()
+	go server.ReconcileTopics(ctx)
+
 	srvErr := make(chan error, 1)
 	go func() {
 		srvErr <- helpers.StartServer(ctx, grpcServer, gwmux, server.GlobalConfig.Chat.Local.Address())
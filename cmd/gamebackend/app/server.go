@@ -6,6 +6,7 @@ import (
 
 	"agones.dev/agones/pkg/client/clientset/versioned"
 	"github.com/ShatteredRealms/go-backend/pkg/config"
+	"github.com/ShatteredRealms/go-backend/pkg/presence"
 	"github.com/ShatteredRealms/go-backend/pkg/repository"
 	"github.com/ShatteredRealms/go-backend/pkg/service"
 	"go.opentelemetry.io/otel/trace"
@@ -20,6 +21,8 @@ type GameBackendServerContext struct {
 	*config.ServerContext
 	GamebackendService service.GamebackendService
 	AgonesClient       versioned.Interface
+	PresenceStore      presence.Store
+	PartyService       service.PartyService
 }
 
 func NewServerContext(ctx context.Context, conf *config.GlobalConfig, tracer trace.Tracer) (*GameBackendServerContext, error) {
@@ -42,7 +45,24 @@ func NewServerContext(ctx context.Context, conf *config.GlobalConfig, tracer tra
 	}
 	server.GamebackendService = gamebackendService
 
+	server.PartyService, err = service.NewPartyService(
+		ctx,
+		repository.NewPartyRepository(db),
+		conf.GameBackend.Party.MaxSize,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("creating party service: %w", err)
+	}
+
+	// Presence is shared between replicas using redis except when running locally
+	server.PresenceStore = presence.NewMemoryStore()
 	if conf.GameBackend.Mode != config.LocalMode {
+		rdb, err := repository.ConnectRedis(ctx, conf.Redis)
+		if err != nil {
+			return nil, fmt.Errorf("connecting to redis: %w", err)
+		}
+		server.PresenceStore = presence.NewRedisStore(rdb)
+
 		config, err := rest.InClusterConfig()
 		if err != nil {
 			return nil, fmt.Errorf("creating cluster config: %w", err)
@@ -80,6 +80,30 @@ func main() {
 		return
 	}
 
+	presenceServer, err := srv.NewPresenceServiceServer(ctx, server)
+	if err != nil {
+		log.Logger.WithContext(ctx).Errorf("creating presence service server: %v", err)
+		return
+	}
+	pb.RegisterPresenceServiceServer(grpcServer, presenceServer)
+	err = pb.RegisterPresenceServiceHandlerFromEndpoint(ctx, gwmux, address, opts)
+	if err != nil {
+		log.Logger.WithContext(ctx).Errorf("register presence service handler endpoint: %v", err)
+		return
+	}
+
+	partyServer, err := srv.NewPartyServiceServer(ctx, server)
+	if err != nil {
+		log.Logger.WithContext(ctx).Errorf("creating party service server: %v", err)
+		return
+	}
+	pb.RegisterPartyServiceServer(grpcServer, partyServer)
+	err = pb.RegisterPartyServiceHandlerFromEndpoint(ctx, gwmux, address, opts)
+	if err != nil {
+		log.Logger.WithContext(ctx).Errorf("register party service handler endpoint: %v", err)
+		return
+	}
+
 	serverManagerServer, err := srv.NewServerManagerServiceServer(ctx, server)
 	if err != nil {
 		log.Logger.WithContext(ctx).Errorf("creating server manager service server: %v", err)
@@ -0,0 +1,13 @@
+package chatfilter_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestChatfilter(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Chatfilter Suite")
+}
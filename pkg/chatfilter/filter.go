@@ -0,0 +1,68 @@
+package chatfilter
+
+import (
+	"errors"
+
+	"github.com/ShatteredRealms/go-backend/pkg/config"
+	"github.com/ShatteredRealms/go-backend/pkg/model/chat"
+)
+
+var (
+	// ErrMessageTooLong thrown when a message is longer than the maximum allowed length
+	ErrMessageTooLong = errors.New("message is too long")
+
+	// ErrSpam thrown when a character repeatedly sends the same message
+	ErrSpam = errors.New("message was sent too many times")
+)
+
+// Filter checks a chat message before it is sent. Filters can modify the message text, or reject the message by
+// returning an error.
+type Filter interface {
+	Filter(message *chat.ChatMessage) error
+}
+
+// Chain runs each filter in order, stopping at the first filter that rejects the message
+type Chain []Filter
+
+func (c Chain) Filter(message *chat.ChatMessage) error {
+	for _, filter := range c {
+		if err := filter.Filter(message); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// New creates the filter chain for the config. Control characters are stripped before the length is checked so
+// they don't count towards it, and profanity is masked last so spam detection compares the original text.
+func New(conf config.ChatFilterConfig) Chain {
+	chain := Chain{}
+	if conf.StripControl {
+		chain = append(chain, ControlCharacters{})
+	}
+
+	if conf.MaxLength > 0 {
+		chain = append(chain, MaxLength(conf.MaxLength))
+	}
+
+	if conf.SpamRepeats > 0 && conf.SpamWindow > 0 {
+		chain = append(chain, NewSpam(conf.SpamRepeats, conf.SpamWindow))
+	}
+
+	if conf.MaskProfanity {
+		chain = append(chain, Profanity{})
+	}
+
+	return chain
+}
+
+// NewProfiles creates a filter chain for each of the configured profiles
+func NewProfiles(profiles map[string]config.ChatFilterConfig) map[string]Filter {
+	out := make(map[string]Filter, len(profiles))
+	for name, conf := range profiles {
+		out[name] = New(conf)
+	}
+
+	return out
+}
@@ -0,0 +1,71 @@
+package chatfilter_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/ShatteredRealms/go-backend/pkg/chatfilter"
+	"github.com/ShatteredRealms/go-backend/pkg/config"
+	"github.com/ShatteredRealms/go-backend/pkg/model/chat"
+)
+
+type rejectFilter struct {
+	calls int
+}
+
+func (f *rejectFilter) Filter(*chat.ChatMessage) error {
+	f.calls++
+	return fmt.Errorf("rejected")
+}
+
+var _ = Describe("Filter", func() {
+	Describe("Chain", func() {
+		It("should stop at the first rejection", func() {
+			first := &rejectFilter{}
+			second := &rejectFilter{}
+			Expect(chatfilter.Chain{first, second}.Filter(&chat.ChatMessage{})).NotTo(Succeed())
+			Expect(first.calls).To(Equal(1))
+			Expect(second.calls).To(Equal(0))
+		})
+
+		It("should allow messages with no filters", func() {
+			Expect(chatfilter.Chain{}.Filter(&chat.ChatMessage{Message: "hello"})).To(Succeed())
+		})
+	})
+
+	Describe("New", func() {
+		It("should not add disabled filters", func() {
+			Expect(chatfilter.New(config.ChatFilterConfig{})).To(BeEmpty())
+		})
+
+		It("should add enabled filters", func() {
+			chain := chatfilter.New(config.ChatFilterConfig{
+				MaxLength:     10,
+				MaskProfanity: true,
+				StripControl:  true,
+				SpamRepeats:   1,
+				SpamWindow:    time.Minute,
+			})
+			Expect(chain).To(HaveLen(4))
+
+			message := &chat.ChatMessage{Message: "\x1b[31m" + strings.Repeat("a", 6)}
+			Expect(chain.Filter(message)).To(Succeed())
+			Expect(message.Message).To(Equal("[31maaaaaa"))
+		})
+	})
+
+	Describe("NewProfiles", func() {
+		It("should create a filter for each profile", func() {
+			conf, err := config.NewGlobalConfig(context.Background())
+			Expect(err).NotTo(HaveOccurred())
+			profiles := chatfilter.NewProfiles(conf.Chat.Filters)
+			Expect(profiles).To(HaveLen(len(conf.Chat.Filters)))
+			Expect(profiles).To(HaveKey(config.DefaultChatFilterProfile))
+		})
+	})
+})
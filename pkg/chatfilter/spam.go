@@ -0,0 +1,74 @@
+package chatfilter
+
+import (
+	"sync"
+	"time"
+
+	"github.com/ShatteredRealms/go-backend/pkg/model/chat"
+)
+
+// Spam rejects messages when a character sends the same message more than the allowed number of times within the
+// window. Sent messages are only tracked in memory.
+type Spam struct {
+	maxRepeats int
+	window     time.Duration
+
+	mu        sync.Mutex
+	senders   map[uint]*spamEntry
+	lastPrune time.Time
+	now       func() time.Time
+}
+
+type spamEntry struct {
+	message string
+	count   int
+	first   time.Time
+}
+
+func NewSpam(maxRepeats int, window time.Duration) *Spam {
+	return &Spam{
+		maxRepeats: maxRepeats,
+		window:     window,
+		senders:    make(map[uint]*spamEntry),
+		now:        time.Now,
+	}
+}
+
+func (s *Spam) Filter(message *chat.ChatMessage) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	s.prune(now)
+
+	entry, ok := s.senders[message.SenderCharacterId]
+	if !ok || entry.message != message.Message || now.Sub(entry.first) > s.window {
+		s.senders[message.SenderCharacterId] = &spamEntry{
+			message: message.Message,
+			count:   1,
+			first:   now,
+		}
+		return nil
+	}
+
+	if entry.count >= s.maxRepeats {
+		return ErrSpam
+	}
+
+	entry.count++
+	return nil
+}
+
+// prune removes expired entries at most once per window so the tracked senders don't grow forever
+func (s *Spam) prune(now time.Time) {
+	if now.Sub(s.lastPrune) < s.window {
+		return
+	}
+
+	for senderId, entry := range s.senders {
+		if now.Sub(entry.first) > s.window {
+			delete(s.senders, senderId)
+		}
+	}
+	s.lastPrune = now
+}
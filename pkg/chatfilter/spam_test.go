@@ -0,0 +1,47 @@
+package chatfilter_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/ShatteredRealms/go-backend/pkg/chatfilter"
+	"github.com/ShatteredRealms/go-backend/pkg/model/chat"
+)
+
+var _ = Describe("Spam filter", func() {
+	var spam *chatfilter.Spam
+	BeforeEach(func() {
+		spam = chatfilter.NewSpam(2, 100*time.Millisecond)
+	})
+
+	It("should reject repeated messages", func() {
+		message := &chat.ChatMessage{SenderCharacterId: 1, Message: "hello"}
+		Expect(spam.Filter(message)).To(Succeed())
+		Expect(spam.Filter(message)).To(Succeed())
+		Expect(spam.Filter(message)).To(MatchError(chatfilter.ErrSpam))
+	})
+
+	It("should track senders separately", func() {
+		Expect(spam.Filter(&chat.ChatMessage{SenderCharacterId: 1, Message: "hello"})).To(Succeed())
+		Expect(spam.Filter(&chat.ChatMessage{SenderCharacterId: 1, Message: "hello"})).To(Succeed())
+		Expect(spam.Filter(&chat.ChatMessage{SenderCharacterId: 2, Message: "hello"})).To(Succeed())
+	})
+
+	It("should reset on a different message", func() {
+		Expect(spam.Filter(&chat.ChatMessage{SenderCharacterId: 1, Message: "hello"})).To(Succeed())
+		Expect(spam.Filter(&chat.ChatMessage{SenderCharacterId: 1, Message: "hello"})).To(Succeed())
+		Expect(spam.Filter(&chat.ChatMessage{SenderCharacterId: 1, Message: "world"})).To(Succeed())
+		Expect(spam.Filter(&chat.ChatMessage{SenderCharacterId: 1, Message: "hello"})).To(Succeed())
+	})
+
+	It("should allow repeats after the window", func() {
+		message := &chat.ChatMessage{SenderCharacterId: 1, Message: "hello"}
+		Expect(spam.Filter(message)).To(Succeed())
+		Expect(spam.Filter(message)).To(Succeed())
+		Eventually(func() error {
+			return spam.Filter(message)
+		}).Within(time.Second).Should(Succeed())
+	})
+})
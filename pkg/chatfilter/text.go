@@ -0,0 +1,44 @@
+package chatfilter
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/ShatteredRealms/go-backend/pkg/model/chat"
+	goaway "github.com/TwiN/go-away"
+)
+
+// ControlCharacters removes control characters from messages
+type ControlCharacters struct{}
+
+func (ControlCharacters) Filter(message *chat.ChatMessage) error {
+	message.Message = strings.Map(func(r rune) rune {
+		if unicode.IsControl(r) {
+			return -1
+		}
+
+		return r
+	}, message.Message)
+
+	return nil
+}
+
+// MaxLength rejects messages with more characters than the given length
+type MaxLength int
+
+func (l MaxLength) Filter(message *chat.ChatMessage) error {
+	if utf8.RuneCountInString(message.Message) > int(l) {
+		return ErrMessageTooLong
+	}
+
+	return nil
+}
+
+// Profanity replaces profane words in messages with asterisks
+type Profanity struct{}
+
+func (Profanity) Filter(message *chat.ChatMessage) error {
+	message.Message = goaway.Censor(message.Message)
+	return nil
+}
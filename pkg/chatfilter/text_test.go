@@ -0,0 +1,50 @@
+package chatfilter_test
+
+import (
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/ShatteredRealms/go-backend/pkg/chatfilter"
+	"github.com/ShatteredRealms/go-backend/pkg/model/chat"
+)
+
+var _ = Describe("Text filters", func() {
+	Describe("ControlCharacters", func() {
+		It("should strip control characters", func() {
+			message := &chat.ChatMessage{Message: "hello\n\tworld\x00!"}
+			Expect(chatfilter.ControlCharacters{}.Filter(message)).To(Succeed())
+			Expect(message.Message).To(Equal("helloworld!"))
+		})
+	})
+
+	Describe("MaxLength", func() {
+		It("should allow messages up to the length", func() {
+			Expect(chatfilter.MaxLength(5).Filter(&chat.ChatMessage{Message: "hello"})).To(Succeed())
+		})
+
+		It("should count characters instead of bytes", func() {
+			Expect(chatfilter.MaxLength(5).Filter(&chat.ChatMessage{Message: strings.Repeat("é", 5)})).To(Succeed())
+		})
+
+		It("should reject longer messages", func() {
+			err := chatfilter.MaxLength(5).Filter(&chat.ChatMessage{Message: "hello!"})
+			Expect(err).To(MatchError(chatfilter.ErrMessageTooLong))
+		})
+	})
+
+	Describe("Profanity", func() {
+		It("should mask profanity", func() {
+			message := &chat.ChatMessage{Message: "what the fuck"}
+			Expect(chatfilter.Profanity{}.Filter(message)).To(Succeed())
+			Expect(message.Message).To(Equal("what the ****"))
+		})
+
+		It("should not change clean messages", func() {
+			message := &chat.ChatMessage{Message: "hello world"}
+			Expect(chatfilter.Profanity{}.Filter(message)).To(Succeed())
+			Expect(message.Message).To(Equal("hello world"))
+		})
+	})
+})
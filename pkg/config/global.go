@@ -2,11 +2,14 @@ package config
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"reflect"
 	"strings"
+	"time"
 
 	"github.com/ShatteredRealms/go-backend/pkg/log"
+	"github.com/ShatteredRealms/go-backend/pkg/model/character"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/viper"
 )
@@ -16,6 +19,9 @@ const (
 	ModeDevelopment ServerMode = "development"
 	ModeDebug       ServerMode = "debug"
 	LocalMode       ServerMode = "local"
+
+	// DefaultChatFilterProfile chat filter profile used when no other profile applies
+	DefaultChatFilterProfile = "default"
 )
 
 type ServerMode string
@@ -45,19 +51,217 @@ type SROServer struct {
 
 type CharacterServer struct {
 	SROServer `yaml:",inline" mapstructure:",squash"`
-	Postgres  DBPoolConfig `yaml:"postgres"`
-	Mongo     DBPoolConfig `yaml:"mongo"`
+	Postgres  DBPoolConfig    `yaml:"postgres"`
+	Mongo     DBPoolConfig    `yaml:"mongo"`
+	Mail      MailConfig      `yaml:"mail"`
+	Inventory InventoryConfig `yaml:"inventory"`
+
+	Progression ProgressionConfig `yaml:"progression"`
+
+	// Creation realms, genders and appearance options characters can be created with
+	Creation character.CreationRules `yaml:"creation"`
+
+	Slots  SlotsConfig  `yaml:"slots"`
+	Rename RenameConfig `yaml:"rename"`
+}
+
+// RenameConfig how characters are renamed
+type RenameConfig struct {
+	// ReservationPeriod how long the old name of a renamed character is reserved for it. Other characters cannot take
+	// the name until it expires so they cannot impersonate the character.
+	ReservationPeriod time.Duration `yaml:"reservationPeriod"`
+}
+
+// ErrNoCharacterSlots thrown when the slots config does not let an account have any characters
+var ErrNoCharacterSlots = errors.New("max characters must be greater than 0")
+
+// SlotsConfig how many characters an account can have
+type SlotsConfig struct {
+	// MaxCharacters number of characters an account can have before any extra slots are granted to it
+	MaxCharacters uint32 `yaml:"maxCharacters"`
+
+	// MaxPerDimension number of characters an account can have in a single dimension. Extra slots do not raise it.
+	// There is no limit per dimension if it is 0.
+	MaxPerDimension uint32 `yaml:"maxPerDimension"`
+}
+
+// Validate verifies an account can have at least one character
+func (c SlotsConfig) Validate() error {
+	if c.MaxCharacters == 0 {
+		return ErrNoCharacterSlots
+	}
+
+	return nil
+}
+
+// ProgressionConfig how characters level up
+type ProgressionConfig struct {
+	character.ExperienceCurve `yaml:",inline" mapstructure:",squash"`
+
+	// BaseAttributes attributes of a new character
+	BaseAttributes character.Attributes `yaml:"baseAttributes"`
+
+	// AttributesPerLevel attributes gained by a character for each level it gains
+	AttributesPerLevel character.Attributes `yaml:"attributesPerLevel"`
+}
+
+// InventoryConfig limits for inventories and the items in them
+type InventoryConfig struct {
+	// Capacity number of inventory slots of a character
+	Capacity uint32 `yaml:"capacity"`
+
+	// BankCapacity number of bank slots of a character
+	BankCapacity uint32 `yaml:"bankCapacity"`
+
+	// ItemCatalogFile YAML or JSON file with item definitions that are loaded into the item catalog on startup. Meant
+	// for local development, items are otherwise managed through the item service.
+	ItemCatalogFile string `yaml:"itemCatalogFile"`
+}
+
+// MailConfig how in-game mail is kept
+type MailConfig struct {
+	// Expiry time until sent mail is removed. Mail is kept until its attachments and gold are claimed.
+	Expiry time.Duration `yaml:"expiry"`
 }
 
 type GamebackendServer struct {
 	SROServer `yaml:",inline" mapstructure:",squash"`
-	Postgres  DBPoolConfig `yaml:"postgres"`
+	Postgres  DBPoolConfig   `yaml:"postgres"`
+	Presence  PresenceConfig `yaml:"presence"`
+	Party     PartyConfig    `yaml:"party"`
+}
+
+// PresenceConfig how character presence is tracked
+type PresenceConfig struct {
+	// TTL time a reported presence lasts before the character is considered offline. Clients should report their
+	// presence more often than this as a heartbeat.
+	TTL time.Duration `yaml:"ttl"`
+}
+
+// PartyConfig limits for parties
+type PartyConfig struct {
+	// MaxSize maximum number of characters in a party including the leader
+	MaxSize int `yaml:"maxSize"`
 }
 
 type ChatServer struct {
 	SROServer `yaml:",inline" mapstructure:",squash"`
 	Postgres  DBPoolConfig  `yaml:"postgres"`
 	Kafka     ServerAddress `yaml:"kafka"`
+
+	// Writers limits the kafka writers kept open for publishing messages
+	Writers KafkaWriterConfig `yaml:"writers"`
+
+	// Topics how the channel and character topics are created and maintained
+	Topics ChatTopicsConfig `yaml:"topics"`
+
+	// Filters message filter profiles by name. Channels without a known profile and direct messages use the
+	// DefaultChatFilterProfile.
+	Filters map[string]ChatFilterConfig `yaml:"filters"`
+
+	RateLimits ChatRateLimitConfig `yaml:"rateLimits"`
+}
+
+// KafkaWriterConfig how many kafka writers are kept open. A writer is created for each topic messages are published to,
+// so writers that are not used are closed to bound the number of open writers.
+type KafkaWriterConfig struct {
+	// MaxOpen number of writers kept open. Once exceeded, the least recently used writers are closed.
+	MaxOpen int `yaml:"maxOpen"`
+
+	// IdleTimeout time a writer can go unused before it is closed
+	IdleTimeout time.Duration `yaml:"idleTimeout"`
+}
+
+// ChatTopicsConfig how chat topics are created and maintained. Topic configs are only applied when a topic is created.
+type ChatTopicsConfig struct {
+	// Channel config for channel topics
+	Channel TopicConfig `yaml:"channel"`
+
+	// Character config for character direct message topics
+	Character TopicConfig `yaml:"character"`
+
+	// Channels overrides the channel topic config by channel name
+	Channels map[string]TopicConfig `yaml:"channels"`
+
+	// ReconcileInterval how often topics are reconciled with the channels and characters. Topics that are missing are
+	// created, and topics for channels and characters that no longer exist are deleted. Disabled if zero.
+	ReconcileInterval time.Duration `yaml:"reconcileInterval"`
+}
+
+// TopicConfig how a message bus topic is created. Zero values use the message bus defaults.
+type TopicConfig struct {
+	Partitions        int `yaml:"partitions"`
+	ReplicationFactor int `yaml:"replicationFactor"`
+
+	// Retention time messages are kept
+	Retention time.Duration `yaml:"retention"`
+
+	// RetentionBytes size of messages kept for each partition
+	RetentionBytes int64 `yaml:"retentionBytes"`
+
+	// Compact only keeps the latest message for each key
+	Compact bool `yaml:"compact"`
+}
+
+// Merge overrides the config with the non-zero values of the override
+func (c TopicConfig) Merge(override TopicConfig) TopicConfig {
+	if override.Partitions > 0 {
+		c.Partitions = override.Partitions
+	}
+	if override.ReplicationFactor > 0 {
+		c.ReplicationFactor = override.ReplicationFactor
+	}
+	if override.Retention > 0 {
+		c.Retention = override.Retention
+	}
+	if override.RetentionBytes > 0 {
+		c.RetentionBytes = override.RetentionBytes
+	}
+	if override.Compact {
+		c.Compact = true
+	}
+
+	return c
+}
+
+// ChatRateLimitConfig limits how often chat messages can be sent
+type ChatRateLimitConfig struct {
+	// Character limits all messages sent by each character
+	Character RateLimit `yaml:"character"`
+
+	// Channel limits all messages sent to each channel to protect it from being flooded by many characters
+	Channel RateLimit `yaml:"channel"`
+
+	// MuteAfter number of times a character can exceed their limit within the MuteWindow before being muted in the
+	// channel for the MuteDuration. Zero disables automatic mutes.
+	MuteAfter    int           `yaml:"muteAfter"`
+	MuteWindow   time.Duration `yaml:"muteWindow"`
+	MuteDuration time.Duration `yaml:"muteDuration"`
+}
+
+// RateLimit token bucket limit. Zero values disable the limit.
+type RateLimit struct {
+	// Rate tokens added to the bucket per second
+	Rate float64 `yaml:"rate"`
+
+	// Burst maximum number of tokens in the bucket
+	Burst int `yaml:"burst"`
+}
+
+// ChatFilterConfig filters applied to chat messages. Zero values disable the filter.
+type ChatFilterConfig struct {
+	// MaxLength maximum number of characters in a message
+	MaxLength int `yaml:"maxLength"`
+
+	// MaskProfanity replaces profane words with asterisks
+	MaskProfanity bool `yaml:"maskProfanity"`
+
+	// StripControl removes control characters such as newlines and escape sequences
+	StripControl bool `yaml:"stripControl"`
+
+	// SpamRepeats maximum number of times a character can send the same message within the SpamWindow
+	SpamRepeats int           `yaml:"spamRepeats"`
+	SpamWindow  time.Duration `yaml:"spamWindow"`
 }
 
 type KeycloakGlobal struct {
@@ -127,6 +331,44 @@ func NewGlobalConfig(ctx context.Context) (*GlobalConfig, error) {
 					Name:     "sro",
 				},
 			},
+			Mail: MailConfig{
+				Expiry: 30 * 24 * time.Hour,
+			},
+			Inventory: InventoryConfig{
+				Capacity:     40,
+				BankCapacity: 80,
+			},
+			Progression: ProgressionConfig{
+				ExperienceCurve: character.ExperienceCurve{
+					MaxLevel:       60,
+					BaseExperience: 100,
+					Growth:         1.15,
+				},
+				BaseAttributes: character.Attributes{
+					Health:    100,
+					Stamina:   100,
+					Mana:      100,
+					Strength:  10,
+					Agility:   10,
+					Intellect: 10,
+				},
+				AttributesPerLevel: character.Attributes{
+					Health:    10,
+					Stamina:   5,
+					Mana:      5,
+					Strength:  1,
+					Agility:   1,
+					Intellect: 1,
+				},
+			},
+			Creation: character.DefaultCreationRules(),
+			Slots: SlotsConfig{
+				MaxCharacters:   10,
+				MaxPerDimension: 5,
+			},
+			Rename: RenameConfig{
+				ReservationPeriod: 30 * 24 * time.Hour,
+			},
 		},
 		GameBackend: GamebackendServer{
 			SROServer: SROServer{
@@ -158,6 +400,12 @@ func NewGlobalConfig(ctx context.Context) (*GlobalConfig, error) {
 				},
 				Slaves: []DBConfig{},
 			},
+			Presence: PresenceConfig{
+				TTL: 90 * time.Second,
+			},
+			Party: PartyConfig{
+				MaxSize: 5,
+			},
 		},
 		Chat: ChatServer{
 			SROServer: SROServer{
@@ -181,6 +429,23 @@ func NewGlobalConfig(ctx context.Context) (*GlobalConfig, error) {
 				Port: "29092",
 				Host: "localhost",
 			},
+			Writers: KafkaWriterConfig{
+				MaxOpen:     1000,
+				IdleTimeout: 5 * time.Minute,
+			},
+			Topics: ChatTopicsConfig{
+				Channel: TopicConfig{
+					Partitions:        1,
+					ReplicationFactor: 1,
+					Retention:         7 * 24 * time.Hour,
+				},
+				Character: TopicConfig{
+					Partitions:        1,
+					ReplicationFactor: 1,
+					Retention:         24 * time.Hour,
+				},
+				ReconcileInterval: 10 * time.Minute,
+			},
 			Postgres: DBPoolConfig{
 				Master: DBConfig{
 					ServerAddress: ServerAddress{
@@ -193,6 +458,34 @@ func NewGlobalConfig(ctx context.Context) (*GlobalConfig, error) {
 				},
 				Slaves: []DBConfig{},
 			},
+			Filters: map[string]ChatFilterConfig{
+				DefaultChatFilterProfile: {
+					MaxLength:     256,
+					MaskProfanity: true,
+					StripControl:  true,
+					SpamRepeats:   3,
+					SpamWindow:    30 * time.Second,
+				},
+				"relaxed": {
+					MaxLength:    1024,
+					StripControl: true,
+					SpamRepeats:  5,
+					SpamWindow:   10 * time.Second,
+				},
+			},
+			RateLimits: ChatRateLimitConfig{
+				Character: RateLimit{
+					Rate:  1,
+					Burst: 5,
+				},
+				Channel: RateLimit{
+					Rate:  20,
+					Burst: 50,
+				},
+				MuteAfter:    5,
+				MuteWindow:   time.Minute,
+				MuteDuration: 5 * time.Minute,
+			},
 		},
 		OpenTelemetry: OpenTelemetryConfig{
 			Addr: "otel-collector:4317",
@@ -241,6 +534,11 @@ func NewGlobalConfig(ctx context.Context) (*GlobalConfig, error) {
 		return nil, err
 	}
 
+	if err := config.Character.Slots.Validate(); err != nil {
+		log.Logger.WithContext(ctx).Errorf("character slots config: %v", err)
+		return nil, err
+	}
+
 	return config, nil
 }
 
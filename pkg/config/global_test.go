@@ -3,6 +3,7 @@ package config_test
 import (
 	"context"
 	"os"
+	"time"
 
 	"github.com/bxcodec/faker/v4"
 	. "github.com/onsi/ginkgo/v2"
@@ -65,6 +66,13 @@ var _ = Describe("Global config", func() {
 			Expect(conf.Character.Local.Host).To(Equal(val))
 		})
 
+		It("should reject character slots that allow no characters", func() {
+			GinkgoT().Setenv("SRO_CHARACTER_SLOTS_MAXCHARACTERS", "0")
+			conf, err := config.NewGlobalConfig(context.TODO())
+			Expect(err).To(MatchError(config.ErrNoCharacterSlots))
+			Expect(conf).To(BeNil())
+		})
+
 		// It("should read in from config file", func() {
 		// 	confFromFile := &config.GlobalConfig{}
 		// 	yamlFile, err := os.ReadFile("../../test/config.yaml")
@@ -75,6 +83,29 @@ var _ = Describe("Global config", func() {
 		// 	Expect(conf.Character.Keycloak.ClientSecret).To(Equal(confFromFile.Character.Keycloak.ClientSecret))
 		// })
 	})
+	Describe("SlotsConfig", func() {
+		It("should require at least one character", func() {
+			Expect(config.SlotsConfig{MaxCharacters: 1}.Validate()).To(Succeed())
+			Expect(config.SlotsConfig{MaxPerDimension: 1}.Validate()).To(MatchError(config.ErrNoCharacterSlots))
+		})
+	})
+	Describe("TopicConfig", func() {
+		It("should merge overrides", func() {
+			base := config.TopicConfig{
+				Partitions:        1,
+				ReplicationFactor: 3,
+				Retention:         time.Hour,
+			}
+			Expect(base.Merge(config.TopicConfig{})).To(Equal(base))
+			Expect(base.Merge(config.TopicConfig{Retention: time.Minute, Compact: true})).To(Equal(config.TopicConfig{
+				Partitions:        1,
+				ReplicationFactor: 3,
+				Retention:         time.Minute,
+				Compact:           true,
+			}))
+		})
+	})
+
 	Describe("Config helpers", func() {
 		var (
 			testStruct  *TestStruct
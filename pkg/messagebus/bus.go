@@ -0,0 +1,63 @@
+package messagebus
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/ShatteredRealms/go-backend/pkg/config"
+)
+
+var (
+	// ErrTopicNotFound thrown when subscribing to a topic that has not been created. Buses that deliver messages
+	// synchronously also throw it when publishing to such a topic.
+	ErrTopicNotFound = errors.New("topic not found")
+)
+
+// Topic to create
+type Topic struct {
+	Name string
+	config.TopicConfig
+}
+
+// Message published to a topic
+type Message struct {
+	Key   []byte
+	Value []byte
+
+	// Time the message was published. If it is zero when publishing, it is set to the current time.
+	Time time.Time
+}
+
+// Bus publish/subscribe message bus. Messages published to a topic are delivered to every subscription of the topic
+// in the order they were published for each key.
+type Bus interface {
+	// CreateTopics creates the topics. Topics that already exist are ignored.
+	CreateTopics(ctx context.Context, topics ...Topic) error
+
+	// ListTopics gets the names of the existing topics
+	ListTopics(ctx context.Context) ([]string, error)
+
+	// DeleteTopics deletes the topics and their messages
+	DeleteTopics(ctx context.Context, topics ...string) error
+
+	// Publish the messages to the topic. Buses that deliver messages asynchronously do not report delivery errors,
+	// including publishing to a topic that does not exist.
+	Publish(ctx context.Context, topic string, messages ...Message) error
+
+	// Subscribe reads the messages published to the topic after subscribing. If from is non-zero, the subscription
+	// starts at the first retained message published at or after that time instead.
+	Subscribe(ctx context.Context, topic string, from time.Time) (Subscription, error)
+
+	// Close releases the resources held by the bus
+	Close() error
+}
+
+// Subscription reads the messages published to a topic
+type Subscription interface {
+	// ReadMessage blocks until the next message is available or the context is done
+	ReadMessage(ctx context.Context) (Message, error)
+
+	// Close stops the subscription
+	Close() error
+}
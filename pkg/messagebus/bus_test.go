@@ -0,0 +1,225 @@
+package messagebus_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/ShatteredRealms/go-backend/pkg/config"
+	"github.com/ShatteredRealms/go-backend/pkg/messagebus"
+	"github.com/ShatteredRealms/go-backend/pkg/repository"
+	testdb "github.com/ShatteredRealms/go-backend/test/db"
+)
+
+var nextTopic = 1
+
+// busSpecs specs that every bus implementation should pass
+func busSpecs(newBus func() messagebus.Bus) {
+	var (
+		bus    messagebus.Bus
+		topic  string
+		ctx    context.Context
+		cancel context.CancelFunc
+	)
+
+	BeforeEach(func() {
+		bus = newBus()
+		topic = fmt.Sprintf("test-topic-%d", nextTopic)
+		nextTopic++
+		ctx, cancel = context.WithTimeout(context.Background(), 30*time.Second)
+		Expect(bus.CreateTopics(ctx, messagebus.Topic{Name: topic})).To(Succeed())
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	publish := func(values ...string) {
+		messages := make([]messagebus.Message, len(values))
+		for idx, value := range values {
+			messages[idx] = messagebus.Message{Value: []byte(value)}
+		}
+		Eventually(func() error {
+			return bus.Publish(ctx, topic, messages...)
+		}).Within(15 * time.Second).Should(Succeed())
+	}
+
+	read := func(sub messagebus.Subscription) string {
+		message, err := sub.ReadMessage(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(message.Time).NotTo(BeZero())
+		return string(message.Value)
+	}
+
+	It("should read messages published after subscribing in order", func() {
+		sub, err := bus.Subscribe(ctx, topic, time.Time{})
+		Expect(err).NotTo(HaveOccurred())
+		defer sub.Close()
+
+		publish("a", "b")
+		publish("c")
+		Expect(read(sub)).To(Equal("a"))
+		Expect(read(sub)).To(Equal("b"))
+		Expect(read(sub)).To(Equal("c"))
+	})
+
+	It("should deliver messages to every subscription", func() {
+		subA, err := bus.Subscribe(ctx, topic, time.Time{})
+		Expect(err).NotTo(HaveOccurred())
+		defer subA.Close()
+		subB, err := bus.Subscribe(ctx, topic, time.Time{})
+		Expect(err).NotTo(HaveOccurred())
+		defer subB.Close()
+
+		publish("a")
+		Expect(read(subA)).To(Equal("a"))
+		Expect(read(subB)).To(Equal("a"))
+	})
+
+	It("should subscribe from a time", func() {
+		start := time.Now().Add(-time.Minute)
+		Expect(bus.Publish(ctx, topic, messagebus.Message{Value: []byte("old"), Time: start.Add(-time.Minute)})).To(Succeed())
+		publish("a", "b")
+
+		sub, err := bus.Subscribe(ctx, topic, start)
+		Expect(err).NotTo(HaveOccurred())
+		defer sub.Close()
+		Expect(read(sub)).To(Equal("a"))
+		Expect(read(sub)).To(Equal("b"))
+	})
+
+	It("should list topics", func() {
+		Eventually(func(g Gomega) []string {
+			topics, err := bus.ListTopics(ctx)
+			g.Expect(err).NotTo(HaveOccurred())
+			return topics
+		}).Within(15 * time.Second).Should(ContainElement(topic))
+
+		Expect(bus.DeleteTopics(ctx, topic)).To(Succeed())
+		Eventually(func(g Gomega) []string {
+			topics, err := bus.ListTopics(ctx)
+			g.Expect(err).NotTo(HaveOccurred())
+			return topics
+		}).Within(15 * time.Second).ShouldNot(ContainElement(topic))
+	})
+
+	It("should stop reading when the context is done", func() {
+		sub, err := bus.Subscribe(ctx, topic, time.Time{})
+		Expect(err).NotTo(HaveOccurred())
+		defer sub.Close()
+
+		readCtx, readCancel := context.WithTimeout(ctx, 100*time.Millisecond)
+		defer readCancel()
+		_, err = sub.ReadMessage(readCtx)
+		Expect(err).To(HaveOccurred())
+	})
+}
+
+var _ = Describe("Bus", func() {
+	Describe("Memory", func() {
+		busSpecs(messagebus.NewMemoryBus)
+
+		It("should fail for unknown topics", func() {
+			bus := messagebus.NewMemoryBus()
+			Expect(bus.Publish(context.Background(), "unknown", messagebus.Message{})).To(MatchError(messagebus.ErrTopicNotFound))
+			Expect(bus.Subscribe(context.Background(), "unknown", time.Time{})).Error().To(MatchError(messagebus.ErrTopicNotFound))
+		})
+
+		It("should end subscriptions when the topic is deleted", func() {
+			bus := messagebus.NewMemoryBus()
+			Expect(bus.CreateTopics(context.Background(), messagebus.Topic{Name: "deleted"})).To(Succeed())
+			sub, err := bus.Subscribe(context.Background(), "deleted", time.Time{})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(bus.DeleteTopics(context.Background(), "deleted")).To(Succeed())
+			Expect(sub.ReadMessage(context.Background())).Error().To(HaveOccurred())
+			Expect(bus.Publish(context.Background(), "deleted", messagebus.Message{})).To(MatchError(messagebus.ErrTopicNotFound))
+		})
+
+		It("should end reads when the subscription is closed", func() {
+			bus := messagebus.NewMemoryBus()
+			Expect(bus.CreateTopics(context.Background(), messagebus.Topic{Name: "closed"})).To(Succeed())
+			sub, err := bus.Subscribe(context.Background(), "closed", time.Time{})
+			Expect(err).NotTo(HaveOccurred())
+
+			go func() {
+				defer GinkgoRecover()
+				time.Sleep(50 * time.Millisecond)
+				Expect(sub.Close()).To(Succeed())
+			}()
+			Expect(sub.ReadMessage(context.Background())).Error().To(HaveOccurred())
+		})
+
+		It("should only retain the latest messages", func() {
+			bus := messagebus.NewMemoryBus()
+			Expect(bus.CreateTopics(context.Background(), messagebus.Topic{Name: "retained"})).To(Succeed())
+			start := time.Now()
+			for i := 0; i < 5000; i++ {
+				Expect(bus.Publish(context.Background(), "retained", messagebus.Message{Value: []byte(fmt.Sprint(i))})).To(Succeed())
+			}
+
+			sub, err := bus.Subscribe(context.Background(), "retained", start)
+			Expect(err).NotTo(HaveOccurred())
+			message, err := sub.ReadMessage(context.Background())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(message.Value)).NotTo(Equal("0"))
+		})
+
+		It("should drop messages older than the retention", func() {
+			bus := messagebus.NewMemoryBus()
+			Expect(bus.CreateTopics(context.Background(), messagebus.Topic{
+				Name:        "expiring",
+				TopicConfig: config.TopicConfig{Retention: time.Hour},
+			})).To(Succeed())
+			start := time.Now().Add(-2 * time.Hour)
+			Expect(bus.Publish(context.Background(), "expiring",
+				messagebus.Message{Value: []byte("expired"), Time: start},
+				messagebus.Message{Value: []byte("kept")},
+			)).To(Succeed())
+
+			sub, err := bus.Subscribe(context.Background(), "expiring", start)
+			Expect(err).NotTo(HaveOccurred())
+			message, err := sub.ReadMessage(context.Background())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(message.Value)).To(Equal("kept"))
+		})
+	})
+
+	Describe("Kafka", Ordered, func() {
+		var (
+			cleanupFunc func()
+			bus         messagebus.Bus
+		)
+
+		BeforeAll(func() {
+			var kafkaPort string
+			cleanupFunc, kafkaPort = testdb.SetupKafkaWithDocker()
+			Eventually(func() error {
+				conn, err := repository.ConnectKafka(config.ServerAddress{
+					Port: kafkaPort,
+					Host: "127.0.0.1",
+				})
+				if err != nil {
+					return err
+				}
+				bus, err = messagebus.NewKafkaBus(conn, config.KafkaWriterConfig{
+					MaxOpen:     10,
+					IdleTimeout: time.Minute,
+				})
+				return err
+			}).Within(time.Minute).Should(Succeed())
+		})
+
+		AfterAll(func() {
+			_ = bus.Close()
+			cleanupFunc()
+		})
+
+		busSpecs(func() messagebus.Bus {
+			return bus
+		})
+	})
+})
@@ -0,0 +1,302 @@
+package messagebus
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ShatteredRealms/go-backend/pkg/config"
+	"github.com/ShatteredRealms/go-backend/pkg/log"
+	"github.com/segmentio/kafka-go"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/metric"
+)
+
+var (
+	meter = otel.Meter("messagebus")
+)
+
+type kafkaBus struct {
+	conn    *kafka.Conn
+	writers *WriterPool
+
+	deliveredCnt metric.Int64Counter
+	failedCnt    metric.Int64Counter
+}
+
+// kafkaSubscription reads every partition of a topic. Messages are ordered within each partition.
+type kafkaSubscription struct {
+	readers []*kafka.Reader
+
+	messages chan Message
+	errs     chan error
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewKafkaBus creates a bus that publishes messages to kafka so they are shared between replicas. Each topic is a
+// kafka topic. Messages are partitioned by key, so they are only read in the order they were published for each key
+// unless the topic has a single partition. Messages are written asynchronously, and failed deliveries are logged and
+// counted.
+func NewKafkaBus(conn *kafka.Conn, conf config.KafkaWriterConfig) (Bus, error) {
+	openCnt, err := meter.Int64UpDownCounter("sro.messagebus.writers.open",
+		metric.WithDescription("The number of open kafka writers"),
+		metric.WithUnit("{writer}"))
+	if err != nil {
+		return nil, fmt.Errorf("writers open metric: %w", err)
+	}
+
+	deliveredCnt, err := meter.Int64Counter("sro.messagebus.messages.delivered",
+		metric.WithDescription("The number of messages delivered to kafka"),
+		metric.WithUnit("{message}"))
+	if err != nil {
+		return nil, fmt.Errorf("messages delivered metric: %w", err)
+	}
+
+	failedCnt, err := meter.Int64Counter("sro.messagebus.messages.failed",
+		metric.WithDescription("The number of messages that failed to be delivered to kafka"),
+		metric.WithUnit("{message}"))
+	if err != nil {
+		return nil, fmt.Errorf("messages failed metric: %w", err)
+	}
+
+	b := &kafkaBus{
+		conn:         conn,
+		deliveredCnt: deliveredCnt,
+		failedCnt:    failedCnt,
+	}
+	b.writers = NewWriterPool(conf, b.newWriter, openCnt)
+
+	return b, nil
+}
+
+func (b *kafkaBus) CreateTopics(_ context.Context, topics ...Topic) error {
+	if len(topics) == 0 {
+		return nil
+	}
+
+	configs := make([]kafka.TopicConfig, len(topics))
+	for idx, topic := range topics {
+		configs[idx] = kafkaTopicConfig(topic)
+	}
+
+	return b.conn.CreateTopics(configs...)
+}
+
+func (b *kafkaBus) ListTopics(_ context.Context) ([]string, error) {
+	partitions, err := b.conn.ReadPartitions()
+	if err != nil {
+		return nil, fmt.Errorf("read partitions: %w", err)
+	}
+
+	seen := make(map[string]struct{})
+	topics := make([]string, 0, len(partitions))
+	for _, partition := range partitions {
+		// Skip internal kafka topics
+		if strings.HasPrefix(partition.Topic, "__") {
+			continue
+		}
+
+		if _, ok := seen[partition.Topic]; !ok {
+			seen[partition.Topic] = struct{}{}
+			topics = append(topics, partition.Topic)
+		}
+	}
+
+	return topics, nil
+}
+
+func (b *kafkaBus) DeleteTopics(_ context.Context, topics ...string) error {
+	if len(topics) == 0 {
+		return nil
+	}
+
+	for _, topic := range topics {
+		b.writers.Remove(topic)
+	}
+
+	return b.conn.DeleteTopics(topics...)
+}
+
+func (b *kafkaBus) Publish(ctx context.Context, topic string, messages ...Message) error {
+	kafkaMessages := make([]kafka.Message, len(messages))
+	for idx, message := range messages {
+		kafkaMessages[idx] = kafka.Message{
+			Key:   message.Key,
+			Value: message.Value,
+			Time:  message.Time,
+		}
+	}
+
+	w, release, err := b.writers.Acquire(topic)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	// Writers are async, so delivery errors such as a missing topic are only reported to the completion callback
+	return w.WriteMessages(ctx, kafkaMessages...)
+}
+
+func (b *kafkaBus) Subscribe(ctx context.Context, topic string, from time.Time) (Subscription, error) {
+	partitions, err := b.conn.ReadPartitions(topic)
+	if errors.Is(err, kafka.UnknownTopicOrPartition) || (err == nil && len(partitions) == 0) {
+		return nil, ErrTopicNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read partitions: %w", err)
+	}
+
+	readCtx, cancel := context.WithCancel(context.Background())
+	sub := &kafkaSubscription{
+		readers:  make([]*kafka.Reader, len(partitions)),
+		messages: make(chan Message),
+		errs:     make(chan error, len(partitions)),
+		cancel:   cancel,
+	}
+
+	for idx, partition := range partitions {
+		r := kafka.NewReader(kafka.ReaderConfig{
+			Brokers:   []string{b.conn.RemoteAddr().String()},
+			Topic:     topic,
+			Partition: partition.ID,
+			MinBytes:  1,
+			MaxBytes:  10e3,
+		})
+		sub.readers[idx] = r
+
+		if from.IsZero() {
+			_ = r.SetOffset(kafka.LastOffset)
+		} else if err := r.SetOffsetAt(ctx, from); err != nil {
+			_ = sub.Close()
+			return nil, err
+		}
+	}
+
+	for _, r := range sub.readers {
+		sub.wg.Add(1)
+		go sub.read(readCtx, r)
+	}
+
+	return sub, nil
+}
+
+// Close flushes and closes the open writers
+func (b *kafkaBus) Close() error {
+	return b.writers.Close()
+}
+
+func (b *kafkaBus) newWriter(topic string) *kafka.Writer {
+	return &kafka.Writer{
+		Addr:       b.conn.RemoteAddr(),
+		Topic:      topic,
+		Balancer:   &kafka.LeastBytes{},
+		Async:      true,
+		Completion: b.completion(topic),
+	}
+}
+
+// completion records the result of asynchronously delivering messages to the topic
+func (b *kafkaBus) completion(topic string) func(messages []kafka.Message, err error) {
+	return func(messages []kafka.Message, err error) {
+		if err != nil {
+			b.failedCnt.Add(context.Background(), int64(len(messages)))
+			log.Logger.Errorf("delivering %d messages to %s: %v", len(messages), topic, err)
+			return
+		}
+
+		b.deliveredCnt.Add(context.Background(), int64(len(messages)))
+	}
+}
+
+func (s *kafkaSubscription) ReadMessage(ctx context.Context) (Message, error) {
+	select {
+	case <-ctx.Done():
+		return Message{}, ctx.Err()
+	case err := <-s.errs:
+		return Message{}, err
+	case message := <-s.messages:
+		return message, nil
+	}
+}
+
+func (s *kafkaSubscription) Close() error {
+	s.cancel()
+
+	var errs []error
+	for _, r := range s.readers {
+		if r != nil {
+			errs = append(errs, r.Close())
+		}
+	}
+	s.wg.Wait()
+
+	return errors.Join(errs...)
+}
+
+// read forwards the messages read from the partition until the context is done or reading fails
+func (s *kafkaSubscription) read(ctx context.Context, r *kafka.Reader) {
+	defer s.wg.Done()
+
+	for {
+		message, err := r.ReadMessage(ctx)
+		if err != nil {
+			if ctx.Err() == nil {
+				s.errs <- err
+			}
+			return
+		}
+
+		select {
+		case s.messages <- Message{
+			Key:   message.Key,
+			Value: message.Value,
+			Time:  message.Time,
+		}:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// kafkaTopicConfig creates the kafka config for the topic. Topics have a single partition and replica by default.
+func kafkaTopicConfig(topic Topic) kafka.TopicConfig {
+	conf := kafka.TopicConfig{
+		Topic:             topic.Name,
+		NumPartitions:     max(topic.Partitions, 1),
+		ReplicationFactor: max(topic.ReplicationFactor, 1),
+	}
+
+	if topic.Retention > 0 {
+		conf.ConfigEntries = append(conf.ConfigEntries, kafka.ConfigEntry{
+			ConfigName:  "retention.ms",
+			ConfigValue: strconv.FormatInt(topic.Retention.Milliseconds(), 10),
+		})
+	}
+
+	if topic.RetentionBytes > 0 {
+		conf.ConfigEntries = append(conf.ConfigEntries, kafka.ConfigEntry{
+			ConfigName:  "retention.bytes",
+			ConfigValue: strconv.FormatInt(topic.RetentionBytes, 10),
+		})
+	}
+
+	if topic.Compact {
+		// Messages are still deleted once they exceed the retention when it is set
+		policy := "compact"
+		if topic.Retention > 0 || topic.RetentionBytes > 0 {
+			policy = "compact,delete"
+		}
+		conf.ConfigEntries = append(conf.ConfigEntries, kafka.ConfigEntry{
+			ConfigName:  "cleanup.policy",
+			ConfigValue: policy,
+		})
+	}
+
+	return conf
+}
@@ -0,0 +1,240 @@
+package messagebus
+
+import (
+	"context"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/ShatteredRealms/go-backend/pkg/config"
+)
+
+const (
+	// memoryRetention number of messages kept for each topic so subscriptions can start from a previous message
+	memoryRetention = 1000
+)
+
+type memoryBus struct {
+	mu     sync.Mutex
+	topics map[string]*memoryTopic
+}
+
+type memoryTopic struct {
+	conf config.TopicConfig
+
+	mu       sync.Mutex
+	messages []Message
+
+	// offset of the first retained message
+	offset int
+
+	// notify is closed and replaced whenever messages are published or the topic is deleted
+	notify  chan struct{}
+	deleted bool
+}
+
+type memorySubscription struct {
+	topic *memoryTopic
+
+	// next offset to read
+	next int
+
+	closeOnce sync.Once
+	closed    chan struct{}
+}
+
+// NewMemoryBus creates a bus that delivers messages within the process. Topics only retain the latest messages, and
+// are lost when the process exits, so it should only be used when running a single replica. Of the topic configs, only
+// the retention time is used.
+func NewMemoryBus() Bus {
+	return &memoryBus{
+		topics: make(map[string]*memoryTopic),
+	}
+}
+
+func (b *memoryBus) CreateTopics(_ context.Context, topics ...Topic) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for _, topic := range topics {
+		if _, ok := b.topics[topic.Name]; !ok {
+			b.topics[topic.Name] = &memoryTopic{
+				conf:   topic.TopicConfig,
+				notify: make(chan struct{}),
+			}
+		}
+	}
+
+	return nil
+}
+
+func (b *memoryBus) ListTopics(_ context.Context) ([]string, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	topics := make([]string, 0, len(b.topics))
+	for name := range b.topics {
+		topics = append(topics, name)
+	}
+
+	return topics, nil
+}
+
+func (b *memoryBus) DeleteTopics(_ context.Context, topics ...string) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for _, name := range topics {
+		if topic, ok := b.topics[name]; ok {
+			topic.delete()
+			delete(b.topics, name)
+		}
+	}
+
+	return nil
+}
+
+func (b *memoryBus) Publish(_ context.Context, topic string, messages ...Message) error {
+	t, err := b.topic(topic)
+	if err != nil {
+		return err
+	}
+
+	t.publish(messages)
+	return nil
+}
+
+func (b *memoryBus) Subscribe(_ context.Context, topic string, from time.Time) (Subscription, error) {
+	t, err := b.topic(topic)
+	if err != nil {
+		return nil, err
+	}
+
+	return &memorySubscription{
+		topic:  t,
+		next:   t.offsetAt(from),
+		closed: make(chan struct{}),
+	}, nil
+}
+
+func (b *memoryBus) Close() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for name, topic := range b.topics {
+		topic.delete()
+		delete(b.topics, name)
+	}
+
+	return nil
+}
+
+func (b *memoryBus) topic(name string) (*memoryTopic, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	topic, ok := b.topics[name]
+	if !ok {
+		return nil, ErrTopicNotFound
+	}
+
+	return topic, nil
+}
+
+func (t *memoryTopic) publish(messages []Message) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	now := time.Now()
+	for _, message := range messages {
+		if message.Time.IsZero() {
+			message.Time = now
+		}
+		t.messages = append(t.messages, message)
+	}
+
+	// Trim in batches so the retained messages are not copied on every publish
+	trimmed := 0
+	if len(t.messages) >= 2*memoryRetention {
+		trimmed = len(t.messages) - memoryRetention
+	}
+	if t.conf.Retention > 0 {
+		expired := now.Add(-t.conf.Retention)
+		for trimmed < len(t.messages) && t.messages[trimmed].Time.Before(expired) {
+			trimmed++
+		}
+	}
+	if trimmed > 0 {
+		t.messages = append([]Message(nil), t.messages[trimmed:]...)
+		t.offset += trimmed
+	}
+
+	close(t.notify)
+	t.notify = make(chan struct{})
+}
+
+// offsetAt gets the offset of the first retained message published at or after the time. If the time is zero, the
+// offset after the last message is returned.
+func (t *memoryTopic) offsetAt(from time.Time) int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if !from.IsZero() {
+		for idx, message := range t.messages {
+			if !message.Time.Before(from) {
+				return t.offset + idx
+			}
+		}
+	}
+
+	return t.offset + len(t.messages)
+}
+
+func (t *memoryTopic) delete() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.deleted = true
+	t.messages = nil
+	close(t.notify)
+	t.notify = make(chan struct{})
+}
+
+func (s *memorySubscription) ReadMessage(ctx context.Context) (Message, error) {
+	for {
+		s.topic.mu.Lock()
+		if s.topic.deleted {
+			s.topic.mu.Unlock()
+			return Message{}, io.EOF
+		}
+
+		if s.next < s.topic.offset {
+			s.next = s.topic.offset
+		}
+		if idx := s.next - s.topic.offset; idx < len(s.topic.messages) {
+			message := s.topic.messages[idx]
+			s.next++
+			s.topic.mu.Unlock()
+			return message, nil
+		}
+
+		notify := s.topic.notify
+		s.topic.mu.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return Message{}, ctx.Err()
+		case <-s.closed:
+			return Message{}, io.EOF
+		case <-notify:
+		}
+	}
+}
+
+func (s *memorySubscription) Close() error {
+	s.closeOnce.Do(func() {
+		close(s.closed)
+	})
+
+	return nil
+}
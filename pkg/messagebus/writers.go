@@ -0,0 +1,230 @@
+package messagebus
+
+import (
+	"container/list"
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/ShatteredRealms/go-backend/pkg/config"
+	"github.com/ShatteredRealms/go-backend/pkg/log"
+	"github.com/segmentio/kafka-go"
+	"go.opentelemetry.io/otel/metric"
+)
+
+var (
+	// ErrClosed thrown when using a writer pool or bus after it has been closed
+	ErrClosed = errors.New("closed")
+)
+
+// WriterPool keeps a kafka writer open for each topic that is published to. Writers are closed once they have been
+// idle for the configured timeout, or when more than the configured number of writers are open, starting with the
+// least recently used. Closing a writer flushes the messages it has buffered.
+type WriterPool struct {
+	conf      config.KafkaWriterConfig
+	newWriter func(topic string) *kafka.Writer
+	openCnt   metric.Int64UpDownCounter
+
+	mu      sync.Mutex
+	writers map[string]*list.Element
+
+	// lru writers ordered from most to least recently used
+	lru    *list.List
+	closed bool
+
+	done    chan struct{}
+	closing sync.WaitGroup
+}
+
+type pooledWriter struct {
+	topic    string
+	writer   *kafka.Writer
+	lastUsed time.Time
+
+	// inUse number of publishers using the writer. Writers in use are not evicted.
+	inUse int
+
+	// removed whether the writer was removed from the pool while in use, so it is closed once it is released
+	removed bool
+}
+
+// NewWriterPool creates a writer pool that uses newWriter to create the writer for a topic. The number of open writers
+// is recorded with openCnt if it is not nil.
+func NewWriterPool(
+	conf config.KafkaWriterConfig,
+	newWriter func(topic string) *kafka.Writer,
+	openCnt metric.Int64UpDownCounter,
+) *WriterPool {
+	p := &WriterPool{
+		conf:      conf,
+		newWriter: newWriter,
+		openCnt:   openCnt,
+		writers:   make(map[string]*list.Element),
+		lru:       list.New(),
+		done:      make(chan struct{}),
+	}
+
+	if conf.IdleTimeout > 0 {
+		go p.evictIdleWriters()
+	}
+
+	return p
+}
+
+// Acquire gets the writer for the topic, creating it if needed. The writer is not closed by the pool until it is
+// released.
+func (p *WriterPool) Acquire(topic string) (*kafka.Writer, func(), error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.closed {
+		return nil, nil, ErrClosed
+	}
+
+	elem, ok := p.writers[topic]
+	if ok {
+		p.lru.MoveToFront(elem)
+	} else {
+		elem = p.lru.PushFront(&pooledWriter{
+			topic:  topic,
+			writer: p.newWriter(topic),
+		})
+		p.writers[topic] = elem
+		p.recordOpen(1)
+	}
+
+	entry := elem.Value.(*pooledWriter)
+	entry.inUse++
+	entry.lastUsed = time.Now()
+
+	p.evictLeastRecentlyUsed()
+
+	var once sync.Once
+	release := func() {
+		once.Do(func() {
+			p.mu.Lock()
+			defer p.mu.Unlock()
+
+			entry.inUse--
+			entry.lastUsed = time.Now()
+			if entry.removed && entry.inUse == 0 {
+				go p.close(entry)
+			}
+		})
+	}
+
+	return entry.writer, release, nil
+}
+
+// Remove closes the writer for the topic if one is open. A writer that is in use is closed once it is released, and
+// the topic gets a new writer when it is acquired again.
+func (p *WriterPool) Remove(topic string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if elem, ok := p.writers[topic]; ok {
+		p.evict(elem)
+	}
+}
+
+// Len gets the number of open writers
+func (p *WriterPool) Len() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.lru.Len()
+}
+
+// Close closes every writer, waiting for their buffered messages to be flushed and for the writers in use to be
+// released
+func (p *WriterPool) Close() error {
+	p.mu.Lock()
+	if p.closed {
+		p.mu.Unlock()
+		return nil
+	}
+
+	p.closed = true
+	close(p.done)
+	for p.lru.Len() > 0 {
+		p.evict(p.lru.Back())
+	}
+	p.mu.Unlock()
+
+	p.closing.Wait()
+	return nil
+}
+
+// evictLeastRecentlyUsed closes the least recently used writers that are not in use until at most MaxOpen writers
+// are open. The caller must hold the lock.
+func (p *WriterPool) evictLeastRecentlyUsed() {
+	if p.conf.MaxOpen <= 0 {
+		return
+	}
+
+	for elem := p.lru.Back(); elem != nil && p.lru.Len() > p.conf.MaxOpen; {
+		prev := elem.Prev()
+		if elem.Value.(*pooledWriter).inUse == 0 {
+			p.evict(elem)
+		}
+		elem = prev
+	}
+}
+
+// evictIdleWriters periodically closes writers that have not been used within the idle timeout
+func (p *WriterPool) evictIdleWriters() {
+	ticker := time.NewTicker(p.conf.IdleTimeout / 2)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-p.done:
+			return
+
+		case now := <-ticker.C:
+			p.mu.Lock()
+			for elem := p.lru.Back(); elem != nil; {
+				prev := elem.Prev()
+				entry := elem.Value.(*pooledWriter)
+				if entry.inUse == 0 && now.Sub(entry.lastUsed) >= p.conf.IdleTimeout {
+					p.evict(elem)
+				}
+				elem = prev
+			}
+			p.mu.Unlock()
+		}
+	}
+}
+
+// evict removes the writer from the pool and closes it in the background so publishers are not blocked while its
+// buffered messages are flushed. A writer in use is closed once the last publisher releases it. The caller must hold
+// the lock.
+func (p *WriterPool) evict(elem *list.Element) {
+	entry := p.lru.Remove(elem).(*pooledWriter)
+	delete(p.writers, entry.topic)
+
+	p.closing.Add(1)
+	if entry.inUse > 0 {
+		entry.removed = true
+		return
+	}
+
+	go p.close(entry)
+}
+
+// close closes the evicted writer
+func (p *WriterPool) close(entry *pooledWriter) {
+	defer p.closing.Done()
+	defer p.recordOpen(-1)
+
+	if err := entry.writer.Close(); err != nil {
+		log.Logger.Errorf("closing writer for %s: %v", entry.topic, err)
+	}
+}
+
+func (p *WriterPool) recordOpen(delta int64) {
+	if p.openCnt != nil {
+		p.openCnt.Add(context.Background(), delta)
+	}
+}
@@ -0,0 +1,175 @@
+package messagebus_test
+
+import (
+	"context"
+	"io"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/segmentio/kafka-go"
+
+	"github.com/ShatteredRealms/go-backend/pkg/config"
+	"github.com/ShatteredRealms/go-backend/pkg/messagebus"
+)
+
+var _ = Describe("WriterPool", func() {
+	var (
+		pool    *messagebus.WriterPool
+		created []string
+		mu      sync.Mutex
+	)
+
+	newPool := func(conf config.KafkaWriterConfig) *messagebus.WriterPool {
+		return messagebus.NewWriterPool(conf, func(topic string) *kafka.Writer {
+			mu.Lock()
+			defer mu.Unlock()
+			created = append(created, topic)
+			return &kafka.Writer{Addr: kafka.TCP("localhost:9092"), Topic: topic}
+		}, nil)
+	}
+
+	BeforeEach(func() {
+		created = nil
+	})
+
+	AfterEach(func() {
+		Expect(pool.Close()).To(Succeed())
+	})
+
+	It("should reuse writers for a topic", func() {
+		pool = newPool(config.KafkaWriterConfig{MaxOpen: 10})
+		a, release, err := pool.Acquire("a")
+		Expect(err).NotTo(HaveOccurred())
+		release()
+
+		b, release, err := pool.Acquire("a")
+		Expect(err).NotTo(HaveOccurred())
+		release()
+
+		Expect(b).To(BeIdenticalTo(a))
+		Expect(created).To(Equal([]string{"a"}))
+		Expect(pool.Len()).To(Equal(1))
+	})
+
+	It("should close the least recently used writers", func() {
+		pool = newPool(config.KafkaWriterConfig{MaxOpen: 2})
+		for _, topic := range []string{"a", "b", "a", "c"} {
+			_, release, err := pool.Acquire(topic)
+			Expect(err).NotTo(HaveOccurred())
+			release()
+		}
+		Expect(pool.Len()).To(Equal(2))
+
+		_, release, err := pool.Acquire("a")
+		Expect(err).NotTo(HaveOccurred())
+		release()
+		Expect(created).To(Equal([]string{"a", "b", "c"}))
+	})
+
+	It("should not close writers that are in use", func() {
+		pool = newPool(config.KafkaWriterConfig{MaxOpen: 1})
+		_, releaseA, err := pool.Acquire("a")
+		Expect(err).NotTo(HaveOccurred())
+		_, releaseB, err := pool.Acquire("b")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(pool.Len()).To(Equal(2))
+
+		releaseA()
+		releaseB()
+		_, release, err := pool.Acquire("b")
+		Expect(err).NotTo(HaveOccurred())
+		release()
+		Expect(pool.Len()).To(Equal(1))
+	})
+
+	It("should close idle writers", func() {
+		pool = newPool(config.KafkaWriterConfig{IdleTimeout: 100 * time.Millisecond})
+		_, release, err := pool.Acquire("a")
+		Expect(err).NotTo(HaveOccurred())
+		release()
+		Expect(pool.Len()).To(Equal(1))
+
+		Eventually(pool.Len).Within(time.Second).Should(Equal(0))
+	})
+
+	It("should remove writers", func() {
+		pool = newPool(config.KafkaWriterConfig{})
+		_, release, err := pool.Acquire("a")
+		Expect(err).NotTo(HaveOccurred())
+		release()
+
+		pool.Remove("a")
+		Expect(pool.Len()).To(Equal(0))
+	})
+
+	It("should close removed writers once they are released", func() {
+		pool = newPool(config.KafkaWriterConfig{})
+		w, release, err := pool.Acquire("a")
+		Expect(err).NotTo(HaveOccurred())
+
+		pool.Remove("a")
+		Expect(pool.Len()).To(Equal(0))
+		Expect(w.WriteMessages(context.Background())).To(Succeed())
+
+		next, releaseNext, err := pool.Acquire("a")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(next).NotTo(BeIdenticalTo(w))
+		releaseNext()
+
+		release()
+		Eventually(func() error {
+			return w.WriteMessages(context.Background())
+		}).Within(time.Second).Should(MatchError(io.ErrClosedPipe))
+	})
+
+	It("should wait for writers in use to be released when closing", func() {
+		pool = newPool(config.KafkaWriterConfig{})
+		w, release, err := pool.Acquire("a")
+		Expect(err).NotTo(HaveOccurred())
+
+		closed := make(chan struct{})
+		go func() {
+			defer close(closed)
+			Expect(pool.Close()).To(Succeed())
+		}()
+		Consistently(closed).Within(100 * time.Millisecond).ShouldNot(BeClosed())
+		Expect(w.WriteMessages(context.Background())).To(Succeed())
+
+		release()
+		Eventually(closed).Within(time.Second).Should(BeClosed())
+		Expect(w.WriteMessages(context.Background())).To(MatchError(io.ErrClosedPipe))
+	})
+
+	It("should be safe for concurrent use", func() {
+		pool = newPool(config.KafkaWriterConfig{MaxOpen: 5})
+		var wg sync.WaitGroup
+		for i := 0; i < 20; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer GinkgoRecover()
+				defer wg.Done()
+				for j := 0; j < 100; j++ {
+					_, release, err := pool.Acquire(string(rune('a' + (i+j)%10)))
+					Expect(err).NotTo(HaveOccurred())
+					release()
+				}
+			}(i)
+		}
+		wg.Wait()
+		Expect(pool.Len()).To(BeNumerically("<=", 5))
+	})
+
+	It("should not be usable after closing", func() {
+		pool = newPool(config.KafkaWriterConfig{})
+		_, release, err := pool.Acquire("a")
+		Expect(err).NotTo(HaveOccurred())
+		release()
+
+		Expect(pool.Close()).To(Succeed())
+		Expect(pool.Len()).To(Equal(0))
+		_, _, err = pool.Acquire("a")
+		Expect(err).To(MatchError(messagebus.ErrClosed))
+	})
+})
@@ -42,6 +42,26 @@ func (m *MockCharacterServiceClient) EXPECT() *MockCharacterServiceClientMockRec
 	return m.recorder
 }
 
+// AddCharacterExperience mocks base method.
+func (m *MockCharacterServiceClient) AddCharacterExperience(ctx context.Context, in *pb.AddExperienceRequest, opts ...grpc.CallOption) (*pb.ExperienceResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddCharacterExperience", varargs...)
+	ret0, _ := ret[0].(*pb.ExperienceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddCharacterExperience indicates an expected call of AddCharacterExperience.
+func (mr *MockCharacterServiceClientMockRecorder) AddCharacterExperience(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddCharacterExperience", reflect.TypeOf((*MockCharacterServiceClient)(nil).AddCharacterExperience), varargs...)
+}
+
 // AddCharacterPlayTime mocks base method.
 func (m *MockCharacterServiceClient) AddCharacterPlayTime(ctx context.Context, in *pb.AddPlayTimeRequest, opts ...grpc.CallOption) (*pb.PlayTimeResponse, error) {
 	m.ctrl.T.Helper()
@@ -62,6 +82,26 @@ func (mr *MockCharacterServiceClientMockRecorder) AddCharacterPlayTime(ctx, in a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddCharacterPlayTime", reflect.TypeOf((*MockCharacterServiceClient)(nil).AddCharacterPlayTime), varargs...)
 }
 
+// AddInventoryItem mocks base method.
+func (m *MockCharacterServiceClient) AddInventoryItem(ctx context.Context, in *pb.AddInventoryItemRequest, opts ...grpc.CallOption) (*pb.Inventory, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddInventoryItem", varargs...)
+	ret0, _ := ret[0].(*pb.Inventory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddInventoryItem indicates an expected call of AddInventoryItem.
+func (mr *MockCharacterServiceClientMockRecorder) AddInventoryItem(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddInventoryItem", reflect.TypeOf((*MockCharacterServiceClient)(nil).AddInventoryItem), varargs...)
+}
+
 // CreateCharacter mocks base method.
 func (m *MockCharacterServiceClient) CreateCharacter(ctx context.Context, in *pb.CreateCharacterRequest, opts ...grpc.CallOption) (*pb.CharacterDetails, error) {
 	m.ctrl.T.Helper()
@@ -102,6 +142,26 @@ func (mr *MockCharacterServiceClientMockRecorder) DeleteCharacter(ctx, in any, o
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCharacter", reflect.TypeOf((*MockCharacterServiceClient)(nil).DeleteCharacter), varargs...)
 }
 
+// EditAppearance mocks base method.
+func (m *MockCharacterServiceClient) EditAppearance(ctx context.Context, in *pb.EditAppearanceRequest, opts ...grpc.CallOption) (*pb.CharacterAppearance, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "EditAppearance", varargs...)
+	ret0, _ := ret[0].(*pb.CharacterAppearance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EditAppearance indicates an expected call of EditAppearance.
+func (mr *MockCharacterServiceClientMockRecorder) EditAppearance(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EditAppearance", reflect.TypeOf((*MockCharacterServiceClient)(nil).EditAppearance), varargs...)
+}
+
 // EditCharacter mocks base method.
 func (m *MockCharacterServiceClient) EditCharacter(ctx context.Context, in *pb.EditCharacterRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	m.ctrl.T.Helper()
@@ -162,6 +222,46 @@ func (mr *MockCharacterServiceClientMockRecorder) GetCharacter(ctx, in any, opts
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCharacter", reflect.TypeOf((*MockCharacterServiceClient)(nil).GetCharacter), varargs...)
 }
 
+// GetCharacterCreationOptions mocks base method.
+func (m *MockCharacterServiceClient) GetCharacterCreationOptions(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*pb.CharacterCreationOptions, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetCharacterCreationOptions", varargs...)
+	ret0, _ := ret[0].(*pb.CharacterCreationOptions)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCharacterCreationOptions indicates an expected call of GetCharacterCreationOptions.
+func (mr *MockCharacterServiceClientMockRecorder) GetCharacterCreationOptions(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCharacterCreationOptions", reflect.TypeOf((*MockCharacterServiceClient)(nil).GetCharacterCreationOptions), varargs...)
+}
+
+// GetCharacterNameHistory mocks base method.
+func (m *MockCharacterServiceClient) GetCharacterNameHistory(ctx context.Context, in *pb.CharacterTarget, opts ...grpc.CallOption) (*pb.CharacterNameHistory, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetCharacterNameHistory", varargs...)
+	ret0, _ := ret[0].(*pb.CharacterNameHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCharacterNameHistory indicates an expected call of GetCharacterNameHistory.
+func (mr *MockCharacterServiceClientMockRecorder) GetCharacterNameHistory(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCharacterNameHistory", reflect.TypeOf((*MockCharacterServiceClient)(nil).GetCharacterNameHistory), varargs...)
+}
+
 // GetCharacters mocks base method.
 func (m *MockCharacterServiceClient) GetCharacters(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*pb.CharactersDetails, error) {
 	m.ctrl.T.Helper()
@@ -202,6 +302,146 @@ func (mr *MockCharacterServiceClientMockRecorder) GetInventory(ctx, in any, opts
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInventory", reflect.TypeOf((*MockCharacterServiceClient)(nil).GetInventory), varargs...)
 }
 
+// GetInventoryHistory mocks base method.
+func (m *MockCharacterServiceClient) GetInventoryHistory(ctx context.Context, in *pb.InventoryHistoryRequest, opts ...grpc.CallOption) (*pb.InventoryEvents, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetInventoryHistory", varargs...)
+	ret0, _ := ret[0].(*pb.InventoryEvents)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetInventoryHistory indicates an expected call of GetInventoryHistory.
+func (mr *MockCharacterServiceClientMockRecorder) GetInventoryHistory(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInventoryHistory", reflect.TypeOf((*MockCharacterServiceClient)(nil).GetInventoryHistory), varargs...)
+}
+
+// GrantCharacterSlots mocks base method.
+func (m *MockCharacterServiceClient) GrantCharacterSlots(ctx context.Context, in *pb.GrantCharacterSlotsRequest, opts ...grpc.CallOption) (*pb.CharacterSlots, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GrantCharacterSlots", varargs...)
+	ret0, _ := ret[0].(*pb.CharacterSlots)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GrantCharacterSlots indicates an expected call of GrantCharacterSlots.
+func (mr *MockCharacterServiceClientMockRecorder) GrantCharacterSlots(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GrantCharacterSlots", reflect.TypeOf((*MockCharacterServiceClient)(nil).GrantCharacterSlots), varargs...)
+}
+
+// MergeInventoryStack mocks base method.
+func (m *MockCharacterServiceClient) MergeInventoryStack(ctx context.Context, in *pb.MergeInventoryStackRequest, opts ...grpc.CallOption) (*pb.Inventory, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "MergeInventoryStack", varargs...)
+	ret0, _ := ret[0].(*pb.Inventory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MergeInventoryStack indicates an expected call of MergeInventoryStack.
+func (mr *MockCharacterServiceClientMockRecorder) MergeInventoryStack(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeInventoryStack", reflect.TypeOf((*MockCharacterServiceClient)(nil).MergeInventoryStack), varargs...)
+}
+
+// MoveInventoryItem mocks base method.
+func (m *MockCharacterServiceClient) MoveInventoryItem(ctx context.Context, in *pb.MoveInventoryItemRequest, opts ...grpc.CallOption) (*pb.Inventory, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "MoveInventoryItem", varargs...)
+	ret0, _ := ret[0].(*pb.Inventory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveInventoryItem indicates an expected call of MoveInventoryItem.
+func (mr *MockCharacterServiceClientMockRecorder) MoveInventoryItem(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveInventoryItem", reflect.TypeOf((*MockCharacterServiceClient)(nil).MoveInventoryItem), varargs...)
+}
+
+// RemoveInventoryItem mocks base method.
+func (m *MockCharacterServiceClient) RemoveInventoryItem(ctx context.Context, in *pb.RemoveInventoryItemRequest, opts ...grpc.CallOption) (*pb.Inventory, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RemoveInventoryItem", varargs...)
+	ret0, _ := ret[0].(*pb.Inventory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveInventoryItem indicates an expected call of RemoveInventoryItem.
+func (mr *MockCharacterServiceClientMockRecorder) RemoveInventoryItem(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveInventoryItem", reflect.TypeOf((*MockCharacterServiceClient)(nil).RemoveInventoryItem), varargs...)
+}
+
+// RenameCharacter mocks base method.
+func (m *MockCharacterServiceClient) RenameCharacter(ctx context.Context, in *pb.RenameCharacterRequest, opts ...grpc.CallOption) (*pb.CharacterDetails, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RenameCharacter", varargs...)
+	ret0, _ := ret[0].(*pb.CharacterDetails)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RenameCharacter indicates an expected call of RenameCharacter.
+func (mr *MockCharacterServiceClientMockRecorder) RenameCharacter(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenameCharacter", reflect.TypeOf((*MockCharacterServiceClient)(nil).RenameCharacter), varargs...)
+}
+
+// RestoreInventory mocks base method.
+func (m *MockCharacterServiceClient) RestoreInventory(ctx context.Context, in *pb.RestoreInventoryRequest, opts ...grpc.CallOption) (*pb.Inventory, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RestoreInventory", varargs...)
+	ret0, _ := ret[0].(*pb.Inventory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreInventory indicates an expected call of RestoreInventory.
+func (mr *MockCharacterServiceClientMockRecorder) RestoreInventory(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreInventory", reflect.TypeOf((*MockCharacterServiceClient)(nil).RestoreInventory), varargs...)
+}
+
 // SetInventory mocks base method.
 func (m *MockCharacterServiceClient) SetInventory(ctx context.Context, in *pb.UpdateInventoryRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	m.ctrl.T.Helper()
@@ -222,6 +462,46 @@ func (mr *MockCharacterServiceClientMockRecorder) SetInventory(ctx, in any, opts
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetInventory", reflect.TypeOf((*MockCharacterServiceClient)(nil).SetInventory), varargs...)
 }
 
+// SplitInventoryStack mocks base method.
+func (m *MockCharacterServiceClient) SplitInventoryStack(ctx context.Context, in *pb.SplitInventoryStackRequest, opts ...grpc.CallOption) (*pb.Inventory, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SplitInventoryStack", varargs...)
+	ret0, _ := ret[0].(*pb.Inventory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SplitInventoryStack indicates an expected call of SplitInventoryStack.
+func (mr *MockCharacterServiceClientMockRecorder) SplitInventoryStack(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SplitInventoryStack", reflect.TypeOf((*MockCharacterServiceClient)(nil).SplitInventoryStack), varargs...)
+}
+
+// TransferInventoryItem mocks base method.
+func (m *MockCharacterServiceClient) TransferInventoryItem(ctx context.Context, in *pb.TransferInventoryItemRequest, opts ...grpc.CallOption) (*pb.Inventory, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "TransferInventoryItem", varargs...)
+	ret0, _ := ret[0].(*pb.Inventory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TransferInventoryItem indicates an expected call of TransferInventoryItem.
+func (mr *MockCharacterServiceClientMockRecorder) TransferInventoryItem(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransferInventoryItem", reflect.TypeOf((*MockCharacterServiceClient)(nil).TransferInventoryItem), varargs...)
+}
+
 // MockCharacterServiceServer is a mock of CharacterServiceServer interface.
 type MockCharacterServiceServer struct {
 	ctrl     *gomock.Controller
@@ -245,6 +525,21 @@ func (m *MockCharacterServiceServer) EXPECT() *MockCharacterServiceServerMockRec
 	return m.recorder
 }
 
+// AddCharacterExperience mocks base method.
+func (m *MockCharacterServiceServer) AddCharacterExperience(arg0 context.Context, arg1 *pb.AddExperienceRequest) (*pb.ExperienceResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddCharacterExperience", arg0, arg1)
+	ret0, _ := ret[0].(*pb.ExperienceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddCharacterExperience indicates an expected call of AddCharacterExperience.
+func (mr *MockCharacterServiceServerMockRecorder) AddCharacterExperience(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddCharacterExperience", reflect.TypeOf((*MockCharacterServiceServer)(nil).AddCharacterExperience), arg0, arg1)
+}
+
 // AddCharacterPlayTime mocks base method.
 func (m *MockCharacterServiceServer) AddCharacterPlayTime(arg0 context.Context, arg1 *pb.AddPlayTimeRequest) (*pb.PlayTimeResponse, error) {
 	m.ctrl.T.Helper()
@@ -260,6 +555,21 @@ func (mr *MockCharacterServiceServerMockRecorder) AddCharacterPlayTime(arg0, arg
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddCharacterPlayTime", reflect.TypeOf((*MockCharacterServiceServer)(nil).AddCharacterPlayTime), arg0, arg1)
 }
 
+// AddInventoryItem mocks base method.
+func (m *MockCharacterServiceServer) AddInventoryItem(arg0 context.Context, arg1 *pb.AddInventoryItemRequest) (*pb.Inventory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddInventoryItem", arg0, arg1)
+	ret0, _ := ret[0].(*pb.Inventory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddInventoryItem indicates an expected call of AddInventoryItem.
+func (mr *MockCharacterServiceServerMockRecorder) AddInventoryItem(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddInventoryItem", reflect.TypeOf((*MockCharacterServiceServer)(nil).AddInventoryItem), arg0, arg1)
+}
+
 // CreateCharacter mocks base method.
 func (m *MockCharacterServiceServer) CreateCharacter(arg0 context.Context, arg1 *pb.CreateCharacterRequest) (*pb.CharacterDetails, error) {
 	m.ctrl.T.Helper()
@@ -290,6 +600,21 @@ func (mr *MockCharacterServiceServerMockRecorder) DeleteCharacter(arg0, arg1 any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCharacter", reflect.TypeOf((*MockCharacterServiceServer)(nil).DeleteCharacter), arg0, arg1)
 }
 
+// EditAppearance mocks base method.
+func (m *MockCharacterServiceServer) EditAppearance(arg0 context.Context, arg1 *pb.EditAppearanceRequest) (*pb.CharacterAppearance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EditAppearance", arg0, arg1)
+	ret0, _ := ret[0].(*pb.CharacterAppearance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EditAppearance indicates an expected call of EditAppearance.
+func (mr *MockCharacterServiceServerMockRecorder) EditAppearance(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EditAppearance", reflect.TypeOf((*MockCharacterServiceServer)(nil).EditAppearance), arg0, arg1)
+}
+
 // EditCharacter mocks base method.
 func (m *MockCharacterServiceServer) EditCharacter(arg0 context.Context, arg1 *pb.EditCharacterRequest) (*emptypb.Empty, error) {
 	m.ctrl.T.Helper()
@@ -335,6 +660,36 @@ func (mr *MockCharacterServiceServerMockRecorder) GetCharacter(arg0, arg1 any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCharacter", reflect.TypeOf((*MockCharacterServiceServer)(nil).GetCharacter), arg0, arg1)
 }
 
+// GetCharacterCreationOptions mocks base method.
+func (m *MockCharacterServiceServer) GetCharacterCreationOptions(arg0 context.Context, arg1 *emptypb.Empty) (*pb.CharacterCreationOptions, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCharacterCreationOptions", arg0, arg1)
+	ret0, _ := ret[0].(*pb.CharacterCreationOptions)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCharacterCreationOptions indicates an expected call of GetCharacterCreationOptions.
+func (mr *MockCharacterServiceServerMockRecorder) GetCharacterCreationOptions(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCharacterCreationOptions", reflect.TypeOf((*MockCharacterServiceServer)(nil).GetCharacterCreationOptions), arg0, arg1)
+}
+
+// GetCharacterNameHistory mocks base method.
+func (m *MockCharacterServiceServer) GetCharacterNameHistory(arg0 context.Context, arg1 *pb.CharacterTarget) (*pb.CharacterNameHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCharacterNameHistory", arg0, arg1)
+	ret0, _ := ret[0].(*pb.CharacterNameHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCharacterNameHistory indicates an expected call of GetCharacterNameHistory.
+func (mr *MockCharacterServiceServerMockRecorder) GetCharacterNameHistory(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCharacterNameHistory", reflect.TypeOf((*MockCharacterServiceServer)(nil).GetCharacterNameHistory), arg0, arg1)
+}
+
 // GetCharacters mocks base method.
 func (m *MockCharacterServiceServer) GetCharacters(arg0 context.Context, arg1 *emptypb.Empty) (*pb.CharactersDetails, error) {
 	m.ctrl.T.Helper()
@@ -365,6 +720,111 @@ func (mr *MockCharacterServiceServerMockRecorder) GetInventory(arg0, arg1 any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInventory", reflect.TypeOf((*MockCharacterServiceServer)(nil).GetInventory), arg0, arg1)
 }
 
+// GetInventoryHistory mocks base method.
+func (m *MockCharacterServiceServer) GetInventoryHistory(arg0 context.Context, arg1 *pb.InventoryHistoryRequest) (*pb.InventoryEvents, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInventoryHistory", arg0, arg1)
+	ret0, _ := ret[0].(*pb.InventoryEvents)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetInventoryHistory indicates an expected call of GetInventoryHistory.
+func (mr *MockCharacterServiceServerMockRecorder) GetInventoryHistory(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInventoryHistory", reflect.TypeOf((*MockCharacterServiceServer)(nil).GetInventoryHistory), arg0, arg1)
+}
+
+// GrantCharacterSlots mocks base method.
+func (m *MockCharacterServiceServer) GrantCharacterSlots(arg0 context.Context, arg1 *pb.GrantCharacterSlotsRequest) (*pb.CharacterSlots, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GrantCharacterSlots", arg0, arg1)
+	ret0, _ := ret[0].(*pb.CharacterSlots)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GrantCharacterSlots indicates an expected call of GrantCharacterSlots.
+func (mr *MockCharacterServiceServerMockRecorder) GrantCharacterSlots(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GrantCharacterSlots", reflect.TypeOf((*MockCharacterServiceServer)(nil).GrantCharacterSlots), arg0, arg1)
+}
+
+// MergeInventoryStack mocks base method.
+func (m *MockCharacterServiceServer) MergeInventoryStack(arg0 context.Context, arg1 *pb.MergeInventoryStackRequest) (*pb.Inventory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MergeInventoryStack", arg0, arg1)
+	ret0, _ := ret[0].(*pb.Inventory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MergeInventoryStack indicates an expected call of MergeInventoryStack.
+func (mr *MockCharacterServiceServerMockRecorder) MergeInventoryStack(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeInventoryStack", reflect.TypeOf((*MockCharacterServiceServer)(nil).MergeInventoryStack), arg0, arg1)
+}
+
+// MoveInventoryItem mocks base method.
+func (m *MockCharacterServiceServer) MoveInventoryItem(arg0 context.Context, arg1 *pb.MoveInventoryItemRequest) (*pb.Inventory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveInventoryItem", arg0, arg1)
+	ret0, _ := ret[0].(*pb.Inventory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveInventoryItem indicates an expected call of MoveInventoryItem.
+func (mr *MockCharacterServiceServerMockRecorder) MoveInventoryItem(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveInventoryItem", reflect.TypeOf((*MockCharacterServiceServer)(nil).MoveInventoryItem), arg0, arg1)
+}
+
+// RemoveInventoryItem mocks base method.
+func (m *MockCharacterServiceServer) RemoveInventoryItem(arg0 context.Context, arg1 *pb.RemoveInventoryItemRequest) (*pb.Inventory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveInventoryItem", arg0, arg1)
+	ret0, _ := ret[0].(*pb.Inventory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveInventoryItem indicates an expected call of RemoveInventoryItem.
+func (mr *MockCharacterServiceServerMockRecorder) RemoveInventoryItem(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveInventoryItem", reflect.TypeOf((*MockCharacterServiceServer)(nil).RemoveInventoryItem), arg0, arg1)
+}
+
+// RenameCharacter mocks base method.
+func (m *MockCharacterServiceServer) RenameCharacter(arg0 context.Context, arg1 *pb.RenameCharacterRequest) (*pb.CharacterDetails, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenameCharacter", arg0, arg1)
+	ret0, _ := ret[0].(*pb.CharacterDetails)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RenameCharacter indicates an expected call of RenameCharacter.
+func (mr *MockCharacterServiceServerMockRecorder) RenameCharacter(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenameCharacter", reflect.TypeOf((*MockCharacterServiceServer)(nil).RenameCharacter), arg0, arg1)
+}
+
+// RestoreInventory mocks base method.
+func (m *MockCharacterServiceServer) RestoreInventory(arg0 context.Context, arg1 *pb.RestoreInventoryRequest) (*pb.Inventory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreInventory", arg0, arg1)
+	ret0, _ := ret[0].(*pb.Inventory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreInventory indicates an expected call of RestoreInventory.
+func (mr *MockCharacterServiceServerMockRecorder) RestoreInventory(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreInventory", reflect.TypeOf((*MockCharacterServiceServer)(nil).RestoreInventory), arg0, arg1)
+}
+
 // SetInventory mocks base method.
 func (m *MockCharacterServiceServer) SetInventory(arg0 context.Context, arg1 *pb.UpdateInventoryRequest) (*emptypb.Empty, error) {
 	m.ctrl.T.Helper()
@@ -380,6 +840,36 @@ func (mr *MockCharacterServiceServerMockRecorder) SetInventory(arg0, arg1 any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetInventory", reflect.TypeOf((*MockCharacterServiceServer)(nil).SetInventory), arg0, arg1)
 }
 
+// SplitInventoryStack mocks base method.
+func (m *MockCharacterServiceServer) SplitInventoryStack(arg0 context.Context, arg1 *pb.SplitInventoryStackRequest) (*pb.Inventory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SplitInventoryStack", arg0, arg1)
+	ret0, _ := ret[0].(*pb.Inventory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SplitInventoryStack indicates an expected call of SplitInventoryStack.
+func (mr *MockCharacterServiceServerMockRecorder) SplitInventoryStack(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SplitInventoryStack", reflect.TypeOf((*MockCharacterServiceServer)(nil).SplitInventoryStack), arg0, arg1)
+}
+
+// TransferInventoryItem mocks base method.
+func (m *MockCharacterServiceServer) TransferInventoryItem(arg0 context.Context, arg1 *pb.TransferInventoryItemRequest) (*pb.Inventory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransferInventoryItem", arg0, arg1)
+	ret0, _ := ret[0].(*pb.Inventory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TransferInventoryItem indicates an expected call of TransferInventoryItem.
+func (mr *MockCharacterServiceServerMockRecorder) TransferInventoryItem(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransferInventoryItem", reflect.TypeOf((*MockCharacterServiceServer)(nil).TransferInventoryItem), arg0, arg1)
+}
+
 // mustEmbedUnimplementedCharacterServiceServer mocks base method.
 func (m *MockCharacterServiceServer) mustEmbedUnimplementedCharacterServiceServer() {
 	m.ctrl.T.Helper()
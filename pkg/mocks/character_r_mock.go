@@ -42,6 +42,21 @@ func (m *MockCharacterRepository) EXPECT() *MockCharacterRepositoryMockRecorder
 	return m.recorder
 }
 
+// AddExtraSlots mocks base method.
+func (m *MockCharacterRepository) AddExtraSlots(ctx context.Context, owner string, slots uint32) (uint32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddExtraSlots", ctx, owner, slots)
+	ret0, _ := ret[0].(uint32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddExtraSlots indicates an expected call of AddExtraSlots.
+func (mr *MockCharacterRepositoryMockRecorder) AddExtraSlots(ctx, owner, slots any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddExtraSlots", reflect.TypeOf((*MockCharacterRepository)(nil).AddExtraSlots), ctx, owner, slots)
+}
+
 // Create mocks base method.
 func (m *MockCharacterRepository) Create(ctx context.Context, char *character.Character) (*character.Character, error) {
 	m.ctrl.T.Helper()
@@ -116,6 +131,21 @@ func (mr *MockCharacterRepositoryMockRecorder) FindById(ctx, id any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockCharacterRepository)(nil).FindById), ctx, id)
 }
 
+// FindByIdForUpdate mocks base method.
+func (m *MockCharacterRepository) FindByIdForUpdate(ctx context.Context, id uint) (*character.Character, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByIdForUpdate", ctx, id)
+	ret0, _ := ret[0].(*character.Character)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByIdForUpdate indicates an expected call of FindByIdForUpdate.
+func (mr *MockCharacterRepositoryMockRecorder) FindByIdForUpdate(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByIdForUpdate", reflect.TypeOf((*MockCharacterRepository)(nil).FindByIdForUpdate), ctx, id)
+}
+
 // FindByName mocks base method.
 func (m *MockCharacterRepository) FindByName(ctx context.Context, name string) (*character.Character, error) {
 	m.ctrl.T.Helper()
@@ -131,6 +161,51 @@ func (mr *MockCharacterRepositoryMockRecorder) FindByName(ctx, name any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByName", reflect.TypeOf((*MockCharacterRepository)(nil).FindByName), ctx, name)
 }
 
+// FindExtraSlots mocks base method.
+func (m *MockCharacterRepository) FindExtraSlots(ctx context.Context, owner string) (uint32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindExtraSlots", ctx, owner)
+	ret0, _ := ret[0].(uint32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindExtraSlots indicates an expected call of FindExtraSlots.
+func (mr *MockCharacterRepositoryMockRecorder) FindExtraSlots(ctx, owner any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindExtraSlots", reflect.TypeOf((*MockCharacterRepository)(nil).FindExtraSlots), ctx, owner)
+}
+
+// FindNameHistory mocks base method.
+func (m *MockCharacterRepository) FindNameHistory(ctx context.Context, characterId uint) (character.NameChanges, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindNameHistory", ctx, characterId)
+	ret0, _ := ret[0].(character.NameChanges)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindNameHistory indicates an expected call of FindNameHistory.
+func (mr *MockCharacterRepositoryMockRecorder) FindNameHistory(ctx, characterId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindNameHistory", reflect.TypeOf((*MockCharacterRepository)(nil).FindNameHistory), ctx, characterId)
+}
+
+// FindNameReservation mocks base method.
+func (m *MockCharacterRepository) FindNameReservation(ctx context.Context, name string) (*character.NameChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindNameReservation", ctx, name)
+	ret0, _ := ret[0].(*character.NameChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindNameReservation indicates an expected call of FindNameReservation.
+func (mr *MockCharacterRepositoryMockRecorder) FindNameReservation(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindNameReservation", reflect.TypeOf((*MockCharacterRepository)(nil).FindNameReservation), ctx, name)
+}
+
 // Migrate mocks base method.
 func (m *MockCharacterRepository) Migrate(ctx context.Context) error {
 	m.ctrl.T.Helper()
@@ -145,6 +220,21 @@ func (mr *MockCharacterRepositoryMockRecorder) Migrate(ctx any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Migrate", reflect.TypeOf((*MockCharacterRepository)(nil).Migrate), ctx)
 }
 
+// Rename mocks base method.
+func (m *MockCharacterRepository) Rename(ctx context.Context, char *character.Character, change *character.NameChange) (*character.Character, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Rename", ctx, char, change)
+	ret0, _ := ret[0].(*character.Character)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Rename indicates an expected call of Rename.
+func (mr *MockCharacterRepositoryMockRecorder) Rename(ctx, char, change any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rename", reflect.TypeOf((*MockCharacterRepository)(nil).Rename), ctx, char, change)
+}
+
 // Save mocks base method.
 func (m *MockCharacterRepository) Save(ctx context.Context, char *character.Character) (*character.Character, error) {
 	m.ctrl.T.Helper()
@@ -160,6 +250,34 @@ func (mr *MockCharacterRepositoryMockRecorder) Save(ctx, char any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockCharacterRepository)(nil).Save), ctx, char)
 }
 
+// WithSlotLock mocks base method.
+func (m *MockCharacterRepository) WithSlotLock(ctx context.Context, owner string, fn func(repository.CharacterRepository) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithSlotLock", ctx, owner, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WithSlotLock indicates an expected call of WithSlotLock.
+func (mr *MockCharacterRepositoryMockRecorder) WithSlotLock(ctx, owner, fn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithSlotLock", reflect.TypeOf((*MockCharacterRepository)(nil).WithSlotLock), ctx, owner, fn)
+}
+
+// WithTransaction mocks base method.
+func (m *MockCharacterRepository) WithTransaction(ctx context.Context, fn func(repository.CharacterRepository) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithTransaction", ctx, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WithTransaction indicates an expected call of WithTransaction.
+func (mr *MockCharacterRepositoryMockRecorder) WithTransaction(ctx, fn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithTransaction", reflect.TypeOf((*MockCharacterRepository)(nil).WithTransaction), ctx, fn)
+}
+
 // WithTrx mocks base method.
 func (m *MockCharacterRepository) WithTrx(trx *gorm.DB) repository.CharacterRepository {
 	m.ctrl.T.Helper()
@@ -41,6 +41,21 @@ func (m *MockCharacterService) EXPECT() *MockCharacterServiceMockRecorder {
 	return m.recorder
 }
 
+// AddExperience mocks base method.
+func (m *MockCharacterService) AddExperience(ctx context.Context, characterId uint, amount uint64) (*character.Character, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddExperience", ctx, characterId, amount)
+	ret0, _ := ret[0].(*character.Character)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddExperience indicates an expected call of AddExperience.
+func (mr *MockCharacterServiceMockRecorder) AddExperience(ctx, characterId, amount any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddExperience", reflect.TypeOf((*MockCharacterService)(nil).AddExperience), ctx, characterId, amount)
+}
+
 // AddPlayTime mocks base method.
 func (m *MockCharacterService) AddPlayTime(ctx context.Context, characterId uint, amount uint64) (*character.Character, error) {
 	m.ctrl.T.Helper()
@@ -57,18 +72,32 @@ func (mr *MockCharacterServiceMockRecorder) AddPlayTime(ctx, characterId, amount
 }
 
 // Create mocks base method.
-func (m *MockCharacterService) Create(ctx context.Context, ownerId, name, gender, realm, dimension string) (*character.Character, error) {
+func (m *MockCharacterService) Create(ctx context.Context, ownerId, name, gender, realm, dimension string, appearance *character.Appearance) (*character.Character, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", ctx, ownerId, name, gender, realm, dimension)
+	ret := m.ctrl.Call(m, "Create", ctx, ownerId, name, gender, realm, dimension, appearance)
 	ret0, _ := ret[0].(*character.Character)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Create indicates an expected call of Create.
-func (mr *MockCharacterServiceMockRecorder) Create(ctx, ownerId, name, gender, realm, dimension any) *gomock.Call {
+func (mr *MockCharacterServiceMockRecorder) Create(ctx, ownerId, name, gender, realm, dimension, appearance any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockCharacterService)(nil).Create), ctx, ownerId, name, gender, realm, dimension)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockCharacterService)(nil).Create), ctx, ownerId, name, gender, realm, dimension, appearance)
+}
+
+// CreationRules mocks base method.
+func (m *MockCharacterService) CreationRules() *character.CreationRules {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreationRules")
+	ret0, _ := ret[0].(*character.CreationRules)
+	return ret0
+}
+
+// CreationRules indicates an expected call of CreationRules.
+func (mr *MockCharacterServiceMockRecorder) CreationRules() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreationRules", reflect.TypeOf((*MockCharacterService)(nil).CreationRules))
 }
 
 // Delete mocks base method.
@@ -86,18 +115,47 @@ func (mr *MockCharacterServiceMockRecorder) Delete(ctx, id any) *gomock.Call {
 }
 
 // Edit mocks base method.
-func (m *MockCharacterService) Edit(ctx context.Context, char *pb.EditCharacterRequest) (*character.Character, error) {
+func (m *MockCharacterService) Edit(ctx context.Context, request *pb.EditCharacterRequest) (*character.Character, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Edit", ctx, char)
+	ret := m.ctrl.Call(m, "Edit", ctx, request)
 	ret0, _ := ret[0].(*character.Character)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Edit indicates an expected call of Edit.
-func (mr *MockCharacterServiceMockRecorder) Edit(ctx, char any) *gomock.Call {
+func (mr *MockCharacterServiceMockRecorder) Edit(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Edit", reflect.TypeOf((*MockCharacterService)(nil).Edit), ctx, request)
+}
+
+// EditAppearance mocks base method.
+func (m *MockCharacterService) EditAppearance(ctx context.Context, characterId uint, appearance *character.Appearance) (*character.Character, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EditAppearance", ctx, characterId, appearance)
+	ret0, _ := ret[0].(*character.Character)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EditAppearance indicates an expected call of EditAppearance.
+func (mr *MockCharacterServiceMockRecorder) EditAppearance(ctx, characterId, appearance any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EditAppearance", reflect.TypeOf((*MockCharacterService)(nil).EditAppearance), ctx, characterId, appearance)
+}
+
+// ExperienceCurve mocks base method.
+func (m *MockCharacterService) ExperienceCurve() character.ExperienceCurve {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExperienceCurve")
+	ret0, _ := ret[0].(character.ExperienceCurve)
+	return ret0
+}
+
+// ExperienceCurve indicates an expected call of ExperienceCurve.
+func (mr *MockCharacterServiceMockRecorder) ExperienceCurve() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Edit", reflect.TypeOf((*MockCharacterService)(nil).Edit), ctx, char)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExperienceCurve", reflect.TypeOf((*MockCharacterService)(nil).ExperienceCurve))
 }
 
 // FindAll mocks base method.
@@ -159,3 +217,93 @@ func (mr *MockCharacterServiceMockRecorder) FindByName(ctx, name any) *gomock.Ca
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByName", reflect.TypeOf((*MockCharacterService)(nil).FindByName), ctx, name)
 }
+
+// FindByTarget mocks base method.
+func (m *MockCharacterService) FindByTarget(ctx context.Context, target *pb.CharacterTarget) (*character.Character, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByTarget", ctx, target)
+	ret0, _ := ret[0].(*character.Character)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByTarget indicates an expected call of FindByTarget.
+func (mr *MockCharacterServiceMockRecorder) FindByTarget(ctx, target any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByTarget", reflect.TypeOf((*MockCharacterService)(nil).FindByTarget), ctx, target)
+}
+
+// GrantSlots mocks base method.
+func (m *MockCharacterService) GrantSlots(ctx context.Context, ownerId string, slots uint32) (*character.CharacterSlots, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GrantSlots", ctx, ownerId, slots)
+	ret0, _ := ret[0].(*character.CharacterSlots)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GrantSlots indicates an expected call of GrantSlots.
+func (mr *MockCharacterServiceMockRecorder) GrantSlots(ctx, ownerId, slots any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GrantSlots", reflect.TypeOf((*MockCharacterService)(nil).GrantSlots), ctx, ownerId, slots)
+}
+
+// NameHistory mocks base method.
+func (m *MockCharacterService) NameHistory(ctx context.Context, characterId uint) (character.NameChanges, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NameHistory", ctx, characterId)
+	ret0, _ := ret[0].(character.NameChanges)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NameHistory indicates an expected call of NameHistory.
+func (mr *MockCharacterServiceMockRecorder) NameHistory(ctx, characterId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NameHistory", reflect.TypeOf((*MockCharacterService)(nil).NameHistory), ctx, characterId)
+}
+
+// Rename mocks base method.
+func (m *MockCharacterService) Rename(ctx context.Context, characterId uint, name string) (*character.Character, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Rename", ctx, characterId, name)
+	ret0, _ := ret[0].(*character.Character)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Rename indicates an expected call of Rename.
+func (mr *MockCharacterServiceMockRecorder) Rename(ctx, characterId, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rename", reflect.TypeOf((*MockCharacterService)(nil).Rename), ctx, characterId, name)
+}
+
+// Save mocks base method.
+func (m *MockCharacterService) Save(ctx context.Context, char *character.Character) (*character.Character, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, char)
+	ret0, _ := ret[0].(*character.Character)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockCharacterServiceMockRecorder) Save(ctx, char any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockCharacterService)(nil).Save), ctx, char)
+}
+
+// Slots mocks base method.
+func (m *MockCharacterService) Slots(ctx context.Context, ownerId string) (*character.CharacterSlots, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Slots", ctx, ownerId)
+	ret0, _ := ret[0].(*character.CharacterSlots)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Slots indicates an expected call of Slots.
+func (mr *MockCharacterServiceMockRecorder) Slots(ctx, ownerId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Slots", reflect.TypeOf((*MockCharacterService)(nil).Slots), ctx, ownerId)
+}
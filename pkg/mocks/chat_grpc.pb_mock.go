@@ -43,6 +43,26 @@ func (m *MockChatServiceClient) EXPECT() *MockChatServiceClientMockRecorder {
 	return m.recorder
 }
 
+// AcceptFriendRequest mocks base method.
+func (m *MockChatServiceClient) AcceptFriendRequest(ctx context.Context, in *pb.CharacterTarget, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AcceptFriendRequest", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AcceptFriendRequest indicates an expected call of AcceptFriendRequest.
+func (mr *MockChatServiceClientMockRecorder) AcceptFriendRequest(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptFriendRequest", reflect.TypeOf((*MockChatServiceClient)(nil).AcceptFriendRequest), varargs...)
+}
+
 // AllChatChannels mocks base method.
 func (m *MockChatServiceClient) AllChatChannels(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*pb.ChatChannels, error) {
 	m.ctrl.T.Helper()
@@ -63,8 +83,28 @@ func (mr *MockChatServiceClientMockRecorder) AllChatChannels(ctx, in any, opts .
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AllChatChannels", reflect.TypeOf((*MockChatServiceClient)(nil).AllChatChannels), varargs...)
 }
 
+// BlockUser mocks base method.
+func (m *MockChatServiceClient) BlockUser(ctx context.Context, in *pb.CharacterTarget, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "BlockUser", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BlockUser indicates an expected call of BlockUser.
+func (mr *MockChatServiceClientMockRecorder) BlockUser(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockUser", reflect.TypeOf((*MockChatServiceClient)(nil).BlockUser), varargs...)
+}
+
 // ConnectChannel mocks base method.
-func (m *MockChatServiceClient) ConnectChannel(ctx context.Context, in *pb.ChatChannelTarget, opts ...grpc.CallOption) (pb.ChatService_ConnectChannelClient, error) {
+func (m *MockChatServiceClient) ConnectChannel(ctx context.Context, in *pb.ConnectChannelRequest, opts ...grpc.CallOption) (pb.ChatService_ConnectChannelClient, error) {
 	m.ctrl.T.Helper()
 	varargs := []any{ctx, in}
 	for _, a := range opts {
@@ -104,14 +144,14 @@ func (mr *MockChatServiceClientMockRecorder) ConnectDirectMessage(ctx, in any, o
 }
 
 // CreateChannel mocks base method.
-func (m *MockChatServiceClient) CreateChannel(ctx context.Context, in *pb.CreateChannelMessage, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+func (m *MockChatServiceClient) CreateChannel(ctx context.Context, in *pb.CreateChannelMessage, opts ...grpc.CallOption) (*pb.ChatChannel, error) {
 	m.ctrl.T.Helper()
 	varargs := []any{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
 	ret := m.ctrl.Call(m, "CreateChannel", varargs...)
-	ret0, _ := ret[0].(*emptypb.Empty)
+	ret0, _ := ret[0].(*pb.ChatChannel)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -123,6 +163,26 @@ func (mr *MockChatServiceClientMockRecorder) CreateChannel(ctx, in any, opts ...
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateChannel", reflect.TypeOf((*MockChatServiceClient)(nil).CreateChannel), varargs...)
 }
 
+// DeclineFriendRequest mocks base method.
+func (m *MockChatServiceClient) DeclineFriendRequest(ctx context.Context, in *pb.CharacterTarget, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeclineFriendRequest", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeclineFriendRequest indicates an expected call of DeclineFriendRequest.
+func (mr *MockChatServiceClientMockRecorder) DeclineFriendRequest(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeclineFriendRequest", reflect.TypeOf((*MockChatServiceClient)(nil).DeclineFriendRequest), varargs...)
+}
+
 // DeleteChannel mocks base method.
 func (m *MockChatServiceClient) DeleteChannel(ctx context.Context, in *pb.ChatChannelTarget, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	m.ctrl.T.Helper()
@@ -143,6 +203,26 @@ func (mr *MockChatServiceClientMockRecorder) DeleteChannel(ctx, in any, opts ...
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteChannel", reflect.TypeOf((*MockChatServiceClient)(nil).DeleteChannel), varargs...)
 }
 
+// DeleteCharacterChat mocks base method.
+func (m *MockChatServiceClient) DeleteCharacterChat(ctx context.Context, in *pb.CharacterTarget, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteCharacterChat", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteCharacterChat indicates an expected call of DeleteCharacterChat.
+func (mr *MockChatServiceClientMockRecorder) DeleteCharacterChat(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCharacterChat", reflect.TypeOf((*MockChatServiceClient)(nil).DeleteCharacterChat), varargs...)
+}
+
 // EditChannel mocks base method.
 func (m *MockChatServiceClient) EditChannel(ctx context.Context, in *pb.UpdateChatChannelRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	m.ctrl.T.Helper()
@@ -163,104 +243,504 @@ func (mr *MockChatServiceClientMockRecorder) EditChannel(ctx, in any, opts ...an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EditChannel", reflect.TypeOf((*MockChatServiceClient)(nil).EditChannel), varargs...)
 }
 
-// GetAuthorizedChatChannels mocks base method.
-func (m *MockChatServiceClient) GetAuthorizedChatChannels(ctx context.Context, in *pb.CharacterTarget, opts ...grpc.CallOption) (*pb.ChatChannels, error) {
+// GetAuthorizedChatChannels mocks base method.
+func (m *MockChatServiceClient) GetAuthorizedChatChannels(ctx context.Context, in *pb.CharacterTarget, opts ...grpc.CallOption) (*pb.ChatChannels, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetAuthorizedChatChannels", varargs...)
+	ret0, _ := ret[0].(*pb.ChatChannels)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAuthorizedChatChannels indicates an expected call of GetAuthorizedChatChannels.
+func (mr *MockChatServiceClientMockRecorder) GetAuthorizedChatChannels(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuthorizedChatChannels", reflect.TypeOf((*MockChatServiceClient)(nil).GetAuthorizedChatChannels), varargs...)
+}
+
+// GetBlockedUsers mocks base method.
+func (m *MockChatServiceClient) GetBlockedUsers(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*pb.SocialUsers, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetBlockedUsers", varargs...)
+	ret0, _ := ret[0].(*pb.SocialUsers)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBlockedUsers indicates an expected call of GetBlockedUsers.
+func (mr *MockChatServiceClientMockRecorder) GetBlockedUsers(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockedUsers", reflect.TypeOf((*MockChatServiceClient)(nil).GetBlockedUsers), varargs...)
+}
+
+// GetChannel mocks base method.
+func (m *MockChatServiceClient) GetChannel(ctx context.Context, in *pb.ChatChannelTarget, opts ...grpc.CallOption) (*pb.ChatChannel, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetChannel", varargs...)
+	ret0, _ := ret[0].(*pb.ChatChannel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChannel indicates an expected call of GetChannel.
+func (mr *MockChatServiceClientMockRecorder) GetChannel(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChannel", reflect.TypeOf((*MockChatServiceClient)(nil).GetChannel), varargs...)
+}
+
+// GetChannelHistory mocks base method.
+func (m *MockChatServiceClient) GetChannelHistory(ctx context.Context, in *pb.ChannelHistoryRequest, opts ...grpc.CallOption) (*pb.ChatMessages, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetChannelHistory", varargs...)
+	ret0, _ := ret[0].(*pb.ChatMessages)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChannelHistory indicates an expected call of GetChannelHistory.
+func (mr *MockChatServiceClientMockRecorder) GetChannelHistory(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChannelHistory", reflect.TypeOf((*MockChatServiceClient)(nil).GetChannelHistory), varargs...)
+}
+
+// GetChannelMembers mocks base method.
+func (m *MockChatServiceClient) GetChannelMembers(ctx context.Context, in *pb.ChatChannelTarget, opts ...grpc.CallOption) (*pb.ChannelMembers, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetChannelMembers", varargs...)
+	ret0, _ := ret[0].(*pb.ChannelMembers)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChannelMembers indicates an expected call of GetChannelMembers.
+func (mr *MockChatServiceClientMockRecorder) GetChannelMembers(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChannelMembers", reflect.TypeOf((*MockChatServiceClient)(nil).GetChannelMembers), varargs...)
+}
+
+// GetChannelModerations mocks base method.
+func (m *MockChatServiceClient) GetChannelModerations(ctx context.Context, in *pb.ChatChannelTarget, opts ...grpc.CallOption) (*pb.ChatModerations, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetChannelModerations", varargs...)
+	ret0, _ := ret[0].(*pb.ChatModerations)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChannelModerations indicates an expected call of GetChannelModerations.
+func (mr *MockChatServiceClientMockRecorder) GetChannelModerations(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChannelModerations", reflect.TypeOf((*MockChatServiceClient)(nil).GetChannelModerations), varargs...)
+}
+
+// GetDirectMessageHistory mocks base method.
+func (m *MockChatServiceClient) GetDirectMessageHistory(ctx context.Context, in *pb.DirectMessageHistoryRequest, opts ...grpc.CallOption) (*pb.ChatMessages, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetDirectMessageHistory", varargs...)
+	ret0, _ := ret[0].(*pb.ChatMessages)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDirectMessageHistory indicates an expected call of GetDirectMessageHistory.
+func (mr *MockChatServiceClientMockRecorder) GetDirectMessageHistory(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDirectMessageHistory", reflect.TypeOf((*MockChatServiceClient)(nil).GetDirectMessageHistory), varargs...)
+}
+
+// GetFriendRequests mocks base method.
+func (m *MockChatServiceClient) GetFriendRequests(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*pb.FriendRequests, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetFriendRequests", varargs...)
+	ret0, _ := ret[0].(*pb.FriendRequests)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFriendRequests indicates an expected call of GetFriendRequests.
+func (mr *MockChatServiceClientMockRecorder) GetFriendRequests(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFriendRequests", reflect.TypeOf((*MockChatServiceClient)(nil).GetFriendRequests), varargs...)
+}
+
+// GetFriends mocks base method.
+func (m *MockChatServiceClient) GetFriends(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*pb.SocialUsers, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetFriends", varargs...)
+	ret0, _ := ret[0].(*pb.SocialUsers)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFriends indicates an expected call of GetFriends.
+func (mr *MockChatServiceClientMockRecorder) GetFriends(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFriends", reflect.TypeOf((*MockChatServiceClient)(nil).GetFriends), varargs...)
+}
+
+// GetUnreadDirectMessages mocks base method.
+func (m *MockChatServiceClient) GetUnreadDirectMessages(ctx context.Context, in *pb.CharacterTarget, opts ...grpc.CallOption) (*pb.UnreadDirectMessages, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetUnreadDirectMessages", varargs...)
+	ret0, _ := ret[0].(*pb.UnreadDirectMessages)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUnreadDirectMessages indicates an expected call of GetUnreadDirectMessages.
+func (mr *MockChatServiceClientMockRecorder) GetUnreadDirectMessages(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUnreadDirectMessages", reflect.TypeOf((*MockChatServiceClient)(nil).GetUnreadDirectMessages), varargs...)
+}
+
+// InviteToChannel mocks base method.
+func (m *MockChatServiceClient) InviteToChannel(ctx context.Context, in *pb.ChannelInviteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "InviteToChannel", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InviteToChannel indicates an expected call of InviteToChannel.
+func (mr *MockChatServiceClientMockRecorder) InviteToChannel(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InviteToChannel", reflect.TypeOf((*MockChatServiceClient)(nil).InviteToChannel), varargs...)
+}
+
+// JoinChannel mocks base method.
+func (m *MockChatServiceClient) JoinChannel(ctx context.Context, in *pb.ChannelMembershipRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "JoinChannel", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// JoinChannel indicates an expected call of JoinChannel.
+func (mr *MockChatServiceClientMockRecorder) JoinChannel(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JoinChannel", reflect.TypeOf((*MockChatServiceClient)(nil).JoinChannel), varargs...)
+}
+
+// LeaveChannel mocks base method.
+func (m *MockChatServiceClient) LeaveChannel(ctx context.Context, in *pb.ChannelMembershipRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "LeaveChannel", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LeaveChannel indicates an expected call of LeaveChannel.
+func (mr *MockChatServiceClientMockRecorder) LeaveChannel(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LeaveChannel", reflect.TypeOf((*MockChatServiceClient)(nil).LeaveChannel), varargs...)
+}
+
+// MarkRead mocks base method.
+func (m *MockChatServiceClient) MarkRead(ctx context.Context, in *pb.MarkReadRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "MarkRead", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkRead indicates an expected call of MarkRead.
+func (mr *MockChatServiceClientMockRecorder) MarkRead(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkRead", reflect.TypeOf((*MockChatServiceClient)(nil).MarkRead), varargs...)
+}
+
+// MigrateCharacterName mocks base method.
+func (m *MockChatServiceClient) MigrateCharacterName(ctx context.Context, in *pb.CharacterRename, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "MigrateCharacterName", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MigrateCharacterName indicates an expected call of MigrateCharacterName.
+func (mr *MockChatServiceClientMockRecorder) MigrateCharacterName(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrateCharacterName", reflect.TypeOf((*MockChatServiceClient)(nil).MigrateCharacterName), varargs...)
+}
+
+// ModerateCharacter mocks base method.
+func (m *MockChatServiceClient) ModerateCharacter(ctx context.Context, in *pb.ChatModerationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ModerateCharacter", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ModerateCharacter indicates an expected call of ModerateCharacter.
+func (mr *MockChatServiceClientMockRecorder) ModerateCharacter(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModerateCharacter", reflect.TypeOf((*MockChatServiceClient)(nil).ModerateCharacter), varargs...)
+}
+
+// NotifyTyping mocks base method.
+func (m *MockChatServiceClient) NotifyTyping(ctx context.Context, in *pb.TypingRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "NotifyTyping", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NotifyTyping indicates an expected call of NotifyTyping.
+func (mr *MockChatServiceClientMockRecorder) NotifyTyping(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotifyTyping", reflect.TypeOf((*MockChatServiceClient)(nil).NotifyTyping), varargs...)
+}
+
+// RemoveFriend mocks base method.
+func (m *MockChatServiceClient) RemoveFriend(ctx context.Context, in *pb.CharacterTarget, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RemoveFriend", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveFriend indicates an expected call of RemoveFriend.
+func (mr *MockChatServiceClientMockRecorder) RemoveFriend(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveFriend", reflect.TypeOf((*MockChatServiceClient)(nil).RemoveFriend), varargs...)
+}
+
+// RevokeModeration mocks base method.
+func (m *MockChatServiceClient) RevokeModeration(ctx context.Context, in *pb.RevokeChatModerationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RevokeModeration", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeModeration indicates an expected call of RevokeModeration.
+func (mr *MockChatServiceClientMockRecorder) RevokeModeration(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeModeration", reflect.TypeOf((*MockChatServiceClient)(nil).RevokeModeration), varargs...)
+}
+
+// SendChatMessage mocks base method.
+func (m *MockChatServiceClient) SendChatMessage(ctx context.Context, in *pb.SendChatMessageRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SendChatMessage", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SendChatMessage indicates an expected call of SendChatMessage.
+func (mr *MockChatServiceClientMockRecorder) SendChatMessage(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendChatMessage", reflect.TypeOf((*MockChatServiceClient)(nil).SendChatMessage), varargs...)
+}
+
+// SendDirectMessage mocks base method.
+func (m *MockChatServiceClient) SendDirectMessage(ctx context.Context, in *pb.SendDirectMessageRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	m.ctrl.T.Helper()
 	varargs := []any{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "GetAuthorizedChatChannels", varargs...)
-	ret0, _ := ret[0].(*pb.ChatChannels)
+	ret := m.ctrl.Call(m, "SendDirectMessage", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetAuthorizedChatChannels indicates an expected call of GetAuthorizedChatChannels.
-func (mr *MockChatServiceClientMockRecorder) GetAuthorizedChatChannels(ctx, in any, opts ...any) *gomock.Call {
+// SendDirectMessage indicates an expected call of SendDirectMessage.
+func (mr *MockChatServiceClientMockRecorder) SendDirectMessage(ctx, in any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]any{ctx, in}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuthorizedChatChannels", reflect.TypeOf((*MockChatServiceClient)(nil).GetAuthorizedChatChannels), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendDirectMessage", reflect.TypeOf((*MockChatServiceClient)(nil).SendDirectMessage), varargs...)
 }
 
-// GetChannel mocks base method.
-func (m *MockChatServiceClient) GetChannel(ctx context.Context, in *pb.ChatChannelTarget, opts ...grpc.CallOption) (*pb.ChatChannel, error) {
+// SendFriendRequest mocks base method.
+func (m *MockChatServiceClient) SendFriendRequest(ctx context.Context, in *pb.CharacterTarget, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	m.ctrl.T.Helper()
 	varargs := []any{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "GetChannel", varargs...)
-	ret0, _ := ret[0].(*pb.ChatChannel)
+	ret := m.ctrl.Call(m, "SendFriendRequest", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetChannel indicates an expected call of GetChannel.
-func (mr *MockChatServiceClientMockRecorder) GetChannel(ctx, in any, opts ...any) *gomock.Call {
+// SendFriendRequest indicates an expected call of SendFriendRequest.
+func (mr *MockChatServiceClientMockRecorder) SendFriendRequest(ctx, in any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]any{ctx, in}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChannel", reflect.TypeOf((*MockChatServiceClient)(nil).GetChannel), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendFriendRequest", reflect.TypeOf((*MockChatServiceClient)(nil).SendFriendRequest), varargs...)
 }
 
-// SendChatMessage mocks base method.
-func (m *MockChatServiceClient) SendChatMessage(ctx context.Context, in *pb.SendChatMessageRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+// SetChannelModerator mocks base method.
+func (m *MockChatServiceClient) SetChannelModerator(ctx context.Context, in *pb.SetChannelModeratorRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	m.ctrl.T.Helper()
 	varargs := []any{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "SendChatMessage", varargs...)
+	ret := m.ctrl.Call(m, "SetChannelModerator", varargs...)
 	ret0, _ := ret[0].(*emptypb.Empty)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// SendChatMessage indicates an expected call of SendChatMessage.
-func (mr *MockChatServiceClientMockRecorder) SendChatMessage(ctx, in any, opts ...any) *gomock.Call {
+// SetChannelModerator indicates an expected call of SetChannelModerator.
+func (mr *MockChatServiceClientMockRecorder) SetChannelModerator(ctx, in any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]any{ctx, in}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendChatMessage", reflect.TypeOf((*MockChatServiceClient)(nil).SendChatMessage), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetChannelModerator", reflect.TypeOf((*MockChatServiceClient)(nil).SetChannelModerator), varargs...)
 }
 
-// SendDirectMessage mocks base method.
-func (m *MockChatServiceClient) SendDirectMessage(ctx context.Context, in *pb.SendDirectMessageRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+// SetUserChatChannelAuthorizations mocks base method.
+func (m *MockChatServiceClient) SetUserChatChannelAuthorizations(ctx context.Context, in *pb.RequestSetChannelAuth, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	m.ctrl.T.Helper()
 	varargs := []any{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "SendDirectMessage", varargs...)
+	ret := m.ctrl.Call(m, "SetUserChatChannelAuthorizations", varargs...)
 	ret0, _ := ret[0].(*emptypb.Empty)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// SendDirectMessage indicates an expected call of SendDirectMessage.
-func (mr *MockChatServiceClientMockRecorder) SendDirectMessage(ctx, in any, opts ...any) *gomock.Call {
+// SetUserChatChannelAuthorizations indicates an expected call of SetUserChatChannelAuthorizations.
+func (mr *MockChatServiceClientMockRecorder) SetUserChatChannelAuthorizations(ctx, in any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]any{ctx, in}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendDirectMessage", reflect.TypeOf((*MockChatServiceClient)(nil).SendDirectMessage), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetUserChatChannelAuthorizations", reflect.TypeOf((*MockChatServiceClient)(nil).SetUserChatChannelAuthorizations), varargs...)
 }
 
-// SetUserChatChannelAuthorizations mocks base method.
-func (m *MockChatServiceClient) SetUserChatChannelAuthorizations(ctx context.Context, in *pb.RequestSetChannelAuth, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+// UnblockUser mocks base method.
+func (m *MockChatServiceClient) UnblockUser(ctx context.Context, in *pb.CharacterTarget, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	m.ctrl.T.Helper()
 	varargs := []any{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "SetUserChatChannelAuthorizations", varargs...)
+	ret := m.ctrl.Call(m, "UnblockUser", varargs...)
 	ret0, _ := ret[0].(*emptypb.Empty)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// SetUserChatChannelAuthorizations indicates an expected call of SetUserChatChannelAuthorizations.
-func (mr *MockChatServiceClientMockRecorder) SetUserChatChannelAuthorizations(ctx, in any, opts ...any) *gomock.Call {
+// UnblockUser indicates an expected call of UnblockUser.
+func (mr *MockChatServiceClientMockRecorder) UnblockUser(ctx, in any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]any{ctx, in}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetUserChatChannelAuthorizations", reflect.TypeOf((*MockChatServiceClient)(nil).SetUserChatChannelAuthorizations), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnblockUser", reflect.TypeOf((*MockChatServiceClient)(nil).UnblockUser), varargs...)
 }
 
 // UpdateUserChatChannelAuthorizations mocks base method.
@@ -552,6 +1032,21 @@ func (m *MockChatServiceServer) EXPECT() *MockChatServiceServerMockRecorder {
 	return m.recorder
 }
 
+// AcceptFriendRequest mocks base method.
+func (m *MockChatServiceServer) AcceptFriendRequest(arg0 context.Context, arg1 *pb.CharacterTarget) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcceptFriendRequest", arg0, arg1)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AcceptFriendRequest indicates an expected call of AcceptFriendRequest.
+func (mr *MockChatServiceServerMockRecorder) AcceptFriendRequest(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptFriendRequest", reflect.TypeOf((*MockChatServiceServer)(nil).AcceptFriendRequest), arg0, arg1)
+}
+
 // AllChatChannels mocks base method.
 func (m *MockChatServiceServer) AllChatChannels(arg0 context.Context, arg1 *emptypb.Empty) (*pb.ChatChannels, error) {
 	m.ctrl.T.Helper()
@@ -567,8 +1062,23 @@ func (mr *MockChatServiceServerMockRecorder) AllChatChannels(arg0, arg1 any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AllChatChannels", reflect.TypeOf((*MockChatServiceServer)(nil).AllChatChannels), arg0, arg1)
 }
 
+// BlockUser mocks base method.
+func (m *MockChatServiceServer) BlockUser(arg0 context.Context, arg1 *pb.CharacterTarget) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BlockUser", arg0, arg1)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BlockUser indicates an expected call of BlockUser.
+func (mr *MockChatServiceServerMockRecorder) BlockUser(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockUser", reflect.TypeOf((*MockChatServiceServer)(nil).BlockUser), arg0, arg1)
+}
+
 // ConnectChannel mocks base method.
-func (m *MockChatServiceServer) ConnectChannel(arg0 *pb.ChatChannelTarget, arg1 pb.ChatService_ConnectChannelServer) error {
+func (m *MockChatServiceServer) ConnectChannel(arg0 *pb.ConnectChannelRequest, arg1 pb.ChatService_ConnectChannelServer) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ConnectChannel", arg0, arg1)
 	ret0, _ := ret[0].(error)
@@ -596,10 +1106,10 @@ func (mr *MockChatServiceServerMockRecorder) ConnectDirectMessage(arg0, arg1 any
 }
 
 // CreateChannel mocks base method.
-func (m *MockChatServiceServer) CreateChannel(arg0 context.Context, arg1 *pb.CreateChannelMessage) (*emptypb.Empty, error) {
+func (m *MockChatServiceServer) CreateChannel(arg0 context.Context, arg1 *pb.CreateChannelMessage) (*pb.ChatChannel, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "CreateChannel", arg0, arg1)
-	ret0, _ := ret[0].(*emptypb.Empty)
+	ret0, _ := ret[0].(*pb.ChatChannel)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -610,6 +1120,21 @@ func (mr *MockChatServiceServerMockRecorder) CreateChannel(arg0, arg1 any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateChannel", reflect.TypeOf((*MockChatServiceServer)(nil).CreateChannel), arg0, arg1)
 }
 
+// DeclineFriendRequest mocks base method.
+func (m *MockChatServiceServer) DeclineFriendRequest(arg0 context.Context, arg1 *pb.CharacterTarget) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeclineFriendRequest", arg0, arg1)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeclineFriendRequest indicates an expected call of DeclineFriendRequest.
+func (mr *MockChatServiceServerMockRecorder) DeclineFriendRequest(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeclineFriendRequest", reflect.TypeOf((*MockChatServiceServer)(nil).DeclineFriendRequest), arg0, arg1)
+}
+
 // DeleteChannel mocks base method.
 func (m *MockChatServiceServer) DeleteChannel(arg0 context.Context, arg1 *pb.ChatChannelTarget) (*emptypb.Empty, error) {
 	m.ctrl.T.Helper()
@@ -625,6 +1150,21 @@ func (mr *MockChatServiceServerMockRecorder) DeleteChannel(arg0, arg1 any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteChannel", reflect.TypeOf((*MockChatServiceServer)(nil).DeleteChannel), arg0, arg1)
 }
 
+// DeleteCharacterChat mocks base method.
+func (m *MockChatServiceServer) DeleteCharacterChat(arg0 context.Context, arg1 *pb.CharacterTarget) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCharacterChat", arg0, arg1)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteCharacterChat indicates an expected call of DeleteCharacterChat.
+func (mr *MockChatServiceServerMockRecorder) DeleteCharacterChat(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCharacterChat", reflect.TypeOf((*MockChatServiceServer)(nil).DeleteCharacterChat), arg0, arg1)
+}
+
 // EditChannel mocks base method.
 func (m *MockChatServiceServer) EditChannel(arg0 context.Context, arg1 *pb.UpdateChatChannelRequest) (*emptypb.Empty, error) {
 	m.ctrl.T.Helper()
@@ -655,6 +1195,21 @@ func (mr *MockChatServiceServerMockRecorder) GetAuthorizedChatChannels(arg0, arg
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuthorizedChatChannels", reflect.TypeOf((*MockChatServiceServer)(nil).GetAuthorizedChatChannels), arg0, arg1)
 }
 
+// GetBlockedUsers mocks base method.
+func (m *MockChatServiceServer) GetBlockedUsers(arg0 context.Context, arg1 *emptypb.Empty) (*pb.SocialUsers, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBlockedUsers", arg0, arg1)
+	ret0, _ := ret[0].(*pb.SocialUsers)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBlockedUsers indicates an expected call of GetBlockedUsers.
+func (mr *MockChatServiceServerMockRecorder) GetBlockedUsers(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockedUsers", reflect.TypeOf((*MockChatServiceServer)(nil).GetBlockedUsers), arg0, arg1)
+}
+
 // GetChannel mocks base method.
 func (m *MockChatServiceServer) GetChannel(arg0 context.Context, arg1 *pb.ChatChannelTarget) (*pb.ChatChannel, error) {
 	m.ctrl.T.Helper()
@@ -670,6 +1225,246 @@ func (mr *MockChatServiceServerMockRecorder) GetChannel(arg0, arg1 any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChannel", reflect.TypeOf((*MockChatServiceServer)(nil).GetChannel), arg0, arg1)
 }
 
+// GetChannelHistory mocks base method.
+func (m *MockChatServiceServer) GetChannelHistory(arg0 context.Context, arg1 *pb.ChannelHistoryRequest) (*pb.ChatMessages, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChannelHistory", arg0, arg1)
+	ret0, _ := ret[0].(*pb.ChatMessages)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChannelHistory indicates an expected call of GetChannelHistory.
+func (mr *MockChatServiceServerMockRecorder) GetChannelHistory(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChannelHistory", reflect.TypeOf((*MockChatServiceServer)(nil).GetChannelHistory), arg0, arg1)
+}
+
+// GetChannelMembers mocks base method.
+func (m *MockChatServiceServer) GetChannelMembers(arg0 context.Context, arg1 *pb.ChatChannelTarget) (*pb.ChannelMembers, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChannelMembers", arg0, arg1)
+	ret0, _ := ret[0].(*pb.ChannelMembers)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChannelMembers indicates an expected call of GetChannelMembers.
+func (mr *MockChatServiceServerMockRecorder) GetChannelMembers(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChannelMembers", reflect.TypeOf((*MockChatServiceServer)(nil).GetChannelMembers), arg0, arg1)
+}
+
+// GetChannelModerations mocks base method.
+func (m *MockChatServiceServer) GetChannelModerations(arg0 context.Context, arg1 *pb.ChatChannelTarget) (*pb.ChatModerations, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChannelModerations", arg0, arg1)
+	ret0, _ := ret[0].(*pb.ChatModerations)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChannelModerations indicates an expected call of GetChannelModerations.
+func (mr *MockChatServiceServerMockRecorder) GetChannelModerations(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChannelModerations", reflect.TypeOf((*MockChatServiceServer)(nil).GetChannelModerations), arg0, arg1)
+}
+
+// GetDirectMessageHistory mocks base method.
+func (m *MockChatServiceServer) GetDirectMessageHistory(arg0 context.Context, arg1 *pb.DirectMessageHistoryRequest) (*pb.ChatMessages, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDirectMessageHistory", arg0, arg1)
+	ret0, _ := ret[0].(*pb.ChatMessages)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDirectMessageHistory indicates an expected call of GetDirectMessageHistory.
+func (mr *MockChatServiceServerMockRecorder) GetDirectMessageHistory(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDirectMessageHistory", reflect.TypeOf((*MockChatServiceServer)(nil).GetDirectMessageHistory), arg0, arg1)
+}
+
+// GetFriendRequests mocks base method.
+func (m *MockChatServiceServer) GetFriendRequests(arg0 context.Context, arg1 *emptypb.Empty) (*pb.FriendRequests, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFriendRequests", arg0, arg1)
+	ret0, _ := ret[0].(*pb.FriendRequests)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFriendRequests indicates an expected call of GetFriendRequests.
+func (mr *MockChatServiceServerMockRecorder) GetFriendRequests(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFriendRequests", reflect.TypeOf((*MockChatServiceServer)(nil).GetFriendRequests), arg0, arg1)
+}
+
+// GetFriends mocks base method.
+func (m *MockChatServiceServer) GetFriends(arg0 context.Context, arg1 *emptypb.Empty) (*pb.SocialUsers, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFriends", arg0, arg1)
+	ret0, _ := ret[0].(*pb.SocialUsers)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFriends indicates an expected call of GetFriends.
+func (mr *MockChatServiceServerMockRecorder) GetFriends(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFriends", reflect.TypeOf((*MockChatServiceServer)(nil).GetFriends), arg0, arg1)
+}
+
+// GetUnreadDirectMessages mocks base method.
+func (m *MockChatServiceServer) GetUnreadDirectMessages(arg0 context.Context, arg1 *pb.CharacterTarget) (*pb.UnreadDirectMessages, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUnreadDirectMessages", arg0, arg1)
+	ret0, _ := ret[0].(*pb.UnreadDirectMessages)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUnreadDirectMessages indicates an expected call of GetUnreadDirectMessages.
+func (mr *MockChatServiceServerMockRecorder) GetUnreadDirectMessages(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUnreadDirectMessages", reflect.TypeOf((*MockChatServiceServer)(nil).GetUnreadDirectMessages), arg0, arg1)
+}
+
+// InviteToChannel mocks base method.
+func (m *MockChatServiceServer) InviteToChannel(arg0 context.Context, arg1 *pb.ChannelInviteRequest) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InviteToChannel", arg0, arg1)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InviteToChannel indicates an expected call of InviteToChannel.
+func (mr *MockChatServiceServerMockRecorder) InviteToChannel(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InviteToChannel", reflect.TypeOf((*MockChatServiceServer)(nil).InviteToChannel), arg0, arg1)
+}
+
+// JoinChannel mocks base method.
+func (m *MockChatServiceServer) JoinChannel(arg0 context.Context, arg1 *pb.ChannelMembershipRequest) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JoinChannel", arg0, arg1)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// JoinChannel indicates an expected call of JoinChannel.
+func (mr *MockChatServiceServerMockRecorder) JoinChannel(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JoinChannel", reflect.TypeOf((*MockChatServiceServer)(nil).JoinChannel), arg0, arg1)
+}
+
+// LeaveChannel mocks base method.
+func (m *MockChatServiceServer) LeaveChannel(arg0 context.Context, arg1 *pb.ChannelMembershipRequest) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LeaveChannel", arg0, arg1)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LeaveChannel indicates an expected call of LeaveChannel.
+func (mr *MockChatServiceServerMockRecorder) LeaveChannel(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LeaveChannel", reflect.TypeOf((*MockChatServiceServer)(nil).LeaveChannel), arg0, arg1)
+}
+
+// MarkRead mocks base method.
+func (m *MockChatServiceServer) MarkRead(arg0 context.Context, arg1 *pb.MarkReadRequest) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkRead", arg0, arg1)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkRead indicates an expected call of MarkRead.
+func (mr *MockChatServiceServerMockRecorder) MarkRead(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkRead", reflect.TypeOf((*MockChatServiceServer)(nil).MarkRead), arg0, arg1)
+}
+
+// MigrateCharacterName mocks base method.
+func (m *MockChatServiceServer) MigrateCharacterName(arg0 context.Context, arg1 *pb.CharacterRename) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MigrateCharacterName", arg0, arg1)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MigrateCharacterName indicates an expected call of MigrateCharacterName.
+func (mr *MockChatServiceServerMockRecorder) MigrateCharacterName(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrateCharacterName", reflect.TypeOf((*MockChatServiceServer)(nil).MigrateCharacterName), arg0, arg1)
+}
+
+// ModerateCharacter mocks base method.
+func (m *MockChatServiceServer) ModerateCharacter(arg0 context.Context, arg1 *pb.ChatModerationRequest) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModerateCharacter", arg0, arg1)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ModerateCharacter indicates an expected call of ModerateCharacter.
+func (mr *MockChatServiceServerMockRecorder) ModerateCharacter(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModerateCharacter", reflect.TypeOf((*MockChatServiceServer)(nil).ModerateCharacter), arg0, arg1)
+}
+
+// NotifyTyping mocks base method.
+func (m *MockChatServiceServer) NotifyTyping(arg0 context.Context, arg1 *pb.TypingRequest) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NotifyTyping", arg0, arg1)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NotifyTyping indicates an expected call of NotifyTyping.
+func (mr *MockChatServiceServerMockRecorder) NotifyTyping(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotifyTyping", reflect.TypeOf((*MockChatServiceServer)(nil).NotifyTyping), arg0, arg1)
+}
+
+// RemoveFriend mocks base method.
+func (m *MockChatServiceServer) RemoveFriend(arg0 context.Context, arg1 *pb.CharacterTarget) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveFriend", arg0, arg1)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveFriend indicates an expected call of RemoveFriend.
+func (mr *MockChatServiceServerMockRecorder) RemoveFriend(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveFriend", reflect.TypeOf((*MockChatServiceServer)(nil).RemoveFriend), arg0, arg1)
+}
+
+// RevokeModeration mocks base method.
+func (m *MockChatServiceServer) RevokeModeration(arg0 context.Context, arg1 *pb.RevokeChatModerationRequest) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeModeration", arg0, arg1)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeModeration indicates an expected call of RevokeModeration.
+func (mr *MockChatServiceServerMockRecorder) RevokeModeration(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeModeration", reflect.TypeOf((*MockChatServiceServer)(nil).RevokeModeration), arg0, arg1)
+}
+
 // SendChatMessage mocks base method.
 func (m *MockChatServiceServer) SendChatMessage(arg0 context.Context, arg1 *pb.SendChatMessageRequest) (*emptypb.Empty, error) {
 	m.ctrl.T.Helper()
@@ -700,6 +1495,36 @@ func (mr *MockChatServiceServerMockRecorder) SendDirectMessage(arg0, arg1 any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendDirectMessage", reflect.TypeOf((*MockChatServiceServer)(nil).SendDirectMessage), arg0, arg1)
 }
 
+// SendFriendRequest mocks base method.
+func (m *MockChatServiceServer) SendFriendRequest(arg0 context.Context, arg1 *pb.CharacterTarget) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendFriendRequest", arg0, arg1)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SendFriendRequest indicates an expected call of SendFriendRequest.
+func (mr *MockChatServiceServerMockRecorder) SendFriendRequest(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendFriendRequest", reflect.TypeOf((*MockChatServiceServer)(nil).SendFriendRequest), arg0, arg1)
+}
+
+// SetChannelModerator mocks base method.
+func (m *MockChatServiceServer) SetChannelModerator(arg0 context.Context, arg1 *pb.SetChannelModeratorRequest) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetChannelModerator", arg0, arg1)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetChannelModerator indicates an expected call of SetChannelModerator.
+func (mr *MockChatServiceServerMockRecorder) SetChannelModerator(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetChannelModerator", reflect.TypeOf((*MockChatServiceServer)(nil).SetChannelModerator), arg0, arg1)
+}
+
 // SetUserChatChannelAuthorizations mocks base method.
 func (m *MockChatServiceServer) SetUserChatChannelAuthorizations(arg0 context.Context, arg1 *pb.RequestSetChannelAuth) (*emptypb.Empty, error) {
 	m.ctrl.T.Helper()
@@ -715,6 +1540,21 @@ func (mr *MockChatServiceServerMockRecorder) SetUserChatChannelAuthorizations(ar
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetUserChatChannelAuthorizations", reflect.TypeOf((*MockChatServiceServer)(nil).SetUserChatChannelAuthorizations), arg0, arg1)
 }
 
+// UnblockUser mocks base method.
+func (m *MockChatServiceServer) UnblockUser(arg0 context.Context, arg1 *pb.CharacterTarget) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnblockUser", arg0, arg1)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnblockUser indicates an expected call of UnblockUser.
+func (mr *MockChatServiceServerMockRecorder) UnblockUser(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnblockUser", reflect.TypeOf((*MockChatServiceServer)(nil).UnblockUser), arg0, arg1)
+}
+
 // UpdateUserChatChannelAuthorizations mocks base method.
 func (m *MockChatServiceServer) UpdateUserChatChannelAuthorizations(arg0 context.Context, arg1 *pb.RequestChatChannelAuthChange) (*emptypb.Empty, error) {
 	m.ctrl.T.Helper()
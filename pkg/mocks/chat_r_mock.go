@@ -14,6 +14,7 @@ import (
 	reflect "reflect"
 
 	chat "github.com/ShatteredRealms/go-backend/pkg/model/chat"
+	pb "github.com/ShatteredRealms/go-backend/pkg/pb"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -40,6 +41,20 @@ func (m *MockChatRepository) EXPECT() *MockChatRepositoryMockRecorder {
 	return m.recorder
 }
 
+// AddFriend mocks base method.
+func (m *MockChatRepository) AddFriend(ctx context.Context, userId, friendId string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddFriend", ctx, userId, friendId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddFriend indicates an expected call of AddFriend.
+func (mr *MockChatRepositoryMockRecorder) AddFriend(ctx, userId, friendId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddFriend", reflect.TypeOf((*MockChatRepository)(nil).AddFriend), ctx, userId, friendId)
+}
+
 // AllChannels mocks base method.
 func (m *MockChatRepository) AllChannels(ctx context.Context) (chat.ChatChannels, error) {
 	m.ctrl.T.Helper()
@@ -70,6 +85,20 @@ func (mr *MockChatRepositoryMockRecorder) AuthorizedChannelsForCharacter(ctx, ch
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthorizedChannelsForCharacter", reflect.TypeOf((*MockChatRepository)(nil).AuthorizedChannelsForCharacter), ctx, characterId)
 }
 
+// Block mocks base method.
+func (m *MockChatRepository) Block(ctx context.Context, userId, blockedId string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Block", ctx, userId, blockedId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Block indicates an expected call of Block.
+func (mr *MockChatRepositoryMockRecorder) Block(ctx, userId, blockedId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Block", reflect.TypeOf((*MockChatRepository)(nil).Block), ctx, userId, blockedId)
+}
+
 // ChangeAuthorizationForCharacter mocks base method.
 func (m *MockChatRepository) ChangeAuthorizationForCharacter(ctx context.Context, characterId uint, channelIds []uint, addAuth bool) error {
 	m.ctrl.T.Helper()
@@ -99,6 +128,65 @@ func (mr *MockChatRepositoryMockRecorder) CreateChannel(ctx, channel any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateChannel", reflect.TypeOf((*MockChatRepository)(nil).CreateChannel), ctx, channel)
 }
 
+// CreateChannelInvite mocks base method.
+func (m *MockChatRepository) CreateChannelInvite(ctx context.Context, invite *chat.ChatChannelInvite) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateChannelInvite", ctx, invite)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateChannelInvite indicates an expected call of CreateChannelInvite.
+func (mr *MockChatRepositoryMockRecorder) CreateChannelInvite(ctx, invite any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateChannelInvite", reflect.TypeOf((*MockChatRepository)(nil).CreateChannelInvite), ctx, invite)
+}
+
+// CreateFriendRequest mocks base method.
+func (m *MockChatRepository) CreateFriendRequest(ctx context.Context, senderId, targetId string) (*chat.UserFriendRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFriendRequest", ctx, senderId, targetId)
+	ret0, _ := ret[0].(*chat.UserFriendRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateFriendRequest indicates an expected call of CreateFriendRequest.
+func (mr *MockChatRepositoryMockRecorder) CreateFriendRequest(ctx, senderId, targetId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFriendRequest", reflect.TypeOf((*MockChatRepository)(nil).CreateFriendRequest), ctx, senderId, targetId)
+}
+
+// CreateMessage mocks base method.
+func (m *MockChatRepository) CreateMessage(ctx context.Context, message *chat.ChatMessage) (*chat.ChatMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateMessage", ctx, message)
+	ret0, _ := ret[0].(*chat.ChatMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateMessage indicates an expected call of CreateMessage.
+func (mr *MockChatRepositoryMockRecorder) CreateMessage(ctx, message any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMessage", reflect.TypeOf((*MockChatRepository)(nil).CreateMessage), ctx, message)
+}
+
+// CreateModeration mocks base method.
+func (m *MockChatRepository) CreateModeration(ctx context.Context, moderation *chat.ChatChannelModeration) (*chat.ChatChannelModeration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateModeration", ctx, moderation)
+	ret0, _ := ret[0].(*chat.ChatChannelModeration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateModeration indicates an expected call of CreateModeration.
+func (mr *MockChatRepositoryMockRecorder) CreateModeration(ctx, moderation any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateModeration", reflect.TypeOf((*MockChatRepository)(nil).CreateModeration), ctx, moderation)
+}
+
 // DeleteChannel mocks base method.
 func (m *MockChatRepository) DeleteChannel(ctx context.Context, channel *chat.ChatChannel) error {
 	m.ctrl.T.Helper()
@@ -113,6 +201,108 @@ func (mr *MockChatRepositoryMockRecorder) DeleteChannel(ctx, channel any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteChannel", reflect.TypeOf((*MockChatRepository)(nil).DeleteChannel), ctx, channel)
 }
 
+// DeleteChannelInvite mocks base method.
+func (m *MockChatRepository) DeleteChannelInvite(ctx context.Context, channelId, characterId uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteChannelInvite", ctx, channelId, characterId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteChannelInvite indicates an expected call of DeleteChannelInvite.
+func (mr *MockChatRepositoryMockRecorder) DeleteChannelInvite(ctx, channelId, characterId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteChannelInvite", reflect.TypeOf((*MockChatRepository)(nil).DeleteChannelInvite), ctx, channelId, characterId)
+}
+
+// DeleteFriendRequest mocks base method.
+func (m *MockChatRepository) DeleteFriendRequest(ctx context.Context, senderId, targetId string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFriendRequest", ctx, senderId, targetId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteFriendRequest indicates an expected call of DeleteFriendRequest.
+func (mr *MockChatRepositoryMockRecorder) DeleteFriendRequest(ctx, senderId, targetId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFriendRequest", reflect.TypeOf((*MockChatRepository)(nil).DeleteFriendRequest), ctx, senderId, targetId)
+}
+
+// ExpireModerations mocks base method.
+func (m *MockChatRepository) ExpireModerations(ctx context.Context, channelId, characterId uint, moderationType pb.ChatModerationType) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpireModerations", ctx, channelId, characterId, moderationType)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExpireModerations indicates an expected call of ExpireModerations.
+func (mr *MockChatRepositoryMockRecorder) ExpireModerations(ctx, channelId, characterId, moderationType any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpireModerations", reflect.TypeOf((*MockChatRepository)(nil).ExpireModerations), ctx, channelId, characterId, moderationType)
+}
+
+// FindActiveModeration mocks base method.
+func (m *MockChatRepository) FindActiveModeration(ctx context.Context, channelId, characterId uint, moderationType pb.ChatModerationType) (*chat.ChatChannelModeration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindActiveModeration", ctx, channelId, characterId, moderationType)
+	ret0, _ := ret[0].(*chat.ChatChannelModeration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindActiveModeration indicates an expected call of FindActiveModeration.
+func (mr *MockChatRepositoryMockRecorder) FindActiveModeration(ctx, channelId, characterId, moderationType any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindActiveModeration", reflect.TypeOf((*MockChatRepository)(nil).FindActiveModeration), ctx, channelId, characterId, moderationType)
+}
+
+// FindActiveModerations mocks base method.
+func (m *MockChatRepository) FindActiveModerations(ctx context.Context, channelId uint) (chat.ChatChannelModerations, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindActiveModerations", ctx, channelId)
+	ret0, _ := ret[0].(chat.ChatChannelModerations)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindActiveModerations indicates an expected call of FindActiveModerations.
+func (mr *MockChatRepositoryMockRecorder) FindActiveModerations(ctx, channelId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindActiveModerations", reflect.TypeOf((*MockChatRepository)(nil).FindActiveModerations), ctx, channelId)
+}
+
+// FindBlock mocks base method.
+func (m *MockChatRepository) FindBlock(ctx context.Context, userId, blockedId string) (*chat.UserBlock, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindBlock", ctx, userId, blockedId)
+	ret0, _ := ret[0].(*chat.UserBlock)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindBlock indicates an expected call of FindBlock.
+func (mr *MockChatRepositoryMockRecorder) FindBlock(ctx, userId, blockedId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindBlock", reflect.TypeOf((*MockChatRepository)(nil).FindBlock), ctx, userId, blockedId)
+}
+
+// FindBlocks mocks base method.
+func (m *MockChatRepository) FindBlocks(ctx context.Context, userId string) (chat.UserBlocks, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindBlocks", ctx, userId)
+	ret0, _ := ret[0].(chat.UserBlocks)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindBlocks indicates an expected call of FindBlocks.
+func (mr *MockChatRepositoryMockRecorder) FindBlocks(ctx, userId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindBlocks", reflect.TypeOf((*MockChatRepository)(nil).FindBlocks), ctx, userId)
+}
+
 // FindChannelById mocks base method.
 func (m *MockChatRepository) FindChannelById(ctx context.Context, id uint) (*chat.ChatChannel, error) {
 	m.ctrl.T.Helper()
@@ -128,6 +318,81 @@ func (mr *MockChatRepositoryMockRecorder) FindChannelById(ctx, id any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindChannelById", reflect.TypeOf((*MockChatRepository)(nil).FindChannelById), ctx, id)
 }
 
+// FindChannelInvite mocks base method.
+func (m *MockChatRepository) FindChannelInvite(ctx context.Context, channelId, characterId uint) (*chat.ChatChannelInvite, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindChannelInvite", ctx, channelId, characterId)
+	ret0, _ := ret[0].(*chat.ChatChannelInvite)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindChannelInvite indicates an expected call of FindChannelInvite.
+func (mr *MockChatRepositoryMockRecorder) FindChannelInvite(ctx, channelId, characterId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindChannelInvite", reflect.TypeOf((*MockChatRepository)(nil).FindChannelInvite), ctx, channelId, characterId)
+}
+
+// FindChannelMember mocks base method.
+func (m *MockChatRepository) FindChannelMember(ctx context.Context, channelId, characterId uint) (*chat.ChatChannelPermission, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindChannelMember", ctx, channelId, characterId)
+	ret0, _ := ret[0].(*chat.ChatChannelPermission)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindChannelMember indicates an expected call of FindChannelMember.
+func (mr *MockChatRepositoryMockRecorder) FindChannelMember(ctx, channelId, characterId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindChannelMember", reflect.TypeOf((*MockChatRepository)(nil).FindChannelMember), ctx, channelId, characterId)
+}
+
+// FindChannelMembers mocks base method.
+func (m *MockChatRepository) FindChannelMembers(ctx context.Context, channelId uint) (chat.ChatChannelPermissions, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindChannelMembers", ctx, channelId)
+	ret0, _ := ret[0].(chat.ChatChannelPermissions)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindChannelMembers indicates an expected call of FindChannelMembers.
+func (mr *MockChatRepositoryMockRecorder) FindChannelMembers(ctx, channelId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindChannelMembers", reflect.TypeOf((*MockChatRepository)(nil).FindChannelMembers), ctx, channelId)
+}
+
+// FindChannelMessages mocks base method.
+func (m *MockChatRepository) FindChannelMessages(ctx context.Context, channelId uint, cursor chat.HistoryCursor) (chat.ChatMessages, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindChannelMessages", ctx, channelId, cursor)
+	ret0, _ := ret[0].(chat.ChatMessages)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindChannelMessages indicates an expected call of FindChannelMessages.
+func (mr *MockChatRepositoryMockRecorder) FindChannelMessages(ctx, channelId, cursor any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindChannelMessages", reflect.TypeOf((*MockChatRepository)(nil).FindChannelMessages), ctx, channelId, cursor)
+}
+
+// FindConversationPartners mocks base method.
+func (m *MockChatRepository) FindConversationPartners(ctx context.Context, characterName string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindConversationPartners", ctx, characterName)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindConversationPartners indicates an expected call of FindConversationPartners.
+func (mr *MockChatRepositoryMockRecorder) FindConversationPartners(ctx, characterName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindConversationPartners", reflect.TypeOf((*MockChatRepository)(nil).FindConversationPartners), ctx, characterName)
+}
+
 // FindDeletedWithName mocks base method.
 func (m *MockChatRepository) FindDeletedWithName(ctx context.Context, name string) (*chat.ChatChannel, error) {
 	m.ctrl.T.Helper()
@@ -143,6 +408,111 @@ func (mr *MockChatRepositoryMockRecorder) FindDeletedWithName(ctx, name any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDeletedWithName", reflect.TypeOf((*MockChatRepository)(nil).FindDeletedWithName), ctx, name)
 }
 
+// FindDirectMessages mocks base method.
+func (m *MockChatRepository) FindDirectMessages(ctx context.Context, characterName, otherCharacterName string, cursor chat.HistoryCursor) (chat.ChatMessages, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDirectMessages", ctx, characterName, otherCharacterName, cursor)
+	ret0, _ := ret[0].(chat.ChatMessages)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindDirectMessages indicates an expected call of FindDirectMessages.
+func (mr *MockChatRepositoryMockRecorder) FindDirectMessages(ctx, characterName, otherCharacterName, cursor any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDirectMessages", reflect.TypeOf((*MockChatRepository)(nil).FindDirectMessages), ctx, characterName, otherCharacterName, cursor)
+}
+
+// FindFriend mocks base method.
+func (m *MockChatRepository) FindFriend(ctx context.Context, userId, friendId string) (*chat.UserFriend, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindFriend", ctx, userId, friendId)
+	ret0, _ := ret[0].(*chat.UserFriend)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindFriend indicates an expected call of FindFriend.
+func (mr *MockChatRepositoryMockRecorder) FindFriend(ctx, userId, friendId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindFriend", reflect.TypeOf((*MockChatRepository)(nil).FindFriend), ctx, userId, friendId)
+}
+
+// FindFriendRequest mocks base method.
+func (m *MockChatRepository) FindFriendRequest(ctx context.Context, senderId, targetId string) (*chat.UserFriendRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindFriendRequest", ctx, senderId, targetId)
+	ret0, _ := ret[0].(*chat.UserFriendRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindFriendRequest indicates an expected call of FindFriendRequest.
+func (mr *MockChatRepositoryMockRecorder) FindFriendRequest(ctx, senderId, targetId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindFriendRequest", reflect.TypeOf((*MockChatRepository)(nil).FindFriendRequest), ctx, senderId, targetId)
+}
+
+// FindFriendRequests mocks base method.
+func (m *MockChatRepository) FindFriendRequests(ctx context.Context, userId string) (chat.UserFriendRequests, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindFriendRequests", ctx, userId)
+	ret0, _ := ret[0].(chat.UserFriendRequests)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindFriendRequests indicates an expected call of FindFriendRequests.
+func (mr *MockChatRepositoryMockRecorder) FindFriendRequests(ctx, userId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindFriendRequests", reflect.TypeOf((*MockChatRepository)(nil).FindFriendRequests), ctx, userId)
+}
+
+// FindFriends mocks base method.
+func (m *MockChatRepository) FindFriends(ctx context.Context, userId string) (chat.UserFriends, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindFriends", ctx, userId)
+	ret0, _ := ret[0].(chat.UserFriends)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindFriends indicates an expected call of FindFriends.
+func (mr *MockChatRepositoryMockRecorder) FindFriends(ctx, userId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindFriends", reflect.TypeOf((*MockChatRepository)(nil).FindFriends), ctx, userId)
+}
+
+// FindMessageById mocks base method.
+func (m *MockChatRepository) FindMessageById(ctx context.Context, id uint) (*chat.ChatMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindMessageById", ctx, id)
+	ret0, _ := ret[0].(*chat.ChatMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindMessageById indicates an expected call of FindMessageById.
+func (mr *MockChatRepositoryMockRecorder) FindMessageById(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindMessageById", reflect.TypeOf((*MockChatRepository)(nil).FindMessageById), ctx, id)
+}
+
+// FindUnreadDirectMessages mocks base method.
+func (m *MockChatRepository) FindUnreadDirectMessages(ctx context.Context, characterName string) (chat.UnreadConversations, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindUnreadDirectMessages", ctx, characterName)
+	ret0, _ := ret[0].(chat.UnreadConversations)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindUnreadDirectMessages indicates an expected call of FindUnreadDirectMessages.
+func (mr *MockChatRepositoryMockRecorder) FindUnreadDirectMessages(ctx, characterName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindUnreadDirectMessages", reflect.TypeOf((*MockChatRepository)(nil).FindUnreadDirectMessages), ctx, characterName)
+}
+
 // FullDeleteChannel mocks base method.
 func (m *MockChatRepository) FullDeleteChannel(ctx context.Context, channel *chat.ChatChannel) error {
 	m.ctrl.T.Helper()
@@ -157,6 +527,35 @@ func (mr *MockChatRepositoryMockRecorder) FullDeleteChannel(ctx, channel any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FullDeleteChannel", reflect.TypeOf((*MockChatRepository)(nil).FullDeleteChannel), ctx, channel)
 }
 
+// IsChannelModerator mocks base method.
+func (m *MockChatRepository) IsChannelModerator(ctx context.Context, channelId, characterId uint) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsChannelModerator", ctx, channelId, characterId)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsChannelModerator indicates an expected call of IsChannelModerator.
+func (mr *MockChatRepositoryMockRecorder) IsChannelModerator(ctx, channelId, characterId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsChannelModerator", reflect.TypeOf((*MockChatRepository)(nil).IsChannelModerator), ctx, channelId, characterId)
+}
+
+// MarkDirectMessagesRead mocks base method.
+func (m *MockChatRepository) MarkDirectMessagesRead(ctx context.Context, characterName, otherCharacterName string, messageId uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkDirectMessagesRead", ctx, characterName, otherCharacterName, messageId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkDirectMessagesRead indicates an expected call of MarkDirectMessagesRead.
+func (mr *MockChatRepositoryMockRecorder) MarkDirectMessagesRead(ctx, characterName, otherCharacterName, messageId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkDirectMessagesRead", reflect.TypeOf((*MockChatRepository)(nil).MarkDirectMessagesRead), ctx, characterName, otherCharacterName, messageId)
+}
+
 // Migrate mocks base method.
 func (m *MockChatRepository) Migrate(ctx context.Context) error {
 	m.ctrl.T.Helper()
@@ -171,6 +570,62 @@ func (mr *MockChatRepositoryMockRecorder) Migrate(ctx any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Migrate", reflect.TypeOf((*MockChatRepository)(nil).Migrate), ctx)
 }
 
+// RemoveFriend mocks base method.
+func (m *MockChatRepository) RemoveFriend(ctx context.Context, userId, friendId string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveFriend", ctx, userId, friendId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveFriend indicates an expected call of RemoveFriend.
+func (mr *MockChatRepositoryMockRecorder) RemoveFriend(ctx, userId, friendId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveFriend", reflect.TypeOf((*MockChatRepository)(nil).RemoveFriend), ctx, userId, friendId)
+}
+
+// RenameDirectMessages mocks base method.
+func (m *MockChatRepository) RenameDirectMessages(ctx context.Context, oldName, newName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenameDirectMessages", ctx, oldName, newName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RenameDirectMessages indicates an expected call of RenameDirectMessages.
+func (mr *MockChatRepositoryMockRecorder) RenameDirectMessages(ctx, oldName, newName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenameDirectMessages", reflect.TypeOf((*MockChatRepository)(nil).RenameDirectMessages), ctx, oldName, newName)
+}
+
+// SetChannelModerator mocks base method.
+func (m *MockChatRepository) SetChannelModerator(ctx context.Context, channelId, characterId uint, moderator bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetChannelModerator", ctx, channelId, characterId, moderator)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetChannelModerator indicates an expected call of SetChannelModerator.
+func (mr *MockChatRepositoryMockRecorder) SetChannelModerator(ctx, channelId, characterId, moderator any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetChannelModerator", reflect.TypeOf((*MockChatRepository)(nil).SetChannelModerator), ctx, channelId, characterId, moderator)
+}
+
+// Unblock mocks base method.
+func (m *MockChatRepository) Unblock(ctx context.Context, userId, blockedId string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unblock", ctx, userId, blockedId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Unblock indicates an expected call of Unblock.
+func (mr *MockChatRepositoryMockRecorder) Unblock(ctx, userId, blockedId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unblock", reflect.TypeOf((*MockChatRepository)(nil).Unblock), ctx, userId, blockedId)
+}
+
 // UpdateChannel mocks base method.
 func (m *MockChatRepository) UpdateChannel(ctx context.Context, channel *chat.ChatChannel) (*chat.ChatChannel, error) {
 	m.ctrl.T.Helper()
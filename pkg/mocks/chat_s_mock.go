@@ -12,10 +12,11 @@ package mocks
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
+	messagebus "github.com/ShatteredRealms/go-backend/pkg/messagebus"
 	chat "github.com/ShatteredRealms/go-backend/pkg/model/chat"
 	pb "github.com/ShatteredRealms/go-backend/pkg/pb"
-	kafka "github.com/segmentio/kafka-go"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -42,6 +43,50 @@ func (m *MockChatService) EXPECT() *MockChatServiceMockRecorder {
 	return m.recorder
 }
 
+// AcceptFriendRequest mocks base method.
+func (m *MockChatService) AcceptFriendRequest(ctx context.Context, userId, senderId string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcceptFriendRequest", ctx, userId, senderId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AcceptFriendRequest indicates an expected call of AcceptFriendRequest.
+func (mr *MockChatServiceMockRecorder) AcceptFriendRequest(ctx, userId, senderId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptFriendRequest", reflect.TypeOf((*MockChatService)(nil).AcceptFriendRequest), ctx, userId, senderId)
+}
+
+// ActiveModeration mocks base method.
+func (m *MockChatService) ActiveModeration(ctx context.Context, channelId, characterId uint, moderationType pb.ChatModerationType) (*chat.ChatChannelModeration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ActiveModeration", ctx, channelId, characterId, moderationType)
+	ret0, _ := ret[0].(*chat.ChatChannelModeration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ActiveModeration indicates an expected call of ActiveModeration.
+func (mr *MockChatServiceMockRecorder) ActiveModeration(ctx, channelId, characterId, moderationType any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ActiveModeration", reflect.TypeOf((*MockChatService)(nil).ActiveModeration), ctx, channelId, characterId, moderationType)
+}
+
+// ActiveModerations mocks base method.
+func (m *MockChatService) ActiveModerations(ctx context.Context, channelId uint) (chat.ChatChannelModerations, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ActiveModerations", ctx, channelId)
+	ret0, _ := ret[0].(chat.ChatChannelModerations)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ActiveModerations indicates an expected call of ActiveModerations.
+func (mr *MockChatServiceMockRecorder) ActiveModerations(ctx, channelId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ActiveModerations", reflect.TypeOf((*MockChatService)(nil).ActiveModerations), ctx, channelId)
+}
+
 // AllChannels mocks base method.
 func (m *MockChatService) AllChannels(ctx context.Context) (chat.ChatChannels, error) {
 	m.ctrl.T.Helper()
@@ -72,6 +117,35 @@ func (mr *MockChatServiceMockRecorder) AuthorizedChannelsForCharacter(ctx, chara
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthorizedChannelsForCharacter", reflect.TypeOf((*MockChatService)(nil).AuthorizedChannelsForCharacter), ctx, characterId)
 }
 
+// BlockChangesReader mocks base method.
+func (m *MockChatService) BlockChangesReader(ctx context.Context) (messagebus.Subscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BlockChangesReader", ctx)
+	ret0, _ := ret[0].(messagebus.Subscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BlockChangesReader indicates an expected call of BlockChangesReader.
+func (mr *MockChatServiceMockRecorder) BlockChangesReader(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockChangesReader", reflect.TypeOf((*MockChatService)(nil).BlockChangesReader), ctx)
+}
+
+// BlockUser mocks base method.
+func (m *MockChatService) BlockUser(ctx context.Context, userId, blockedId string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BlockUser", ctx, userId, blockedId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BlockUser indicates an expected call of BlockUser.
+func (mr *MockChatServiceMockRecorder) BlockUser(ctx, userId, blockedId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockUser", reflect.TypeOf((*MockChatService)(nil).BlockUser), ctx, userId, blockedId)
+}
+
 // ChangeAuthorizationForCharacter mocks base method.
 func (m *MockChatService) ChangeAuthorizationForCharacter(ctx context.Context, characterId uint, channelIds []uint, addAuth bool) error {
 	m.ctrl.T.Helper()
@@ -86,18 +160,34 @@ func (mr *MockChatServiceMockRecorder) ChangeAuthorizationForCharacter(ctx, char
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeAuthorizationForCharacter", reflect.TypeOf((*MockChatService)(nil).ChangeAuthorizationForCharacter), ctx, characterId, channelIds, addAuth)
 }
 
+// ChannelMembers mocks base method.
+func (m *MockChatService) ChannelMembers(ctx context.Context, channelId uint) (chat.ChatChannelPermissions, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChannelMembers", ctx, channelId)
+	ret0, _ := ret[0].(chat.ChatChannelPermissions)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChannelMembers indicates an expected call of ChannelMembers.
+func (mr *MockChatServiceMockRecorder) ChannelMembers(ctx, channelId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChannelMembers", reflect.TypeOf((*MockChatService)(nil).ChannelMembers), ctx, channelId)
+}
+
 // ChannelMessagesReader mocks base method.
-func (m *MockChatService) ChannelMessagesReader(ctx context.Context, channelId uint) *kafka.Reader {
+func (m *MockChatService) ChannelMessagesReader(ctx context.Context, channelId, afterMessageId uint) (messagebus.Subscription, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ChannelMessagesReader", ctx, channelId)
-	ret0, _ := ret[0].(*kafka.Reader)
-	return ret0
+	ret := m.ctrl.Call(m, "ChannelMessagesReader", ctx, channelId, afterMessageId)
+	ret0, _ := ret[0].(messagebus.Subscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // ChannelMessagesReader indicates an expected call of ChannelMessagesReader.
-func (mr *MockChatServiceMockRecorder) ChannelMessagesReader(ctx, channelId any) *gomock.Call {
+func (mr *MockChatServiceMockRecorder) ChannelMessagesReader(ctx, channelId, afterMessageId any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChannelMessagesReader", reflect.TypeOf((*MockChatService)(nil).ChannelMessagesReader), ctx, channelId)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChannelMessagesReader", reflect.TypeOf((*MockChatService)(nil).ChannelMessagesReader), ctx, channelId, afterMessageId)
 }
 
 // CreateChannel mocks base method.
@@ -115,6 +205,20 @@ func (mr *MockChatServiceMockRecorder) CreateChannel(ctx, channel any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateChannel", reflect.TypeOf((*MockChatService)(nil).CreateChannel), ctx, channel)
 }
 
+// DeclineFriendRequest mocks base method.
+func (m *MockChatService) DeclineFriendRequest(ctx context.Context, userId, otherId string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeclineFriendRequest", ctx, userId, otherId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeclineFriendRequest indicates an expected call of DeclineFriendRequest.
+func (mr *MockChatServiceMockRecorder) DeclineFriendRequest(ctx, userId, otherId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeclineFriendRequest", reflect.TypeOf((*MockChatService)(nil).DeclineFriendRequest), ctx, userId, otherId)
+}
+
 // DeleteChannel mocks base method.
 func (m *MockChatService) DeleteChannel(ctx context.Context, channel *chat.ChatChannel) error {
 	m.ctrl.T.Helper()
@@ -130,11 +234,12 @@ func (mr *MockChatServiceMockRecorder) DeleteChannel(ctx, channel any) *gomock.C
 }
 
 // DirectMessagesReader mocks base method.
-func (m *MockChatService) DirectMessagesReader(ctx context.Context, username string) *kafka.Reader {
+func (m *MockChatService) DirectMessagesReader(ctx context.Context, username string) (messagebus.Subscription, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "DirectMessagesReader", ctx, username)
-	ret0, _ := ret[0].(*kafka.Reader)
-	return ret0
+	ret0, _ := ret[0].(messagebus.Subscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // DirectMessagesReader indicates an expected call of DirectMessagesReader.
@@ -143,6 +248,21 @@ func (mr *MockChatServiceMockRecorder) DirectMessagesReader(ctx, username any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DirectMessagesReader", reflect.TypeOf((*MockChatService)(nil).DirectMessagesReader), ctx, username)
 }
 
+// GetBlockedUsers mocks base method.
+func (m *MockChatService) GetBlockedUsers(ctx context.Context, userId string) (chat.UserBlocks, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBlockedUsers", ctx, userId)
+	ret0, _ := ret[0].(chat.UserBlocks)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBlockedUsers indicates an expected call of GetBlockedUsers.
+func (mr *MockChatServiceMockRecorder) GetBlockedUsers(ctx, userId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockedUsers", reflect.TypeOf((*MockChatService)(nil).GetBlockedUsers), ctx, userId)
+}
+
 // GetChannel mocks base method.
 func (m *MockChatService) GetChannel(ctx context.Context, id uint) (*chat.ChatChannel, error) {
 	m.ctrl.T.Helper()
@@ -158,6 +278,224 @@ func (mr *MockChatServiceMockRecorder) GetChannel(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChannel", reflect.TypeOf((*MockChatService)(nil).GetChannel), ctx, id)
 }
 
+// GetChannelHistory mocks base method.
+func (m *MockChatService) GetChannelHistory(ctx context.Context, channelId uint, cursor chat.HistoryCursor) (chat.ChatMessages, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChannelHistory", ctx, channelId, cursor)
+	ret0, _ := ret[0].(chat.ChatMessages)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChannelHistory indicates an expected call of GetChannelHistory.
+func (mr *MockChatServiceMockRecorder) GetChannelHistory(ctx, channelId, cursor any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChannelHistory", reflect.TypeOf((*MockChatService)(nil).GetChannelHistory), ctx, channelId, cursor)
+}
+
+// GetDirectMessageHistory mocks base method.
+func (m *MockChatService) GetDirectMessageHistory(ctx context.Context, characterName, otherCharacterName string, cursor chat.HistoryCursor) (chat.ChatMessages, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDirectMessageHistory", ctx, characterName, otherCharacterName, cursor)
+	ret0, _ := ret[0].(chat.ChatMessages)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDirectMessageHistory indicates an expected call of GetDirectMessageHistory.
+func (mr *MockChatServiceMockRecorder) GetDirectMessageHistory(ctx, characterName, otherCharacterName, cursor any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDirectMessageHistory", reflect.TypeOf((*MockChatService)(nil).GetDirectMessageHistory), ctx, characterName, otherCharacterName, cursor)
+}
+
+// GetFriendRequests mocks base method.
+func (m *MockChatService) GetFriendRequests(ctx context.Context, userId string) (chat.UserFriendRequests, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFriendRequests", ctx, userId)
+	ret0, _ := ret[0].(chat.UserFriendRequests)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFriendRequests indicates an expected call of GetFriendRequests.
+func (mr *MockChatServiceMockRecorder) GetFriendRequests(ctx, userId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFriendRequests", reflect.TypeOf((*MockChatService)(nil).GetFriendRequests), ctx, userId)
+}
+
+// GetFriends mocks base method.
+func (m *MockChatService) GetFriends(ctx context.Context, userId string) (chat.UserFriends, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFriends", ctx, userId)
+	ret0, _ := ret[0].(chat.UserFriends)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFriends indicates an expected call of GetFriends.
+func (mr *MockChatServiceMockRecorder) GetFriends(ctx, userId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFriends", reflect.TypeOf((*MockChatService)(nil).GetFriends), ctx, userId)
+}
+
+// HasBlocked mocks base method.
+func (m *MockChatService) HasBlocked(ctx context.Context, userId, blockedId string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasBlocked", ctx, userId, blockedId)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HasBlocked indicates an expected call of HasBlocked.
+func (mr *MockChatServiceMockRecorder) HasBlocked(ctx, userId, blockedId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasBlocked", reflect.TypeOf((*MockChatService)(nil).HasBlocked), ctx, userId, blockedId)
+}
+
+// InviteToChannel mocks base method.
+func (m *MockChatService) InviteToChannel(ctx context.Context, channelId, characterId, inviterId uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InviteToChannel", ctx, channelId, characterId, inviterId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InviteToChannel indicates an expected call of InviteToChannel.
+func (mr *MockChatServiceMockRecorder) InviteToChannel(ctx, channelId, characterId, inviterId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InviteToChannel", reflect.TypeOf((*MockChatService)(nil).InviteToChannel), ctx, channelId, characterId, inviterId)
+}
+
+// IsChannelMember mocks base method.
+func (m *MockChatService) IsChannelMember(ctx context.Context, channelId, characterId uint) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsChannelMember", ctx, channelId, characterId)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsChannelMember indicates an expected call of IsChannelMember.
+func (mr *MockChatServiceMockRecorder) IsChannelMember(ctx, channelId, characterId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsChannelMember", reflect.TypeOf((*MockChatService)(nil).IsChannelMember), ctx, channelId, characterId)
+}
+
+// IsChannelModerator mocks base method.
+func (m *MockChatService) IsChannelModerator(ctx context.Context, channelId, characterId uint) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsChannelModerator", ctx, channelId, characterId)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsChannelModerator indicates an expected call of IsChannelModerator.
+func (mr *MockChatServiceMockRecorder) IsChannelModerator(ctx, channelId, characterId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsChannelModerator", reflect.TypeOf((*MockChatService)(nil).IsChannelModerator), ctx, channelId, characterId)
+}
+
+// JoinChannel mocks base method.
+func (m *MockChatService) JoinChannel(ctx context.Context, channelId, characterId uint, dimension string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JoinChannel", ctx, channelId, characterId, dimension)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// JoinChannel indicates an expected call of JoinChannel.
+func (mr *MockChatServiceMockRecorder) JoinChannel(ctx, channelId, characterId, dimension any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JoinChannel", reflect.TypeOf((*MockChatService)(nil).JoinChannel), ctx, channelId, characterId, dimension)
+}
+
+// LeaveChannel mocks base method.
+func (m *MockChatService) LeaveChannel(ctx context.Context, channelId, characterId uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LeaveChannel", ctx, channelId, characterId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LeaveChannel indicates an expected call of LeaveChannel.
+func (mr *MockChatServiceMockRecorder) LeaveChannel(ctx, channelId, characterId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LeaveChannel", reflect.TypeOf((*MockChatService)(nil).LeaveChannel), ctx, channelId, characterId)
+}
+
+// MarkRead mocks base method.
+func (m *MockChatService) MarkRead(ctx context.Context, characterId uint, characterName string, messageId uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkRead", ctx, characterId, characterName, messageId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkRead indicates an expected call of MarkRead.
+func (mr *MockChatServiceMockRecorder) MarkRead(ctx, characterId, characterName, messageId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkRead", reflect.TypeOf((*MockChatService)(nil).MarkRead), ctx, characterId, characterName, messageId)
+}
+
+// MigrateCharacterName mocks base method.
+func (m *MockChatService) MigrateCharacterName(ctx context.Context, characterId uint, oldName, newName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MigrateCharacterName", ctx, characterId, oldName, newName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MigrateCharacterName indicates an expected call of MigrateCharacterName.
+func (mr *MockChatServiceMockRecorder) MigrateCharacterName(ctx, characterId, oldName, newName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrateCharacterName", reflect.TypeOf((*MockChatService)(nil).MigrateCharacterName), ctx, characterId, oldName, newName)
+}
+
+// ModerateCharacter mocks base method.
+func (m *MockChatService) ModerateCharacter(ctx context.Context, moderation *chat.ChatChannelModeration, duration time.Duration) (*chat.ChatChannelModeration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModerateCharacter", ctx, moderation, duration)
+	ret0, _ := ret[0].(*chat.ChatChannelModeration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ModerateCharacter indicates an expected call of ModerateCharacter.
+func (mr *MockChatServiceMockRecorder) ModerateCharacter(ctx, moderation, duration any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModerateCharacter", reflect.TypeOf((*MockChatService)(nil).ModerateCharacter), ctx, moderation, duration)
+}
+
+// NotifyTyping mocks base method.
+func (m *MockChatService) NotifyTyping(ctx context.Context, characterId uint, characterName, targetCharacterName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NotifyTyping", ctx, characterId, characterName, targetCharacterName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// NotifyTyping indicates an expected call of NotifyTyping.
+func (mr *MockChatServiceMockRecorder) NotifyTyping(ctx, characterId, characterName, targetCharacterName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotifyTyping", reflect.TypeOf((*MockChatService)(nil).NotifyTyping), ctx, characterId, characterName, targetCharacterName)
+}
+
+// ReconcileTopics mocks base method.
+func (m *MockChatService) ReconcileTopics(ctx context.Context, characterNames func(context.Context) ([]string, error)) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReconcileTopics", ctx, characterNames)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReconcileTopics indicates an expected call of ReconcileTopics.
+func (mr *MockChatServiceMockRecorder) ReconcileTopics(ctx, characterNames any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReconcileTopics", reflect.TypeOf((*MockChatService)(nil).ReconcileTopics), ctx, characterNames)
+}
+
 // RegisterCharacterChatTopic mocks base method.
 func (m *MockChatService) RegisterCharacterChatTopic(ctx context.Context, username string) error {
 	m.ctrl.T.Helper()
@@ -172,32 +510,133 @@ func (mr *MockChatServiceMockRecorder) RegisterCharacterChatTopic(ctx, username
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterCharacterChatTopic", reflect.TypeOf((*MockChatService)(nil).RegisterCharacterChatTopic), ctx, username)
 }
 
-// SendChannelMessage mocks base method.
-func (m *MockChatService) SendChannelMessage(ctx context.Context, username, message string, channelId uint) error {
+// RemoveFriend mocks base method.
+func (m *MockChatService) RemoveFriend(ctx context.Context, userId, friendId string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveFriend", ctx, userId, friendId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveFriend indicates an expected call of RemoveFriend.
+func (mr *MockChatServiceMockRecorder) RemoveFriend(ctx, userId, friendId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveFriend", reflect.TypeOf((*MockChatService)(nil).RemoveFriend), ctx, userId, friendId)
+}
+
+// RevokeModeration mocks base method.
+func (m *MockChatService) RevokeModeration(ctx context.Context, channelId, characterId uint, moderationType pb.ChatModerationType) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SendChannelMessage", ctx, username, message, channelId)
+	ret := m.ctrl.Call(m, "RevokeModeration", ctx, channelId, characterId, moderationType)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
+// RevokeModeration indicates an expected call of RevokeModeration.
+func (mr *MockChatServiceMockRecorder) RevokeModeration(ctx, channelId, characterId, moderationType any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeModeration", reflect.TypeOf((*MockChatService)(nil).RevokeModeration), ctx, channelId, characterId, moderationType)
+}
+
+// SendChannelMessage mocks base method.
+func (m *MockChatService) SendChannelMessage(ctx context.Context, channelId uint, message *chat.ChatMessage) (*chat.ChatMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendChannelMessage", ctx, channelId, message)
+	ret0, _ := ret[0].(*chat.ChatMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
 // SendChannelMessage indicates an expected call of SendChannelMessage.
-func (mr *MockChatServiceMockRecorder) SendChannelMessage(ctx, username, message, channelId any) *gomock.Call {
+func (mr *MockChatServiceMockRecorder) SendChannelMessage(ctx, channelId, message any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendChannelMessage", reflect.TypeOf((*MockChatService)(nil).SendChannelMessage), ctx, username, message, channelId)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendChannelMessage", reflect.TypeOf((*MockChatService)(nil).SendChannelMessage), ctx, channelId, message)
 }
 
 // SendDirectMessage mocks base method.
-func (m *MockChatService) SendDirectMessage(ctx context.Context, senderCharacter, message, targetCharacter string) error {
+func (m *MockChatService) SendDirectMessage(ctx context.Context, targetCharacterName string, message *chat.ChatMessage) (*chat.ChatMessage, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SendDirectMessage", ctx, senderCharacter, message, targetCharacter)
+	ret := m.ctrl.Call(m, "SendDirectMessage", ctx, targetCharacterName, message)
+	ret0, _ := ret[0].(*chat.ChatMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SendDirectMessage indicates an expected call of SendDirectMessage.
+func (mr *MockChatServiceMockRecorder) SendDirectMessage(ctx, targetCharacterName, message any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendDirectMessage", reflect.TypeOf((*MockChatService)(nil).SendDirectMessage), ctx, targetCharacterName, message)
+}
+
+// SendFriendRequest mocks base method.
+func (m *MockChatService) SendFriendRequest(ctx context.Context, senderId, targetId string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendFriendRequest", ctx, senderId, targetId)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// SendDirectMessage indicates an expected call of SendDirectMessage.
-func (mr *MockChatServiceMockRecorder) SendDirectMessage(ctx, senderCharacter, message, targetCharacter any) *gomock.Call {
+// SendFriendRequest indicates an expected call of SendFriendRequest.
+func (mr *MockChatServiceMockRecorder) SendFriendRequest(ctx, senderId, targetId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendFriendRequest", reflect.TypeOf((*MockChatService)(nil).SendFriendRequest), ctx, senderId, targetId)
+}
+
+// SetChannelModerator mocks base method.
+func (m *MockChatService) SetChannelModerator(ctx context.Context, channelId, characterId uint, moderator bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetChannelModerator", ctx, channelId, characterId, moderator)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetChannelModerator indicates an expected call of SetChannelModerator.
+func (mr *MockChatServiceMockRecorder) SetChannelModerator(ctx, channelId, characterId, moderator any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetChannelModerator", reflect.TypeOf((*MockChatService)(nil).SetChannelModerator), ctx, channelId, characterId, moderator)
+}
+
+// UnblockUser mocks base method.
+func (m *MockChatService) UnblockUser(ctx context.Context, userId, blockedId string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnblockUser", ctx, userId, blockedId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnblockUser indicates an expected call of UnblockUser.
+func (mr *MockChatServiceMockRecorder) UnblockUser(ctx, userId, blockedId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnblockUser", reflect.TypeOf((*MockChatService)(nil).UnblockUser), ctx, userId, blockedId)
+}
+
+// UnreadDirectMessages mocks base method.
+func (m *MockChatService) UnreadDirectMessages(ctx context.Context, characterName string) (chat.UnreadConversations, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnreadDirectMessages", ctx, characterName)
+	ret0, _ := ret[0].(chat.UnreadConversations)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnreadDirectMessages indicates an expected call of UnreadDirectMessages.
+func (mr *MockChatServiceMockRecorder) UnreadDirectMessages(ctx, characterName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnreadDirectMessages", reflect.TypeOf((*MockChatService)(nil).UnreadDirectMessages), ctx, characterName)
+}
+
+// UnregisterCharacterChatTopic mocks base method.
+func (m *MockChatService) UnregisterCharacterChatTopic(ctx context.Context, username string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnregisterCharacterChatTopic", ctx, username)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnregisterCharacterChatTopic indicates an expected call of UnregisterCharacterChatTopic.
+func (mr *MockChatServiceMockRecorder) UnregisterCharacterChatTopic(ctx, username any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendDirectMessage", reflect.TypeOf((*MockChatService)(nil).SendDirectMessage), ctx, senderCharacter, message, targetCharacter)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnregisterCharacterChatTopic", reflect.TypeOf((*MockChatService)(nil).UnregisterCharacterChatTopic), ctx, username)
 }
 
 // UpdateChannel mocks base method.
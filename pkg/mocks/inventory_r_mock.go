@@ -12,8 +12,10 @@ package mocks
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	character "github.com/ShatteredRealms/go-backend/pkg/model/character"
+	repository "github.com/ShatteredRealms/go-backend/pkg/repository"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -40,6 +42,66 @@ func (m *MockInventoryRepository) EXPECT() *MockInventoryRepositoryMockRecorder
 	return m.recorder
 }
 
+// AddItem mocks base method.
+func (m *MockInventoryRepository) AddItem(ctx context.Context, inventory *character.Inventory, location character.InventoryLocation, item *character.InventoryItem) (*character.Inventory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddItem", ctx, inventory, location, item)
+	ret0, _ := ret[0].(*character.Inventory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddItem indicates an expected call of AddItem.
+func (mr *MockInventoryRepositoryMockRecorder) AddItem(ctx, inventory, location, item any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddItem", reflect.TypeOf((*MockInventoryRepository)(nil).AddItem), ctx, inventory, location, item)
+}
+
+// ClaimMail mocks base method.
+func (m *MockInventoryRepository) ClaimMail(ctx context.Context, inventory *character.Inventory, mail *character.Mail) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimMail", ctx, inventory, mail)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimMail indicates an expected call of ClaimMail.
+func (mr *MockInventoryRepositoryMockRecorder) ClaimMail(ctx, inventory, mail any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimMail", reflect.TypeOf((*MockInventoryRepository)(nil).ClaimMail), ctx, inventory, mail)
+}
+
+// FindHistory mocks base method.
+func (m *MockInventoryRepository) FindHistory(ctx context.Context, characterId uint, limit int64) (character.InventoryEvents, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindHistory", ctx, characterId, limit)
+	ret0, _ := ret[0].(character.InventoryEvents)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindHistory indicates an expected call of FindHistory.
+func (mr *MockInventoryRepositoryMockRecorder) FindHistory(ctx, characterId, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindHistory", reflect.TypeOf((*MockInventoryRepository)(nil).FindHistory), ctx, characterId, limit)
+}
+
+// FindLatestEvent mocks base method.
+func (m *MockInventoryRepository) FindLatestEvent(ctx context.Context, characterId uint, at time.Time) (*character.InventoryEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindLatestEvent", ctx, characterId, at)
+	ret0, _ := ret[0].(*character.InventoryEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindLatestEvent indicates an expected call of FindLatestEvent.
+func (mr *MockInventoryRepositoryMockRecorder) FindLatestEvent(ctx, characterId, at any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindLatestEvent", reflect.TypeOf((*MockInventoryRepository)(nil).FindLatestEvent), ctx, characterId, at)
+}
+
 // GetInventory mocks base method.
 func (m *MockInventoryRepository) GetInventory(ctx context.Context, characterId uint) (*character.Inventory, error) {
 	m.ctrl.T.Helper()
@@ -55,16 +117,201 @@ func (mr *MockInventoryRepositoryMockRecorder) GetInventory(ctx, characterId any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInventory", reflect.TypeOf((*MockInventoryRepository)(nil).GetInventory), ctx, characterId)
 }
 
+// MergeStack mocks base method.
+func (m *MockInventoryRepository) MergeStack(ctx context.Context, inventory *character.Inventory, location character.InventoryLocation, from, to uint32, quantity uint64) (*character.Inventory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MergeStack", ctx, inventory, location, from, to, quantity)
+	ret0, _ := ret[0].(*character.Inventory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MergeStack indicates an expected call of MergeStack.
+func (mr *MockInventoryRepositoryMockRecorder) MergeStack(ctx, inventory, location, from, to, quantity any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeStack", reflect.TypeOf((*MockInventoryRepository)(nil).MergeStack), ctx, inventory, location, from, to, quantity)
+}
+
+// Migrate mocks base method.
+func (m *MockInventoryRepository) Migrate(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Migrate", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Migrate indicates an expected call of Migrate.
+func (mr *MockInventoryRepositoryMockRecorder) Migrate(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Migrate", reflect.TypeOf((*MockInventoryRepository)(nil).Migrate), ctx)
+}
+
+// MoveItem mocks base method.
+func (m *MockInventoryRepository) MoveItem(ctx context.Context, inventory *character.Inventory, location character.InventoryLocation, from, to uint32) (*character.Inventory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveItem", ctx, inventory, location, from, to)
+	ret0, _ := ret[0].(*character.Inventory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveItem indicates an expected call of MoveItem.
+func (mr *MockInventoryRepositoryMockRecorder) MoveItem(ctx, inventory, location, from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveItem", reflect.TypeOf((*MockInventoryRepository)(nil).MoveItem), ctx, inventory, location, from, to)
+}
+
+// RemoveItem mocks base method.
+func (m *MockInventoryRepository) RemoveItem(ctx context.Context, inventory *character.Inventory, location character.InventoryLocation, slot uint32, quantity uint64) (*character.Inventory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveItem", ctx, inventory, location, slot, quantity)
+	ret0, _ := ret[0].(*character.Inventory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveItem indicates an expected call of RemoveItem.
+func (mr *MockInventoryRepositoryMockRecorder) RemoveItem(ctx, inventory, location, slot, quantity any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveItem", reflect.TypeOf((*MockInventoryRepository)(nil).RemoveItem), ctx, inventory, location, slot, quantity)
+}
+
+// SendMail mocks base method.
+func (m *MockInventoryRepository) SendMail(ctx context.Context, inventory *character.Inventory, mail *character.Mail) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendMail", ctx, inventory, mail)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SendMail indicates an expected call of SendMail.
+func (mr *MockInventoryRepositoryMockRecorder) SendMail(ctx, inventory, mail any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMail", reflect.TypeOf((*MockInventoryRepository)(nil).SendMail), ctx, inventory, mail)
+}
+
+// SplitStack mocks base method.
+func (m *MockInventoryRepository) SplitStack(ctx context.Context, inventory *character.Inventory, location character.InventoryLocation, from, to uint32, quantity uint64) (*character.Inventory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SplitStack", ctx, inventory, location, from, to, quantity)
+	ret0, _ := ret[0].(*character.Inventory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SplitStack indicates an expected call of SplitStack.
+func (mr *MockInventoryRepositoryMockRecorder) SplitStack(ctx, inventory, location, from, to, quantity any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SplitStack", reflect.TypeOf((*MockInventoryRepository)(nil).SplitStack), ctx, inventory, location, from, to, quantity)
+}
+
+// SwapInventory mocks base method.
+func (m *MockInventoryRepository) SwapInventory(ctx context.Context, inventory *character.Inventory, reason string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SwapInventory", ctx, inventory, reason)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SwapInventory indicates an expected call of SwapInventory.
+func (mr *MockInventoryRepositoryMockRecorder) SwapInventory(ctx, inventory, reason any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SwapInventory", reflect.TypeOf((*MockInventoryRepository)(nil).SwapInventory), ctx, inventory, reason)
+}
+
+// Trade mocks base method.
+func (m *MockInventoryRepository) Trade(ctx context.Context, trade *character.Trade, validator repository.InventoryValidator) (*character.Trade, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Trade", ctx, trade, validator)
+	ret0, _ := ret[0].(*character.Trade)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Trade indicates an expected call of Trade.
+func (mr *MockInventoryRepositoryMockRecorder) Trade(ctx, trade, validator any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trade", reflect.TypeOf((*MockInventoryRepository)(nil).Trade), ctx, trade, validator)
+}
+
+// TransferItem mocks base method.
+func (m *MockInventoryRepository) TransferItem(ctx context.Context, inventory *character.Inventory, from character.InventoryLocation, fromSlot, toSlot uint32) (*character.Inventory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransferItem", ctx, inventory, from, fromSlot, toSlot)
+	ret0, _ := ret[0].(*character.Inventory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TransferItem indicates an expected call of TransferItem.
+func (mr *MockInventoryRepositoryMockRecorder) TransferItem(ctx, inventory, from, fromSlot, toSlot any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransferItem", reflect.TypeOf((*MockInventoryRepository)(nil).TransferItem), ctx, inventory, from, fromSlot, toSlot)
+}
+
 // UpdateInventory mocks base method.
-func (m *MockInventoryRepository) UpdateInventory(ctx context.Context, inventory *character.Inventory) error {
+func (m *MockInventoryRepository) UpdateInventory(ctx context.Context, inventory *character.Inventory, reason string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateInventory", ctx, inventory)
+	ret := m.ctrl.Call(m, "UpdateInventory", ctx, inventory, reason)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // UpdateInventory indicates an expected call of UpdateInventory.
-func (mr *MockInventoryRepositoryMockRecorder) UpdateInventory(ctx, inventory any) *gomock.Call {
+func (mr *MockInventoryRepositoryMockRecorder) UpdateInventory(ctx, inventory, reason any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateInventory", reflect.TypeOf((*MockInventoryRepository)(nil).UpdateInventory), ctx, inventory, reason)
+}
+
+// MockInventoryValidator is a mock of InventoryValidator interface.
+type MockInventoryValidator struct {
+	ctrl     *gomock.Controller
+	recorder *MockInventoryValidatorMockRecorder
+}
+
+// MockInventoryValidatorMockRecorder is the mock recorder for MockInventoryValidator.
+type MockInventoryValidatorMockRecorder struct {
+	mock *MockInventoryValidator
+}
+
+// NewMockInventoryValidator creates a new mock instance.
+func NewMockInventoryValidator(ctrl *gomock.Controller) *MockInventoryValidator {
+	mock := &MockInventoryValidator{ctrl: ctrl}
+	mock.recorder = &MockInventoryValidatorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockInventoryValidator) EXPECT() *MockInventoryValidatorMockRecorder {
+	return m.recorder
+}
+
+// Capacity mocks base method.
+func (m *MockInventoryValidator) Capacity() uint32 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Capacity")
+	ret0, _ := ret[0].(uint32)
+	return ret0
+}
+
+// Capacity indicates an expected call of Capacity.
+func (mr *MockInventoryValidatorMockRecorder) Capacity() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Capacity", reflect.TypeOf((*MockInventoryValidator)(nil).Capacity))
+}
+
+// Validate mocks base method.
+func (m *MockInventoryValidator) Validate(ctx context.Context, inventory *character.Inventory) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Validate", ctx, inventory)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Validate indicates an expected call of Validate.
+func (mr *MockInventoryValidatorMockRecorder) Validate(ctx, inventory any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateInventory", reflect.TypeOf((*MockInventoryRepository)(nil).UpdateInventory), ctx, inventory)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Validate", reflect.TypeOf((*MockInventoryValidator)(nil).Validate), ctx, inventory)
 }
@@ -12,6 +12,7 @@ package mocks
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	character "github.com/ShatteredRealms/go-backend/pkg/model/character"
 	gomock "go.uber.org/mock/gomock"
@@ -40,6 +41,21 @@ func (m *MockInventoryService) EXPECT() *MockInventoryServiceMockRecorder {
 	return m.recorder
 }
 
+// AddItem mocks base method.
+func (m *MockInventoryService) AddItem(ctx context.Context, characterId uint, version uint64, location character.InventoryLocation, item *character.InventoryItem) (*character.Inventory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddItem", ctx, characterId, version, location, item)
+	ret0, _ := ret[0].(*character.Inventory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddItem indicates an expected call of AddItem.
+func (mr *MockInventoryServiceMockRecorder) AddItem(ctx, characterId, version, location, item any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddItem", reflect.TypeOf((*MockInventoryService)(nil).AddItem), ctx, characterId, version, location, item)
+}
+
 // GetInventory mocks base method.
 func (m *MockInventoryService) GetInventory(ctx context.Context, characterId uint) (*character.Inventory, error) {
 	m.ctrl.T.Helper()
@@ -55,6 +71,125 @@ func (mr *MockInventoryServiceMockRecorder) GetInventory(ctx, characterId any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInventory", reflect.TypeOf((*MockInventoryService)(nil).GetInventory), ctx, characterId)
 }
 
+// History mocks base method.
+func (m *MockInventoryService) History(ctx context.Context, characterId uint, limit uint32) (character.InventoryEvents, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "History", ctx, characterId, limit)
+	ret0, _ := ret[0].(character.InventoryEvents)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// History indicates an expected call of History.
+func (mr *MockInventoryServiceMockRecorder) History(ctx, characterId, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "History", reflect.TypeOf((*MockInventoryService)(nil).History), ctx, characterId, limit)
+}
+
+// MergeStack mocks base method.
+func (m *MockInventoryService) MergeStack(ctx context.Context, characterId uint, version uint64, location character.InventoryLocation, from, to uint32) (*character.Inventory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MergeStack", ctx, characterId, version, location, from, to)
+	ret0, _ := ret[0].(*character.Inventory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MergeStack indicates an expected call of MergeStack.
+func (mr *MockInventoryServiceMockRecorder) MergeStack(ctx, characterId, version, location, from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeStack", reflect.TypeOf((*MockInventoryService)(nil).MergeStack), ctx, characterId, version, location, from, to)
+}
+
+// MoveItem mocks base method.
+func (m *MockInventoryService) MoveItem(ctx context.Context, characterId uint, version uint64, location character.InventoryLocation, from, to uint32) (*character.Inventory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveItem", ctx, characterId, version, location, from, to)
+	ret0, _ := ret[0].(*character.Inventory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveItem indicates an expected call of MoveItem.
+func (mr *MockInventoryServiceMockRecorder) MoveItem(ctx, characterId, version, location, from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveItem", reflect.TypeOf((*MockInventoryService)(nil).MoveItem), ctx, characterId, version, location, from, to)
+}
+
+// RemoveItem mocks base method.
+func (m *MockInventoryService) RemoveItem(ctx context.Context, characterId uint, version uint64, location character.InventoryLocation, slot uint32, quantity uint64) (*character.Inventory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveItem", ctx, characterId, version, location, slot, quantity)
+	ret0, _ := ret[0].(*character.Inventory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveItem indicates an expected call of RemoveItem.
+func (mr *MockInventoryServiceMockRecorder) RemoveItem(ctx, characterId, version, location, slot, quantity any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveItem", reflect.TypeOf((*MockInventoryService)(nil).RemoveItem), ctx, characterId, version, location, slot, quantity)
+}
+
+// Restore mocks base method.
+func (m *MockInventoryService) Restore(ctx context.Context, characterId uint, at time.Time, reason string) (*character.Inventory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", ctx, characterId, at, reason)
+	ret0, _ := ret[0].(*character.Inventory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockInventoryServiceMockRecorder) Restore(ctx, characterId, at, reason any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockInventoryService)(nil).Restore), ctx, characterId, at, reason)
+}
+
+// SetStartingInventory mocks base method.
+func (m *MockInventoryService) SetStartingInventory(ctx context.Context, characterId uint, items character.InventoryItems) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetStartingInventory", ctx, characterId, items)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetStartingInventory indicates an expected call of SetStartingInventory.
+func (mr *MockInventoryServiceMockRecorder) SetStartingInventory(ctx, characterId, items any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetStartingInventory", reflect.TypeOf((*MockInventoryService)(nil).SetStartingInventory), ctx, characterId, items)
+}
+
+// SplitStack mocks base method.
+func (m *MockInventoryService) SplitStack(ctx context.Context, characterId uint, version uint64, location character.InventoryLocation, from, to uint32, quantity uint64) (*character.Inventory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SplitStack", ctx, characterId, version, location, from, to, quantity)
+	ret0, _ := ret[0].(*character.Inventory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SplitStack indicates an expected call of SplitStack.
+func (mr *MockInventoryServiceMockRecorder) SplitStack(ctx, characterId, version, location, from, to, quantity any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SplitStack", reflect.TypeOf((*MockInventoryService)(nil).SplitStack), ctx, characterId, version, location, from, to, quantity)
+}
+
+// TransferItem mocks base method.
+func (m *MockInventoryService) TransferItem(ctx context.Context, characterId uint, version uint64, from character.InventoryLocation, fromSlot, toSlot uint32) (*character.Inventory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransferItem", ctx, characterId, version, from, fromSlot, toSlot)
+	ret0, _ := ret[0].(*character.Inventory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TransferItem indicates an expected call of TransferItem.
+func (mr *MockInventoryServiceMockRecorder) TransferItem(ctx, characterId, version, from, fromSlot, toSlot any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransferItem", reflect.TypeOf((*MockInventoryService)(nil).TransferItem), ctx, characterId, version, from, fromSlot, toSlot)
+}
+
 // UpdateInventory mocks base method.
 func (m *MockInventoryService) UpdateInventory(ctx context.Context, inventory *character.Inventory) error {
 	m.ctrl.T.Helper()
@@ -0,0 +1,121 @@
+package character
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+
+	"github.com/ShatteredRealms/go-backend/pkg/pb"
+)
+
+const (
+	// AppearanceVersion current version of the appearance format. Appearances are always saved with it.
+	AppearanceVersion = 1
+)
+
+var (
+	AppearanceColorRegex = regexp.MustCompile("^#[0-9a-fA-F]{6}$")
+
+	// ErrAppearanceBodyType thrown when the body type is not allowed by the creation rules
+	ErrAppearanceBodyType = errors.New("body type is not allowed")
+
+	// ErrAppearanceFacePreset thrown when the face preset is not allowed by the creation rules
+	ErrAppearanceFacePreset = errors.New("face preset is not allowed")
+
+	// ErrAppearanceColorPart thrown when coloring a part that the creation rules do not allow to be colored
+	ErrAppearanceColorPart = errors.New("part cannot be colored")
+
+	// ErrAppearanceColor thrown when a color is not a hex color such as #a1b2c3
+	ErrAppearanceColor = errors.New("color must be a hex color such as #a1b2c3")
+
+	// ErrAppearanceMorph thrown when a morph is not allowed by the creation rules
+	ErrAppearanceMorph = errors.New("morph is not allowed")
+
+	// ErrAppearanceMorphWeight thrown when the weight of a morph is outside the bounds in the creation rules
+	ErrAppearanceMorphWeight = errors.New("morph weight is out of bounds")
+)
+
+// Appearance how a character looks
+type Appearance struct {
+	// Version of the appearance format the appearance was saved with
+	Version    uint32 `json:"version"`
+	BodyType   string `json:"bodyType"`
+	FacePreset string `json:"facePreset"`
+
+	// Colors hex colors by the part they color
+	Colors map[string]string `json:"colors"`
+
+	// Morphs weights of body and face morphs by name
+	Morphs map[string]float32 `json:"morphs"`
+}
+
+// Validate verifies the appearance only uses options allowed by the rules and its morphs are within their bounds
+func (appearance *Appearance) Validate(rules *AppearanceRules) error {
+	if !allowed(rules.BodyTypes, appearance.BodyType) {
+		return ErrAppearanceBodyType
+	}
+
+	if !allowed(rules.FacePresets, appearance.FacePreset) {
+		return ErrAppearanceFacePreset
+	}
+
+	for part, color := range appearance.Colors {
+		if !contains(rules.ColorParts, part) {
+			return fmt.Errorf("%s: %w", part, ErrAppearanceColorPart)
+		}
+		if !AppearanceColorRegex.MatchString(color) {
+			return fmt.Errorf("%s: %w", part, ErrAppearanceColor)
+		}
+	}
+
+	for name, weight := range appearance.Morphs {
+		morph := rules.Morph(name)
+		if morph == nil {
+			return fmt.Errorf("%s: %w", name, ErrAppearanceMorph)
+		}
+		if weight < morph.Min || weight > morph.Max {
+			return fmt.Errorf("%s: %w", name, ErrAppearanceMorphWeight)
+		}
+	}
+
+	return nil
+}
+
+func (appearance *Appearance) ToPb() *pb.CharacterAppearance {
+	return &pb.CharacterAppearance{
+		Version:    appearance.Version,
+		BodyType:   appearance.BodyType,
+		FacePreset: appearance.FacePreset,
+		Colors:     appearance.Colors,
+		Morphs:     appearance.Morphs,
+	}
+}
+
+func AppearanceFromPb(appearance *pb.CharacterAppearance) *Appearance {
+	return &Appearance{
+		Version:    appearance.Version,
+		BodyType:   appearance.BodyType,
+		FacePreset: appearance.FacePreset,
+		Colors:     appearance.Colors,
+		Morphs:     appearance.Morphs,
+	}
+}
+
+// allowed checks whether the value is one of the options. Only an empty value is allowed if there are no options.
+func allowed(options []string, value string) bool {
+	if len(options) == 0 {
+		return value == ""
+	}
+
+	return contains(options, value)
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,84 @@
+package character_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/ShatteredRealms/go-backend/pkg/model/character"
+)
+
+var _ = Describe("Appearance model", func() {
+	var (
+		rules      *character.AppearanceRules
+		appearance *character.Appearance
+	)
+
+	BeforeEach(func() {
+		rules = &character.AppearanceRules{
+			BodyTypes:   []string{"slim", "broad"},
+			FacePresets: []string{"round", "sharp"},
+			ColorParts:  []string{"skin", "hair"},
+			Morphs:      character.MorphRules{{Name: "height", Min: -1, Max: 1}},
+		}
+		appearance = &character.Appearance{
+			BodyType:   "broad",
+			FacePreset: "sharp",
+			Colors:     map[string]string{"hair": "#A1b2c3"},
+			Morphs:     map[string]float32{"height": 0.5},
+		}
+	})
+
+	Describe("Validate", func() {
+		It("should succeed for allowed options", func() {
+			Expect(appearance.Validate(rules)).To(Succeed())
+		})
+
+		It("should error on options not in the rules", func() {
+			appearance.BodyType = "tall"
+			Expect(appearance.Validate(rules)).To(MatchError(character.ErrAppearanceBodyType))
+
+			appearance.BodyType = "slim"
+			appearance.FacePreset = ""
+			Expect(appearance.Validate(rules)).To(MatchError(character.ErrAppearanceFacePreset))
+		})
+
+		It("should only allow empty options if the rules have none", func() {
+			rules.BodyTypes = nil
+			Expect(appearance.Validate(rules)).To(MatchError(character.ErrAppearanceBodyType))
+
+			appearance.BodyType = ""
+			Expect(appearance.Validate(rules)).To(Succeed())
+		})
+
+		It("should error on invalid colors", func() {
+			appearance.Colors["eyes"] = "#000000"
+			Expect(appearance.Validate(rules)).To(MatchError(character.ErrAppearanceColorPart))
+
+			delete(appearance.Colors, "eyes")
+			appearance.Colors["skin"] = "red"
+			Expect(appearance.Validate(rules)).To(MatchError(character.ErrAppearanceColor))
+		})
+
+		It("should error on invalid morphs", func() {
+			appearance.Morphs["nose"] = 0
+			Expect(appearance.Validate(rules)).To(MatchError(character.ErrAppearanceMorph))
+
+			delete(appearance.Morphs, "nose")
+			appearance.Morphs["height"] = 1.1
+			Expect(appearance.Validate(rules)).To(MatchError(character.ErrAppearanceMorphWeight))
+		})
+	})
+
+	It("should use the first options by default", func() {
+		out := rules.DefaultAppearance()
+		Expect(out.Version).To(BeEquivalentTo(character.AppearanceVersion))
+		Expect(out.BodyType).To(Equal("slim"))
+		Expect(out.FacePreset).To(Equal("round"))
+		Expect(out.Validate(rules)).To(Succeed())
+	})
+
+	It("should convert to and from protobuf", func() {
+		appearance.Version = character.AppearanceVersion
+		Expect(character.AppearanceFromPb(appearance.ToPb())).To(Equal(appearance))
+	})
+})
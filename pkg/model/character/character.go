@@ -45,15 +45,23 @@ type Character struct {
 
 	// Location last location recorded for the character
 	Location game.Location `gorm:"type:bytes;serializer:gob" json:"location"`
+
+	Level uint32 `gorm:"not null;default:1" json:"level"`
+
+	// Experience gained towards the next level
+	Experience uint64     `gorm:"not null;default:0" json:"experience"`
+	Attributes Attributes `gorm:"embedded;embeddedPrefix:attribute_" json:"attributes"`
+	Appearance Appearance `gorm:"type:jsonb;serializer:json" json:"appearance"`
 }
 type Characters []*Character
 
-func (c *Character) Validate() error {
-	if err := c.ValidateGender(); err != nil {
+// Validate verifies the name is allowed and the gender and realm are allowed by the creation rules
+func (c *Character) Validate(rules *CreationRules) error {
+	if err := c.ValidateGender(rules); err != nil {
 		return err
 	}
 
-	if err := c.ValidateRealm(); err != nil {
+	if err := c.ValidateRealm(rules); err != nil {
 		return err
 	}
 
@@ -80,18 +88,16 @@ func (c *Character) ValidateName() error {
 	return nil
 }
 
-func (c *Character) ValidateGender() error {
-	if c.Gender == "Male" ||
-		c.Gender == "Female" {
+func (c *Character) ValidateGender(rules *CreationRules) error {
+	if rules.HasGender(c.Gender) {
 		return nil
 	}
 
 	return common.ErrInvalidGender
 }
 
-func (c *Character) ValidateRealm() error {
-	if c.Realm == "Human" ||
-		c.Realm == "Cyborg" {
+func (c *Character) ValidateRealm(rules *CreationRules) error {
+	if rules.Realm(c.Realm) != nil {
 		return nil
 	}
 
@@ -100,14 +106,18 @@ func (c *Character) ValidateRealm() error {
 
 func (c *Character) ToPb() *pb.CharacterDetails {
 	return &pb.CharacterDetails{
-		Id:        uint64(c.ID),
-		Owner:     c.OwnerId,
-		Name:      c.Name,
-		Gender:    c.Gender,
-		Realm:     c.Realm,
-		PlayTime:  c.PlayTime,
-		Location:  c.Location.ToPb(),
-		Dimension: c.Dimension,
+		Id:         uint64(c.ID),
+		Owner:      c.OwnerId,
+		Name:       c.Name,
+		Gender:     c.Gender,
+		Realm:      c.Realm,
+		PlayTime:   c.PlayTime,
+		Location:   c.Location.ToPb(),
+		Dimension:  c.Dimension,
+		Level:      c.Level,
+		Experience: c.Experience,
+		Attributes: c.Attributes.ToPb(),
+		Appearance: c.Appearance.ToPb(),
 	}
 }
 
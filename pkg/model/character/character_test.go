@@ -12,7 +12,8 @@ import (
 
 var _ = Describe("Character model", func() {
 	var (
-		char = &character.Character{}
+		char  = &character.Character{}
+		rules = character.DefaultCreationRules()
 	)
 
 	BeforeEach(func() {
@@ -26,42 +27,42 @@ var _ = Describe("Character model", func() {
 		Context("issues", func() {
 			It("wrong gender should error", func() {
 				char.Gender = faker.Email()
-				Expect(char.Validate()).To(MatchError(common.ErrInvalidGender))
+				Expect(char.Validate(&rules)).To(MatchError(common.ErrInvalidGender))
 			})
 
 			It("wrong realm should error", func() {
 				char.Realm = faker.Email()
-				Expect(char.Validate()).To(MatchError(common.ErrInvalidRealm))
+				Expect(char.Validate(&rules)).To(MatchError(common.ErrInvalidRealm))
 			})
 
 			Context("with name", func() {
 				It("should error while under minimum length", func() {
 					char.Name = "a"
-					Expect(char.Validate()).To(MatchError(character.ErrCharacterNameToShort))
+					Expect(char.Validate(&rules)).To(MatchError(character.ErrCharacterNameToShort))
 				})
 				It("should error while above maximum length", func() {
 					char.Name = "aaaaaaaaaaaaaaaaaaaaa"
-					Expect(char.Validate()).To(MatchError(character.ErrCharacterNameToLong))
+					Expect(char.Validate(&rules)).To(MatchError(character.ErrCharacterNameToLong))
 				})
 				It("should only allow letters and numbers", func() {
 					char.Name = "name@"
-					Expect(char.Validate()).To(MatchError(common.ErrInvalidName))
+					Expect(char.Validate(&rules)).To(MatchError(common.ErrInvalidName))
 					char.Name = "!name"
-					Expect(char.Validate()).To(MatchError(common.ErrInvalidName))
+					Expect(char.Validate(&rules)).To(MatchError(common.ErrInvalidName))
 					char.Name = " name"
-					Expect(char.Validate()).To(MatchError(common.ErrInvalidName))
+					Expect(char.Validate(&rules)).To(MatchError(common.ErrInvalidName))
 					char.Name = "name_"
-					Expect(char.Validate()).To(MatchError(common.ErrInvalidName))
+					Expect(char.Validate(&rules)).To(MatchError(common.ErrInvalidName))
 				})
 
 				It("shouldn't allow profanity", func() {
 					char.Name = "fuck"
-					Expect(char.Validate()).To(MatchError(common.ErrNameProfane))
+					Expect(char.Validate(&rules)).To(MatchError(common.ErrNameProfane))
 				})
 			})
 		})
 		It("should not error for valid character", func() {
-			Expect(char.Validate()).To(Succeed())
+			Expect(char.Validate(&rules)).To(Succeed())
 		})
 	})
 
@@ -73,6 +74,10 @@ var _ = Describe("Character model", func() {
 		Expect(pb.Realm).To(Equal(char.Realm))
 		Expect(pb.PlayTime).To(Equal(char.PlayTime))
 		Expect(pb.Location).NotTo(BeNil())
+		Expect(pb.Level).To(Equal(char.Level))
+		Expect(pb.Experience).To(Equal(char.Experience))
+		Expect(pb.Attributes.Health).To(Equal(char.Attributes.Health))
+		Expect(pb.Attributes.Intellect).To(Equal(char.Attributes.Intellect))
 	})
 
 	Describe("ToPb", func() {
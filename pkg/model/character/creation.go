@@ -0,0 +1,239 @@
+package character
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ShatteredRealms/go-backend/pkg/model/game"
+	"github.com/ShatteredRealms/go-backend/pkg/pb"
+)
+
+var (
+	// ErrCreationNoGenders thrown when the creation rules do not allow any gender
+	ErrCreationNoGenders = errors.New("at least one gender is required")
+
+	// ErrCreationNoRealms thrown when the creation rules do not allow any realm
+	ErrCreationNoRealms = errors.New("at least one realm is required")
+
+	// ErrCreationDuplicate thrown when a gender, realm or appearance option is listed more than once
+	ErrCreationDuplicate = errors.New("listed more than once")
+
+	// ErrCreationEmpty thrown when a gender, realm or appearance option has no name
+	ErrCreationEmpty = errors.New("cannot be empty")
+
+	// ErrCreationMorphBounds thrown when the min of a morph is above its max
+	ErrCreationMorphBounds = errors.New("morph min cannot be above its max")
+)
+
+// CreationRules options a character can be created with
+type CreationRules struct {
+	Genders []string   `yaml:"genders" json:"genders"`
+	Realms  RealmRules `yaml:"realms" json:"realms"`
+
+	// Appearance options characters can pick from
+	Appearance AppearanceRules `yaml:"appearance" json:"appearance"`
+}
+
+// RealmRule a realm characters can be created in and what they start with
+type RealmRule struct {
+	Name             string        `yaml:"name" json:"name"`
+	StartingLocation game.Location `yaml:"startingLocation" json:"startingLocation"`
+
+	// StartingInventory items given to characters created in the realm
+	StartingInventory InventoryItems `yaml:"startingInventory" json:"startingInventory"`
+}
+
+type RealmRules []*RealmRule
+
+// AppearanceRules options a character appearance is validated against
+type AppearanceRules struct {
+	BodyTypes   []string `yaml:"bodyTypes" json:"bodyTypes"`
+	FacePresets []string `yaml:"facePresets" json:"facePresets"`
+
+	// ColorParts parts of a character that can be colored, such as skin or hair
+	ColorParts []string   `yaml:"colorParts" json:"colorParts"`
+	Morphs     MorphRules `yaml:"morphs" json:"morphs"`
+}
+
+// MorphRule bounds of the weight of a body or face morph
+type MorphRule struct {
+	Name string  `yaml:"name" json:"name"`
+	Min  float32 `yaml:"min" json:"min"`
+	Max  float32 `yaml:"max" json:"max"`
+}
+
+type MorphRules []*MorphRule
+
+// DefaultCreationRules rules used when none are configured
+func DefaultCreationRules() CreationRules {
+	return CreationRules{
+		Genders: []string{"Male", "Female"},
+		Realms: RealmRules{
+			{Name: "Human"},
+			{Name: "Cyborg"},
+		},
+	}
+}
+
+// Validate verifies the rules allow creating characters and nothing is listed twice
+func (rules *CreationRules) Validate() error {
+	if len(rules.Genders) == 0 {
+		return ErrCreationNoGenders
+	}
+	err := unique("gender", rules.Genders)
+	if err != nil {
+		return err
+	}
+
+	if len(rules.Realms) == 0 {
+		return ErrCreationNoRealms
+	}
+	realms := make([]string, len(rules.Realms))
+	for idx, realm := range rules.Realms {
+		realms[idx] = realm.Name
+		err = uniqueSlots(realm)
+		if err != nil {
+			return err
+		}
+	}
+	err = unique("realm", realms)
+	if err != nil {
+		return err
+	}
+
+	return rules.Appearance.Validate()
+}
+
+// Validate verifies nothing is listed twice and the morph bounds are valid
+func (rules *AppearanceRules) Validate() error {
+	err := unique("body type", rules.BodyTypes)
+	if err != nil {
+		return err
+	}
+
+	err = unique("face preset", rules.FacePresets)
+	if err != nil {
+		return err
+	}
+
+	err = unique("color part", rules.ColorParts)
+	if err != nil {
+		return err
+	}
+
+	morphs := make([]string, len(rules.Morphs))
+	for idx, morph := range rules.Morphs {
+		morphs[idx] = morph.Name
+		if morph.Min > morph.Max {
+			return fmt.Errorf("morph '%s': %w", morph.Name, ErrCreationMorphBounds)
+		}
+	}
+
+	return unique("morph", morphs)
+}
+
+// Morph gets the bounds of the morph with the name, or nil if it cannot be set
+func (rules *AppearanceRules) Morph(name string) *MorphRule {
+	for _, morph := range rules.Morphs {
+		if morph.Name == name {
+			return morph
+		}
+	}
+
+	return nil
+}
+
+// DefaultAppearance gets the appearance used when a character is created without one
+func (rules *AppearanceRules) DefaultAppearance() *Appearance {
+	appearance := &Appearance{Version: AppearanceVersion}
+	if len(rules.BodyTypes) > 0 {
+		appearance.BodyType = rules.BodyTypes[0]
+	}
+	if len(rules.FacePresets) > 0 {
+		appearance.FacePreset = rules.FacePresets[0]
+	}
+
+	return appearance
+}
+
+// HasGender checks whether characters can be created with the gender
+func (rules *CreationRules) HasGender(gender string) bool {
+	return contains(rules.Genders, gender)
+}
+
+// Realm gets the rules for the realm with the name, or nil if characters cannot be created in it
+func (rules *CreationRules) Realm(name string) *RealmRule {
+	for _, realm := range rules.Realms {
+		if realm.Name == name {
+			return realm
+		}
+	}
+
+	return nil
+}
+
+func (rules *CreationRules) ToPb() *pb.CharacterCreationOptions {
+	out := &pb.CharacterCreationOptions{
+		Genders:    rules.Genders,
+		Realms:     make([]*pb.RealmCreationOptions, len(rules.Realms)),
+		Appearance: rules.Appearance.ToPb(),
+	}
+	for idx, realm := range rules.Realms {
+		out.Realms[idx] = realm.ToPb()
+	}
+
+	return out
+}
+
+func (rules *AppearanceRules) ToPb() *pb.AppearanceOptions {
+	out := &pb.AppearanceOptions{
+		BodyTypes:   rules.BodyTypes,
+		FacePresets: rules.FacePresets,
+		ColorParts:  rules.ColorParts,
+		Morphs:      make([]*pb.MorphOption, len(rules.Morphs)),
+	}
+	for idx, morph := range rules.Morphs {
+		out.Morphs[idx] = &pb.MorphOption{
+			Name: morph.Name,
+			Min:  morph.Min,
+			Max:  morph.Max,
+		}
+	}
+
+	return out
+}
+
+func (realm *RealmRule) ToPb() *pb.RealmCreationOptions {
+	return &pb.RealmCreationOptions{
+		Name:              realm.Name,
+		StartingLocation:  realm.StartingLocation.ToPb(),
+		StartingInventory: realm.StartingInventory.ToPb(),
+	}
+}
+
+func unique(kind string, names []string) error {
+	seen := make(map[string]struct{}, len(names))
+	for _, name := range names {
+		if name == "" {
+			return fmt.Errorf("%s: %w", kind, ErrCreationEmpty)
+		}
+		if _, ok := seen[name]; ok {
+			return fmt.Errorf("%s '%s': %w", kind, name, ErrCreationDuplicate)
+		}
+		seen[name] = struct{}{}
+	}
+
+	return nil
+}
+
+func uniqueSlots(realm *RealmRule) error {
+	slots := make(map[uint32]struct{}, len(realm.StartingInventory))
+	for _, item := range realm.StartingInventory {
+		if _, ok := slots[item.Slot]; ok {
+			return fmt.Errorf("realm '%s' starting inventory: %w", realm.Name, ErrInventorySlotOccupied)
+		}
+		slots[item.Slot] = struct{}{}
+	}
+
+	return nil
+}
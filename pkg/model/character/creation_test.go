@@ -0,0 +1,94 @@
+package character_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/ShatteredRealms/go-backend/pkg/model/character"
+	"github.com/ShatteredRealms/go-backend/pkg/model/game"
+)
+
+var _ = Describe("Creation rules model", func() {
+	var rules character.CreationRules
+
+	BeforeEach(func() {
+		rules = character.DefaultCreationRules()
+		rules.Realms[0].StartingLocation = game.Location{World: "start", X: 1}
+		rules.Realms[0].StartingInventory = character.InventoryItems{{Id: "sword", Slot: 0, Quantity: 1}}
+		rules.Appearance = character.AppearanceRules{
+			BodyTypes:   []string{"slim", "broad"},
+			FacePresets: []string{"round"},
+			ColorParts:  []string{"skin", "hair"},
+			Morphs:      character.MorphRules{{Name: "height", Min: -1, Max: 1}},
+		}
+	})
+
+	Describe("Validate", func() {
+		It("should succeed for valid rules", func() {
+			Expect(rules.Validate()).To(Succeed())
+		})
+
+		It("should require genders and realms", func() {
+			genders := rules.Genders
+			rules.Genders = nil
+			Expect(rules.Validate()).To(MatchError(character.ErrCreationNoGenders))
+
+			rules.Genders = genders
+			rules.Realms = nil
+			Expect(rules.Validate()).To(MatchError(character.ErrCreationNoRealms))
+		})
+
+		It("should error on duplicates", func() {
+			rules.Genders = append(rules.Genders, rules.Genders[0])
+			Expect(rules.Validate()).To(MatchError(character.ErrCreationDuplicate))
+		})
+
+		It("should error on duplicate realms", func() {
+			rules.Realms = append(rules.Realms, &character.RealmRule{Name: rules.Realms[0].Name})
+			Expect(rules.Validate()).To(MatchError(character.ErrCreationDuplicate))
+		})
+
+		It("should error on empty names", func() {
+			rules.Realms[1].Name = ""
+			Expect(rules.Validate()).To(MatchError(character.ErrCreationEmpty))
+		})
+
+		It("should error on duplicate appearance options", func() {
+			rules.Appearance.ColorParts = append(rules.Appearance.ColorParts, "skin")
+			Expect(rules.Validate()).To(MatchError(character.ErrCreationDuplicate))
+		})
+
+		It("should error on morphs with a min above the max", func() {
+			rules.Appearance.Morphs[0].Min = 2
+			Expect(rules.Validate()).To(MatchError(character.ErrCreationMorphBounds))
+		})
+
+		It("should error on starting items in the same slot", func() {
+			rules.Realms[0].StartingInventory = append(
+				rules.Realms[0].StartingInventory,
+				&character.InventoryItem{Id: "shield", Slot: 0, Quantity: 1},
+			)
+			Expect(rules.Validate()).To(MatchError(character.ErrInventorySlotOccupied))
+		})
+	})
+
+	It("should find allowed genders and realms", func() {
+		Expect(rules.HasGender("Male")).To(BeTrue())
+		Expect(rules.HasGender("Robot")).To(BeFalse())
+		Expect(rules.Realm("Human")).To(Equal(rules.Realms[0]))
+		Expect(rules.Realm("Elf")).To(BeNil())
+	})
+
+	It("should convert to protobuf", func() {
+		out := rules.ToPb()
+		Expect(out.Genders).To(Equal(rules.Genders))
+		Expect(out.Realms).To(HaveLen(len(rules.Realms)))
+		Expect(out.Realms[0].Name).To(Equal(rules.Realms[0].Name))
+		Expect(out.Realms[0].StartingLocation.World).To(Equal("start"))
+		Expect(out.Realms[0].StartingInventory).To(HaveLen(1))
+		Expect(out.Appearance.BodyTypes).To(Equal(rules.Appearance.BodyTypes))
+		Expect(out.Appearance.ColorParts).To(Equal(rules.Appearance.ColorParts))
+		Expect(out.Appearance.Morphs).To(HaveLen(1))
+		Expect(out.Appearance.Morphs[0].Max).To(BeEquivalentTo(1))
+	})
+})
@@ -0,0 +1,260 @@
+package character
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"time"
+
+	"github.com/ShatteredRealms/go-backend/pkg/common"
+	"github.com/ShatteredRealms/go-backend/pkg/pb"
+	goaway "github.com/TwiN/go-away"
+)
+
+const (
+	MinGuildNameLength = 3
+	MaxGuildNameLength = 24
+
+	MaxGuildRankNameLength = 20
+	MaxGuildMotdLength     = 256
+
+	// GuildLeaderLevel level of the rank held by the guild leader
+	GuildLeaderLevel = 0
+)
+
+var (
+	// GuildNameRegex guild names are words separated by single spaces
+	GuildNameRegex = regexp.MustCompile("^[a-zA-Z0-9]+( [a-zA-Z0-9]+)*$")
+
+	// ErrGuildNameToShort thrown when a guild name is too short
+	ErrGuildNameToShort = fmt.Errorf("name must be at least %d characters", MinGuildNameLength)
+
+	// ErrGuildNameToLong thrown when a guild name is too long
+	ErrGuildNameToLong = fmt.Errorf("name can be at most %d characters", MaxGuildNameLength)
+
+	// ErrGuildRankName thrown when a guild rank name is empty or too long
+	ErrGuildRankName = fmt.Errorf("rank name must be between 1 and %d characters", MaxGuildRankNameLength)
+
+	// ErrGuildMotdToLong thrown when a guild message of the day is too long
+	ErrGuildMotdToLong = fmt.Errorf("message of the day can be at most %d characters", MaxGuildMotdLength)
+)
+
+type Guild struct {
+	ID        uint `gorm:"primarykey"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+
+	Name      string `gorm:"not null;uniqueIndex:udx_guild_name" json:"name"`
+	Dimension string `gorm:"not null;uniqueIndex:udx_guild_name" json:"dimension"`
+
+	// Motd message of the day shown to guild members
+	Motd string `gorm:"not null;default:''" json:"motd"`
+
+	// LeaderId id of the character that leads the guild
+	LeaderId uint `gorm:"not null" json:"leaderId"`
+
+	// ChatChannelId id of the private chat channel for the guild members. Zero if it has not been provisioned.
+	ChatChannelId uint `gorm:"not null;default:0" json:"chatChannelId"`
+
+	Ranks GuildRanks `gorm:"constraint:OnDelete:CASCADE" json:"ranks"`
+}
+type Guilds []*Guild
+
+// GuildRank rank within a guild and the permissions granted to its members. The guild leader always has every
+// permission.
+type GuildRank struct {
+	ID      uint   `gorm:"primarykey"`
+	GuildId uint   `gorm:"not null;uniqueIndex:udx_guild_rank_level" json:"guildId"`
+	Name    string `gorm:"not null" json:"name"`
+
+	// Level position of the rank in the guild where lower levels outrank higher levels
+	Level uint32 `gorm:"not null;uniqueIndex:udx_guild_rank_level" json:"level"`
+
+	Invite     bool `gorm:"not null;default:false" json:"invite"`
+	Kick       bool `gorm:"not null;default:false" json:"kick"`
+	EditMotd   bool `gorm:"not null;default:false" json:"editMotd"`
+	ManageBank bool `gorm:"not null;default:false" json:"manageBank"`
+}
+type GuildRanks []*GuildRank
+
+// GuildMember a character in a guild. Characters can only be in one guild at a time.
+type GuildMember struct {
+	CharacterId uint      `gorm:"primarykey" json:"characterId"`
+	GuildId     uint      `gorm:"not null;index" json:"guildId"`
+	RankId      uint      `gorm:"not null" json:"rankId"`
+	CreatedAt   time.Time `json:"joinedAt"`
+}
+type GuildMembers []*GuildMember
+
+// GuildInvite an invite for a character to join a guild
+type GuildInvite struct {
+	GuildId     uint      `gorm:"primarykey" json:"guildId"`
+	CharacterId uint      `gorm:"primarykey" json:"characterId"`
+	InviterId   uint      `json:"inviterId"`
+	CreatedAt   time.Time `json:"createdAt"`
+
+	Guild *Guild `gorm:"constraint:OnDelete:CASCADE" json:"-"`
+}
+type GuildInvites []*GuildInvite
+
+// DefaultGuildRanks ranks given to newly created guilds
+func DefaultGuildRanks() GuildRanks {
+	return GuildRanks{
+		{Name: "Leader", Level: GuildLeaderLevel, Invite: true, Kick: true, EditMotd: true, ManageBank: true},
+		{Name: "Officer", Level: 1, Invite: true, Kick: true, EditMotd: true},
+		{Name: "Member", Level: 2},
+	}
+}
+
+func (g *Guild) Validate() error {
+	if len(g.Name) < MinGuildNameLength {
+		return ErrGuildNameToShort
+	}
+
+	if len(g.Name) > MaxGuildNameLength {
+		return ErrGuildNameToLong
+	}
+
+	if !GuildNameRegex.MatchString(g.Name) {
+		return common.ErrInvalidName
+	}
+
+	if goaway.IsProfane(g.Name) {
+		return common.ErrNameProfane
+	}
+
+	if len(g.Motd) > MaxGuildMotdLength {
+		return ErrGuildMotdToLong
+	}
+
+	return nil
+}
+
+// IsLeader checks if the character leads the guild
+func (g *Guild) IsLeader(characterId uint) bool {
+	return g.LeaderId == characterId
+}
+
+// Rank gets the guild rank with the given id or nil if it is not a rank of the guild
+func (g *Guild) Rank(id uint) *GuildRank {
+	for _, rank := range g.Ranks {
+		if rank.ID == id {
+			return rank
+		}
+	}
+
+	return nil
+}
+
+// LowestRank gets the rank with the highest level, which is given to new members
+func (g *Guild) LowestRank() *GuildRank {
+	var lowest *GuildRank
+	for _, rank := range g.Ranks {
+		if lowest == nil || rank.Level > lowest.Level {
+			lowest = rank
+		}
+	}
+
+	return lowest
+}
+
+func (g *Guild) ToPb() *pb.Guild {
+	ranks := slices.Clone(g.Ranks)
+	slices.SortFunc(ranks, func(a, b *GuildRank) int {
+		return int(a.Level) - int(b.Level)
+	})
+
+	return &pb.Guild{
+		Id:            uint64(g.ID),
+		Name:          g.Name,
+		Dimension:     g.Dimension,
+		Motd:          g.Motd,
+		LeaderId:      uint64(g.LeaderId),
+		ChatChannelId: uint64(g.ChatChannelId),
+		Ranks:         ranks.ToPb(),
+		CreatedAt:     g.CreatedAt.Unix(),
+	}
+}
+
+func (r *GuildRank) Validate() error {
+	if len(r.Name) == 0 || len(r.Name) > MaxGuildRankNameLength {
+		return ErrGuildRankName
+	}
+
+	return nil
+}
+
+// IsLeader checks if the rank is the guild leader rank
+func (r *GuildRank) IsLeader() bool {
+	return r.Level == GuildLeaderLevel
+}
+
+// Outranks checks if the rank is higher than the other rank
+func (r *GuildRank) Outranks(other *GuildRank) bool {
+	return r.Level < other.Level
+}
+
+func (r *GuildRank) ToPb() *pb.GuildRank {
+	return &pb.GuildRank{
+		Id:         uint64(r.ID),
+		Name:       r.Name,
+		Level:      r.Level,
+		Invite:     r.Invite,
+		Kick:       r.Kick,
+		EditMotd:   r.EditMotd,
+		ManageBank: r.ManageBank,
+	}
+}
+
+func (r GuildRanks) ToPb() []*pb.GuildRank {
+	out := make([]*pb.GuildRank, len(r))
+	for idx, rank := range r {
+		out[idx] = rank.ToPb()
+	}
+
+	return out
+}
+
+func GuildRankFromPb(rank *pb.GuildRank) *GuildRank {
+	return &GuildRank{
+		ID:         uint(rank.Id),
+		Name:       rank.Name,
+		Level:      rank.Level,
+		Invite:     rank.Invite,
+		Kick:       rank.Kick,
+		EditMotd:   rank.EditMotd,
+		ManageBank: rank.ManageBank,
+	}
+}
+
+// ToPb converts the guild members to their protobuf representation. Character names are not known and should be
+// added by the caller.
+func (m GuildMembers) ToPb() *pb.GuildMembers {
+	resp := &pb.GuildMembers{Members: make([]*pb.GuildMember, len(m))}
+	for idx, member := range m {
+		resp.Members[idx] = &pb.GuildMember{
+			CharacterId: uint64(member.CharacterId),
+			RankId:      uint64(member.RankId),
+			JoinedAt:    member.CreatedAt.Unix(),
+		}
+	}
+
+	return resp
+}
+
+func (i GuildInvites) ToPb() *pb.GuildInvites {
+	resp := &pb.GuildInvites{Invites: make([]*pb.GuildInvite, len(i))}
+	for idx, invite := range i {
+		resp.Invites[idx] = &pb.GuildInvite{
+			GuildId:     uint64(invite.GuildId),
+			CharacterId: uint64(invite.CharacterId),
+			InviterId:   uint64(invite.InviterId),
+			CreatedAt:   invite.CreatedAt.Unix(),
+		}
+		if invite.Guild != nil {
+			resp.Invites[idx].GuildName = invite.Guild.Name
+		}
+	}
+
+	return resp
+}
@@ -0,0 +1,136 @@
+package character_test
+
+import (
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/ShatteredRealms/go-backend/pkg/common"
+	"github.com/ShatteredRealms/go-backend/pkg/model/character"
+)
+
+var _ = Describe("Guild model", func() {
+	var (
+		guild *character.Guild
+	)
+
+	BeforeEach(func() {
+		guild = &character.Guild{
+			ID:        1,
+			CreatedAt: time.Now(),
+			Name:      "The Guild",
+			Dimension: "dimension",
+			LeaderId:  2,
+			Ranks:     character.DefaultGuildRanks(),
+		}
+		for idx, rank := range guild.Ranks {
+			rank.ID = uint(idx + 1)
+			rank.GuildId = guild.ID
+		}
+	})
+
+	Describe("Validate", func() {
+		It("should accept valid guilds", func() {
+			Expect(guild.Validate()).To(Succeed())
+		})
+
+		It("should error while under minimum length", func() {
+			guild.Name = "a"
+			Expect(guild.Validate()).To(MatchError(character.ErrGuildNameToShort))
+		})
+
+		It("should error while over maximum length", func() {
+			guild.Name = strings.Repeat("a", character.MaxGuildNameLength+1)
+			Expect(guild.Validate()).To(MatchError(character.ErrGuildNameToLong))
+		})
+
+		It("should error on invalid characters and spacing", func() {
+			for _, name := range []string{"the_guild", " guild", "guild ", "the  guild"} {
+				guild.Name = name
+				Expect(guild.Validate()).To(MatchError(common.ErrInvalidName), name)
+			}
+		})
+
+		It("should error on profane names", func() {
+			guild.Name = "fuck"
+			Expect(guild.Validate()).To(MatchError(common.ErrNameProfane))
+		})
+
+		It("should error on long messages of the day", func() {
+			guild.Motd = strings.Repeat("a", character.MaxGuildMotdLength+1)
+			Expect(guild.Validate()).To(MatchError(character.ErrGuildMotdToLong))
+		})
+	})
+
+	Describe("Ranks", func() {
+		It("should give the leader every permission by default", func() {
+			leader := guild.Rank(1)
+			Expect(leader).NotTo(BeNil())
+			Expect(leader.IsLeader()).To(BeTrue())
+			Expect(leader.Invite && leader.Kick && leader.EditMotd && leader.ManageBank).To(BeTrue())
+		})
+
+		It("should find ranks by id", func() {
+			Expect(guild.Rank(2).Name).To(Equal("Officer"))
+			Expect(guild.Rank(100)).To(BeNil())
+		})
+
+		It("should find the lowest rank", func() {
+			Expect(guild.LowestRank().Name).To(Equal("Member"))
+			guild.Ranks = append(guild.Ranks, &character.GuildRank{ID: 4, Name: "Recruit", Level: 10})
+			Expect(guild.LowestRank().Name).To(Equal("Recruit"))
+		})
+
+		It("should compare ranks by level", func() {
+			Expect(guild.Rank(1).Outranks(guild.Rank(2))).To(BeTrue())
+			Expect(guild.Rank(2).Outranks(guild.Rank(1))).To(BeFalse())
+			Expect(guild.Rank(2).Outranks(guild.Rank(2))).To(BeFalse())
+		})
+
+		It("should validate rank names", func() {
+			Expect(guild.Rank(1).Validate()).To(Succeed())
+			Expect((&character.GuildRank{}).Validate()).To(MatchError(character.ErrGuildRankName))
+			Expect((&character.GuildRank{Name: strings.Repeat("a", character.MaxGuildRankNameLength+1)}).Validate()).
+				To(MatchError(character.ErrGuildRankName))
+		})
+
+		It("should convert to and from protobuf", func() {
+			rank := guild.Rank(2)
+			Expect(character.GuildRankFromPb(rank.ToPb())).To(Equal(&character.GuildRank{
+				ID:       rank.ID,
+				Name:     rank.Name,
+				Level:    rank.Level,
+				Invite:   rank.Invite,
+				Kick:     rank.Kick,
+				EditMotd: rank.EditMotd,
+			}))
+		})
+	})
+
+	Describe("ToPb", func() {
+		It("should order ranks by level", func() {
+			guild.Ranks[0], guild.Ranks[2] = guild.Ranks[2], guild.Ranks[0]
+			out := guild.ToPb()
+			Expect(out.Id).To(BeEquivalentTo(guild.ID))
+			Expect(out.Name).To(Equal(guild.Name))
+			Expect(out.LeaderId).To(BeEquivalentTo(guild.LeaderId))
+			Expect(out.Ranks).To(HaveLen(3))
+			for idx, rank := range out.Ranks {
+				Expect(rank.Level).To(BeEquivalentTo(idx))
+			}
+			Expect(guild.Ranks[0].Name).To(Equal("Member"))
+		})
+
+		It("should include guild names in invites", func() {
+			out := character.GuildInvites{
+				{GuildId: guild.ID, CharacterId: 3, InviterId: 2, Guild: guild},
+				{GuildId: 5, CharacterId: 3},
+			}.ToPb()
+			Expect(out.Invites).To(HaveLen(2))
+			Expect(out.Invites[0].GuildName).To(Equal(guild.Name))
+			Expect(out.Invites[1].GuildName).To(BeEmpty())
+		})
+	})
+})
@@ -1,6 +1,67 @@
 package character
 
-import "github.com/ShatteredRealms/go-backend/pkg/pb"
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/ShatteredRealms/go-backend/pkg/pb"
+)
+
+var (
+	// ErrInventorySlotEmpty thrown when taking an item from an inventory slot without an item
+	ErrInventorySlotEmpty = errors.New("inventory slot is empty")
+
+	// ErrInventoryQuantity thrown when taking more of an item than the inventory slot has
+	ErrInventoryQuantity = errors.New("not enough items in the inventory slot")
+
+	// ErrInventoryGold thrown when taking more gold than the inventory has
+	ErrInventoryGold = errors.New("not enough gold")
+
+	// ErrInventoryUnknownItem thrown when an inventory has an item that is not in the item catalog
+	ErrInventoryUnknownItem = errors.New("unknown item")
+
+	// ErrInventoryEmptyItem thrown when an inventory has an item without a quantity
+	ErrInventoryEmptyItem = errors.New("item quantity must be at least 1")
+
+	// ErrInventoryOverStacked thrown when an inventory slot has more of an item than its max stack
+	ErrInventoryOverStacked = errors.New("item quantity exceeds its max stack")
+
+	// ErrInventoryDuplicateSlot thrown when an inventory has multiple items in the same slot
+	ErrInventoryDuplicateSlot = errors.New("multiple items in the same slot")
+
+	// ErrInventorySlotCapacity thrown when an inventory has an item in a slot beyond its capacity
+	ErrInventorySlotCapacity = errors.New("slot is beyond the inventory capacity")
+
+	// ErrInventorySlotOccupied thrown when placing an item in an inventory slot that already has an item
+	ErrInventorySlotOccupied = errors.New("inventory slot is not empty")
+
+	// ErrInventorySameSlot thrown when moving an item to the slot it is already in
+	ErrInventorySameSlot = errors.New("source and destination slots must differ")
+
+	// ErrInventoryMerge thrown when merging stacks of different items or into a full stack
+	ErrInventoryMerge = errors.New("stacks must be the same item and the destination must not be full")
+
+	// ErrInventoryLocation thrown when using an unknown inventory location
+	ErrInventoryLocation = errors.New("unknown inventory location")
+
+	// ErrInventoryVersion thrown when changing an inventory that changed since it was read
+	ErrInventoryVersion = errors.New("inventory changed since it was read")
+
+	// ErrInventoryFull thrown when giving an item to an inventory without an empty slot
+	ErrInventoryFull = errors.New("inventory is full")
+
+	// ErrInventoryGoldLimit thrown when giving more gold than an inventory can hold
+	ErrInventoryGoldLimit = errors.New("gold would exceed the maximum")
+)
+
+// InventoryLocation where items of an inventory are kept. The value is the name of the field the items are stored in.
+type InventoryLocation string
+
+const (
+	InventoryLocationInventory InventoryLocation = "inventory"
+	InventoryLocationBank      InventoryLocation = "bank"
+)
 
 type InventoryItem struct {
 	Id       string
@@ -14,6 +75,10 @@ type Inventory struct {
 	CharacterId uint           `json:"_id" bson:"_id"`
 	Inventory   InventoryItems `json:"inventory"`
 	Bank        InventoryItems `json:"bank"`
+	Gold        uint64         `json:"gold"`
+
+	// Version incremented on every change so concurrent changes can be detected
+	Version uint64 `json:"version"`
 }
 
 func (item *InventoryItem) ToPb() *pb.InventoryItem {
@@ -37,7 +102,406 @@ func (inventory *Inventory) ToPb() *pb.Inventory {
 	return &pb.Inventory{
 		InventoryItems: inventory.Inventory.ToPb(),
 		BankItems:      inventory.Bank.ToPb(),
+		Gold:           inventory.Gold,
+		Version:        inventory.Version,
+	}
+}
+
+// Clone copies the inventory and its items
+func (inventory *Inventory) Clone() *Inventory {
+	out := *inventory
+	out.Inventory = inventory.Inventory.clone()
+	out.Bank = inventory.Bank.clone()
+	return &out
+}
+
+// Items gets the items kept in the location
+func (inventory *Inventory) Items(location InventoryLocation) (*InventoryItems, error) {
+	switch location {
+	case InventoryLocationInventory:
+		return &inventory.Inventory, nil
+	case InventoryLocationBank:
+		return &inventory.Bank, nil
+	}
+
+	return nil, ErrInventoryLocation
+}
+
+// AddItem places the item in its slot of the location
+func (inventory *Inventory) AddItem(location InventoryLocation, item *InventoryItem) error {
+	items, err := inventory.Items(location)
+	if err != nil {
+		return err
+	}
+
+	if item.Quantity == 0 {
+		return ErrInventoryEmptyItem
+	}
+
+	if items.Item(item.Slot) != nil {
+		return ErrInventorySlotOccupied
+	}
+
+	*items = append(*items, &InventoryItem{Id: item.Id, Slot: item.Slot, Quantity: item.Quantity})
+	return nil
+}
+
+// RemoveItem removes the quantity of the item in the slot of the location and returns the removed items
+func (inventory *Inventory) RemoveItem(location InventoryLocation, slot uint32, quantity uint64) (*InventoryItem, error) {
+	items, err := inventory.Items(location)
+	if err != nil {
+		return nil, err
+	}
+
+	return items.take(slot, quantity)
+}
+
+// MoveItem moves the item to another slot of the location. If the other slot has an item, the items are swapped.
+func (inventory *Inventory) MoveItem(location InventoryLocation, from uint32, to uint32) error {
+	items, err := inventory.Items(location)
+	if err != nil {
+		return err
+	}
+
+	if from == to {
+		return ErrInventorySameSlot
 	}
+
+	item := items.Item(from)
+	if item == nil {
+		return ErrInventorySlotEmpty
+	}
+
+	if other := items.Item(to); other != nil {
+		other.Slot = from
+	}
+	item.Slot = to
+
+	return nil
+}
+
+// SplitStack moves the quantity of the item to an empty slot of the location. At least one item must stay in the
+// original slot.
+func (inventory *Inventory) SplitStack(location InventoryLocation, from uint32, to uint32, quantity uint64) error {
+	items, err := inventory.Items(location)
+	if err != nil {
+		return err
+	}
+
+	if from == to {
+		return ErrInventorySameSlot
+	}
+
+	item := items.Item(from)
+	if item == nil {
+		return ErrInventorySlotEmpty
+	}
+
+	if quantity == 0 || quantity >= item.Quantity {
+		return ErrInventoryQuantity
+	}
+
+	if items.Item(to) != nil {
+		return ErrInventorySlotOccupied
+	}
+
+	item.Quantity -= quantity
+	*items = append(*items, &InventoryItem{Id: item.Id, Slot: to, Quantity: quantity})
+	return nil
+}
+
+// MergeStack moves as many of the item as fit within the max stack onto the same item in another slot of the
+// location and returns the quantity moved. The original slot is emptied if all of its items are moved.
+func (inventory *Inventory) MergeStack(
+	location InventoryLocation,
+	from uint32,
+	to uint32,
+	maxStack uint64,
+) (uint64, error) {
+	items, err := inventory.Items(location)
+	if err != nil {
+		return 0, err
+	}
+
+	if from == to {
+		return 0, ErrInventorySameSlot
+	}
+
+	source := items.Item(from)
+	target := items.Item(to)
+	if source == nil || target == nil {
+		return 0, ErrInventorySlotEmpty
+	}
+
+	if source.Id != target.Id || target.Quantity >= maxStack {
+		return 0, ErrInventoryMerge
+	}
+
+	quantity := min(source.Quantity, maxStack-target.Quantity)
+	target.Quantity += quantity
+	_, err = items.take(from, quantity)
+	return quantity, err
+}
+
+// TransferItem moves the item from the slot of the location to an empty slot of the other location
+func (inventory *Inventory) TransferItem(from InventoryLocation, fromSlot uint32, toSlot uint32) error {
+	source, err := inventory.Items(from)
+	if err != nil {
+		return err
+	}
+
+	target, err := inventory.Items(from.Other())
+	if err != nil {
+		return err
+	}
+
+	item := source.Item(fromSlot)
+	if item == nil {
+		return ErrInventorySlotEmpty
+	}
+
+	if target.Item(toSlot) != nil {
+		return ErrInventorySlotOccupied
+	}
+
+	removed, err := source.take(fromSlot, item.Quantity)
+	if err != nil {
+		return err
+	}
+
+	removed.Slot = toSlot
+	*target = append(*target, removed)
+	return nil
+}
+
+// Item gets the item in the inventory slot or nil if the slot is empty
+func (inventory *Inventory) Item(slot uint32) *InventoryItem {
+	return inventory.Inventory.Item(slot)
+}
+
+// Take removes the quantity of the item in the inventory slot and returns the removed items. The slot is emptied once
+// all of its items are taken.
+func (inventory *Inventory) Take(slot uint32, quantity uint64) (*InventoryItem, error) {
+	return inventory.Inventory.take(slot, quantity)
+}
+
+// Give places the item in the lowest empty inventory slot within the capacity
+func (inventory *Inventory) Give(item *InventoryItem, capacity uint32) error {
+	used := make(map[uint32]struct{}, len(inventory.Inventory))
+	for _, other := range inventory.Inventory {
+		used[other.Slot] = struct{}{}
+	}
+
+	slot := uint32(0)
+	for ; slot < capacity; slot++ {
+		if _, ok := used[slot]; !ok {
+			break
+		}
+	}
+
+	if slot >= capacity {
+		return ErrInventoryFull
+	}
+
+	inventory.Inventory = append(inventory.Inventory, &InventoryItem{
+		Id:       item.Id,
+		Slot:     slot,
+		Quantity: item.Quantity,
+	})
+
+	return nil
+}
+
+// GiveGold adds the amount of gold to the inventory
+func (inventory *Inventory) GiveGold(amount uint64) error {
+	if inventory.Gold > math.MaxUint64-amount {
+		return ErrInventoryGoldLimit
+	}
+
+	inventory.Gold += amount
+	return nil
+}
+
+// TakeGold removes the amount of gold from the inventory
+func (inventory *Inventory) TakeGold(amount uint64) error {
+	if inventory.Gold < amount {
+		return ErrInventoryGold
+	}
+
+	inventory.Gold -= amount
+	return nil
+}
+
+// ItemIds ids of the items in the inventory and bank without duplicates
+func (inventory *Inventory) ItemIds() []string {
+	seen := make(map[string]struct{}, len(inventory.Inventory)+len(inventory.Bank))
+	ids := make([]string, 0, len(inventory.Inventory)+len(inventory.Bank))
+	for _, items := range []InventoryItems{inventory.Inventory, inventory.Bank} {
+		for _, item := range items {
+			if _, ok := seen[item.Id]; !ok {
+				seen[item.Id] = struct{}{}
+				ids = append(ids, item.Id)
+			}
+		}
+	}
+
+	return ids
+}
+
+// Validate checks every item in the inventory and bank is in the catalog, is stacked at most to its max stack and
+// is in a unique slot within the capacity
+func (inventory *Inventory) Validate(catalog map[string]*ItemDefinition, capacity uint32, bankCapacity uint32) error {
+	if err := inventory.Inventory.validate(catalog, capacity); err != nil {
+		return fmt.Errorf("inventory: %w", err)
+	}
+
+	if err := inventory.Bank.validate(catalog, bankCapacity); err != nil {
+		return fmt.Errorf("bank: %w", err)
+	}
+
+	return nil
+}
+
+// Item gets the item in the slot or nil if the slot is empty
+func (items InventoryItems) Item(slot uint32) *InventoryItem {
+	for _, item := range items {
+		if item.Slot == slot {
+			return item
+		}
+	}
+
+	return nil
+}
+
+func (items InventoryItems) clone() InventoryItems {
+	if items == nil {
+		return nil
+	}
+
+	out := make(InventoryItems, len(items))
+	for idx, item := range items {
+		copied := *item
+		out[idx] = &copied
+	}
+
+	return out
+}
+
+func (items *InventoryItems) take(slot uint32, quantity uint64) (*InventoryItem, error) {
+	for idx, item := range *items {
+		if item.Slot != slot {
+			continue
+		}
+
+		if quantity == 0 || item.Quantity < quantity {
+			return nil, ErrInventoryQuantity
+		}
+
+		item.Quantity -= quantity
+		if item.Quantity == 0 {
+			*items = append((*items)[:idx], (*items)[idx+1:]...)
+		}
+
+		return &InventoryItem{Id: item.Id, Slot: slot, Quantity: quantity}, nil
+	}
+
+	return nil, ErrInventorySlotEmpty
+}
+
+// ItemIds ids of the items without duplicates
+func (items InventoryItems) ItemIds() []string {
+	seen := make(map[string]struct{}, len(items))
+	ids := make([]string, 0, len(items))
+	for _, item := range items {
+		if _, ok := seen[item.Id]; !ok {
+			seen[item.Id] = struct{}{}
+			ids = append(ids, item.Id)
+		}
+	}
+
+	return ids
+}
+
+// ValidateTransfer checks that the items can be given to another character. Every item must be in the catalog and fit
+// in a single slot. If tradable is set, the items must also be tradable.
+func (items InventoryItems) ValidateTransfer(catalog map[string]*ItemDefinition, tradable bool) error {
+	for _, item := range items {
+		definition, ok := catalog[item.Id]
+		if !ok {
+			return fmt.Errorf("%w: %s", ErrInventoryUnknownItem, item.Id)
+		}
+
+		if item.Quantity == 0 {
+			return fmt.Errorf("%w: %s", ErrInventoryEmptyItem, item.Id)
+		}
+
+		if item.Quantity > definition.MaxStack {
+			return fmt.Errorf("%w: %s stacks to %d", ErrInventoryOverStacked, item.Id, definition.MaxStack)
+		}
+
+		if tradable && !definition.IsTradable() {
+			return fmt.Errorf("%w: %s", ErrItemNotTradable, item.Id)
+		}
+	}
+
+	return nil
+}
+
+func (items InventoryItems) validate(catalog map[string]*ItemDefinition, capacity uint32) error {
+	used := make(map[uint32]struct{}, len(items))
+	for _, item := range items {
+		if item.Slot >= capacity {
+			return fmt.Errorf("%w: slot %d", ErrInventorySlotCapacity, item.Slot)
+		}
+
+		if _, ok := used[item.Slot]; ok {
+			return fmt.Errorf("%w: slot %d", ErrInventoryDuplicateSlot, item.Slot)
+		}
+		used[item.Slot] = struct{}{}
+
+		definition, ok := catalog[item.Id]
+		if !ok {
+			return fmt.Errorf("%w: %s", ErrInventoryUnknownItem, item.Id)
+		}
+
+		if item.Quantity == 0 {
+			return fmt.Errorf("%w: slot %d", ErrInventoryEmptyItem, item.Slot)
+		}
+
+		if item.Quantity > definition.MaxStack {
+			return fmt.Errorf("%w: %s stacks to %d", ErrInventoryOverStacked, item.Id, definition.MaxStack)
+		}
+	}
+
+	return nil
+}
+
+// Other gets the location items are transferred to from this location
+func (location InventoryLocation) Other() InventoryLocation {
+	if location == InventoryLocationBank {
+		return InventoryLocationInventory
+	}
+
+	return InventoryLocationBank
+}
+
+func (location InventoryLocation) ToPb() pb.InventoryLocation {
+	if location == InventoryLocationBank {
+		return pb.InventoryLocation_LOCATION_BANK
+	}
+
+	return pb.InventoryLocation_LOCATION_INVENTORY
+}
+
+func InventoryLocationFromPb(location pb.InventoryLocation) (InventoryLocation, error) {
+	switch location {
+	case pb.InventoryLocation_LOCATION_INVENTORY:
+		return InventoryLocationInventory, nil
+	case pb.InventoryLocation_LOCATION_BANK:
+		return InventoryLocationBank, nil
+	}
+
+	return "", ErrInventoryLocation
 }
 
 func InventoryItemFromPb(item *pb.InventoryItem) *InventoryItem {
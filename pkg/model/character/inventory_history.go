@@ -0,0 +1,137 @@
+package character
+
+import (
+	"sort"
+	"time"
+
+	"github.com/ShatteredRealms/go-backend/pkg/pb"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// InventoryEvent a change to the inventory of a character
+type InventoryEvent struct {
+	Id          primitive.ObjectID `json:"id" bson:"_id,omitempty"`
+	CharacterId uint               `json:"characterId" bson:"characterId"`
+
+	// ActorId user id of the requester that made the change. Empty if the change was made by the system.
+	ActorId   string `json:"actorId" bson:"actorId"`
+	ActorName string `json:"actorName" bson:"actorName"`
+	Reason    string `json:"reason" bson:"reason"`
+
+	// Changes items that changed in the inventory and bank
+	Changes    InventoryChanges `json:"changes" bson:"changes"`
+	GoldBefore uint64           `json:"goldBefore" bson:"goldBefore"`
+	GoldAfter  uint64           `json:"goldAfter" bson:"goldAfter"`
+
+	// After the inventory once changed, which it can be restored to
+	After *Inventory `json:"after" bson:"after"`
+
+	CreatedAt time.Time `json:"createdAt" bson:"createdAt"`
+}
+
+type InventoryEvents []*InventoryEvent
+
+// InventoryChange the item in a slot before and after a change. The item is nil if the slot is empty.
+type InventoryChange struct {
+	Location InventoryLocation `json:"location" bson:"location"`
+	Slot     uint32            `json:"slot" bson:"slot"`
+	Before   *InventoryItem    `json:"before" bson:"before"`
+	After    *InventoryItem    `json:"after" bson:"after"`
+}
+
+type InventoryChanges []*InventoryChange
+
+// NewInventoryEvent creates the event for the inventory changing from before to after
+func NewInventoryEvent(before *Inventory, after *Inventory, reason string) *InventoryEvent {
+	return &InventoryEvent{
+		CharacterId: after.CharacterId,
+		Reason:      reason,
+		Changes:     DiffInventories(before, after),
+		GoldBefore:  before.Gold,
+		GoldAfter:   after.Gold,
+		After:       after.Clone(),
+		CreatedAt:   time.Now(),
+	}
+}
+
+// DiffInventories gets the slots that have a different item in the inventories, ordered by location and slot
+func DiffInventories(before *Inventory, after *Inventory) InventoryChanges {
+	changes := diffItems(InventoryLocationInventory, before.Inventory, after.Inventory)
+	return append(changes, diffItems(InventoryLocationBank, before.Bank, after.Bank)...)
+}
+
+func diffItems(location InventoryLocation, before InventoryItems, after InventoryItems) InventoryChanges {
+	slots := make(map[uint32]*InventoryChange, len(before)+len(after))
+	for _, item := range before {
+		slots[item.Slot] = &InventoryChange{Location: location, Slot: item.Slot, Before: item}
+	}
+	for _, item := range after {
+		change, ok := slots[item.Slot]
+		if !ok {
+			change = &InventoryChange{Location: location, Slot: item.Slot}
+			slots[item.Slot] = change
+		}
+		change.After = item
+	}
+
+	changes := make(InventoryChanges, 0, len(slots))
+	for _, change := range slots {
+		if change.Before != nil && change.After != nil && *change.Before == *change.After {
+			continue
+		}
+		changes = append(changes, change)
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Slot < changes[j].Slot
+	})
+
+	return changes
+}
+
+func (event *InventoryEvent) ToPb() *pb.InventoryEvent {
+	return &pb.InventoryEvent{
+		Id:         event.Id.Hex(),
+		ActorId:    event.ActorId,
+		ActorName:  event.ActorName,
+		Reason:     event.Reason,
+		Changes:    event.Changes.ToPb(),
+		GoldBefore: event.GoldBefore,
+		GoldAfter:  event.GoldAfter,
+		Version:    event.After.Version,
+		CreatedAt:  event.CreatedAt.Unix(),
+	}
+}
+
+func (events InventoryEvents) ToPb() *pb.InventoryEvents {
+	resp := &pb.InventoryEvents{Events: make([]*pb.InventoryEvent, len(events))}
+	for idx, event := range events {
+		resp.Events[idx] = event.ToPb()
+	}
+
+	return resp
+}
+
+func (change *InventoryChange) ToPb() *pb.InventoryChange {
+	out := &pb.InventoryChange{
+		Location: change.Location.ToPb(),
+		Slot:     change.Slot,
+	}
+	if change.Before != nil {
+		out.Before = change.Before.ToPb()
+	}
+	if change.After != nil {
+		out.After = change.After.ToPb()
+	}
+
+	return out
+}
+
+func (changes InventoryChanges) ToPb() []*pb.InventoryChange {
+	out := make([]*pb.InventoryChange, len(changes))
+	for idx, change := range changes {
+		out[idx] = change.ToPb()
+	}
+
+	return out
+}
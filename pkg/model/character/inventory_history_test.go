@@ -0,0 +1,88 @@
+package character_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/ShatteredRealms/go-backend/pkg/model/character"
+	"github.com/ShatteredRealms/go-backend/pkg/pb"
+)
+
+var _ = Describe("Inventory history model", func() {
+	var before, after *character.Inventory
+
+	BeforeEach(func() {
+		before = &character.Inventory{
+			CharacterId: 1,
+			Inventory: character.InventoryItems{
+				{Id: "potion", Slot: 0, Quantity: 10},
+				{Id: "sword", Slot: 1, Quantity: 1},
+			},
+			Bank: character.InventoryItems{{Id: "shield", Slot: 4, Quantity: 1}},
+			Gold: 10,
+		}
+		after = before.Clone()
+		after.Version = 1
+	})
+
+	Describe("DiffInventories", func() {
+		It("should have no changes for the same items", func() {
+			Expect(character.DiffInventories(before, after)).To(BeEmpty())
+		})
+
+		It("should have the changed slots in order", func() {
+			Expect(after.TransferItem(character.InventoryLocationInventory, 1, 2)).To(Succeed())
+			_, err := after.RemoveItem(character.InventoryLocationInventory, 0, 3)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(character.DiffInventories(before, after)).To(Equal(character.InventoryChanges{
+				{
+					Location: character.InventoryLocationInventory,
+					Slot:     0,
+					Before:   &character.InventoryItem{Id: "potion", Slot: 0, Quantity: 10},
+					After:    &character.InventoryItem{Id: "potion", Slot: 0, Quantity: 7},
+				},
+				{
+					Location: character.InventoryLocationInventory,
+					Slot:     1,
+					Before:   &character.InventoryItem{Id: "sword", Slot: 1, Quantity: 1},
+				},
+				{
+					Location: character.InventoryLocationBank,
+					Slot:     2,
+					After:    &character.InventoryItem{Id: "sword", Slot: 2, Quantity: 1},
+				},
+			}))
+		})
+	})
+
+	Describe("NewInventoryEvent", func() {
+		It("should snapshot the inventory after the change", func() {
+			after.Gold = 20
+			event := character.NewInventoryEvent(before, after, "reason")
+			Expect(event.CharacterId).To(Equal(after.CharacterId))
+			Expect(event.GoldBefore).To(BeEquivalentTo(10))
+			Expect(event.GoldAfter).To(BeEquivalentTo(20))
+			Expect(event.After).To(Equal(after))
+			Expect(event.After).NotTo(BeIdenticalTo(after))
+
+			out := event.ToPb()
+			Expect(out.Reason).To(Equal("reason"))
+			Expect(out.Version).To(BeEquivalentTo(1))
+		})
+	})
+
+	Describe("ToPb", func() {
+		It("should leave empty slots unset", func() {
+			change := &character.InventoryChange{
+				Location: character.InventoryLocationBank,
+				Slot:     2,
+				After:    &character.InventoryItem{Id: "sword", Slot: 2, Quantity: 1},
+			}
+			out := change.ToPb()
+			Expect(out.Location).To(Equal(pb.InventoryLocation_LOCATION_BANK))
+			Expect(out.Before).To(BeNil())
+			Expect(out.After.Id).To(Equal("sword"))
+		})
+	})
+})
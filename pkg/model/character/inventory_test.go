@@ -1,6 +1,8 @@
 package character_test
 
 import (
+	"math"
+
 	"github.com/bxcodec/faker/v4"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -85,4 +87,206 @@ var _ = Describe("Inventory model", func() {
 			}
 		})
 	})
+
+	Describe("Validate", func() {
+		var catalog map[string]*character.ItemDefinition
+
+		BeforeEach(func() {
+			invItem.Slot, invItem.Quantity = 0, 5
+			invItem2.Slot, invItem2.Quantity = 3, 1
+			catalog = character.ItemDefinitions{
+				{Id: invItem.Id, MaxStack: 5},
+				{Id: invItem2.Id, MaxStack: 1},
+			}.ById()
+		})
+
+		It("should accept items in the catalog", func() {
+			Expect(charInv.Validate(catalog, 1, 4)).To(Succeed())
+		})
+
+		It("should error on items not in the catalog", func() {
+			delete(catalog, invItem2.Id)
+			Expect(charInv.Validate(catalog, 1, 4)).To(MatchError(character.ErrInventoryUnknownItem))
+		})
+
+		It("should error on empty items", func() {
+			invItem.Quantity = 0
+			Expect(charInv.Validate(catalog, 1, 4)).To(MatchError(character.ErrInventoryEmptyItem))
+		})
+
+		It("should error on items over their max stack", func() {
+			invItem.Quantity = 6
+			Expect(charInv.Validate(catalog, 1, 4)).To(MatchError(character.ErrInventoryOverStacked))
+		})
+
+		It("should error on items in the same slot", func() {
+			charInv.Bank = append(charInv.Bank, &character.InventoryItem{Id: invItem2.Id, Slot: 3, Quantity: 1})
+			Expect(charInv.Validate(catalog, 1, 4)).To(MatchError(character.ErrInventoryDuplicateSlot))
+		})
+
+		It("should error on slots beyond the capacity", func() {
+			Expect(charInv.Validate(catalog, 0, 4)).To(MatchError(character.ErrInventorySlotCapacity))
+			Expect(charInv.Validate(catalog, 1, 3)).To(MatchError(character.ErrInventorySlotCapacity))
+		})
+	})
+
+	Describe("ValidateTransfer", func() {
+		var (
+			items   character.InventoryItems
+			catalog map[string]*character.ItemDefinition
+		)
+
+		BeforeEach(func() {
+			items = character.InventoryItems{{Id: "potion", Slot: 0, Quantity: 5}}
+			catalog = character.ItemDefinitions{{Id: "potion", MaxStack: 5, Tradable: true}}.ById()
+		})
+
+		It("should accept tradable items in the catalog", func() {
+			Expect(items.ValidateTransfer(catalog, true)).To(Succeed())
+		})
+
+		It("should error on items not in the catalog", func() {
+			delete(catalog, "potion")
+			Expect(items.ValidateTransfer(catalog, false)).To(MatchError(character.ErrInventoryUnknownItem))
+		})
+
+		It("should error on items over their max stack", func() {
+			items[0].Quantity = 6
+			Expect(items.ValidateTransfer(catalog, false)).To(MatchError(character.ErrInventoryOverStacked))
+		})
+
+		It("should only require tradable items if requested", func() {
+			catalog["potion"].Tradable = false
+			Expect(items.ValidateTransfer(catalog, true)).To(MatchError(character.ErrItemNotTradable))
+			Expect(items.ValidateTransfer(catalog, false)).To(Succeed())
+		})
+
+		It("should error on items that bind on pickup", func() {
+			catalog["potion"].BindOnPickup = true
+			Expect(items.ValidateTransfer(catalog, true)).To(MatchError(character.ErrItemNotTradable))
+		})
+	})
+
+	Describe("item operations", func() {
+		var inv *character.Inventory
+
+		BeforeEach(func() {
+			inv = &character.Inventory{
+				Inventory: character.InventoryItems{{Id: "potion", Slot: 0, Quantity: 10}},
+				Bank:      character.InventoryItems{{Id: "sword", Slot: 1, Quantity: 1}},
+			}
+		})
+
+		It("should not change clones", func() {
+			clone := inv.Clone()
+			Expect(clone.MoveItem(character.InventoryLocationInventory, 0, 2)).To(Succeed())
+			Expect(inv.Item(0)).NotTo(BeNil())
+		})
+
+		It("should error on unknown locations", func() {
+			Expect(inv.MoveItem("unknown", 0, 1)).To(MatchError(character.ErrInventoryLocation))
+		})
+
+		Describe("AddItem", func() {
+			It("should only add to empty slots", func() {
+				item := &character.InventoryItem{Id: "potion", Slot: 1, Quantity: 1}
+				Expect(inv.AddItem(character.InventoryLocationInventory, item)).To(Succeed())
+				Expect(inv.AddItem(character.InventoryLocationBank, item)).To(MatchError(character.ErrInventorySlotOccupied))
+				item.Quantity = 0
+				Expect(inv.AddItem(character.InventoryLocationBank, item)).To(MatchError(character.ErrInventoryEmptyItem))
+			})
+		})
+
+		Describe("RemoveItem", func() {
+			It("should remove the quantity", func() {
+				out, err := inv.RemoveItem(character.InventoryLocationBank, 1, 1)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(out.Id).To(Equal("sword"))
+				Expect(inv.Bank).To(BeEmpty())
+
+				_, err = inv.RemoveItem(character.InventoryLocationBank, 1, 1)
+				Expect(err).To(MatchError(character.ErrInventorySlotEmpty))
+			})
+		})
+
+		Describe("MoveItem", func() {
+			It("should swap occupied slots", func() {
+				Expect(inv.SplitStack(character.InventoryLocationInventory, 0, 1, 3)).To(Succeed())
+				Expect(inv.MoveItem(character.InventoryLocationInventory, 0, 1)).To(Succeed())
+				Expect(inv.Inventory.Item(0).Quantity).To(BeEquivalentTo(3))
+				Expect(inv.Inventory.Item(1).Quantity).To(BeEquivalentTo(7))
+			})
+
+			It("should error moving to the same slot", func() {
+				Expect(inv.MoveItem(character.InventoryLocationInventory, 0, 0)).To(MatchError(character.ErrInventorySameSlot))
+			})
+		})
+
+		Describe("SplitStack", func() {
+			It("should leave at least one item", func() {
+				Expect(inv.SplitStack(character.InventoryLocationInventory, 0, 1, 10)).To(MatchError(character.ErrInventoryQuantity))
+			})
+
+			It("should only split into empty slots", func() {
+				Expect(inv.SplitStack(character.InventoryLocationInventory, 0, 1, 5)).To(Succeed())
+				Expect(inv.SplitStack(character.InventoryLocationInventory, 0, 1, 1)).To(MatchError(character.ErrInventorySlotOccupied))
+			})
+		})
+
+		Describe("MergeStack", func() {
+			BeforeEach(func() {
+				Expect(inv.SplitStack(character.InventoryLocationInventory, 0, 1, 4)).To(Succeed())
+			})
+
+			It("should merge the whole stack", func() {
+				quantity, err := inv.MergeStack(character.InventoryLocationInventory, 1, 0, 20)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(quantity).To(BeEquivalentTo(4))
+				Expect(inv.Inventory).To(HaveLen(1))
+			})
+
+			It("should merge up to the max stack", func() {
+				quantity, err := inv.MergeStack(character.InventoryLocationInventory, 1, 0, 8)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(quantity).To(BeEquivalentTo(2))
+				Expect(inv.Inventory.Item(1).Quantity).To(BeEquivalentTo(2))
+			})
+
+			It("should error merging into full stacks or other items", func() {
+				_, err := inv.MergeStack(character.InventoryLocationInventory, 1, 0, 6)
+				Expect(err).To(MatchError(character.ErrInventoryMerge))
+
+				inv.Inventory.Item(1).Id = "other"
+				_, err = inv.MergeStack(character.InventoryLocationInventory, 1, 0, 20)
+				Expect(err).To(MatchError(character.ErrInventoryMerge))
+			})
+		})
+
+		Describe("TransferItem", func() {
+			It("should move the item to the other location", func() {
+				Expect(inv.TransferItem(character.InventoryLocationBank, 1, 1)).To(Succeed())
+				Expect(inv.Bank).To(BeEmpty())
+				Expect(inv.Inventory.Item(1).Id).To(Equal("sword"))
+			})
+
+			It("should only transfer into empty slots", func() {
+				Expect(inv.TransferItem(character.InventoryLocationBank, 1, 0)).To(MatchError(character.ErrInventorySlotOccupied))
+			})
+		})
+
+		Describe("Give", func() {
+			It("should use the lowest empty slot within the capacity", func() {
+				Expect(inv.Give(&character.InventoryItem{Id: "sword", Quantity: 1}, 2)).To(Succeed())
+				Expect(inv.Item(1).Id).To(Equal("sword"))
+				Expect(inv.Give(&character.InventoryItem{Id: "sword", Quantity: 1}, 2)).To(MatchError(character.ErrInventoryFull))
+			})
+
+			It("should not overflow the gold", func() {
+				inv.Gold = math.MaxUint64 - 1
+				Expect(inv.GiveGold(1)).To(Succeed())
+				Expect(inv.GiveGold(1)).To(MatchError(character.ErrInventoryGoldLimit))
+				Expect(inv.Gold).To(BeEquivalentTo(uint64(math.MaxUint64)))
+			})
+		})
+	})
 })
@@ -0,0 +1,131 @@
+package character
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/ShatteredRealms/go-backend/pkg/pb"
+)
+
+const (
+	MaxItemIdLength   = 64
+	MaxItemNameLength = 64
+)
+
+var (
+	ItemIdRegex, _ = regexp.Compile("^[a-z0-9_.-]+$")
+
+	// ErrItemId thrown when an item id is empty, too long or has invalid characters
+	ErrItemId = fmt.Errorf(
+		"item id must be between 1 and %d lowercase letters, numbers, '_', '.' or '-'",
+		MaxItemIdLength,
+	)
+
+	// ErrItemName thrown when an item name is empty or too long
+	ErrItemName = fmt.Errorf("item name must be between 1 and %d characters", MaxItemNameLength)
+
+	// ErrItemMaxStack thrown when an item can not be stacked at least once
+	ErrItemMaxStack = errors.New("item max stack must be at least 1")
+
+	// ErrItemNotTradable thrown when giving another character an item that is not tradable or binds on pickup
+	ErrItemNotTradable = errors.New("item cannot be traded")
+)
+
+// ItemDefinition describes an item that can be placed in an inventory. Inventory items reference the definition by
+// its id.
+type ItemDefinition struct {
+	Id        string    `gorm:"primaryKey" json:"id" yaml:"id"`
+	CreatedAt time.Time `json:"-" yaml:"-"`
+	UpdatedAt time.Time `json:"-" yaml:"-"`
+
+	Name     string `gorm:"not null" json:"name" yaml:"name"`
+	Category string `gorm:"not null;index" json:"category" yaml:"category"`
+
+	// MaxStack maximum quantity of the item in a single inventory slot
+	MaxStack uint64 `gorm:"not null" json:"maxStack" yaml:"maxStack"`
+
+	// EquipSlot type of equipment slot the item can be equipped in. Empty if the item cannot be equipped.
+	EquipSlot string `gorm:"not null" json:"equipSlot" yaml:"equipSlot"`
+
+	// BindOnPickup items are bound to the first character that picks them up
+	BindOnPickup bool `gorm:"not null" json:"bindOnPickup" yaml:"bindOnPickup"`
+	Tradable     bool `gorm:"not null" json:"tradable" yaml:"tradable"`
+
+	// Value gold the item is worth
+	Value uint64 `gorm:"not null" json:"value" yaml:"value"`
+}
+
+type ItemDefinitions []*ItemDefinition
+
+func (item *ItemDefinition) Validate() error {
+	if len(item.Id) == 0 || len(item.Id) > MaxItemIdLength || !ItemIdRegex.MatchString(item.Id) {
+		return ErrItemId
+	}
+
+	if len(item.Name) == 0 || len(item.Name) > MaxItemNameLength {
+		return ErrItemName
+	}
+
+	if item.MaxStack == 0 {
+		return ErrItemMaxStack
+	}
+
+	return nil
+}
+
+// IsEquippable checks if the item can be equipped
+func (item *ItemDefinition) IsEquippable() bool {
+	return item.EquipSlot != ""
+}
+
+// IsTradable checks if the item can be given to another character
+func (item *ItemDefinition) IsTradable() bool {
+	return item.Tradable && !item.BindOnPickup
+}
+
+func (item *ItemDefinition) ToPb() *pb.ItemDefinition {
+	return &pb.ItemDefinition{
+		Id:           item.Id,
+		Name:         item.Name,
+		Category:     item.Category,
+		MaxStack:     item.MaxStack,
+		EquipSlot:    item.EquipSlot,
+		BindOnPickup: item.BindOnPickup,
+		Tradable:     item.Tradable,
+		Value:        item.Value,
+	}
+}
+
+func (items ItemDefinitions) ToPb() *pb.ItemDefinitions {
+	resp := &pb.ItemDefinitions{Items: make([]*pb.ItemDefinition, len(items))}
+	for idx, item := range items {
+		resp.Items[idx] = item.ToPb()
+	}
+
+	return resp
+}
+
+// ById maps the item definitions by their id
+func (items ItemDefinitions) ById() map[string]*ItemDefinition {
+	out := make(map[string]*ItemDefinition, len(items))
+	for _, item := range items {
+		out[item.Id] = item
+	}
+
+	return out
+}
+
+func ItemDefinitionFromPb(item *pb.ItemDefinition) *ItemDefinition {
+	return &ItemDefinition{
+		Id:           item.Id,
+		Name:         item.Name,
+		Category:     item.Category,
+		MaxStack:     item.MaxStack,
+		EquipSlot:    item.EquipSlot,
+		BindOnPickup: item.BindOnPickup,
+		Tradable:     item.Tradable,
+		Value:        item.Value,
+	}
+}
@@ -0,0 +1,83 @@
+package character_test
+
+import (
+	"strings"
+
+	"github.com/bxcodec/faker/v4"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/ShatteredRealms/go-backend/pkg/model/character"
+)
+
+var _ = Describe("Item model", func() {
+	var item *character.ItemDefinition
+
+	BeforeEach(func() {
+		item = &character.ItemDefinition{
+			Id:           "iron_sword",
+			Name:         "Iron Sword",
+			Category:     "weapon",
+			MaxStack:     1,
+			EquipSlot:    "main_hand",
+			BindOnPickup: true,
+			Tradable:     false,
+			Value:        uint64(faker.UnixTime()),
+		}
+	})
+
+	Describe("Validate", func() {
+		It("should accept a valid item", func() {
+			Expect(item.Validate()).To(Succeed())
+		})
+
+		It("should error on invalid ids", func() {
+			for _, id := range []string{"", "Iron Sword", strings.Repeat("a", character.MaxItemIdLength+1)} {
+				item.Id = id
+				Expect(item.Validate()).To(MatchError(character.ErrItemId))
+			}
+		})
+
+		It("should error on invalid names", func() {
+			for _, name := range []string{"", strings.Repeat("a", character.MaxItemNameLength+1)} {
+				item.Name = name
+				Expect(item.Validate()).To(MatchError(character.ErrItemName))
+			}
+		})
+
+		It("should error if the item cannot stack", func() {
+			item.MaxStack = 0
+			Expect(item.Validate()).To(MatchError(character.ErrItemMaxStack))
+		})
+	})
+
+	Describe("IsEquippable", func() {
+		It("should depend on the equip slot", func() {
+			Expect(item.IsEquippable()).To(BeTrue())
+			item.EquipSlot = ""
+			Expect(item.IsEquippable()).To(BeFalse())
+		})
+	})
+
+	Describe("IsTradable", func() {
+		It("should only be tradable if it does not bind on pickup", func() {
+			Expect(item.IsTradable()).To(BeFalse())
+			item.Tradable = true
+			Expect(item.IsTradable()).To(BeFalse())
+			item.BindOnPickup = false
+			Expect(item.IsTradable()).To(BeTrue())
+		})
+	})
+
+	Describe("ToPb", func() {
+		It("should retain all fields", func() {
+			Expect(character.ItemDefinitionFromPb(item.ToPb())).To(Equal(item))
+		})
+
+		It("should convert many items", func() {
+			out := character.ItemDefinitions{item, item}.ToPb()
+			Expect(out.Items).To(HaveLen(2))
+			Expect(out.Items[0].Id).To(Equal(item.Id))
+		})
+	})
+})
@@ -0,0 +1,142 @@
+package character
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ShatteredRealms/go-backend/pkg/pb"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	MaxMailSubjectLength = 64
+	MaxMailBodyLength    = 2000
+	MaxMailAttachments   = 8
+
+	// SystemMailSenderId sender id of mail sent by the backend instead of a character
+	SystemMailSenderId = 0
+)
+
+var (
+	// ErrMailSubject thrown when a mail subject is empty or too long
+	ErrMailSubject = fmt.Errorf("subject must be between 1 and %d characters", MaxMailSubjectLength)
+
+	// ErrMailBodyToLong thrown when a mail body is too long
+	ErrMailBodyToLong = fmt.Errorf("body can be at most %d characters", MaxMailBodyLength)
+
+	// ErrMailAttachments thrown when a mail has too many attachments
+	ErrMailAttachments = fmt.Errorf("mail can have at most %d attachments", MaxMailAttachments)
+)
+
+// Mail message sent to a character that can contain items and gold. Mail is removed once it expires, but is kept until
+// its attachments and gold are claimed.
+type Mail struct {
+	Id primitive.ObjectID `json:"id" bson:"_id,omitempty"`
+
+	// SenderId id of the character that sent the mail or SystemMailSenderId for system mail
+	SenderId uint `json:"senderId" bson:"senderId"`
+
+	// SenderName name of the sender shown to the recipient
+	SenderName  string `json:"senderName" bson:"senderName"`
+	RecipientId uint   `json:"recipientId" bson:"recipientId"`
+
+	Subject     string         `json:"subject" bson:"subject"`
+	Body        string         `json:"body" bson:"body"`
+	Attachments InventoryItems `json:"attachments" bson:"attachments"`
+	Gold        uint64         `json:"gold" bson:"gold"`
+
+	Read    bool `json:"read" bson:"read"`
+	Claimed bool `json:"claimed" bson:"claimed"`
+
+	CreatedAt time.Time `json:"createdAt" bson:"createdAt"`
+	ExpiresAt time.Time `json:"expiresAt" bson:"expiresAt"`
+
+	// DeleteAt time mongo removes the mail. Only set once the mail has nothing to claim.
+	DeleteAt *time.Time `json:"deleteAt,omitempty" bson:"deleteAt,omitempty"`
+}
+type Mails []*Mail
+
+// MailAttachment item in the inventory of the sender to attach to a mail
+type MailAttachment struct {
+	Slot     uint32
+	Quantity uint64
+}
+type MailAttachments []*MailAttachment
+
+func (m *Mail) Validate() error {
+	if len(m.Subject) == 0 || len(m.Subject) > MaxMailSubjectLength {
+		return ErrMailSubject
+	}
+
+	if len(m.Body) > MaxMailBodyLength {
+		return ErrMailBodyToLong
+	}
+
+	if len(m.Attachments) > MaxMailAttachments {
+		return ErrMailAttachments
+	}
+
+	return nil
+}
+
+// IsSystem checks if the mail was sent by the backend
+func (m *Mail) IsSystem() bool {
+	return m.SenderId == SystemMailSenderId
+}
+
+// IsExpired checks if the mail expired. Mail with attachments or gold that have not been claimed does not expire.
+func (m *Mail) IsExpired() bool {
+	return !m.HasUnclaimed() && !m.ExpiresAt.IsZero() && !time.Now().Before(m.ExpiresAt)
+}
+
+// HasUnclaimed checks if the mail has attachments or gold that have not been claimed
+func (m *Mail) HasUnclaimed() bool {
+	return !m.Claimed && (len(m.Attachments) > 0 || m.Gold > 0)
+}
+
+// ScheduleDelete sets the mail to be removed when it expires if it has nothing to claim
+func (m *Mail) ScheduleDelete() {
+	m.DeleteAt = nil
+	if !m.HasUnclaimed() {
+		deleteAt := m.ExpiresAt
+		m.DeleteAt = &deleteAt
+	}
+}
+
+func (m *Mail) ToPb() *pb.Mail {
+	return &pb.Mail{
+		Id:          m.Id.Hex(),
+		SenderId:    uint64(m.SenderId),
+		SenderName:  m.SenderName,
+		RecipientId: uint64(m.RecipientId),
+		Subject:     m.Subject,
+		Body:        m.Body,
+		Attachments: m.Attachments.ToPb(),
+		Gold:        m.Gold,
+		Read:        m.Read,
+		Claimed:     m.Claimed,
+		CreatedAt:   m.CreatedAt.Unix(),
+		ExpiresAt:   m.ExpiresAt.Unix(),
+	}
+}
+
+func (m Mails) ToPb() *pb.Mails {
+	resp := &pb.Mails{Mails: make([]*pb.Mail, len(m))}
+	for idx, mail := range m {
+		resp.Mails[idx] = mail.ToPb()
+	}
+
+	return resp
+}
+
+func MailAttachmentsFromPb(attachments []*pb.MailAttachment) MailAttachments {
+	out := make(MailAttachments, len(attachments))
+	for idx, attachment := range attachments {
+		out[idx] = &MailAttachment{
+			Slot:     attachment.Slot,
+			Quantity: attachment.Quantity,
+		}
+	}
+
+	return out
+}
@@ -0,0 +1,47 @@
+package character
+
+import (
+	"time"
+
+	"github.com/ShatteredRealms/go-backend/pkg/pb"
+)
+
+// NameChange a rename of a character. The old name stays reserved for the character until ReservedUntil.
+type NameChange struct {
+	ID          uint      `gorm:"primarykey" json:"id"`
+	CreatedAt   time.Time `json:"createdAt"`
+	CharacterId uint      `gorm:"not null;index" json:"characterId"`
+	OldName     string    `gorm:"not null;index" json:"oldName"`
+	NewName     string    `gorm:"not null" json:"newName"`
+
+	// ReservedUntil time until no other character can take the old name
+	ReservedUntil time.Time `gorm:"not null" json:"reservedUntil"`
+}
+type NameChanges []*NameChange
+
+func (NameChange) TableName() string {
+	return "character_name_history"
+}
+
+// Reserves checks whether the change keeps the old name reserved at the time
+func (change *NameChange) Reserves(at time.Time) bool {
+	return at.Before(change.ReservedUntil)
+}
+
+func (change *NameChange) ToPb() *pb.CharacterNameChange {
+	return &pb.CharacterNameChange{
+		OldName:       change.OldName,
+		NewName:       change.NewName,
+		ChangedAt:     change.CreatedAt.Unix(),
+		ReservedUntil: change.ReservedUntil.Unix(),
+	}
+}
+
+func (changes NameChanges) ToPb() *pb.CharacterNameHistory {
+	resp := &pb.CharacterNameHistory{Changes: make([]*pb.CharacterNameChange, len(changes))}
+	for idx, change := range changes {
+		resp.Changes[idx] = change.ToPb()
+	}
+
+	return resp
+}
@@ -0,0 +1,38 @@
+package character_test
+
+import (
+	"time"
+
+	"github.com/bxcodec/faker/v4"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/ShatteredRealms/go-backend/pkg/model/character"
+)
+
+var _ = Describe("Name change model", func() {
+	It("should reserve the old name until it expires", func() {
+		now := time.Now()
+		change := &character.NameChange{ReservedUntil: now.Add(time.Hour)}
+		Expect(change.Reserves(now)).To(BeTrue())
+		Expect(change.Reserves(now.Add(time.Hour))).To(BeFalse())
+		Expect(change.Reserves(now.Add(2 * time.Hour))).To(BeFalse())
+	})
+
+	It("should convert to protobuf", func() {
+		changes := character.NameChanges{
+			{
+				CreatedAt:     time.Now(),
+				OldName:       faker.Username(),
+				NewName:       faker.Username(),
+				ReservedUntil: time.Now().Add(time.Hour),
+			},
+		}
+		out := changes.ToPb()
+		Expect(out.Changes).To(HaveLen(1))
+		Expect(out.Changes[0].OldName).To(Equal(changes[0].OldName))
+		Expect(out.Changes[0].NewName).To(Equal(changes[0].NewName))
+		Expect(out.Changes[0].ChangedAt).To(Equal(changes[0].CreatedAt.Unix()))
+		Expect(out.Changes[0].ReservedUntil).To(Equal(changes[0].ReservedUntil.Unix()))
+	})
+})
@@ -0,0 +1,83 @@
+package character
+
+import (
+	"math"
+
+	"github.com/ShatteredRealms/go-backend/pkg/pb"
+)
+
+// Attributes base attributes of a character
+type Attributes struct {
+	Health    uint32 `gorm:"not null;default:100" json:"health" yaml:"health"`
+	Stamina   uint32 `gorm:"not null;default:100" json:"stamina" yaml:"stamina"`
+	Mana      uint32 `gorm:"not null;default:100" json:"mana" yaml:"mana"`
+	Strength  uint32 `gorm:"not null;default:10" json:"strength" yaml:"strength"`
+	Agility   uint32 `gorm:"not null;default:10" json:"agility" yaml:"agility"`
+	Intellect uint32 `gorm:"not null;default:10" json:"intellect" yaml:"intellect"`
+}
+
+// ExperienceCurve experience required to advance from each level to the next
+type ExperienceCurve struct {
+	// MaxLevel highest level a character can reach
+	MaxLevel uint32 `yaml:"maxLevel"`
+
+	// BaseExperience experience required to advance from level 1 to 2
+	BaseExperience uint64 `yaml:"baseExperience"`
+
+	// Growth multiplier applied to the required experience for every level after the first
+	Growth float64 `yaml:"growth"`
+}
+
+// Required gets the experience required to advance from the level to the next. It is 0 at the max level.
+func (curve ExperienceCurve) Required(level uint32) uint64 {
+	if level >= curve.MaxLevel {
+		return 0
+	}
+
+	return uint64(math.Round(float64(curve.BaseExperience) * math.Pow(curve.Growth, float64(level-1))))
+}
+
+// Add increases each attribute by the attributes times the count
+func (a *Attributes) Add(other Attributes, count uint32) {
+	a.Health += other.Health * count
+	a.Stamina += other.Stamina * count
+	a.Mana += other.Mana * count
+	a.Strength += other.Strength * count
+	a.Agility += other.Agility * count
+	a.Intellect += other.Intellect * count
+}
+
+// AddExperience gives the character experience and levels it up while it has enough experience for the next level.
+// Each level gained increases the attributes by perLevel. Experience past the max level is discarded. The number of
+// levels gained is returned.
+func (c *Character) AddExperience(amount uint64, curve ExperienceCurve, perLevel Attributes) uint32 {
+	if c.Level == 0 {
+		c.Level = 1
+	}
+
+	c.Experience += amount
+	gained := uint32(0)
+	for required := curve.Required(c.Level); required > 0 && c.Experience >= required; required = curve.Required(c.Level) {
+		c.Experience -= required
+		c.Level++
+		gained++
+	}
+
+	if c.Level >= curve.MaxLevel {
+		c.Experience = 0
+	}
+
+	c.Attributes.Add(perLevel, gained)
+	return gained
+}
+
+func (a *Attributes) ToPb() *pb.CharacterAttributes {
+	return &pb.CharacterAttributes{
+		Health:    a.Health,
+		Stamina:   a.Stamina,
+		Mana:      a.Mana,
+		Strength:  a.Strength,
+		Agility:   a.Agility,
+		Intellect: a.Intellect,
+	}
+}
@@ -0,0 +1,71 @@
+package character_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/ShatteredRealms/go-backend/pkg/model/character"
+)
+
+var _ = Describe("Progression model", func() {
+	var (
+		curve    character.ExperienceCurve
+		perLevel character.Attributes
+		char     *character.Character
+	)
+
+	BeforeEach(func() {
+		curve = character.ExperienceCurve{MaxLevel: 5, BaseExperience: 100, Growth: 1.5}
+		perLevel = character.Attributes{Health: 10, Strength: 1}
+		char = &character.Character{
+			Level:      1,
+			Attributes: character.Attributes{Health: 100, Strength: 10},
+		}
+	})
+
+	Describe("ExperienceCurve", func() {
+		It("should grow the required experience each level", func() {
+			Expect(curve.Required(1)).To(BeEquivalentTo(100))
+			Expect(curve.Required(2)).To(BeEquivalentTo(150))
+			Expect(curve.Required(3)).To(BeEquivalentTo(225))
+		})
+
+		It("should require nothing at the max level", func() {
+			Expect(curve.Required(5)).To(BeZero())
+			Expect(curve.Required(6)).To(BeZero())
+		})
+	})
+
+	Describe("AddExperience", func() {
+		It("should keep the level without enough experience", func() {
+			Expect(char.AddExperience(99, curve, perLevel)).To(BeZero())
+			Expect(char.Level).To(BeEquivalentTo(1))
+			Expect(char.Experience).To(BeEquivalentTo(99))
+			Expect(char.Attributes.Health).To(BeEquivalentTo(100))
+		})
+
+		It("should level up multiple times and keep the remaining experience", func() {
+			Expect(char.AddExperience(260, curve, perLevel)).To(BeEquivalentTo(2))
+			Expect(char.Level).To(BeEquivalentTo(3))
+			Expect(char.Experience).To(BeEquivalentTo(10))
+			Expect(char.Attributes.Health).To(BeEquivalentTo(120))
+			Expect(char.Attributes.Strength).To(BeEquivalentTo(12))
+		})
+
+		It("should stop at the max level", func() {
+			Expect(char.AddExperience(1e6, curve, perLevel)).To(BeEquivalentTo(4))
+			Expect(char.Level).To(BeEquivalentTo(5))
+			Expect(char.Experience).To(BeZero())
+
+			Expect(char.AddExperience(100, curve, perLevel)).To(BeZero())
+			Expect(char.Level).To(BeEquivalentTo(5))
+			Expect(char.Experience).To(BeZero())
+		})
+
+		It("should treat an unset level as level 1", func() {
+			char.Level = 0
+			char.AddExperience(100, curve, perLevel)
+			Expect(char.Level).To(BeEquivalentTo(2))
+		})
+	})
+})
@@ -0,0 +1,52 @@
+package character
+
+import (
+	"time"
+
+	"github.com/ShatteredRealms/go-backend/pkg/pb"
+)
+
+// AccountSlots character slots granted to an account on top of the default maximum
+type AccountSlots struct {
+	OwnerId    string `gorm:"primaryKey" json:"owner"`
+	ExtraSlots uint32 `gorm:"not null;default:0" json:"extraSlots"`
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+func (AccountSlots) TableName() string {
+	return "account_slots"
+}
+
+// CharacterSlots how many characters an account has and can have
+type CharacterSlots struct {
+	Used uint32
+
+	// Max number of characters the account can have, including the extra slots
+	Max uint32
+
+	// MaxPerDimension number of characters the account can have in a dimension. 0 if there is no limit per dimension.
+	MaxPerDimension uint32
+
+	// Extra slots granted to the account
+	Extra uint32
+}
+
+// Available number of characters the account can still create
+func (slots *CharacterSlots) Available() uint32 {
+	if slots.Used >= slots.Max {
+		return 0
+	}
+
+	return slots.Max - slots.Used
+}
+
+func (slots *CharacterSlots) ToPb() *pb.CharacterSlots {
+	return &pb.CharacterSlots{
+		Used:            slots.Used,
+		Available:       slots.Available(),
+		Max:             slots.Max,
+		MaxPerDimension: slots.MaxPerDimension,
+		Extra:           slots.Extra,
+	}
+}
@@ -0,0 +1,28 @@
+package character_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/ShatteredRealms/go-backend/pkg/model/character"
+)
+
+var _ = Describe("Slots model", func() {
+	It("should count the available slots", func() {
+		slots := &character.CharacterSlots{Used: 2, Max: 5}
+		Expect(slots.Available()).To(BeEquivalentTo(3))
+
+		slots.Used = 6
+		Expect(slots.Available()).To(BeZero())
+	})
+
+	It("should convert to protobuf", func() {
+		slots := &character.CharacterSlots{Used: 2, Max: 5, MaxPerDimension: 3, Extra: 1}
+		out := slots.ToPb()
+		Expect(out.Used).To(Equal(slots.Used))
+		Expect(out.Available).To(BeEquivalentTo(3))
+		Expect(out.Max).To(Equal(slots.Max))
+		Expect(out.MaxPerDimension).To(Equal(slots.MaxPerDimension))
+		Expect(out.Extra).To(Equal(slots.Extra))
+	})
+})
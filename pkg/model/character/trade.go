@@ -0,0 +1,205 @@
+package character
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ShatteredRealms/go-backend/pkg/pb"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	MaxTradeItems = 12
+)
+
+type TradeStatus string
+
+const (
+	TradeStatusOpen      TradeStatus = "open"
+	TradeStatusCompleted TradeStatus = "completed"
+	TradeStatusCancelled TradeStatus = "cancelled"
+)
+
+var (
+	// ErrTradeItems thrown when offering too many items in a trade
+	ErrTradeItems = fmt.Errorf("a trade offer can have at most %d items", MaxTradeItems)
+
+	// ErrTradeItemChanged thrown when an offered item is no longer in the offered inventory slot
+	ErrTradeItemChanged = errors.New("offered item is no longer in the inventory")
+
+	// ErrTradeClosed thrown when changing a trade that is not open
+	ErrTradeClosed = errors.New("trade is not open")
+)
+
+// Trade session between two characters. Each side offers items and gold, and once both sides confirm the current
+// offers they are exchanged. Completed trades are kept as a record of the exchange.
+type Trade struct {
+	Id        primitive.ObjectID `json:"id" bson:"_id,omitempty"`
+	Dimension string             `json:"dimension" bson:"dimension"`
+	Status    TradeStatus        `json:"status" bson:"status"`
+	Initiator *TradeOffer        `json:"initiator" bson:"initiator"`
+	Partner   *TradeOffer        `json:"partner" bson:"partner"`
+
+	// Traders ids of the initiator and partner, used to find the trades of a character
+	Traders []uint `json:"traders" bson:"traders"`
+
+	// Revision incremented whenever an offer changes so confirmations only apply to the offers that were seen
+	Revision uint64 `json:"revision" bson:"revision"`
+
+	CreatedAt   time.Time `json:"createdAt" bson:"createdAt"`
+	UpdatedAt   time.Time `json:"updatedAt" bson:"updatedAt"`
+	CompletedAt time.Time `json:"completedAt" bson:"completedAt"`
+}
+type Trades []*Trade
+
+// TradeOffer items and gold a character offers in a trade
+type TradeOffer struct {
+	CharacterId   uint           `json:"characterId" bson:"characterId"`
+	CharacterName string         `json:"characterName" bson:"characterName"`
+	Items         InventoryItems `json:"items" bson:"items"`
+	Gold          uint64         `json:"gold" bson:"gold"`
+	Confirmed     bool           `json:"confirmed" bson:"confirmed"`
+}
+
+// TradeOfferItem item in the inventory of a character to offer in a trade
+type TradeOfferItem struct {
+	Slot     uint32
+	Quantity uint64
+}
+type TradeOfferItems []*TradeOfferItem
+
+// IsOpen checks if the offers of the trade can still change
+func (t *Trade) IsOpen() bool {
+	return t.Status == TradeStatusOpen
+}
+
+// IsTrader checks if the character is one of the sides of the trade
+func (t *Trade) IsTrader(characterId uint) bool {
+	return t.Offer(characterId) != nil
+}
+
+// Offer gets the offer of the character or nil if the character is not trading
+func (t *Trade) Offer(characterId uint) *TradeOffer {
+	if t.Initiator != nil && t.Initiator.CharacterId == characterId {
+		return t.Initiator
+	}
+
+	if t.Partner != nil && t.Partner.CharacterId == characterId {
+		return t.Partner
+	}
+
+	return nil
+}
+
+// Confirmed checks if both sides confirmed the current offers
+func (t *Trade) Confirmed() bool {
+	return t.Initiator.Confirmed && t.Partner.Confirmed
+}
+
+// Apply exchanges the offers between the inventories of the initiator and partner. Every offered item must still be
+// in the offered slot with at least the offered quantity and each side must have room within the capacity for the
+// items it receives, otherwise neither inventory should be saved.
+func (t *Trade) Apply(initiator *Inventory, partner *Inventory, capacity uint32) error {
+	err := initiator.takeOffer(t.Initiator)
+	if err != nil {
+		return err
+	}
+
+	err = partner.takeOffer(t.Partner)
+	if err != nil {
+		return err
+	}
+
+	err = initiator.giveOffer(t.Partner, capacity)
+	if err != nil {
+		return err
+	}
+
+	return partner.giveOffer(t.Initiator, capacity)
+}
+
+func (inventory *Inventory) takeOffer(offer *TradeOffer) error {
+	for _, offered := range offer.Items {
+		if item := inventory.Item(offered.Slot); item == nil || item.Id != offered.Id {
+			return ErrTradeItemChanged
+		}
+
+		_, err := inventory.Take(offered.Slot, offered.Quantity)
+		if err != nil {
+			return err
+		}
+	}
+
+	return inventory.TakeGold(offer.Gold)
+}
+
+func (inventory *Inventory) giveOffer(offer *TradeOffer, capacity uint32) error {
+	for _, item := range offer.Items {
+		err := inventory.Give(item, capacity)
+		if err != nil {
+			return err
+		}
+	}
+
+	return inventory.GiveGold(offer.Gold)
+}
+
+func (t *Trade) ToPb() *pb.Trade {
+	out := &pb.Trade{
+		Id:        t.Id.Hex(),
+		Status:    t.Status.ToPb(),
+		Initiator: t.Initiator.ToPb(),
+		Partner:   t.Partner.ToPb(),
+		Revision:  t.Revision,
+		CreatedAt: t.CreatedAt.Unix(),
+	}
+
+	if !t.CompletedAt.IsZero() {
+		out.CompletedAt = t.CompletedAt.Unix()
+	}
+
+	return out
+}
+
+func (t Trades) ToPb() *pb.Trades {
+	resp := &pb.Trades{Trades: make([]*pb.Trade, len(t))}
+	for idx, trade := range t {
+		resp.Trades[idx] = trade.ToPb()
+	}
+
+	return resp
+}
+
+func (o *TradeOffer) ToPb() *pb.TradeOffer {
+	return &pb.TradeOffer{
+		CharacterId:   uint64(o.CharacterId),
+		CharacterName: o.CharacterName,
+		Items:         o.Items.ToPb(),
+		Gold:          o.Gold,
+		Confirmed:     o.Confirmed,
+	}
+}
+
+func (s TradeStatus) ToPb() pb.TradeStatus {
+	switch s {
+	case TradeStatusCompleted:
+		return pb.TradeStatus_TRADE_COMPLETED
+	case TradeStatusCancelled:
+		return pb.TradeStatus_TRADE_CANCELLED
+	}
+
+	return pb.TradeStatus_TRADE_OPEN
+}
+
+func TradeOfferItemsFromPb(items []*pb.TradeOfferItem) TradeOfferItems {
+	out := make(TradeOfferItems, len(items))
+	for idx, item := range items {
+		out[idx] = &TradeOfferItem{
+			Slot:     item.Slot,
+			Quantity: item.Quantity,
+		}
+	}
+
+	return out
+}
@@ -0,0 +1,125 @@
+package character_test
+
+import (
+	"math"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/ShatteredRealms/go-backend/pkg/model/character"
+	"github.com/ShatteredRealms/go-backend/pkg/pb"
+)
+
+var _ = Describe("Trade model", func() {
+	var (
+		trade     *character.Trade
+		initiator *character.Inventory
+		partner   *character.Inventory
+	)
+
+	BeforeEach(func() {
+		initiator = &character.Inventory{
+			CharacterId: 1,
+			Inventory: character.InventoryItems{
+				{Id: "sword", Slot: 0, Quantity: 1},
+				{Id: "potion", Slot: 1, Quantity: 5},
+			},
+			Gold: 50,
+		}
+		partner = &character.Inventory{
+			CharacterId: 2,
+			Inventory: character.InventoryItems{
+				{Id: "shield", Slot: 0, Quantity: 1},
+			},
+			Gold: 100,
+		}
+		trade = &character.Trade{
+			Status: character.TradeStatusOpen,
+			Initiator: &character.TradeOffer{
+				CharacterId: 1,
+				Items:       character.InventoryItems{{Id: "potion", Slot: 1, Quantity: 2}},
+				Gold:        10,
+			},
+			Partner: &character.TradeOffer{
+				CharacterId: 2,
+				Items:       character.InventoryItems{{Id: "shield", Slot: 0, Quantity: 1}},
+			},
+		}
+	})
+
+	Describe("Offer", func() {
+		It("should get the offer of either trader", func() {
+			Expect(trade.Offer(1)).To(Equal(trade.Initiator))
+			Expect(trade.Offer(2)).To(Equal(trade.Partner))
+			Expect(trade.Offer(3)).To(BeNil())
+			Expect(trade.IsTrader(3)).To(BeFalse())
+		})
+	})
+
+	Describe("Confirmed", func() {
+		It("should require both confirmations", func() {
+			trade.Initiator.Confirmed = true
+			Expect(trade.Confirmed()).To(BeFalse())
+			trade.Partner.Confirmed = true
+			Expect(trade.Confirmed()).To(BeTrue())
+		})
+	})
+
+	Describe("Apply", func() {
+		It("should exchange the offers", func() {
+			Expect(trade.Apply(initiator, partner, 10)).To(Succeed())
+
+			Expect(initiator.Gold).To(BeEquivalentTo(40))
+			Expect(initiator.Item(1).Quantity).To(BeEquivalentTo(3))
+			Expect(initiator.Item(2).Id).To(Equal("shield"))
+
+			Expect(partner.Gold).To(BeEquivalentTo(110))
+			Expect(partner.Item(0).Id).To(Equal("potion"))
+			Expect(partner.Item(0).Quantity).To(BeEquivalentTo(2))
+			Expect(partner.Inventory).To(HaveLen(1))
+		})
+
+		It("should error if an offered item was replaced", func() {
+			partner.Inventory[0].Id = "bow"
+			Expect(trade.Apply(initiator, partner, 10)).To(MatchError(character.ErrTradeItemChanged))
+		})
+
+		It("should error if an offered item was moved", func() {
+			initiator.Inventory[1].Slot = 5
+			Expect(trade.Apply(initiator, partner, 10)).To(MatchError(character.ErrTradeItemChanged))
+		})
+
+		It("should error if the quantity is no longer available", func() {
+			initiator.Inventory[1].Quantity = 1
+			Expect(trade.Apply(initiator, partner, 10)).To(MatchError(character.ErrInventoryQuantity))
+		})
+
+		It("should error if the gold is no longer available", func() {
+			initiator.Gold = 5
+			Expect(trade.Apply(initiator, partner, 10)).To(MatchError(character.ErrInventoryGold))
+		})
+
+		It("should error if a trader has no room for the items", func() {
+			Expect(trade.Apply(initiator, partner, 2)).To(MatchError(character.ErrInventoryFull))
+		})
+
+		It("should error if a trader would have too much gold", func() {
+			partner.Gold = math.MaxUint64 - 5
+			Expect(trade.Apply(initiator, partner, 10)).To(MatchError(character.ErrInventoryGoldLimit))
+		})
+	})
+
+	Describe("ToPb", func() {
+		It("should convert the status", func() {
+			Expect(trade.ToPb().Status).To(Equal(pb.TradeStatus_TRADE_OPEN))
+			trade.Status = character.TradeStatusCompleted
+			Expect(trade.ToPb().Status).To(Equal(pb.TradeStatus_TRADE_COMPLETED))
+			trade.Status = character.TradeStatusCancelled
+			Expect(trade.ToPb().Status).To(Equal(pb.TradeStatus_TRADE_CANCELLED))
+		})
+
+		It("should only set completed at for completed trades", func() {
+			Expect(trade.ToPb().CompletedAt).To(BeZero())
+		})
+	})
+})
@@ -1,6 +1,8 @@
 package chat
 
 import (
+	"time"
+
 	"github.com/ShatteredRealms/go-backend/pkg/pb"
 	"gorm.io/gorm"
 )
@@ -9,21 +11,51 @@ type ChatChannel struct {
 	gorm.Model
 	Name      string `gorm:"uniqueIndex:idx_channel" json:"name"`
 	Dimension string `gorm:"uniqueIndex:idx_channel" json:"dimension"`
+
+	// FilterProfile name of the message filter profile used for the channel
+	FilterProfile string `json:"filterProfile"`
+
+	Type pb.ChatChannelType `gorm:"not null;default:0" json:"type"`
+
+	// OwnerId id of the character that owns the channel. If nil, the channel is owned by the server.
+	OwnerId *uint `gorm:"index" json:"ownerId"`
 }
 type ChatChannels []*ChatChannel
 
 type ChatChannelPermission struct {
 	ChannelId   uint `gorm:"uniqueIndex:idx_permission" json:"channelId"`
 	CharacterId uint `gorm:"uniqueIndex:idx_permission" json:"characterId"`
+	Moderator   bool `gorm:"not null;default:false" json:"moderator"`
 }
 type ChatChannelPermissions []*ChatChannelPermission
 
+// ChatChannelInvite an invite for a character to join a private channel
+type ChatChannelInvite struct {
+	ChannelId   uint      `gorm:"uniqueIndex:idx_invite" json:"channelId"`
+	CharacterId uint      `gorm:"uniqueIndex:idx_invite" json:"characterId"`
+	InviterId   uint      `json:"inviterId"`
+	CreatedAt   time.Time `json:"createdAt"`
+}
+
+// IsOwner checks if the character owns the channel
+func (c *ChatChannel) IsOwner(characterId uint) bool {
+	return c.OwnerId != nil && *c.OwnerId == characterId
+}
+
 func (c *ChatChannel) ToPb() *pb.ChatChannel {
-	return &pb.ChatChannel{
-		Id:        uint64(c.ID),
-		Name:      c.Name,
-		Dimension: c.Dimension,
+	resp := &pb.ChatChannel{
+		Id:            uint64(c.ID),
+		Name:          c.Name,
+		Dimension:     c.Dimension,
+		FilterProfile: c.FilterProfile,
+		Type:          c.Type,
 	}
+
+	if c.OwnerId != nil {
+		resp.OwnerId = uint64(*c.OwnerId)
+	}
+
+	return resp
 }
 
 func (c ChatChannels) ToPb() *pb.ChatChannels {
@@ -34,3 +66,18 @@ func (c ChatChannels) ToPb() *pb.ChatChannels {
 
 	return resp
 }
+
+// ToPb converts the channel members to their protobuf representation. Character names are not known and should be
+// added by the caller.
+func (p ChatChannelPermissions) ToPb(channel *ChatChannel) *pb.ChannelMembers {
+	resp := &pb.ChannelMembers{Members: make([]*pb.ChannelMember, len(p))}
+	for idx, permission := range p {
+		resp.Members[idx] = &pb.ChannelMember{
+			CharacterId: uint64(permission.CharacterId),
+			Moderator:   permission.Moderator,
+			Owner:       channel.IsOwner(permission.CharacterId),
+		}
+	}
+
+	return resp
+}
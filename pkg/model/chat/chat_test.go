@@ -39,4 +39,33 @@ var _ = Describe("Chat model", func() {
 			}
 		})
 	})
+
+	Describe("IsOwner", func() {
+		It("should only match the owning character", func() {
+			ownerId := uint(3)
+			Expect((&chat.ChatChannel{}).IsOwner(ownerId)).To(BeFalse())
+			Expect((&chat.ChatChannel{OwnerId: &ownerId}).IsOwner(ownerId)).To(BeTrue())
+			Expect((&chat.ChatChannel{OwnerId: &ownerId}).IsOwner(ownerId + 1)).To(BeFalse())
+		})
+	})
+
+	Describe("Members ToPb", func() {
+		It("should mark moderators and the owner", func() {
+			ownerId := uint(1)
+			channel := &chat.ChatChannel{OwnerId: &ownerId}
+			members := chat.ChatChannelPermissions{
+				{CharacterId: ownerId, Moderator: true},
+				{CharacterId: 2},
+			}
+
+			out := members.ToPb(channel)
+			Expect(out.Members).To(HaveLen(2))
+			Expect(out.Members[0].CharacterId).To(BeEquivalentTo(ownerId))
+			Expect(out.Members[0].Moderator).To(BeTrue())
+			Expect(out.Members[0].Owner).To(BeTrue())
+			Expect(out.Members[1].CharacterId).To(BeEquivalentTo(2))
+			Expect(out.Members[1].Moderator).To(BeFalse())
+			Expect(out.Members[1].Owner).To(BeFalse())
+		})
+	})
 })
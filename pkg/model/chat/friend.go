@@ -1,6 +1,10 @@
 package chat
 
-import "time"
+import (
+	"time"
+
+	"github.com/ShatteredRealms/go-backend/pkg/pb"
+)
 
 type UserFriend struct {
 	OwningId  string    `gorm:"primarykey" json:"user"`
@@ -9,9 +13,72 @@ type UserFriend struct {
 }
 type UserFriends []*UserFriend
 
+// UserFriendRequest a pending friend request from one user to another
+type UserFriendRequest struct {
+	SenderId  string    `gorm:"primarykey" json:"sender"`
+	TargetId  string    `gorm:"primarykey" json:"target"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+type UserFriendRequests []*UserFriendRequest
+
 type UserBlock struct {
 	OwningId  string    `gorm:"primarykey" json:"user"`
 	BlockedId string    `gorm:"primarykey" json:"blockedUser"`
 	CreatedAt time.Time `json:"createdAt"`
 }
 type UserBlocks []*UserBlock
+
+func (f *UserFriend) ToPb() *pb.SocialUser {
+	return &pb.SocialUser{
+		UserId:    f.FriendId,
+		CreatedAt: f.CreatedAt.Unix(),
+	}
+}
+
+func (f UserFriends) ToPb() *pb.SocialUsers {
+	resp := &pb.SocialUsers{Users: make([]*pb.SocialUser, len(f))}
+	for idx, friend := range f {
+		resp.Users[idx] = friend.ToPb()
+	}
+
+	return resp
+}
+
+func (b *UserBlock) ToPb() *pb.SocialUser {
+	return &pb.SocialUser{
+		UserId:    b.BlockedId,
+		CreatedAt: b.CreatedAt.Unix(),
+	}
+}
+
+func (b UserBlocks) ToPb() *pb.SocialUsers {
+	resp := &pb.SocialUsers{Users: make([]*pb.SocialUser, len(b))}
+	for idx, block := range b {
+		resp.Users[idx] = block.ToPb()
+	}
+
+	return resp
+}
+
+// ToPb converts the requests into incoming and outgoing requests relative to the given user
+func (r UserFriendRequests) ToPb(userId string) *pb.FriendRequests {
+	resp := &pb.FriendRequests{
+		Incoming: make([]*pb.SocialUser, 0),
+		Outgoing: make([]*pb.SocialUser, 0),
+	}
+	for _, request := range r {
+		if request.TargetId == userId {
+			resp.Incoming = append(resp.Incoming, &pb.SocialUser{
+				UserId:    request.SenderId,
+				CreatedAt: request.CreatedAt.Unix(),
+			})
+		} else if request.SenderId == userId {
+			resp.Outgoing = append(resp.Outgoing, &pb.SocialUser{
+				UserId:    request.TargetId,
+				CreatedAt: request.CreatedAt.Unix(),
+			})
+		}
+	}
+
+	return resp
+}
@@ -0,0 +1,57 @@
+package chat_test
+
+import (
+	"time"
+
+	"github.com/bxcodec/faker/v4"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/ShatteredRealms/go-backend/pkg/model/chat"
+)
+
+var _ = Describe("Friend model", func() {
+	Describe("ToPb", func() {
+		It("should convert friends", func() {
+			friends := chat.UserFriends{
+				{OwningId: faker.UUIDHyphenated(), FriendId: faker.UUIDHyphenated(), CreatedAt: time.Now()},
+				{OwningId: faker.UUIDHyphenated(), FriendId: faker.UUIDHyphenated(), CreatedAt: time.Now()},
+			}
+
+			out := friends.ToPb()
+			Expect(out.Users).To(HaveLen(len(friends)))
+			for idx, friend := range friends {
+				Expect(out.Users[idx].UserId).To(Equal(friend.FriendId))
+				Expect(out.Users[idx].CreatedAt).To(Equal(friend.CreatedAt.Unix()))
+			}
+		})
+
+		It("should convert blocks", func() {
+			blocks := chat.UserBlocks{
+				{OwningId: faker.UUIDHyphenated(), BlockedId: faker.UUIDHyphenated(), CreatedAt: time.Now()},
+			}
+
+			out := blocks.ToPb()
+			Expect(out.Users).To(HaveLen(1))
+			Expect(out.Users[0].UserId).To(Equal(blocks[0].BlockedId))
+			Expect(out.Users[0].CreatedAt).To(Equal(blocks[0].CreatedAt.Unix()))
+		})
+
+		It("should split friend requests into incoming and outgoing", func() {
+			userId := faker.UUIDHyphenated()
+			incomingId := faker.UUIDHyphenated()
+			outgoingId := faker.UUIDHyphenated()
+			requests := chat.UserFriendRequests{
+				{SenderId: incomingId, TargetId: userId, CreatedAt: time.Now()},
+				{SenderId: userId, TargetId: outgoingId, CreatedAt: time.Now()},
+				{SenderId: faker.UUIDHyphenated(), TargetId: faker.UUIDHyphenated(), CreatedAt: time.Now()},
+			}
+
+			out := requests.ToPb(userId)
+			Expect(out.Incoming).To(HaveLen(1))
+			Expect(out.Incoming[0].UserId).To(Equal(incomingId))
+			Expect(out.Outgoing).To(HaveLen(1))
+			Expect(out.Outgoing[0].UserId).To(Equal(outgoingId))
+		})
+	})
+})
@@ -0,0 +1,130 @@
+package chat
+
+import (
+	"time"
+
+	"github.com/ShatteredRealms/go-backend/pkg/pb"
+)
+
+const (
+	// DefaultHistoryLimit number of messages returned from history when no limit is given
+	DefaultHistoryLimit = 50
+
+	// MaxHistoryLimit maximum number of messages returned from history in a single request
+	MaxHistoryLimit = 100
+)
+
+// ChatMessage a message sent to a chat channel or directly to a character
+type ChatMessage struct {
+	ID                  uint               `gorm:"primarykey" json:"id"`
+	CreatedAt           time.Time          `json:"createdAt"`
+	SenderCharacterId   uint               `json:"senderId"`
+	SenderCharacterName string             `gorm:"not null;index" json:"sender"`
+	Dimension           string             `json:"dimension"`
+	ChannelId           *uint              `gorm:"index" json:"channelId"`
+	TargetCharacterName *string            `gorm:"index" json:"target"`
+	Type                pb.ChatMessageType `gorm:"not null;default:0" json:"type"`
+	ReplyToId           *uint              `json:"replyToId"`
+	Message             string             `gorm:"not null" json:"message"`
+}
+type ChatMessages []*ChatMessage
+
+// ChatDirectMessageRead the newest direct message a character has read from another character
+type ChatDirectMessageRead struct {
+	CharacterName      string    `gorm:"uniqueIndex:idx_direct_message_read" json:"characterName"`
+	OtherCharacterName string    `gorm:"uniqueIndex:idx_direct_message_read" json:"otherCharacterName"`
+	LastReadMessageId  uint      `gorm:"not null" json:"lastReadMessageId"`
+	UpdatedAt          time.Time `json:"updatedAt"`
+}
+
+// UnreadConversation number of unread direct messages sent by another character
+type UnreadConversation struct {
+	CharacterName     string
+	Count             uint
+	LastReadMessageId uint
+}
+type UnreadConversations []*UnreadConversation
+
+// HistoryCursor used to page through chat history by message id
+type HistoryCursor struct {
+	// Before only messages with an id less than this are matched if non-zero
+	Before uint
+
+	// After only messages with an id greater than this are matched if non-zero
+	After uint
+
+	// Limit maximum number of messages to match
+	Limit int
+}
+
+// NewHistoryCursor creates a cursor with the limit bounded by MaxHistoryLimit
+func NewHistoryCursor(before uint64, after uint64, limit uint32) HistoryCursor {
+	cursor := HistoryCursor{
+		Before: uint(before),
+		After:  uint(after),
+		Limit:  int(limit),
+	}
+
+	if cursor.Limit <= 0 {
+		cursor.Limit = DefaultHistoryLimit
+	} else if cursor.Limit > MaxHistoryLimit {
+		cursor.Limit = MaxHistoryLimit
+	}
+
+	return cursor
+}
+
+func (m *ChatMessage) ToPb() *pb.ChatMessage {
+	resp := &pb.ChatMessage{
+		Id:            uint64(m.ID),
+		Message:       m.Message,
+		CharacterName: m.SenderCharacterName,
+		SentAt:        m.CreatedAt.UnixMilli(),
+		CharacterId:   uint64(m.SenderCharacterId),
+		Dimension:     m.Dimension,
+		Type:          m.Type,
+	}
+
+	if m.ChannelId != nil {
+		resp.ChannelId = uint64(*m.ChannelId)
+	}
+
+	if m.TargetCharacterName != nil {
+		resp.TargetCharacterName = *m.TargetCharacterName
+	}
+
+	if m.ReplyToId != nil {
+		resp.ReplyToId = uint64(*m.ReplyToId)
+	}
+
+	return resp
+}
+
+// IsEventType checks if the message type is an event sent through message streams rather than a message
+func IsEventType(messageType pb.ChatMessageType) bool {
+	return messageType == pb.ChatMessageType_TYPING ||
+		messageType == pb.ChatMessageType_READ_RECEIPT ||
+		messageType == pb.ChatMessageType_CHARACTER_REMOVED
+}
+
+func (m ChatMessages) ToPb() *pb.ChatMessages {
+	resp := &pb.ChatMessages{Messages: make([]*pb.ChatMessage, len(m))}
+	for idx, message := range m {
+		resp.Messages[idx] = message.ToPb()
+	}
+
+	return resp
+}
+
+func (u UnreadConversations) ToPb() *pb.UnreadDirectMessages {
+	resp := &pb.UnreadDirectMessages{Conversations: make([]*pb.UnreadDirectMessage, len(u))}
+	for idx, conversation := range u {
+		resp.Conversations[idx] = &pb.UnreadDirectMessage{
+			CharacterName:     conversation.CharacterName,
+			Count:             uint64(conversation.Count),
+			LastReadMessageId: uint64(conversation.LastReadMessageId),
+		}
+	}
+
+	return resp
+}
@@ -0,0 +1,112 @@
+package chat_test
+
+import (
+	"time"
+
+	"github.com/bxcodec/faker/v4"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/ShatteredRealms/go-backend/pkg/model/chat"
+	"github.com/ShatteredRealms/go-backend/pkg/pb"
+)
+
+var _ = Describe("Message model", func() {
+	Describe("ToPb", func() {
+		It("should convert channel messages", func() {
+			channelId := uint(5)
+			message := &chat.ChatMessage{
+				ID:                  10,
+				CreatedAt:           time.Now(),
+				SenderCharacterName: faker.Username(),
+				ChannelId:           &channelId,
+				Message:             faker.Sentence(),
+			}
+
+			out := message.ToPb()
+			Expect(out.Id).To(BeEquivalentTo(message.ID))
+			Expect(out.SentAt).To(Equal(message.CreatedAt.UnixMilli()))
+			Expect(out.CharacterName).To(Equal(message.SenderCharacterName))
+			Expect(out.Message).To(Equal(message.Message))
+			Expect(out.ChannelId).To(BeEquivalentTo(channelId))
+			Expect(out.TargetCharacterName).To(BeEmpty())
+			Expect(out.ReplyToId).To(BeZero())
+		})
+
+		It("should convert the envelope details", func() {
+			replyToId := uint(3)
+			message := &chat.ChatMessage{
+				ID:                  4,
+				SenderCharacterId:   7,
+				SenderCharacterName: faker.Username(),
+				Dimension:           faker.Username(),
+				Type:                pb.ChatMessageType_EMOTE,
+				ReplyToId:           &replyToId,
+				Message:             faker.Sentence(),
+			}
+
+			out := message.ToPb()
+			Expect(out.CharacterId).To(BeEquivalentTo(message.SenderCharacterId))
+			Expect(out.Dimension).To(Equal(message.Dimension))
+			Expect(out.Type).To(Equal(pb.ChatMessageType_EMOTE))
+			Expect(out.ReplyToId).To(BeEquivalentTo(replyToId))
+		})
+
+		It("should convert direct messages", func() {
+			target := faker.Username()
+			messages := chat.ChatMessages{
+				{ID: 1, SenderCharacterName: faker.Username(), TargetCharacterName: &target, Message: faker.Sentence()},
+				{ID: 2, SenderCharacterName: faker.Username(), TargetCharacterName: &target, Message: faker.Sentence()},
+			}
+
+			out := messages.ToPb()
+			Expect(out.Messages).To(HaveLen(len(messages)))
+			for idx, message := range out.Messages {
+				Expect(message.Id).To(BeEquivalentTo(messages[idx].ID))
+				Expect(message.TargetCharacterName).To(Equal(target))
+				Expect(message.ChannelId).To(BeZero())
+			}
+		})
+	})
+
+	Describe("NewHistoryCursor", func() {
+		It("should use the default limit", func() {
+			Expect(chat.NewHistoryCursor(1, 2, 0)).To(Equal(chat.HistoryCursor{
+				Before: 1,
+				After:  2,
+				Limit:  chat.DefaultHistoryLimit,
+			}))
+		})
+
+		It("should bound the limit", func() {
+			Expect(chat.NewHistoryCursor(0, 0, chat.MaxHistoryLimit+1).Limit).To(Equal(chat.MaxHistoryLimit))
+			Expect(chat.NewHistoryCursor(0, 0, 10).Limit).To(Equal(10))
+		})
+	})
+
+	Describe("IsEventType", func() {
+		It("should only match events", func() {
+			Expect(chat.IsEventType(pb.ChatMessageType_TYPING)).To(BeTrue())
+			Expect(chat.IsEventType(pb.ChatMessageType_READ_RECEIPT)).To(BeTrue())
+			Expect(chat.IsEventType(pb.ChatMessageType_CHARACTER_REMOVED)).To(BeTrue())
+			Expect(chat.IsEventType(pb.ChatMessageType_WHISPER)).To(BeFalse())
+			Expect(chat.IsEventType(pb.ChatMessageType_SAY)).To(BeFalse())
+		})
+	})
+
+	Describe("UnreadConversations ToPb", func() {
+		It("should convert unread counts", func() {
+			conversations := chat.UnreadConversations{
+				{CharacterName: faker.Username(), Count: 3, LastReadMessageId: 7},
+				{CharacterName: faker.Username(), Count: 1},
+			}
+
+			out := conversations.ToPb()
+			Expect(out.Conversations).To(HaveLen(2))
+			Expect(out.Conversations[0].CharacterName).To(Equal(conversations[0].CharacterName))
+			Expect(out.Conversations[0].Count).To(BeEquivalentTo(3))
+			Expect(out.Conversations[0].LastReadMessageId).To(BeEquivalentTo(7))
+			Expect(out.Conversations[1].LastReadMessageId).To(BeZero())
+		})
+	})
+})
@@ -0,0 +1,54 @@
+package chat
+
+import (
+	"time"
+
+	"github.com/ShatteredRealms/go-backend/pkg/pb"
+	"gorm.io/gorm"
+)
+
+// ChatChannelModeration a moderation action taken against a character in a chat channel
+type ChatChannelModeration struct {
+	gorm.Model
+	ChannelId   uint                  `gorm:"not null;index:idx_moderation" json:"channelId"`
+	CharacterId uint                  `gorm:"not null;index:idx_moderation" json:"characterId"`
+	Type        pb.ChatModerationType `gorm:"not null" json:"type"`
+	Reason      string                `json:"reason"`
+	ModeratorId string                `gorm:"not null" json:"moderatorId"`
+
+	// ExpiresAt when the action expires. If nil, the action never expires.
+	ExpiresAt *time.Time `json:"expiresAt"`
+}
+type ChatChannelModerations []*ChatChannelModeration
+
+// IsActive checks if the action has not expired yet
+func (m *ChatChannelModeration) IsActive() bool {
+	return m.ExpiresAt == nil || m.ExpiresAt.After(time.Now())
+}
+
+func (m *ChatChannelModeration) ToPb() *pb.ChatModeration {
+	resp := &pb.ChatModeration{
+		Id:          uint64(m.ID),
+		ChannelId:   uint64(m.ChannelId),
+		CharacterId: uint64(m.CharacterId),
+		Type:        m.Type,
+		Reason:      m.Reason,
+		ModeratorId: m.ModeratorId,
+		CreatedAt:   m.CreatedAt.Unix(),
+	}
+
+	if m.ExpiresAt != nil {
+		resp.ExpiresAt = m.ExpiresAt.Unix()
+	}
+
+	return resp
+}
+
+func (m ChatChannelModerations) ToPb() *pb.ChatModerations {
+	resp := &pb.ChatModerations{Moderations: make([]*pb.ChatModeration, len(m))}
+	for idx, moderation := range m {
+		resp.Moderations[idx] = moderation.ToPb()
+	}
+
+	return resp
+}
@@ -0,0 +1,61 @@
+package chat_test
+
+import (
+	"time"
+
+	"github.com/bxcodec/faker/v4"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"gorm.io/gorm"
+
+	"github.com/ShatteredRealms/go-backend/pkg/model/chat"
+	"github.com/ShatteredRealms/go-backend/pkg/pb"
+)
+
+var _ = Describe("Moderation model", func() {
+	Describe("IsActive", func() {
+		It("should be active without an expiration", func() {
+			Expect((&chat.ChatChannelModeration{}).IsActive()).To(BeTrue())
+		})
+
+		It("should only be active before expiring", func() {
+			future := time.Now().Add(time.Minute)
+			past := time.Now().Add(-time.Minute)
+			Expect((&chat.ChatChannelModeration{ExpiresAt: &future}).IsActive()).To(BeTrue())
+			Expect((&chat.ChatChannelModeration{ExpiresAt: &past}).IsActive()).To(BeFalse())
+		})
+	})
+
+	Describe("ToPb", func() {
+		It("should convert moderations", func() {
+			expiresAt := time.Now().Add(time.Hour)
+			moderations := chat.ChatChannelModerations{
+				{
+					Model:       gorm.Model{ID: 1, CreatedAt: time.Now()},
+					ChannelId:   2,
+					CharacterId: 3,
+					Type:        pb.ChatModerationType_BAN,
+					Reason:      faker.Sentence(),
+					ModeratorId: faker.UUIDHyphenated(),
+					ExpiresAt:   &expiresAt,
+				},
+				{
+					Model:       gorm.Model{ID: 4, CreatedAt: time.Now()},
+					Type:        pb.ChatModerationType_MUTE,
+					ModeratorId: faker.UUIDHyphenated(),
+				},
+			}
+
+			out := moderations.ToPb()
+			Expect(out.Moderations).To(HaveLen(2))
+			Expect(out.Moderations[0].Id).To(BeEquivalentTo(1))
+			Expect(out.Moderations[0].ChannelId).To(BeEquivalentTo(2))
+			Expect(out.Moderations[0].CharacterId).To(BeEquivalentTo(3))
+			Expect(out.Moderations[0].Type).To(Equal(pb.ChatModerationType_BAN))
+			Expect(out.Moderations[0].Reason).To(Equal(moderations[0].Reason))
+			Expect(out.Moderations[0].ModeratorId).To(Equal(moderations[0].ModeratorId))
+			Expect(out.Moderations[0].ExpiresAt).To(Equal(expiresAt.Unix()))
+			Expect(out.Moderations[1].ExpiresAt).To(BeZero())
+		})
+	})
+})
@@ -0,0 +1,129 @@
+package gamebackend
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/ShatteredRealms/go-backend/pkg/pb"
+)
+
+// PartyAllocationLabel label of game servers with the id of a party that has members on it
+const PartyAllocationLabel = "party"
+
+// Party group of characters playing together. Parties only exist while they have members and are disbanded once less
+// than two members remain.
+type Party struct {
+	ID        uint `gorm:"primarykey"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+
+	// LeaderId id of the character that leads the party
+	LeaderId uint `gorm:"not null" json:"leaderId"`
+
+	// Dimension all members of the party must be in
+	Dimension string `gorm:"not null" json:"dimension"`
+
+	// ChatChannelId id of the private chat channel for the party members. Zero if it has not been provisioned.
+	ChatChannelId uint `gorm:"not null;default:0" json:"chatChannelId"`
+
+	// Members of the party in the order they joined
+	Members PartyMembers `gorm:"constraint:OnDelete:CASCADE" json:"members"`
+}
+
+// PartyMember a character in a party. Characters can only be in one party at a time.
+type PartyMember struct {
+	CharacterId uint      `gorm:"primarykey" json:"characterId"`
+	PartyId     uint      `gorm:"not null;index" json:"partyId"`
+	CreatedAt   time.Time `json:"joinedAt"`
+}
+type PartyMembers []*PartyMember
+
+// PartyInvite an invite for a character to join a party
+type PartyInvite struct {
+	PartyId     uint      `gorm:"primarykey" json:"partyId"`
+	CharacterId uint      `gorm:"primarykey" json:"characterId"`
+	InviterId   uint      `json:"inviterId"`
+	CreatedAt   time.Time `json:"createdAt"`
+
+	Party *Party `gorm:"constraint:OnDelete:CASCADE" json:"-"`
+}
+type PartyInvites []*PartyInvite
+
+// IsLeader checks if the character leads the party
+func (p *Party) IsLeader(characterId uint) bool {
+	return p.LeaderId == characterId
+}
+
+// IsMember checks if the character is in the party
+func (p *Party) IsMember(characterId uint) bool {
+	return p.Member(characterId) != nil
+}
+
+// Member gets the member for the character or nil if the character is not in the party
+func (p *Party) Member(characterId uint) *PartyMember {
+	for _, member := range p.Members {
+		if member.CharacterId == characterId {
+			return member
+		}
+	}
+
+	return nil
+}
+
+// CharacterIds ids of the characters in the party
+func (p *Party) CharacterIds() []uint {
+	ids := make([]uint, len(p.Members))
+	for idx, member := range p.Members {
+		ids[idx] = member.CharacterId
+	}
+
+	return ids
+}
+
+// AllocationLabels labels given to game servers hosting members of the party so the other members can be allocated to
+// the same game server. Game servers keep the label of the party of their latest allocation, so a game server never
+// has more than one party label.
+func (p *Party) AllocationLabels() map[string]string {
+	return map[string]string{PartyAllocationLabel: strconv.FormatUint(uint64(p.ID), 10)}
+}
+
+// ToPb converts the party to its protobuf representation. The character names are not known and should be added by the
+// caller.
+func (p *Party) ToPb() *pb.Party {
+	return &pb.Party{
+		Id:            uint64(p.ID),
+		LeaderId:      uint64(p.LeaderId),
+		Dimension:     p.Dimension,
+		ChatChannelId: uint64(p.ChatChannelId),
+		Members:       p.Members.ToPb(),
+		CreatedAt:     p.CreatedAt.Unix(),
+	}
+}
+
+func (m PartyMembers) ToPb() []*pb.PartyMember {
+	resp := make([]*pb.PartyMember, len(m))
+	for idx, member := range m {
+		resp[idx] = &pb.PartyMember{
+			CharacterId: uint64(member.CharacterId),
+			JoinedAt:    member.CreatedAt.Unix(),
+		}
+	}
+
+	return resp
+}
+
+// ToPb converts the invites to their protobuf representation. The inviter names are not known and should be added by
+// the caller.
+func (i PartyInvites) ToPb() *pb.PartyInvites {
+	resp := &pb.PartyInvites{Invites: make([]*pb.PartyInvite, len(i))}
+	for idx, invite := range i {
+		resp.Invites[idx] = &pb.PartyInvite{
+			PartyId:     uint64(invite.PartyId),
+			CharacterId: uint64(invite.CharacterId),
+			InviterId:   uint64(invite.InviterId),
+			CreatedAt:   invite.CreatedAt.Unix(),
+		}
+	}
+
+	return resp
+}
@@ -0,0 +1,58 @@
+package gamebackend_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/ShatteredRealms/go-backend/pkg/model/gamebackend"
+)
+
+var _ = Describe("Party model", func() {
+	var (
+		party *gamebackend.Party
+	)
+
+	BeforeEach(func() {
+		party = &gamebackend.Party{
+			ID:        1,
+			CreatedAt: time.Now(),
+			LeaderId:  2,
+			Dimension: "dimension",
+			Members: gamebackend.PartyMembers{
+				{CharacterId: 2, PartyId: 1, CreatedAt: time.Now()},
+				{CharacterId: 3, PartyId: 1, CreatedAt: time.Now()},
+			},
+		}
+	})
+
+	It("should find members", func() {
+		Expect(party.IsLeader(2)).To(BeTrue())
+		Expect(party.IsLeader(3)).To(BeFalse())
+		Expect(party.IsMember(3)).To(BeTrue())
+		Expect(party.IsMember(4)).To(BeFalse())
+		Expect(party.Member(4)).To(BeNil())
+		Expect(party.CharacterIds()).To(Equal([]uint{2, 3}))
+	})
+
+	It("should have a unique allocation label value", func() {
+		other := &gamebackend.Party{ID: 2}
+		Expect(party.AllocationLabels()).To(HaveKeyWithValue(gamebackend.PartyAllocationLabel, "1"))
+		Expect(other.AllocationLabels()).To(HaveKeyWithValue(gamebackend.PartyAllocationLabel, "2"))
+	})
+
+	It("should convert to protobuf", func() {
+		out := party.ToPb()
+		Expect(out.Id).To(BeEquivalentTo(party.ID))
+		Expect(out.LeaderId).To(BeEquivalentTo(party.LeaderId))
+		Expect(out.Dimension).To(Equal(party.Dimension))
+		Expect(out.CreatedAt).To(Equal(party.CreatedAt.Unix()))
+		Expect(out.Members).To(HaveLen(2))
+		Expect(out.Members[1].CharacterId).To(BeEquivalentTo(3))
+
+		invites := gamebackend.PartyInvites{{PartyId: 1, CharacterId: 4, InviterId: 2}}.ToPb()
+		Expect(invites.Invites).To(HaveLen(1))
+		Expect(invites.Invites[0].InviterId).To(BeEquivalentTo(2))
+	})
+})
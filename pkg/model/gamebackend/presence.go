@@ -0,0 +1,60 @@
+package gamebackend
+
+import (
+	"time"
+
+	"github.com/ShatteredRealms/go-backend/pkg/pb"
+)
+
+// Presence what a character is currently doing
+type Presence struct {
+	CharacterId uint              `json:"characterId"`
+	Status      pb.PresenceStatus `json:"status"`
+	Dimension   string            `json:"dimension"`
+	Map         string            `json:"map"`
+
+	// UpdatedAt when the presence was last reported
+	UpdatedAt time.Time `json:"updatedAt"`
+
+	// ExpiresAt when the presence should be considered offline if it is not reported again
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// OfflinePresence presence of a character that has not reported or whose presence expired
+func OfflinePresence(characterId uint) *Presence {
+	return &Presence{
+		CharacterId: characterId,
+		Status:      pb.PresenceStatus_OFFLINE,
+	}
+}
+
+// IsExpired checks if the presence was not reported again in time
+func (p *Presence) IsExpired() bool {
+	return !p.ExpiresAt.IsZero() && !time.Now().Before(p.ExpiresAt)
+}
+
+// IsOnline checks if the character is playing
+func (p *Presence) IsOnline() bool {
+	return p.Status != pb.PresenceStatus_OFFLINE && !p.IsExpired()
+}
+
+// ToPb converts the presence to its protobuf representation. Expired presence is reported as offline. The character
+// name is not known and should be added by the caller.
+func (p *Presence) ToPb() *pb.Presence {
+	if p.IsExpired() {
+		return OfflinePresence(p.CharacterId).ToPb()
+	}
+
+	resp := &pb.Presence{
+		CharacterId: uint64(p.CharacterId),
+		Status:      p.Status,
+		Dimension:   p.Dimension,
+		Map:         p.Map,
+	}
+
+	if !p.UpdatedAt.IsZero() {
+		resp.UpdatedAt = p.UpdatedAt.Unix()
+	}
+
+	return resp
+}
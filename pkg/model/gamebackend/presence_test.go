@@ -0,0 +1,53 @@
+package gamebackend_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/ShatteredRealms/go-backend/pkg/model/gamebackend"
+	"github.com/ShatteredRealms/go-backend/pkg/pb"
+)
+
+var _ = Describe("Presence model", func() {
+	It("should only be online until expiring", func() {
+		presence := &gamebackend.Presence{
+			CharacterId: 1,
+			Status:      pb.PresenceStatus_IN_COMBAT,
+			ExpiresAt:   time.Now().Add(time.Minute),
+		}
+		Expect(presence.IsOnline()).To(BeTrue())
+
+		presence.ExpiresAt = time.Now().Add(-time.Minute)
+		Expect(presence.IsExpired()).To(BeTrue())
+		Expect(presence.IsOnline()).To(BeFalse())
+	})
+
+	It("should not be online when offline", func() {
+		Expect(gamebackend.OfflinePresence(1).IsOnline()).To(BeFalse())
+	})
+
+	It("should convert expired presence as offline", func() {
+		presence := &gamebackend.Presence{
+			CharacterId: 1,
+			Status:      pb.PresenceStatus_AWAY,
+			Dimension:   "dimension",
+			Map:         "map",
+			UpdatedAt:   time.Now(),
+			ExpiresAt:   time.Now().Add(time.Minute),
+		}
+
+		out := presence.ToPb()
+		Expect(out.CharacterId).To(BeEquivalentTo(1))
+		Expect(out.Status).To(Equal(pb.PresenceStatus_AWAY))
+		Expect(out.Dimension).To(Equal("dimension"))
+		Expect(out.Map).To(Equal("map"))
+		Expect(out.UpdatedAt).To(Equal(presence.UpdatedAt.Unix()))
+
+		presence.ExpiresAt = time.Now().Add(-time.Second)
+		out = presence.ToPb()
+		Expect(out.Status).To(Equal(pb.PresenceStatus_OFFLINE))
+		Expect(out.Dimension).To(BeEmpty())
+	})
+})
@@ -20,15 +20,29 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	CharacterService_GetCharacters_FullMethodName           = "/sro.character.CharacterService/GetCharacters"
-	CharacterService_GetCharacter_FullMethodName            = "/sro.character.CharacterService/GetCharacter"
-	CharacterService_CreateCharacter_FullMethodName         = "/sro.character.CharacterService/CreateCharacter"
-	CharacterService_DeleteCharacter_FullMethodName         = "/sro.character.CharacterService/DeleteCharacter"
-	CharacterService_GetAllCharactersForUser_FullMethodName = "/sro.character.CharacterService/GetAllCharactersForUser"
-	CharacterService_EditCharacter_FullMethodName           = "/sro.character.CharacterService/EditCharacter"
-	CharacterService_AddCharacterPlayTime_FullMethodName    = "/sro.character.CharacterService/AddCharacterPlayTime"
-	CharacterService_GetInventory_FullMethodName            = "/sro.character.CharacterService/GetInventory"
-	CharacterService_SetInventory_FullMethodName            = "/sro.character.CharacterService/SetInventory"
+	CharacterService_GetCharacters_FullMethodName               = "/sro.character.CharacterService/GetCharacters"
+	CharacterService_GetCharacterCreationOptions_FullMethodName = "/sro.character.CharacterService/GetCharacterCreationOptions"
+	CharacterService_GetCharacter_FullMethodName                = "/sro.character.CharacterService/GetCharacter"
+	CharacterService_CreateCharacter_FullMethodName             = "/sro.character.CharacterService/CreateCharacter"
+	CharacterService_DeleteCharacter_FullMethodName             = "/sro.character.CharacterService/DeleteCharacter"
+	CharacterService_GetAllCharactersForUser_FullMethodName     = "/sro.character.CharacterService/GetAllCharactersForUser"
+	CharacterService_GrantCharacterSlots_FullMethodName         = "/sro.character.CharacterService/GrantCharacterSlots"
+	CharacterService_EditCharacter_FullMethodName               = "/sro.character.CharacterService/EditCharacter"
+	CharacterService_AddCharacterPlayTime_FullMethodName        = "/sro.character.CharacterService/AddCharacterPlayTime"
+	CharacterService_AddCharacterExperience_FullMethodName      = "/sro.character.CharacterService/AddCharacterExperience"
+	CharacterService_EditAppearance_FullMethodName              = "/sro.character.CharacterService/EditAppearance"
+	CharacterService_RenameCharacter_FullMethodName             = "/sro.character.CharacterService/RenameCharacter"
+	CharacterService_GetCharacterNameHistory_FullMethodName     = "/sro.character.CharacterService/GetCharacterNameHistory"
+	CharacterService_GetInventory_FullMethodName                = "/sro.character.CharacterService/GetInventory"
+	CharacterService_SetInventory_FullMethodName                = "/sro.character.CharacterService/SetInventory"
+	CharacterService_AddInventoryItem_FullMethodName            = "/sro.character.CharacterService/AddInventoryItem"
+	CharacterService_RemoveInventoryItem_FullMethodName         = "/sro.character.CharacterService/RemoveInventoryItem"
+	CharacterService_MoveInventoryItem_FullMethodName           = "/sro.character.CharacterService/MoveInventoryItem"
+	CharacterService_SplitInventoryStack_FullMethodName         = "/sro.character.CharacterService/SplitInventoryStack"
+	CharacterService_MergeInventoryStack_FullMethodName         = "/sro.character.CharacterService/MergeInventoryStack"
+	CharacterService_TransferInventoryItem_FullMethodName       = "/sro.character.CharacterService/TransferInventoryItem"
+	CharacterService_GetInventoryHistory_FullMethodName         = "/sro.character.CharacterService/GetInventoryHistory"
+	CharacterService_RestoreInventory_FullMethodName            = "/sro.character.CharacterService/RestoreInventory"
 )
 
 // CharacterServiceClient is the client API for CharacterService service.
@@ -36,16 +50,52 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type CharacterServiceClient interface {
 	GetCharacters(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CharactersDetails, error)
+	// Gets the realms, genders and appearance options a character can be
+	// created with. Does not require authentication.
+	GetCharacterCreationOptions(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CharacterCreationOptions, error)
 	GetCharacter(ctx context.Context, in *CharacterTarget, opts ...grpc.CallOption) (*CharacterDetails, error)
 	CreateCharacter(ctx context.Context, in *CreateCharacterRequest, opts ...grpc.CallOption) (*CharacterDetails, error)
 	DeleteCharacter(ctx context.Context, in *CharacterTarget, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	GetAllCharactersForUser(ctx context.Context, in *UserTarget, opts ...grpc.CallOption) (*CharactersDetails, error)
+	// Grants the account extra character slots and returns its slots
+	GrantCharacterSlots(ctx context.Context, in *GrantCharacterSlotsRequest, opts ...grpc.CallOption) (*CharacterSlots, error)
 	EditCharacter(ctx context.Context, in *EditCharacterRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Adds the given amount of playtime to the character and returns the total
 	// playtime
 	AddCharacterPlayTime(ctx context.Context, in *AddPlayTimeRequest, opts ...grpc.CallOption) (*PlayTimeResponse, error)
+	// Adds the given amount of experience to the character, leveling it up if
+	// enough experience is gained, and returns the new level and experience
+	AddCharacterExperience(ctx context.Context, in *AddExperienceRequest, opts ...grpc.CallOption) (*ExperienceResponse, error)
+	// Changes how the character looks
+	EditAppearance(ctx context.Context, in *EditAppearanceRequest, opts ...grpc.CallOption) (*CharacterAppearance, error)
+	// Renames the character. The old name stays reserved for the character for
+	// a configured period so no other character can take it.
+	RenameCharacter(ctx context.Context, in *RenameCharacterRequest, opts ...grpc.CallOption) (*CharacterDetails, error)
+	// Gets the previous names of the character, oldest first
+	GetCharacterNameHistory(ctx context.Context, in *CharacterTarget, opts ...grpc.CallOption) (*CharacterNameHistory, error)
 	GetInventory(ctx context.Context, in *CharacterTarget, opts ...grpc.CallOption) (*Inventory, error)
 	SetInventory(ctx context.Context, in *UpdateInventoryRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Places the item in an empty slot
+	AddInventoryItem(ctx context.Context, in *AddInventoryItemRequest, opts ...grpc.CallOption) (*Inventory, error)
+	// Removes the quantity of the item in the slot. The slot is emptied once all
+	// of its items are removed.
+	RemoveInventoryItem(ctx context.Context, in *RemoveInventoryItemRequest, opts ...grpc.CallOption) (*Inventory, error)
+	// Moves the item to another slot. If the other slot has an item, the items are
+	// swapped.
+	MoveInventoryItem(ctx context.Context, in *MoveInventoryItemRequest, opts ...grpc.CallOption) (*Inventory, error)
+	// Moves the quantity of the item to an empty slot. At least one item must stay
+	// in the original slot.
+	SplitInventoryStack(ctx context.Context, in *SplitInventoryStackRequest, opts ...grpc.CallOption) (*Inventory, error)
+	// Moves as many of the item as fit within its max stack onto the same item in
+	// another slot
+	MergeInventoryStack(ctx context.Context, in *MergeInventoryStackRequest, opts ...grpc.CallOption) (*Inventory, error)
+	// Moves the item between the inventory and the bank
+	TransferInventoryItem(ctx context.Context, in *TransferInventoryItemRequest, opts ...grpc.CallOption) (*Inventory, error)
+	// Lists the changes to the inventory, newest first
+	GetInventoryHistory(ctx context.Context, in *InventoryHistoryRequest, opts ...grpc.CallOption) (*InventoryEvents, error)
+	// Restores the inventory to how it was at the given time. The restore is
+	// recorded in the inventory history, so it can be undone.
+	RestoreInventory(ctx context.Context, in *RestoreInventoryRequest, opts ...grpc.CallOption) (*Inventory, error)
 }
 
 type characterServiceClient struct {
@@ -65,6 +115,15 @@ func (c *characterServiceClient) GetCharacters(ctx context.Context, in *emptypb.
 	return out, nil
 }
 
+func (c *characterServiceClient) GetCharacterCreationOptions(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CharacterCreationOptions, error) {
+	out := new(CharacterCreationOptions)
+	err := c.cc.Invoke(ctx, CharacterService_GetCharacterCreationOptions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *characterServiceClient) GetCharacter(ctx context.Context, in *CharacterTarget, opts ...grpc.CallOption) (*CharacterDetails, error) {
 	out := new(CharacterDetails)
 	err := c.cc.Invoke(ctx, CharacterService_GetCharacter_FullMethodName, in, out, opts...)
@@ -101,6 +160,15 @@ func (c *characterServiceClient) GetAllCharactersForUser(ctx context.Context, in
 	return out, nil
 }
 
+func (c *characterServiceClient) GrantCharacterSlots(ctx context.Context, in *GrantCharacterSlotsRequest, opts ...grpc.CallOption) (*CharacterSlots, error) {
+	out := new(CharacterSlots)
+	err := c.cc.Invoke(ctx, CharacterService_GrantCharacterSlots_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *characterServiceClient) EditCharacter(ctx context.Context, in *EditCharacterRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, CharacterService_EditCharacter_FullMethodName, in, out, opts...)
@@ -119,6 +187,42 @@ func (c *characterServiceClient) AddCharacterPlayTime(ctx context.Context, in *A
 	return out, nil
 }
 
+func (c *characterServiceClient) AddCharacterExperience(ctx context.Context, in *AddExperienceRequest, opts ...grpc.CallOption) (*ExperienceResponse, error) {
+	out := new(ExperienceResponse)
+	err := c.cc.Invoke(ctx, CharacterService_AddCharacterExperience_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *characterServiceClient) EditAppearance(ctx context.Context, in *EditAppearanceRequest, opts ...grpc.CallOption) (*CharacterAppearance, error) {
+	out := new(CharacterAppearance)
+	err := c.cc.Invoke(ctx, CharacterService_EditAppearance_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *characterServiceClient) RenameCharacter(ctx context.Context, in *RenameCharacterRequest, opts ...grpc.CallOption) (*CharacterDetails, error) {
+	out := new(CharacterDetails)
+	err := c.cc.Invoke(ctx, CharacterService_RenameCharacter_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *characterServiceClient) GetCharacterNameHistory(ctx context.Context, in *CharacterTarget, opts ...grpc.CallOption) (*CharacterNameHistory, error) {
+	out := new(CharacterNameHistory)
+	err := c.cc.Invoke(ctx, CharacterService_GetCharacterNameHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *characterServiceClient) GetInventory(ctx context.Context, in *CharacterTarget, opts ...grpc.CallOption) (*Inventory, error) {
 	out := new(Inventory)
 	err := c.cc.Invoke(ctx, CharacterService_GetInventory_FullMethodName, in, out, opts...)
@@ -137,21 +241,129 @@ func (c *characterServiceClient) SetInventory(ctx context.Context, in *UpdateInv
 	return out, nil
 }
 
+func (c *characterServiceClient) AddInventoryItem(ctx context.Context, in *AddInventoryItemRequest, opts ...grpc.CallOption) (*Inventory, error) {
+	out := new(Inventory)
+	err := c.cc.Invoke(ctx, CharacterService_AddInventoryItem_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *characterServiceClient) RemoveInventoryItem(ctx context.Context, in *RemoveInventoryItemRequest, opts ...grpc.CallOption) (*Inventory, error) {
+	out := new(Inventory)
+	err := c.cc.Invoke(ctx, CharacterService_RemoveInventoryItem_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *characterServiceClient) MoveInventoryItem(ctx context.Context, in *MoveInventoryItemRequest, opts ...grpc.CallOption) (*Inventory, error) {
+	out := new(Inventory)
+	err := c.cc.Invoke(ctx, CharacterService_MoveInventoryItem_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *characterServiceClient) SplitInventoryStack(ctx context.Context, in *SplitInventoryStackRequest, opts ...grpc.CallOption) (*Inventory, error) {
+	out := new(Inventory)
+	err := c.cc.Invoke(ctx, CharacterService_SplitInventoryStack_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *characterServiceClient) MergeInventoryStack(ctx context.Context, in *MergeInventoryStackRequest, opts ...grpc.CallOption) (*Inventory, error) {
+	out := new(Inventory)
+	err := c.cc.Invoke(ctx, CharacterService_MergeInventoryStack_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *characterServiceClient) TransferInventoryItem(ctx context.Context, in *TransferInventoryItemRequest, opts ...grpc.CallOption) (*Inventory, error) {
+	out := new(Inventory)
+	err := c.cc.Invoke(ctx, CharacterService_TransferInventoryItem_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *characterServiceClient) GetInventoryHistory(ctx context.Context, in *InventoryHistoryRequest, opts ...grpc.CallOption) (*InventoryEvents, error) {
+	out := new(InventoryEvents)
+	err := c.cc.Invoke(ctx, CharacterService_GetInventoryHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *characterServiceClient) RestoreInventory(ctx context.Context, in *RestoreInventoryRequest, opts ...grpc.CallOption) (*Inventory, error) {
+	out := new(Inventory)
+	err := c.cc.Invoke(ctx, CharacterService_RestoreInventory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CharacterServiceServer is the server API for CharacterService service.
 // All implementations must embed UnimplementedCharacterServiceServer
 // for forward compatibility
 type CharacterServiceServer interface {
 	GetCharacters(context.Context, *emptypb.Empty) (*CharactersDetails, error)
+	// Gets the realms, genders and appearance options a character can be
+	// created with. Does not require authentication.
+	GetCharacterCreationOptions(context.Context, *emptypb.Empty) (*CharacterCreationOptions, error)
 	GetCharacter(context.Context, *CharacterTarget) (*CharacterDetails, error)
 	CreateCharacter(context.Context, *CreateCharacterRequest) (*CharacterDetails, error)
 	DeleteCharacter(context.Context, *CharacterTarget) (*emptypb.Empty, error)
 	GetAllCharactersForUser(context.Context, *UserTarget) (*CharactersDetails, error)
+	// Grants the account extra character slots and returns its slots
+	GrantCharacterSlots(context.Context, *GrantCharacterSlotsRequest) (*CharacterSlots, error)
 	EditCharacter(context.Context, *EditCharacterRequest) (*emptypb.Empty, error)
 	// Adds the given amount of playtime to the character and returns the total
 	// playtime
 	AddCharacterPlayTime(context.Context, *AddPlayTimeRequest) (*PlayTimeResponse, error)
+	// Adds the given amount of experience to the character, leveling it up if
+	// enough experience is gained, and returns the new level and experience
+	AddCharacterExperience(context.Context, *AddExperienceRequest) (*ExperienceResponse, error)
+	// Changes how the character looks
+	EditAppearance(context.Context, *EditAppearanceRequest) (*CharacterAppearance, error)
+	// Renames the character. The old name stays reserved for the character for
+	// a configured period so no other character can take it.
+	RenameCharacter(context.Context, *RenameCharacterRequest) (*CharacterDetails, error)
+	// Gets the previous names of the character, oldest first
+	GetCharacterNameHistory(context.Context, *CharacterTarget) (*CharacterNameHistory, error)
 	GetInventory(context.Context, *CharacterTarget) (*Inventory, error)
 	SetInventory(context.Context, *UpdateInventoryRequest) (*emptypb.Empty, error)
+	// Places the item in an empty slot
+	AddInventoryItem(context.Context, *AddInventoryItemRequest) (*Inventory, error)
+	// Removes the quantity of the item in the slot. The slot is emptied once all
+	// of its items are removed.
+	RemoveInventoryItem(context.Context, *RemoveInventoryItemRequest) (*Inventory, error)
+	// Moves the item to another slot. If the other slot has an item, the items are
+	// swapped.
+	MoveInventoryItem(context.Context, *MoveInventoryItemRequest) (*Inventory, error)
+	// Moves the quantity of the item to an empty slot. At least one item must stay
+	// in the original slot.
+	SplitInventoryStack(context.Context, *SplitInventoryStackRequest) (*Inventory, error)
+	// Moves as many of the item as fit within its max stack onto the same item in
+	// another slot
+	MergeInventoryStack(context.Context, *MergeInventoryStackRequest) (*Inventory, error)
+	// Moves the item between the inventory and the bank
+	TransferInventoryItem(context.Context, *TransferInventoryItemRequest) (*Inventory, error)
+	// Lists the changes to the inventory, newest first
+	GetInventoryHistory(context.Context, *InventoryHistoryRequest) (*InventoryEvents, error)
+	// Restores the inventory to how it was at the given time. The restore is
+	// recorded in the inventory history, so it can be undone.
+	RestoreInventory(context.Context, *RestoreInventoryRequest) (*Inventory, error)
 	mustEmbedUnimplementedCharacterServiceServer()
 }
 
@@ -162,6 +374,9 @@ type UnimplementedCharacterServiceServer struct {
 func (UnimplementedCharacterServiceServer) GetCharacters(context.Context, *emptypb.Empty) (*CharactersDetails, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCharacters not implemented")
 }
+func (UnimplementedCharacterServiceServer) GetCharacterCreationOptions(context.Context, *emptypb.Empty) (*CharacterCreationOptions, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCharacterCreationOptions not implemented")
+}
 func (UnimplementedCharacterServiceServer) GetCharacter(context.Context, *CharacterTarget) (*CharacterDetails, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCharacter not implemented")
 }
@@ -174,18 +389,57 @@ func (UnimplementedCharacterServiceServer) DeleteCharacter(context.Context, *Cha
 func (UnimplementedCharacterServiceServer) GetAllCharactersForUser(context.Context, *UserTarget) (*CharactersDetails, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAllCharactersForUser not implemented")
 }
+func (UnimplementedCharacterServiceServer) GrantCharacterSlots(context.Context, *GrantCharacterSlotsRequest) (*CharacterSlots, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantCharacterSlots not implemented")
+}
 func (UnimplementedCharacterServiceServer) EditCharacter(context.Context, *EditCharacterRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EditCharacter not implemented")
 }
 func (UnimplementedCharacterServiceServer) AddCharacterPlayTime(context.Context, *AddPlayTimeRequest) (*PlayTimeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddCharacterPlayTime not implemented")
 }
+func (UnimplementedCharacterServiceServer) AddCharacterExperience(context.Context, *AddExperienceRequest) (*ExperienceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddCharacterExperience not implemented")
+}
+func (UnimplementedCharacterServiceServer) EditAppearance(context.Context, *EditAppearanceRequest) (*CharacterAppearance, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditAppearance not implemented")
+}
+func (UnimplementedCharacterServiceServer) RenameCharacter(context.Context, *RenameCharacterRequest) (*CharacterDetails, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenameCharacter not implemented")
+}
+func (UnimplementedCharacterServiceServer) GetCharacterNameHistory(context.Context, *CharacterTarget) (*CharacterNameHistory, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCharacterNameHistory not implemented")
+}
 func (UnimplementedCharacterServiceServer) GetInventory(context.Context, *CharacterTarget) (*Inventory, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetInventory not implemented")
 }
 func (UnimplementedCharacterServiceServer) SetInventory(context.Context, *UpdateInventoryRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetInventory not implemented")
 }
+func (UnimplementedCharacterServiceServer) AddInventoryItem(context.Context, *AddInventoryItemRequest) (*Inventory, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddInventoryItem not implemented")
+}
+func (UnimplementedCharacterServiceServer) RemoveInventoryItem(context.Context, *RemoveInventoryItemRequest) (*Inventory, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveInventoryItem not implemented")
+}
+func (UnimplementedCharacterServiceServer) MoveInventoryItem(context.Context, *MoveInventoryItemRequest) (*Inventory, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveInventoryItem not implemented")
+}
+func (UnimplementedCharacterServiceServer) SplitInventoryStack(context.Context, *SplitInventoryStackRequest) (*Inventory, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SplitInventoryStack not implemented")
+}
+func (UnimplementedCharacterServiceServer) MergeInventoryStack(context.Context, *MergeInventoryStackRequest) (*Inventory, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeInventoryStack not implemented")
+}
+func (UnimplementedCharacterServiceServer) TransferInventoryItem(context.Context, *TransferInventoryItemRequest) (*Inventory, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferInventoryItem not implemented")
+}
+func (UnimplementedCharacterServiceServer) GetInventoryHistory(context.Context, *InventoryHistoryRequest) (*InventoryEvents, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInventoryHistory not implemented")
+}
+func (UnimplementedCharacterServiceServer) RestoreInventory(context.Context, *RestoreInventoryRequest) (*Inventory, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreInventory not implemented")
+}
 func (UnimplementedCharacterServiceServer) mustEmbedUnimplementedCharacterServiceServer() {}
 
 // UnsafeCharacterServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -217,6 +471,24 @@ func _CharacterService_GetCharacters_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CharacterService_GetCharacterCreationOptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CharacterServiceServer).GetCharacterCreationOptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CharacterService_GetCharacterCreationOptions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CharacterServiceServer).GetCharacterCreationOptions(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _CharacterService_GetCharacter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CharacterTarget)
 	if err := dec(in); err != nil {
@@ -289,6 +561,24 @@ func _CharacterService_GetAllCharactersForUser_Handler(srv interface{}, ctx cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CharacterService_GrantCharacterSlots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GrantCharacterSlotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CharacterServiceServer).GrantCharacterSlots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CharacterService_GrantCharacterSlots_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CharacterServiceServer).GrantCharacterSlots(ctx, req.(*GrantCharacterSlotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _CharacterService_EditCharacter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(EditCharacterRequest)
 	if err := dec(in); err != nil {
@@ -325,6 +615,78 @@ func _CharacterService_AddCharacterPlayTime_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CharacterService_AddCharacterExperience_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddExperienceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CharacterServiceServer).AddCharacterExperience(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CharacterService_AddCharacterExperience_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CharacterServiceServer).AddCharacterExperience(ctx, req.(*AddExperienceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CharacterService_EditAppearance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EditAppearanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CharacterServiceServer).EditAppearance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CharacterService_EditAppearance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CharacterServiceServer).EditAppearance(ctx, req.(*EditAppearanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CharacterService_RenameCharacter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameCharacterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CharacterServiceServer).RenameCharacter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CharacterService_RenameCharacter_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CharacterServiceServer).RenameCharacter(ctx, req.(*RenameCharacterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CharacterService_GetCharacterNameHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CharacterTarget)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CharacterServiceServer).GetCharacterNameHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CharacterService_GetCharacterNameHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CharacterServiceServer).GetCharacterNameHistory(ctx, req.(*CharacterTarget))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _CharacterService_GetInventory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CharacterTarget)
 	if err := dec(in); err != nil {
@@ -361,6 +723,150 @@ func _CharacterService_SetInventory_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CharacterService_AddInventoryItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddInventoryItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CharacterServiceServer).AddInventoryItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CharacterService_AddInventoryItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CharacterServiceServer).AddInventoryItem(ctx, req.(*AddInventoryItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CharacterService_RemoveInventoryItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveInventoryItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CharacterServiceServer).RemoveInventoryItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CharacterService_RemoveInventoryItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CharacterServiceServer).RemoveInventoryItem(ctx, req.(*RemoveInventoryItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CharacterService_MoveInventoryItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveInventoryItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CharacterServiceServer).MoveInventoryItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CharacterService_MoveInventoryItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CharacterServiceServer).MoveInventoryItem(ctx, req.(*MoveInventoryItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CharacterService_SplitInventoryStack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SplitInventoryStackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CharacterServiceServer).SplitInventoryStack(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CharacterService_SplitInventoryStack_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CharacterServiceServer).SplitInventoryStack(ctx, req.(*SplitInventoryStackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CharacterService_MergeInventoryStack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeInventoryStackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CharacterServiceServer).MergeInventoryStack(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CharacterService_MergeInventoryStack_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CharacterServiceServer).MergeInventoryStack(ctx, req.(*MergeInventoryStackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CharacterService_TransferInventoryItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferInventoryItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CharacterServiceServer).TransferInventoryItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CharacterService_TransferInventoryItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CharacterServiceServer).TransferInventoryItem(ctx, req.(*TransferInventoryItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CharacterService_GetInventoryHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InventoryHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CharacterServiceServer).GetInventoryHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CharacterService_GetInventoryHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CharacterServiceServer).GetInventoryHistory(ctx, req.(*InventoryHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CharacterService_RestoreInventory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreInventoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CharacterServiceServer).RestoreInventory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CharacterService_RestoreInventory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CharacterServiceServer).RestoreInventory(ctx, req.(*RestoreInventoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CharacterService_ServiceDesc is the grpc.ServiceDesc for CharacterService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -372,6 +878,10 @@ var CharacterService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetCharacters",
 			Handler:    _CharacterService_GetCharacters_Handler,
 		},
+		{
+			MethodName: "GetCharacterCreationOptions",
+			Handler:    _CharacterService_GetCharacterCreationOptions_Handler,
+		},
 		{
 			MethodName: "GetCharacter",
 			Handler:    _CharacterService_GetCharacter_Handler,
@@ -388,6 +898,10 @@ var CharacterService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetAllCharactersForUser",
 			Handler:    _CharacterService_GetAllCharactersForUser_Handler,
 		},
+		{
+			MethodName: "GrantCharacterSlots",
+			Handler:    _CharacterService_GrantCharacterSlots_Handler,
+		},
 		{
 			MethodName: "EditCharacter",
 			Handler:    _CharacterService_EditCharacter_Handler,
@@ -396,6 +910,22 @@ var CharacterService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AddCharacterPlayTime",
 			Handler:    _CharacterService_AddCharacterPlayTime_Handler,
 		},
+		{
+			MethodName: "AddCharacterExperience",
+			Handler:    _CharacterService_AddCharacterExperience_Handler,
+		},
+		{
+			MethodName: "EditAppearance",
+			Handler:    _CharacterService_EditAppearance_Handler,
+		},
+		{
+			MethodName: "RenameCharacter",
+			Handler:    _CharacterService_RenameCharacter_Handler,
+		},
+		{
+			MethodName: "GetCharacterNameHistory",
+			Handler:    _CharacterService_GetCharacterNameHistory_Handler,
+		},
 		{
 			MethodName: "GetInventory",
 			Handler:    _CharacterService_GetInventory_Handler,
@@ -404,6 +934,38 @@ var CharacterService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetInventory",
 			Handler:    _CharacterService_SetInventory_Handler,
 		},
+		{
+			MethodName: "AddInventoryItem",
+			Handler:    _CharacterService_AddInventoryItem_Handler,
+		},
+		{
+			MethodName: "RemoveInventoryItem",
+			Handler:    _CharacterService_RemoveInventoryItem_Handler,
+		},
+		{
+			MethodName: "MoveInventoryItem",
+			Handler:    _CharacterService_MoveInventoryItem_Handler,
+		},
+		{
+			MethodName: "SplitInventoryStack",
+			Handler:    _CharacterService_SplitInventoryStack_Handler,
+		},
+		{
+			MethodName: "MergeInventoryStack",
+			Handler:    _CharacterService_MergeInventoryStack_Handler,
+		},
+		{
+			MethodName: "TransferInventoryItem",
+			Handler:    _CharacterService_TransferInventoryItem_Handler,
+		},
+		{
+			MethodName: "GetInventoryHistory",
+			Handler:    _CharacterService_GetInventoryHistory_Handler,
+		},
+		{
+			MethodName: "RestoreInventory",
+			Handler:    _CharacterService_RestoreInventory_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "sro/character/character.proto",
@@ -24,6 +24,11 @@ const (
 	ChatService_ConnectDirectMessage_FullMethodName                = "/sro.chat.ChatService/ConnectDirectMessage"
 	ChatService_SendChatMessage_FullMethodName                     = "/sro.chat.ChatService/SendChatMessage"
 	ChatService_SendDirectMessage_FullMethodName                   = "/sro.chat.ChatService/SendDirectMessage"
+	ChatService_GetChannelHistory_FullMethodName                   = "/sro.chat.ChatService/GetChannelHistory"
+	ChatService_GetDirectMessageHistory_FullMethodName             = "/sro.chat.ChatService/GetDirectMessageHistory"
+	ChatService_NotifyTyping_FullMethodName                        = "/sro.chat.ChatService/NotifyTyping"
+	ChatService_MarkRead_FullMethodName                            = "/sro.chat.ChatService/MarkRead"
+	ChatService_GetUnreadDirectMessages_FullMethodName             = "/sro.chat.ChatService/GetUnreadDirectMessages"
 	ChatService_GetChannel_FullMethodName                          = "/sro.chat.ChatService/GetChannel"
 	ChatService_AllChatChannels_FullMethodName                     = "/sro.chat.ChatService/AllChatChannels"
 	ChatService_CreateChannel_FullMethodName                       = "/sro.chat.ChatService/CreateChannel"
@@ -32,24 +37,95 @@ const (
 	ChatService_GetAuthorizedChatChannels_FullMethodName           = "/sro.chat.ChatService/GetAuthorizedChatChannels"
 	ChatService_UpdateUserChatChannelAuthorizations_FullMethodName = "/sro.chat.ChatService/UpdateUserChatChannelAuthorizations"
 	ChatService_SetUserChatChannelAuthorizations_FullMethodName    = "/sro.chat.ChatService/SetUserChatChannelAuthorizations"
+	ChatService_JoinChannel_FullMethodName                         = "/sro.chat.ChatService/JoinChannel"
+	ChatService_LeaveChannel_FullMethodName                        = "/sro.chat.ChatService/LeaveChannel"
+	ChatService_InviteToChannel_FullMethodName                     = "/sro.chat.ChatService/InviteToChannel"
+	ChatService_GetChannelMembers_FullMethodName                   = "/sro.chat.ChatService/GetChannelMembers"
+	ChatService_ModerateCharacter_FullMethodName                   = "/sro.chat.ChatService/ModerateCharacter"
+	ChatService_RevokeModeration_FullMethodName                    = "/sro.chat.ChatService/RevokeModeration"
+	ChatService_GetChannelModerations_FullMethodName               = "/sro.chat.ChatService/GetChannelModerations"
+	ChatService_SetChannelModerator_FullMethodName                 = "/sro.chat.ChatService/SetChannelModerator"
+	ChatService_MigrateCharacterName_FullMethodName                = "/sro.chat.ChatService/MigrateCharacterName"
+	ChatService_DeleteCharacterChat_FullMethodName                 = "/sro.chat.ChatService/DeleteCharacterChat"
+	ChatService_GetFriends_FullMethodName                          = "/sro.chat.ChatService/GetFriends"
+	ChatService_GetFriendRequests_FullMethodName                   = "/sro.chat.ChatService/GetFriendRequests"
+	ChatService_SendFriendRequest_FullMethodName                   = "/sro.chat.ChatService/SendFriendRequest"
+	ChatService_AcceptFriendRequest_FullMethodName                 = "/sro.chat.ChatService/AcceptFriendRequest"
+	ChatService_DeclineFriendRequest_FullMethodName                = "/sro.chat.ChatService/DeclineFriendRequest"
+	ChatService_RemoveFriend_FullMethodName                        = "/sro.chat.ChatService/RemoveFriend"
+	ChatService_GetBlockedUsers_FullMethodName                     = "/sro.chat.ChatService/GetBlockedUsers"
+	ChatService_BlockUser_FullMethodName                           = "/sro.chat.ChatService/BlockUser"
+	ChatService_UnblockUser_FullMethodName                         = "/sro.chat.ChatService/UnblockUser"
 )
 
 // ChatServiceClient is the client API for ChatService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type ChatServiceClient interface {
-	ConnectChannel(ctx context.Context, in *ChatChannelTarget, opts ...grpc.CallOption) (ChatService_ConnectChannelClient, error)
+	// Streams messages sent to the channel. If after_message_id is given, every
+	// message sent after it is streamed first so there is no gap after reading
+	// the channel history.
+	ConnectChannel(ctx context.Context, in *ConnectChannelRequest, opts ...grpc.CallOption) (ChatService_ConnectChannelClient, error)
 	ConnectDirectMessage(ctx context.Context, in *CharacterTarget, opts ...grpc.CallOption) (ChatService_ConnectDirectMessageClient, error)
 	SendChatMessage(ctx context.Context, in *SendChatMessageRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	SendDirectMessage(ctx context.Context, in *SendDirectMessageRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetChannelHistory(ctx context.Context, in *ChannelHistoryRequest, opts ...grpc.CallOption) (*ChatMessages, error)
+	GetDirectMessageHistory(ctx context.Context, in *DirectMessageHistoryRequest, opts ...grpc.CallOption) (*ChatMessages, error)
+	// Notifies the target that the character is typing a direct message to them.
+	// The event is sent through the target's direct message stream.
+	NotifyTyping(ctx context.Context, in *TypingRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Marks every direct message up to and including the message sent by the
+	// same character as read. A read receipt is sent through the sender's direct
+	// message stream.
+	MarkRead(ctx context.Context, in *MarkReadRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Gets the number of unread direct messages from each character
+	GetUnreadDirectMessages(ctx context.Context, in *CharacterTarget, opts ...grpc.CallOption) (*UnreadDirectMessages, error)
 	GetChannel(ctx context.Context, in *ChatChannelTarget, opts ...grpc.CallOption) (*ChatChannel, error)
 	AllChatChannels(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ChatChannels, error)
-	CreateChannel(ctx context.Context, in *CreateChannelMessage, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	CreateChannel(ctx context.Context, in *CreateChannelMessage, opts ...grpc.CallOption) (*ChatChannel, error)
 	DeleteChannel(ctx context.Context, in *ChatChannelTarget, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	EditChannel(ctx context.Context, in *UpdateChatChannelRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Gets the channels the character is a member of, either by joining or by
+	// being added by a chat manager
 	GetAuthorizedChatChannels(ctx context.Context, in *CharacterTarget, opts ...grpc.CallOption) (*ChatChannels, error)
 	UpdateUserChatChannelAuthorizations(ctx context.Context, in *RequestChatChannelAuthChange, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	SetUserChatChannelAuthorizations(ctx context.Context, in *RequestSetChannelAuth, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Joins a public channel in the character's dimension, or a private channel
+	// the character was invited to. System channels cannot be joined.
+	JoinChannel(ctx context.Context, in *ChannelMembershipRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	LeaveChannel(ctx context.Context, in *ChannelMembershipRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Invites a character to a private channel. The inviting character must be
+	// the owner or a moderator of the channel unless the requester is a chat
+	// manager.
+	InviteToChannel(ctx context.Context, in *ChannelInviteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetChannelMembers(ctx context.Context, in *ChatChannelTarget, opts ...grpc.CallOption) (*ChannelMembers, error)
+	// Mutes, kicks or bans a character from a channel. Requires chat management
+	// permissions or being a moderator of the channel. Kicks and bans remove the
+	// character's authorization for the channel and close active streams.
+	ModerateCharacter(ctx context.Context, in *ChatModerationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Ends any active mutes or bans of the given type for the character
+	RevokeModeration(ctx context.Context, in *RevokeChatModerationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetChannelModerations(ctx context.Context, in *ChatChannelTarget, opts ...grpc.CallOption) (*ChatModerations, error)
+	SetChannelModerator(ctx context.Context, in *SetChannelModeratorRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Moves the direct messages of a renamed character to its new name and
+	// notifies the characters it has conversations with. Called by the
+	// character service after a rename.
+	MigrateCharacterName(ctx context.Context, in *CharacterRename, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Deletes the direct message topic of a deleted character along with its
+	// undelivered direct messages. Called by the character service after a
+	// character is deleted.
+	DeleteCharacterChat(ctx context.Context, in *CharacterTarget, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetFriends(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*SocialUsers, error)
+	GetFriendRequests(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*FriendRequests, error)
+	// Sends a friend request to the owner of the target character. If the owner
+	// already sent a request to the requester, the friendship is accepted.
+	SendFriendRequest(ctx context.Context, in *CharacterTarget, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	AcceptFriendRequest(ctx context.Context, in *CharacterTarget, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DeclineFriendRequest(ctx context.Context, in *CharacterTarget, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	RemoveFriend(ctx context.Context, in *CharacterTarget, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetBlockedUsers(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*SocialUsers, error)
+	BlockUser(ctx context.Context, in *CharacterTarget, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	UnblockUser(ctx context.Context, in *CharacterTarget, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type chatServiceClient struct {
@@ -60,7 +136,7 @@ func NewChatServiceClient(cc grpc.ClientConnInterface) ChatServiceClient {
 	return &chatServiceClient{cc}
 }
 
-func (c *chatServiceClient) ConnectChannel(ctx context.Context, in *ChatChannelTarget, opts ...grpc.CallOption) (ChatService_ConnectChannelClient, error) {
+func (c *chatServiceClient) ConnectChannel(ctx context.Context, in *ConnectChannelRequest, opts ...grpc.CallOption) (ChatService_ConnectChannelClient, error) {
 	stream, err := c.cc.NewStream(ctx, &ChatService_ServiceDesc.Streams[0], ChatService_ConnectChannel_FullMethodName, opts...)
 	if err != nil {
 		return nil, err
@@ -142,6 +218,51 @@ func (c *chatServiceClient) SendDirectMessage(ctx context.Context, in *SendDirec
 	return out, nil
 }
 
+func (c *chatServiceClient) GetChannelHistory(ctx context.Context, in *ChannelHistoryRequest, opts ...grpc.CallOption) (*ChatMessages, error) {
+	out := new(ChatMessages)
+	err := c.cc.Invoke(ctx, ChatService_GetChannelHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) GetDirectMessageHistory(ctx context.Context, in *DirectMessageHistoryRequest, opts ...grpc.CallOption) (*ChatMessages, error) {
+	out := new(ChatMessages)
+	err := c.cc.Invoke(ctx, ChatService_GetDirectMessageHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) NotifyTyping(ctx context.Context, in *TypingRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ChatService_NotifyTyping_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) MarkRead(ctx context.Context, in *MarkReadRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ChatService_MarkRead_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) GetUnreadDirectMessages(ctx context.Context, in *CharacterTarget, opts ...grpc.CallOption) (*UnreadDirectMessages, error) {
+	out := new(UnreadDirectMessages)
+	err := c.cc.Invoke(ctx, ChatService_GetUnreadDirectMessages_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *chatServiceClient) GetChannel(ctx context.Context, in *ChatChannelTarget, opts ...grpc.CallOption) (*ChatChannel, error) {
 	out := new(ChatChannel)
 	err := c.cc.Invoke(ctx, ChatService_GetChannel_FullMethodName, in, out, opts...)
@@ -160,8 +281,8 @@ func (c *chatServiceClient) AllChatChannels(ctx context.Context, in *emptypb.Emp
 	return out, nil
 }
 
-func (c *chatServiceClient) CreateChannel(ctx context.Context, in *CreateChannelMessage, opts ...grpc.CallOption) (*emptypb.Empty, error) {
-	out := new(emptypb.Empty)
+func (c *chatServiceClient) CreateChannel(ctx context.Context, in *CreateChannelMessage, opts ...grpc.CallOption) (*ChatChannel, error) {
+	out := new(ChatChannel)
 	err := c.cc.Invoke(ctx, ChatService_CreateChannel_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
@@ -214,22 +335,245 @@ func (c *chatServiceClient) SetUserChatChannelAuthorizations(ctx context.Context
 	return out, nil
 }
 
+func (c *chatServiceClient) JoinChannel(ctx context.Context, in *ChannelMembershipRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ChatService_JoinChannel_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) LeaveChannel(ctx context.Context, in *ChannelMembershipRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ChatService_LeaveChannel_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) InviteToChannel(ctx context.Context, in *ChannelInviteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ChatService_InviteToChannel_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) GetChannelMembers(ctx context.Context, in *ChatChannelTarget, opts ...grpc.CallOption) (*ChannelMembers, error) {
+	out := new(ChannelMembers)
+	err := c.cc.Invoke(ctx, ChatService_GetChannelMembers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) ModerateCharacter(ctx context.Context, in *ChatModerationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ChatService_ModerateCharacter_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) RevokeModeration(ctx context.Context, in *RevokeChatModerationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ChatService_RevokeModeration_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) GetChannelModerations(ctx context.Context, in *ChatChannelTarget, opts ...grpc.CallOption) (*ChatModerations, error) {
+	out := new(ChatModerations)
+	err := c.cc.Invoke(ctx, ChatService_GetChannelModerations_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) SetChannelModerator(ctx context.Context, in *SetChannelModeratorRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ChatService_SetChannelModerator_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) MigrateCharacterName(ctx context.Context, in *CharacterRename, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ChatService_MigrateCharacterName_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) DeleteCharacterChat(ctx context.Context, in *CharacterTarget, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ChatService_DeleteCharacterChat_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) GetFriends(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*SocialUsers, error) {
+	out := new(SocialUsers)
+	err := c.cc.Invoke(ctx, ChatService_GetFriends_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) GetFriendRequests(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*FriendRequests, error) {
+	out := new(FriendRequests)
+	err := c.cc.Invoke(ctx, ChatService_GetFriendRequests_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) SendFriendRequest(ctx context.Context, in *CharacterTarget, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ChatService_SendFriendRequest_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) AcceptFriendRequest(ctx context.Context, in *CharacterTarget, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ChatService_AcceptFriendRequest_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) DeclineFriendRequest(ctx context.Context, in *CharacterTarget, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ChatService_DeclineFriendRequest_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) RemoveFriend(ctx context.Context, in *CharacterTarget, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ChatService_RemoveFriend_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) GetBlockedUsers(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*SocialUsers, error) {
+	out := new(SocialUsers)
+	err := c.cc.Invoke(ctx, ChatService_GetBlockedUsers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) BlockUser(ctx context.Context, in *CharacterTarget, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ChatService_BlockUser_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) UnblockUser(ctx context.Context, in *CharacterTarget, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ChatService_UnblockUser_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ChatServiceServer is the server API for ChatService service.
 // All implementations must embed UnimplementedChatServiceServer
 // for forward compatibility
 type ChatServiceServer interface {
-	ConnectChannel(*ChatChannelTarget, ChatService_ConnectChannelServer) error
+	// Streams messages sent to the channel. If after_message_id is given, every
+	// message sent after it is streamed first so there is no gap after reading
+	// the channel history.
+	ConnectChannel(*ConnectChannelRequest, ChatService_ConnectChannelServer) error
 	ConnectDirectMessage(*CharacterTarget, ChatService_ConnectDirectMessageServer) error
 	SendChatMessage(context.Context, *SendChatMessageRequest) (*emptypb.Empty, error)
 	SendDirectMessage(context.Context, *SendDirectMessageRequest) (*emptypb.Empty, error)
+	GetChannelHistory(context.Context, *ChannelHistoryRequest) (*ChatMessages, error)
+	GetDirectMessageHistory(context.Context, *DirectMessageHistoryRequest) (*ChatMessages, error)
+	// Notifies the target that the character is typing a direct message to them.
+	// The event is sent through the target's direct message stream.
+	NotifyTyping(context.Context, *TypingRequest) (*emptypb.Empty, error)
+	// Marks every direct message up to and including the message sent by the
+	// same character as read. A read receipt is sent through the sender's direct
+	// message stream.
+	MarkRead(context.Context, *MarkReadRequest) (*emptypb.Empty, error)
+	// Gets the number of unread direct messages from each character
+	GetUnreadDirectMessages(context.Context, *CharacterTarget) (*UnreadDirectMessages, error)
 	GetChannel(context.Context, *ChatChannelTarget) (*ChatChannel, error)
 	AllChatChannels(context.Context, *emptypb.Empty) (*ChatChannels, error)
-	CreateChannel(context.Context, *CreateChannelMessage) (*emptypb.Empty, error)
+	CreateChannel(context.Context, *CreateChannelMessage) (*ChatChannel, error)
 	DeleteChannel(context.Context, *ChatChannelTarget) (*emptypb.Empty, error)
 	EditChannel(context.Context, *UpdateChatChannelRequest) (*emptypb.Empty, error)
+	// Gets the channels the character is a member of, either by joining or by
+	// being added by a chat manager
 	GetAuthorizedChatChannels(context.Context, *CharacterTarget) (*ChatChannels, error)
 	UpdateUserChatChannelAuthorizations(context.Context, *RequestChatChannelAuthChange) (*emptypb.Empty, error)
 	SetUserChatChannelAuthorizations(context.Context, *RequestSetChannelAuth) (*emptypb.Empty, error)
+	// Joins a public channel in the character's dimension, or a private channel
+	// the character was invited to. System channels cannot be joined.
+	JoinChannel(context.Context, *ChannelMembershipRequest) (*emptypb.Empty, error)
+	LeaveChannel(context.Context, *ChannelMembershipRequest) (*emptypb.Empty, error)
+	// Invites a character to a private channel. The inviting character must be
+	// the owner or a moderator of the channel unless the requester is a chat
+	// manager.
+	InviteToChannel(context.Context, *ChannelInviteRequest) (*emptypb.Empty, error)
+	GetChannelMembers(context.Context, *ChatChannelTarget) (*ChannelMembers, error)
+	// Mutes, kicks or bans a character from a channel. Requires chat management
+	// permissions or being a moderator of the channel. Kicks and bans remove the
+	// character's authorization for the channel and close active streams.
+	ModerateCharacter(context.Context, *ChatModerationRequest) (*emptypb.Empty, error)
+	// Ends any active mutes or bans of the given type for the character
+	RevokeModeration(context.Context, *RevokeChatModerationRequest) (*emptypb.Empty, error)
+	GetChannelModerations(context.Context, *ChatChannelTarget) (*ChatModerations, error)
+	SetChannelModerator(context.Context, *SetChannelModeratorRequest) (*emptypb.Empty, error)
+	// Moves the direct messages of a renamed character to its new name and
+	// notifies the characters it has conversations with. Called by the
+	// character service after a rename.
+	MigrateCharacterName(context.Context, *CharacterRename) (*emptypb.Empty, error)
+	// Deletes the direct message topic of a deleted character along with its
+	// undelivered direct messages. Called by the character service after a
+	// character is deleted.
+	DeleteCharacterChat(context.Context, *CharacterTarget) (*emptypb.Empty, error)
+	GetFriends(context.Context, *emptypb.Empty) (*SocialUsers, error)
+	GetFriendRequests(context.Context, *emptypb.Empty) (*FriendRequests, error)
+	// Sends a friend request to the owner of the target character. If the owner
+	// already sent a request to the requester, the friendship is accepted.
+	SendFriendRequest(context.Context, *CharacterTarget) (*emptypb.Empty, error)
+	AcceptFriendRequest(context.Context, *CharacterTarget) (*emptypb.Empty, error)
+	DeclineFriendRequest(context.Context, *CharacterTarget) (*emptypb.Empty, error)
+	RemoveFriend(context.Context, *CharacterTarget) (*emptypb.Empty, error)
+	GetBlockedUsers(context.Context, *emptypb.Empty) (*SocialUsers, error)
+	BlockUser(context.Context, *CharacterTarget) (*emptypb.Empty, error)
+	UnblockUser(context.Context, *CharacterTarget) (*emptypb.Empty, error)
 	mustEmbedUnimplementedChatServiceServer()
 }
 
@@ -237,7 +581,7 @@ type ChatServiceServer interface {
 type UnimplementedChatServiceServer struct {
 }
 
-func (UnimplementedChatServiceServer) ConnectChannel(*ChatChannelTarget, ChatService_ConnectChannelServer) error {
+func (UnimplementedChatServiceServer) ConnectChannel(*ConnectChannelRequest, ChatService_ConnectChannelServer) error {
 	return status.Errorf(codes.Unimplemented, "method ConnectChannel not implemented")
 }
 func (UnimplementedChatServiceServer) ConnectDirectMessage(*CharacterTarget, ChatService_ConnectDirectMessageServer) error {
@@ -249,13 +593,28 @@ func (UnimplementedChatServiceServer) SendChatMessage(context.Context, *SendChat
 func (UnimplementedChatServiceServer) SendDirectMessage(context.Context, *SendDirectMessageRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendDirectMessage not implemented")
 }
+func (UnimplementedChatServiceServer) GetChannelHistory(context.Context, *ChannelHistoryRequest) (*ChatMessages, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChannelHistory not implemented")
+}
+func (UnimplementedChatServiceServer) GetDirectMessageHistory(context.Context, *DirectMessageHistoryRequest) (*ChatMessages, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDirectMessageHistory not implemented")
+}
+func (UnimplementedChatServiceServer) NotifyTyping(context.Context, *TypingRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NotifyTyping not implemented")
+}
+func (UnimplementedChatServiceServer) MarkRead(context.Context, *MarkReadRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkRead not implemented")
+}
+func (UnimplementedChatServiceServer) GetUnreadDirectMessages(context.Context, *CharacterTarget) (*UnreadDirectMessages, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUnreadDirectMessages not implemented")
+}
 func (UnimplementedChatServiceServer) GetChannel(context.Context, *ChatChannelTarget) (*ChatChannel, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetChannel not implemented")
 }
 func (UnimplementedChatServiceServer) AllChatChannels(context.Context, *emptypb.Empty) (*ChatChannels, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AllChatChannels not implemented")
 }
-func (UnimplementedChatServiceServer) CreateChannel(context.Context, *CreateChannelMessage) (*emptypb.Empty, error) {
+func (UnimplementedChatServiceServer) CreateChannel(context.Context, *CreateChannelMessage) (*ChatChannel, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateChannel not implemented")
 }
 func (UnimplementedChatServiceServer) DeleteChannel(context.Context, *ChatChannelTarget) (*emptypb.Empty, error) {
@@ -273,6 +632,63 @@ func (UnimplementedChatServiceServer) UpdateUserChatChannelAuthorizations(contex
 func (UnimplementedChatServiceServer) SetUserChatChannelAuthorizations(context.Context, *RequestSetChannelAuth) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetUserChatChannelAuthorizations not implemented")
 }
+func (UnimplementedChatServiceServer) JoinChannel(context.Context, *ChannelMembershipRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JoinChannel not implemented")
+}
+func (UnimplementedChatServiceServer) LeaveChannel(context.Context, *ChannelMembershipRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeaveChannel not implemented")
+}
+func (UnimplementedChatServiceServer) InviteToChannel(context.Context, *ChannelInviteRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InviteToChannel not implemented")
+}
+func (UnimplementedChatServiceServer) GetChannelMembers(context.Context, *ChatChannelTarget) (*ChannelMembers, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChannelMembers not implemented")
+}
+func (UnimplementedChatServiceServer) ModerateCharacter(context.Context, *ChatModerationRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModerateCharacter not implemented")
+}
+func (UnimplementedChatServiceServer) RevokeModeration(context.Context, *RevokeChatModerationRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeModeration not implemented")
+}
+func (UnimplementedChatServiceServer) GetChannelModerations(context.Context, *ChatChannelTarget) (*ChatModerations, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChannelModerations not implemented")
+}
+func (UnimplementedChatServiceServer) SetChannelModerator(context.Context, *SetChannelModeratorRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetChannelModerator not implemented")
+}
+func (UnimplementedChatServiceServer) MigrateCharacterName(context.Context, *CharacterRename) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MigrateCharacterName not implemented")
+}
+func (UnimplementedChatServiceServer) DeleteCharacterChat(context.Context, *CharacterTarget) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCharacterChat not implemented")
+}
+func (UnimplementedChatServiceServer) GetFriends(context.Context, *emptypb.Empty) (*SocialUsers, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFriends not implemented")
+}
+func (UnimplementedChatServiceServer) GetFriendRequests(context.Context, *emptypb.Empty) (*FriendRequests, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFriendRequests not implemented")
+}
+func (UnimplementedChatServiceServer) SendFriendRequest(context.Context, *CharacterTarget) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendFriendRequest not implemented")
+}
+func (UnimplementedChatServiceServer) AcceptFriendRequest(context.Context, *CharacterTarget) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptFriendRequest not implemented")
+}
+func (UnimplementedChatServiceServer) DeclineFriendRequest(context.Context, *CharacterTarget) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeclineFriendRequest not implemented")
+}
+func (UnimplementedChatServiceServer) RemoveFriend(context.Context, *CharacterTarget) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveFriend not implemented")
+}
+func (UnimplementedChatServiceServer) GetBlockedUsers(context.Context, *emptypb.Empty) (*SocialUsers, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlockedUsers not implemented")
+}
+func (UnimplementedChatServiceServer) BlockUser(context.Context, *CharacterTarget) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockUser not implemented")
+}
+func (UnimplementedChatServiceServer) UnblockUser(context.Context, *CharacterTarget) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnblockUser not implemented")
+}
 func (UnimplementedChatServiceServer) mustEmbedUnimplementedChatServiceServer() {}
 
 // UnsafeChatServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -287,7 +703,7 @@ func RegisterChatServiceServer(s grpc.ServiceRegistrar, srv ChatServiceServer) {
 }
 
 func _ChatService_ConnectChannel_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(ChatChannelTarget)
+	m := new(ConnectChannelRequest)
 	if err := stream.RecvMsg(m); err != nil {
 		return err
 	}
@@ -364,6 +780,96 @@ func _ChatService_SendDirectMessage_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ChatService_GetChannelHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChannelHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).GetChannelHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_GetChannelHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).GetChannelHistory(ctx, req.(*ChannelHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_GetDirectMessageHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DirectMessageHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).GetDirectMessageHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_GetDirectMessageHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).GetDirectMessageHistory(ctx, req.(*DirectMessageHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_NotifyTyping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TypingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).NotifyTyping(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_NotifyTyping_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).NotifyTyping(ctx, req.(*TypingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_MarkRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkReadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).MarkRead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_MarkRead_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).MarkRead(ctx, req.(*MarkReadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_GetUnreadDirectMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CharacterTarget)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).GetUnreadDirectMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_GetUnreadDirectMessages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).GetUnreadDirectMessages(ctx, req.(*CharacterTarget))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ChatService_GetChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ChatChannelTarget)
 	if err := dec(in); err != nil {
@@ -508,10 +1014,352 @@ func _ChatService_SetUserChatChannelAuthorizations_Handler(srv interface{}, ctx
 	return interceptor(ctx, in, info, handler)
 }
 
-// ChatService_ServiceDesc is the grpc.ServiceDesc for ChatService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var ChatService_ServiceDesc = grpc.ServiceDesc{
+func _ChatService_JoinChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChannelMembershipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).JoinChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_JoinChannel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).JoinChannel(ctx, req.(*ChannelMembershipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_LeaveChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChannelMembershipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).LeaveChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_LeaveChannel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).LeaveChannel(ctx, req.(*ChannelMembershipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_InviteToChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChannelInviteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).InviteToChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_InviteToChannel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).InviteToChannel(ctx, req.(*ChannelInviteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_GetChannelMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChatChannelTarget)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).GetChannelMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_GetChannelMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).GetChannelMembers(ctx, req.(*ChatChannelTarget))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_ModerateCharacter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChatModerationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).ModerateCharacter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_ModerateCharacter_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).ModerateCharacter(ctx, req.(*ChatModerationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_RevokeModeration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeChatModerationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).RevokeModeration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_RevokeModeration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).RevokeModeration(ctx, req.(*RevokeChatModerationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_GetChannelModerations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChatChannelTarget)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).GetChannelModerations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_GetChannelModerations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).GetChannelModerations(ctx, req.(*ChatChannelTarget))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_SetChannelModerator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetChannelModeratorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).SetChannelModerator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_SetChannelModerator_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).SetChannelModerator(ctx, req.(*SetChannelModeratorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_MigrateCharacterName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CharacterRename)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).MigrateCharacterName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_MigrateCharacterName_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).MigrateCharacterName(ctx, req.(*CharacterRename))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_DeleteCharacterChat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CharacterTarget)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).DeleteCharacterChat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_DeleteCharacterChat_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).DeleteCharacterChat(ctx, req.(*CharacterTarget))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_GetFriends_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).GetFriends(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_GetFriends_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).GetFriends(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_GetFriendRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).GetFriendRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_GetFriendRequests_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).GetFriendRequests(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_SendFriendRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CharacterTarget)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).SendFriendRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_SendFriendRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).SendFriendRequest(ctx, req.(*CharacterTarget))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_AcceptFriendRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CharacterTarget)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).AcceptFriendRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_AcceptFriendRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).AcceptFriendRequest(ctx, req.(*CharacterTarget))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_DeclineFriendRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CharacterTarget)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).DeclineFriendRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_DeclineFriendRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).DeclineFriendRequest(ctx, req.(*CharacterTarget))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_RemoveFriend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CharacterTarget)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).RemoveFriend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_RemoveFriend_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).RemoveFriend(ctx, req.(*CharacterTarget))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_GetBlockedUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).GetBlockedUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_GetBlockedUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).GetBlockedUsers(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_BlockUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CharacterTarget)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).BlockUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_BlockUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).BlockUser(ctx, req.(*CharacterTarget))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_UnblockUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CharacterTarget)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).UnblockUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_UnblockUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).UnblockUser(ctx, req.(*CharacterTarget))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ChatService_ServiceDesc is the grpc.ServiceDesc for ChatService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ChatService_ServiceDesc = grpc.ServiceDesc{
 	ServiceName: "sro.chat.ChatService",
 	HandlerType: (*ChatServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
@@ -523,6 +1371,26 @@ var ChatService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SendDirectMessage",
 			Handler:    _ChatService_SendDirectMessage_Handler,
 		},
+		{
+			MethodName: "GetChannelHistory",
+			Handler:    _ChatService_GetChannelHistory_Handler,
+		},
+		{
+			MethodName: "GetDirectMessageHistory",
+			Handler:    _ChatService_GetDirectMessageHistory_Handler,
+		},
+		{
+			MethodName: "NotifyTyping",
+			Handler:    _ChatService_NotifyTyping_Handler,
+		},
+		{
+			MethodName: "MarkRead",
+			Handler:    _ChatService_MarkRead_Handler,
+		},
+		{
+			MethodName: "GetUnreadDirectMessages",
+			Handler:    _ChatService_GetUnreadDirectMessages_Handler,
+		},
 		{
 			MethodName: "GetChannel",
 			Handler:    _ChatService_GetChannel_Handler,
@@ -555,6 +1423,82 @@ var ChatService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetUserChatChannelAuthorizations",
 			Handler:    _ChatService_SetUserChatChannelAuthorizations_Handler,
 		},
+		{
+			MethodName: "JoinChannel",
+			Handler:    _ChatService_JoinChannel_Handler,
+		},
+		{
+			MethodName: "LeaveChannel",
+			Handler:    _ChatService_LeaveChannel_Handler,
+		},
+		{
+			MethodName: "InviteToChannel",
+			Handler:    _ChatService_InviteToChannel_Handler,
+		},
+		{
+			MethodName: "GetChannelMembers",
+			Handler:    _ChatService_GetChannelMembers_Handler,
+		},
+		{
+			MethodName: "ModerateCharacter",
+			Handler:    _ChatService_ModerateCharacter_Handler,
+		},
+		{
+			MethodName: "RevokeModeration",
+			Handler:    _ChatService_RevokeModeration_Handler,
+		},
+		{
+			MethodName: "GetChannelModerations",
+			Handler:    _ChatService_GetChannelModerations_Handler,
+		},
+		{
+			MethodName: "SetChannelModerator",
+			Handler:    _ChatService_SetChannelModerator_Handler,
+		},
+		{
+			MethodName: "MigrateCharacterName",
+			Handler:    _ChatService_MigrateCharacterName_Handler,
+		},
+		{
+			MethodName: "DeleteCharacterChat",
+			Handler:    _ChatService_DeleteCharacterChat_Handler,
+		},
+		{
+			MethodName: "GetFriends",
+			Handler:    _ChatService_GetFriends_Handler,
+		},
+		{
+			MethodName: "GetFriendRequests",
+			Handler:    _ChatService_GetFriendRequests_Handler,
+		},
+		{
+			MethodName: "SendFriendRequest",
+			Handler:    _ChatService_SendFriendRequest_Handler,
+		},
+		{
+			MethodName: "AcceptFriendRequest",
+			Handler:    _ChatService_AcceptFriendRequest_Handler,
+		},
+		{
+			MethodName: "DeclineFriendRequest",
+			Handler:    _ChatService_DeclineFriendRequest_Handler,
+		},
+		{
+			MethodName: "RemoveFriend",
+			Handler:    _ChatService_RemoveFriend_Handler,
+		},
+		{
+			MethodName: "GetBlockedUsers",
+			Handler:    _ChatService_GetBlockedUsers_Handler,
+		},
+		{
+			MethodName: "BlockUser",
+			Handler:    _ChatService_BlockUser_Handler,
+		},
+		{
+			MethodName: "UnblockUser",
+			Handler:    _ChatService_UnblockUser_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -0,0 +1,145 @@
+package presence
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/ShatteredRealms/go-backend/pkg/model/gamebackend"
+)
+
+type memoryStore struct {
+	mu       sync.Mutex
+	presence map[uint]gamebackend.Presence
+	watchers map[*memoryWatcher]struct{}
+}
+
+// memoryWatcher collects the latest presence of the watched characters so slow watchers never block reporting and
+// always receive the most recent presence.
+type memoryWatcher struct {
+	characterIds map[uint]struct{}
+	mu           sync.Mutex
+	pending      map[uint]*gamebackend.Presence
+	notify       chan struct{}
+}
+
+// NewMemoryStore creates a store that keeps presence in memory. Presence is only shared within the process, so it
+// should only be used when running a single replica.
+func NewMemoryStore() Store {
+	return &memoryStore{
+		presence: make(map[uint]gamebackend.Presence),
+		watchers: make(map[*memoryWatcher]struct{}),
+	}
+}
+
+func (s *memoryStore) Set(_ context.Context, presence *gamebackend.Presence, ttl time.Duration) error {
+	refresh(presence, ttl)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.presence[presence.CharacterId] = *presence
+	for watcher := range s.watchers {
+		watcher.push(presence)
+	}
+
+	return nil
+}
+
+func (s *memoryStore) Get(_ context.Context, characterId uint) (*gamebackend.Presence, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.get(characterId), nil
+}
+
+func (s *memoryStore) Watch(ctx context.Context, characterIds []uint) (<-chan *gamebackend.Presence, error) {
+	watcher := &memoryWatcher{
+		characterIds: make(map[uint]struct{}, len(characterIds)),
+		pending:      make(map[uint]*gamebackend.Presence),
+		notify:       make(chan struct{}, 1),
+	}
+	for _, characterId := range characterIds {
+		watcher.characterIds[characterId] = struct{}{}
+	}
+
+	s.mu.Lock()
+	initial := make([]*gamebackend.Presence, 0, len(watcher.characterIds))
+	for characterId := range watcher.characterIds {
+		initial = append(initial, s.get(characterId))
+	}
+	s.watchers[watcher] = struct{}{}
+	s.mu.Unlock()
+
+	updates := make(chan *gamebackend.Presence)
+	go func() {
+		defer close(updates)
+		defer func() {
+			s.mu.Lock()
+			delete(s.watchers, watcher)
+			s.mu.Unlock()
+		}()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-watcher.notify:
+			}
+
+			for _, presence := range watcher.drain() {
+				select {
+				case updates <- presence:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return watch(ctx, initial, updates), nil
+}
+
+// get the presence of the character. The caller must hold the lock.
+func (s *memoryStore) get(characterId uint) *gamebackend.Presence {
+	presence, ok := s.presence[characterId]
+	if !ok {
+		return gamebackend.OfflinePresence(characterId)
+	}
+
+	if presence.IsExpired() {
+		delete(s.presence, characterId)
+		return gamebackend.OfflinePresence(characterId)
+	}
+
+	return &presence
+}
+
+func (w *memoryWatcher) push(presence *gamebackend.Presence) {
+	if _, ok := w.characterIds[presence.CharacterId]; !ok {
+		return
+	}
+
+	w.mu.Lock()
+	update := *presence
+	w.pending[presence.CharacterId] = &update
+	w.mu.Unlock()
+
+	select {
+	case w.notify <- struct{}{}:
+	default:
+	}
+}
+
+func (w *memoryWatcher) drain() []*gamebackend.Presence {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	out := make([]*gamebackend.Presence, 0, len(w.pending))
+	for _, presence := range w.pending {
+		out = append(out, presence)
+	}
+	clear(w.pending)
+
+	return out
+}
@@ -0,0 +1,112 @@
+package presence
+
+import (
+	"context"
+	"time"
+
+	"github.com/ShatteredRealms/go-backend/pkg/model/gamebackend"
+)
+
+// Store keeps the presence of characters. Presence expires after its TTL so characters whose sessions end without
+// reporting are treated as offline.
+type Store interface {
+	// Set reports the presence of the character. The presence expires after the ttl unless it is reported again.
+	Set(ctx context.Context, presence *gamebackend.Presence, ttl time.Duration) error
+
+	// Get the presence of the character. Characters without a presence are offline.
+	Get(ctx context.Context, characterId uint) (*gamebackend.Presence, error)
+
+	// Watch streams the current presence of each character followed by any changes. The channel is closed once the
+	// context is done.
+	Watch(ctx context.Context, characterIds []uint) (<-chan *gamebackend.Presence, error)
+}
+
+// refresh sets the report and expiration time of the presence
+func refresh(presence *gamebackend.Presence, ttl time.Duration) {
+	presence.UpdatedAt = time.Now()
+	presence.ExpiresAt = presence.UpdatedAt.Add(ttl)
+}
+
+// watch sends the initial presence followed by the updates. Characters are reported as offline once their presence
+// expires without being reported again. The returned channel is closed once the context is done or the updates
+// channel is closed.
+func watch(
+	ctx context.Context,
+	initial []*gamebackend.Presence,
+	updates <-chan *gamebackend.Presence,
+) <-chan *gamebackend.Presence {
+	out := make(chan *gamebackend.Presence)
+
+	go func() {
+		defer close(out)
+
+		expirations := make(map[uint]time.Time)
+		send := func(presence *gamebackend.Presence) bool {
+			if presence.IsOnline() && !presence.ExpiresAt.IsZero() {
+				expirations[presence.CharacterId] = presence.ExpiresAt
+			} else {
+				delete(expirations, presence.CharacterId)
+			}
+
+			select {
+			case out <- presence:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		}
+
+		for _, presence := range initial {
+			if !send(presence) {
+				return
+			}
+		}
+
+		for {
+			var expired <-chan time.Time
+			var timer *time.Timer
+			if next, ok := nextExpiration(expirations); ok {
+				timer = time.NewTimer(time.Until(next))
+				expired = timer.C
+			}
+
+			select {
+			case <-ctx.Done():
+				stopTimer(timer)
+				return
+
+			case presence, ok := <-updates:
+				stopTimer(timer)
+				if !ok || !send(presence) {
+					return
+				}
+
+			case now := <-expired:
+				for characterId, expiresAt := range expirations {
+					if !now.Before(expiresAt) && !send(gamebackend.OfflinePresence(characterId)) {
+						return
+					}
+				}
+			}
+		}
+	}()
+
+	return out
+}
+
+func nextExpiration(expirations map[uint]time.Time) (next time.Time, ok bool) {
+	for _, expiresAt := range expirations {
+		if !ok || expiresAt.Before(next) {
+			next = expiresAt
+			ok = true
+		}
+	}
+
+	return next, ok
+}
+
+func stopTimer(timer *time.Timer) {
+	if timer != nil {
+		timer.Stop()
+	}
+}
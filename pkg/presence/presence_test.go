@@ -0,0 +1,150 @@
+package presence_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/ShatteredRealms/go-backend/pkg/config"
+	"github.com/ShatteredRealms/go-backend/pkg/model/gamebackend"
+	"github.com/ShatteredRealms/go-backend/pkg/pb"
+	"github.com/ShatteredRealms/go-backend/pkg/presence"
+	"github.com/ShatteredRealms/go-backend/pkg/repository"
+	testdb "github.com/ShatteredRealms/go-backend/test/db"
+)
+
+var nextCharacterId = uint(1)
+
+// storeSpecs specs that every store implementation should pass
+func storeSpecs(newStore func() presence.Store) {
+	var (
+		store       presence.Store
+		characterId uint
+		ctx         context.Context
+		cancel      context.CancelFunc
+	)
+
+	BeforeEach(func() {
+		store = newStore()
+		characterId = nextCharacterId
+		nextCharacterId++
+		ctx, cancel = context.WithCancel(context.Background())
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	online := func(characterId uint) *gamebackend.Presence {
+		return &gamebackend.Presence{
+			CharacterId: characterId,
+			Status:      pb.PresenceStatus_ONLINE,
+			Dimension:   "dimension",
+			Map:         "map",
+		}
+	}
+
+	It("should be offline without a presence", func() {
+		out, err := store.Get(ctx, characterId)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out.Status).To(Equal(pb.PresenceStatus_OFFLINE))
+		Expect(out.IsOnline()).To(BeFalse())
+	})
+
+	It("should get the reported presence", func() {
+		Expect(store.Set(ctx, online(characterId), time.Minute)).To(Succeed())
+		out, err := store.Get(ctx, characterId)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out.Status).To(Equal(pb.PresenceStatus_ONLINE))
+		Expect(out.Dimension).To(Equal("dimension"))
+		Expect(out.Map).To(Equal("map"))
+		Expect(out.IsOnline()).To(BeTrue())
+	})
+
+	It("should expire presence that is not reported again", func() {
+		Expect(store.Set(ctx, online(characterId), time.Second)).To(Succeed())
+		Eventually(func(g Gomega) pb.PresenceStatus {
+			out, err := store.Get(ctx, characterId)
+			g.Expect(err).NotTo(HaveOccurred())
+			return out.Status
+		}).Within(3 * time.Second).Should(Equal(pb.PresenceStatus_OFFLINE))
+	})
+
+	It("should watch the current presence and changes", func() {
+		Expect(store.Set(ctx, online(characterId), time.Minute)).To(Succeed())
+		updates, err := store.Watch(ctx, []uint{characterId})
+		Expect(err).NotTo(HaveOccurred())
+
+		var out *gamebackend.Presence
+		Eventually(updates).Should(Receive(&out))
+		Expect(out.Status).To(Equal(pb.PresenceStatus_ONLINE))
+
+		away := online(characterId)
+		away.Status = pb.PresenceStatus_AWAY
+		Expect(store.Set(ctx, away, time.Minute)).To(Succeed())
+		Eventually(updates).Should(Receive(&out))
+		Expect(out.Status).To(Equal(pb.PresenceStatus_AWAY))
+
+		cancel()
+		Eventually(updates).Should(BeClosed())
+	})
+
+	It("should watch presence expire", func() {
+		updates, err := store.Watch(ctx, []uint{characterId})
+		Expect(err).NotTo(HaveOccurred())
+
+		var out *gamebackend.Presence
+		Eventually(updates).Should(Receive(&out))
+		Expect(out.Status).To(Equal(pb.PresenceStatus_OFFLINE))
+
+		Expect(store.Set(ctx, online(characterId), time.Second)).To(Succeed())
+		Eventually(updates).Should(Receive(&out))
+		Expect(out.Status).To(Equal(pb.PresenceStatus_ONLINE))
+
+		Eventually(updates).Within(3 * time.Second).Should(Receive(&out))
+		Expect(out.Status).To(Equal(pb.PresenceStatus_OFFLINE))
+	})
+
+	It("should not watch other characters", func() {
+		updates, err := store.Watch(ctx, []uint{characterId})
+		Expect(err).NotTo(HaveOccurred())
+		Eventually(updates).Should(Receive())
+
+		Expect(store.Set(ctx, online(characterId+1000), time.Minute)).To(Succeed())
+		Consistently(updates).ShouldNot(Receive())
+	})
+}
+
+var _ = Describe("Store", func() {
+	Describe("Memory", func() {
+		storeSpecs(presence.NewMemoryStore)
+	})
+
+	Describe("Redis", Ordered, func() {
+		var (
+			cleanupFunc func()
+			store       presence.Store
+		)
+
+		BeforeAll(func() {
+			var redisConf config.DBPoolConfig
+			cleanupFunc, redisConf = testdb.SetupRedisWithDocker()
+			rdb, err := repository.ConnectRedis(context.Background(), redisConf)
+			Expect(err).NotTo(HaveOccurred())
+			Eventually(func() error {
+				return rdb.Ping(context.Background()).Err()
+			}).Within(time.Minute).Should(Succeed())
+			store = presence.NewRedisStore(rdb)
+		})
+
+		AfterAll(func() {
+			cleanupFunc()
+		})
+
+		storeSpecs(func() presence.Store {
+			return store
+		})
+	})
+})
@@ -0,0 +1,132 @@
+package presence
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ShatteredRealms/go-backend/pkg/log"
+	"github.com/ShatteredRealms/go-backend/pkg/model/gamebackend"
+	"github.com/redis/go-redis/v9"
+)
+
+type redisStore struct {
+	rdb redis.UniversalClient
+}
+
+// NewRedisStore creates a store that keeps presence in redis so it is shared between replicas. Presence keys expire
+// with their TTL and changes are published to a channel for each character.
+func NewRedisStore(rdb redis.UniversalClient) Store {
+	return &redisStore{
+		rdb: rdb,
+	}
+}
+
+func (s *redisStore) Set(ctx context.Context, presence *gamebackend.Presence, ttl time.Duration) error {
+	refresh(presence, ttl)
+
+	data, err := json.Marshal(presence)
+	if err != nil {
+		return fmt.Errorf("encode presence: %w", err)
+	}
+
+	err = s.rdb.Set(ctx, presenceKey(presence.CharacterId), data, ttl).Err()
+	if err != nil {
+		return fmt.Errorf("set presence: %w", err)
+	}
+
+	err = s.rdb.Publish(ctx, presenceChannel(presence.CharacterId), data).Err()
+	if err != nil {
+		return fmt.Errorf("publish presence: %w", err)
+	}
+
+	return nil
+}
+
+func (s *redisStore) Get(ctx context.Context, characterId uint) (*gamebackend.Presence, error) {
+	data, err := s.rdb.Get(ctx, presenceKey(characterId)).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return gamebackend.OfflinePresence(characterId), nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get presence: %w", err)
+	}
+
+	presence := &gamebackend.Presence{}
+	if err := json.Unmarshal(data, presence); err != nil {
+		return nil, fmt.Errorf("decode presence: %w", err)
+	}
+
+	if presence.IsExpired() {
+		return gamebackend.OfflinePresence(characterId), nil
+	}
+
+	return presence, nil
+}
+
+func (s *redisStore) Watch(ctx context.Context, characterIds []uint) (<-chan *gamebackend.Presence, error) {
+	channels := make([]string, len(characterIds))
+	for idx, characterId := range characterIds {
+		channels[idx] = presenceChannel(characterId)
+	}
+
+	// Subscribe before reading the current presence so no changes are missed in between
+	sub := s.rdb.Subscribe(ctx, channels...)
+	if _, err := sub.Receive(ctx); err != nil {
+		_ = sub.Close()
+		return nil, fmt.Errorf("subscribe presence: %w", err)
+	}
+
+	initial := make([]*gamebackend.Presence, len(characterIds))
+	for idx, characterId := range characterIds {
+		presence, err := s.Get(ctx, characterId)
+		if err != nil {
+			_ = sub.Close()
+			return nil, err
+		}
+		initial[idx] = presence
+	}
+
+	updates := make(chan *gamebackend.Presence)
+	go func() {
+		defer close(updates)
+		defer sub.Close()
+
+		messages := sub.Channel()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+
+			case msg, ok := <-messages:
+				if !ok {
+					return
+				}
+
+				presence := &gamebackend.Presence{}
+				if err := json.Unmarshal([]byte(msg.Payload), presence); err != nil {
+					log.Logger.WithContext(ctx).Errorf("decode presence: %v", err)
+					continue
+				}
+
+				select {
+				case updates <- presence:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return watch(ctx, initial, updates), nil
+}
+
+func presenceKey(characterId uint) string {
+	return fmt.Sprintf("presence:%d", characterId)
+}
+
+func presenceChannel(characterId uint) string {
+	return fmt.Sprintf("presence:updates:%d", characterId)
+}
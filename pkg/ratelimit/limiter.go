@@ -0,0 +1,37 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ShatteredRealms/go-backend/pkg/config"
+)
+
+// Limiter token bucket rate limiter. Each key has its own bucket that holds up to the burst number of tokens and is
+// refilled at the given rate.
+type Limiter interface {
+	// Allow takes a token from the bucket for the key. If the bucket is empty, false is returned along with the time
+	// until the next token is available. Disabled limits always allow.
+	Allow(ctx context.Context, key string, limit config.RateLimit) (bool, time.Duration, error)
+}
+
+// Error returned when a rate limit is exceeded
+type Error struct {
+	// RetryAfter time until the request can be retried
+	RetryAfter time.Duration
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("rate limit exceeded, retry after %s", e.RetryAfter.Round(time.Millisecond))
+}
+
+// enabled checks if the limit should be enforced
+func enabled(limit config.RateLimit) bool {
+	return limit.Rate > 0 && limit.Burst > 0
+}
+
+// refillTime time it takes for an empty bucket to be full
+func refillTime(limit config.RateLimit) time.Duration {
+	return time.Duration(float64(limit.Burst) / limit.Rate * float64(time.Second))
+}
@@ -0,0 +1,107 @@
+package ratelimit_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/bxcodec/faker/v4"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/ShatteredRealms/go-backend/pkg/config"
+	"github.com/ShatteredRealms/go-backend/pkg/ratelimit"
+	"github.com/ShatteredRealms/go-backend/pkg/repository"
+	testdb "github.com/ShatteredRealms/go-backend/test/db"
+)
+
+// limiterSpecs specs that every limiter implementation should pass
+func limiterSpecs(newLimiter func() ratelimit.Limiter) {
+	var (
+		limiter ratelimit.Limiter
+		key     string
+	)
+
+	BeforeEach(func() {
+		limiter = newLimiter()
+		key = faker.UUIDHyphenated()
+	})
+
+	It("should allow up to the burst", func() {
+		limit := config.RateLimit{Rate: 0.01, Burst: 3}
+		for i := 0; i < limit.Burst; i++ {
+			allowed, _, err := limiter.Allow(context.Background(), key, limit)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(allowed).To(BeTrue())
+		}
+
+		allowed, wait, err := limiter.Allow(context.Background(), key, limit)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(allowed).To(BeFalse())
+		Expect(wait).To(BeNumerically("~", 100*time.Second, time.Second))
+	})
+
+	It("should refill at the rate", func() {
+		limit := config.RateLimit{Rate: 10, Burst: 1}
+		Expect(limiter.Allow(context.Background(), key, limit)).To(BeTrue())
+		allowed, _, err := limiter.Allow(context.Background(), key, limit)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(allowed).To(BeFalse())
+
+		Eventually(func(g Gomega) bool {
+			allowed, _, err := limiter.Allow(context.Background(), key, limit)
+			g.Expect(err).NotTo(HaveOccurred())
+			return allowed
+		}).Within(time.Second).Should(BeTrue())
+	})
+
+	It("should track keys separately", func() {
+		limit := config.RateLimit{Rate: 0.01, Burst: 1}
+		Expect(limiter.Allow(context.Background(), key, limit)).To(BeTrue())
+		Expect(limiter.Allow(context.Background(), faker.UUIDHyphenated(), limit)).To(BeTrue())
+	})
+
+	It("should always allow disabled limits", func() {
+		for i := 0; i < 10; i++ {
+			Expect(limiter.Allow(context.Background(), key, config.RateLimit{})).To(BeTrue())
+		}
+	})
+}
+
+var _ = Describe("Limiter", func() {
+	Describe("Memory", func() {
+		limiterSpecs(ratelimit.NewMemoryLimiter)
+	})
+
+	Describe("Redis", Ordered, func() {
+		var (
+			cleanupFunc func()
+			limiter     ratelimit.Limiter
+		)
+
+		BeforeAll(func() {
+			var redisConf config.DBPoolConfig
+			cleanupFunc, redisConf = testdb.SetupRedisWithDocker()
+			rdb, err := repository.ConnectRedis(context.Background(), redisConf)
+			Expect(err).NotTo(HaveOccurred())
+			Eventually(func() error {
+				return rdb.Ping(context.Background()).Err()
+			}).Within(time.Minute).Should(Succeed())
+			limiter = ratelimit.NewRedisLimiter(rdb)
+		})
+
+		AfterAll(func() {
+			cleanupFunc()
+		})
+
+		limiterSpecs(func() ratelimit.Limiter {
+			return limiter
+		})
+	})
+
+	Describe("Error", func() {
+		It("should include the retry time", func() {
+			err := &ratelimit.Error{RetryAfter: 1500 * time.Millisecond}
+			Expect(err.Error()).To(ContainSubstring("1.5s"))
+		})
+	})
+})
@@ -0,0 +1,75 @@
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/ShatteredRealms/go-backend/pkg/config"
+)
+
+const memoryPruneInterval = time.Minute
+
+type memoryLimiter struct {
+	mu        sync.Mutex
+	buckets   map[string]*bucket
+	lastPrune time.Time
+}
+
+type bucket struct {
+	tokens  float64
+	updated time.Time
+	full    time.Duration
+}
+
+// NewMemoryLimiter creates a limiter that keeps buckets in memory. Limits are only enforced per process, so it
+// should only be used when running a single replica.
+func NewMemoryLimiter() Limiter {
+	return &memoryLimiter{
+		buckets: make(map[string]*bucket),
+	}
+}
+
+func (l *memoryLimiter) Allow(_ context.Context, key string, limit config.RateLimit) (bool, time.Duration, error) {
+	if !enabled(limit) {
+		return true, 0, nil
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	l.prune(now)
+
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &bucket{tokens: float64(limit.Burst), updated: now}
+		l.buckets[key] = b
+	}
+	b.full = refillTime(limit)
+	b.tokens = math.Min(float64(limit.Burst), b.tokens+now.Sub(b.updated).Seconds()*limit.Rate)
+	b.updated = now
+
+	if b.tokens < 1 {
+		wait := time.Duration((1 - b.tokens) / limit.Rate * float64(time.Second))
+		return false, wait, nil
+	}
+
+	b.tokens--
+	return true, 0, nil
+}
+
+// prune removes buckets that would be full by now since they are the same as new buckets
+func (l *memoryLimiter) prune(now time.Time) {
+	if now.Sub(l.lastPrune) < memoryPruneInterval {
+		return
+	}
+
+	for key, b := range l.buckets {
+		if now.Sub(b.updated) > b.full {
+			delete(l.buckets, key)
+		}
+	}
+	l.lastPrune = now
+}
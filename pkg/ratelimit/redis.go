@@ -0,0 +1,80 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ShatteredRealms/go-backend/pkg/config"
+	"github.com/redis/go-redis/v9"
+)
+
+// tokenBucketScript atomically refills and takes a token from the bucket stored in a hash. Returns whether the token
+// was taken and the milliseconds until the next token is available.
+var tokenBucketScript = redis.NewScript(`
+local rate = tonumber(ARGV[1])
+local burst = tonumber(ARGV[2])
+local now = tonumber(ARGV[3])
+local ttl = tonumber(ARGV[4])
+
+local bucket = redis.call("HMGET", KEYS[1], "tokens", "updated")
+local tokens = tonumber(bucket[1])
+local updated = tonumber(bucket[2])
+if tokens == nil or updated == nil then
+	tokens = burst
+	updated = now
+end
+
+tokens = math.min(burst, tokens + math.max(0, now - updated) / 1000 * rate)
+
+local allowed = 0
+local wait = 0
+if tokens >= 1 then
+	tokens = tokens - 1
+	allowed = 1
+else
+	wait = math.ceil((1 - tokens) / rate * 1000)
+end
+
+redis.call("HSET", KEYS[1], "tokens", tostring(tokens), "updated", now)
+redis.call("PEXPIRE", KEYS[1], ttl)
+return {allowed, wait}
+`)
+
+type redisLimiter struct {
+	rdb redis.Scripter
+}
+
+// NewRedisLimiter creates a limiter that keeps buckets in redis so limits are shared between replicas
+func NewRedisLimiter(rdb redis.Scripter) Limiter {
+	return &redisLimiter{
+		rdb: rdb,
+	}
+}
+
+func (l *redisLimiter) Allow(ctx context.Context, key string, limit config.RateLimit) (bool, time.Duration, error) {
+	if !enabled(limit) {
+		return true, 0, nil
+	}
+
+	// Buckets expire once they would be full again since they are the same as new buckets
+	ttl := refillTime(limit) + time.Second
+	res, err := tokenBucketScript.Run(
+		ctx,
+		l.rdb,
+		[]string{key},
+		limit.Rate,
+		limit.Burst,
+		time.Now().UnixMilli(),
+		ttl.Milliseconds(),
+	).Int64Slice()
+	if err != nil {
+		return false, 0, fmt.Errorf("token bucket: %w", err)
+	}
+
+	if len(res) != 2 {
+		return false, 0, fmt.Errorf("token bucket: unexpected result %v", res)
+	}
+
+	return res[0] == 1, time.Duration(res[1]) * time.Millisecond, nil
+}
@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/ShatteredRealms/go-backend/pkg/log"
 	"github.com/ShatteredRealms/go-backend/pkg/model/character"
@@ -11,6 +12,7 @@ import (
 	"go.opentelemetry.io/otel/metric"
 	"go.opentelemetry.io/otel/trace"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 var (
@@ -25,12 +27,38 @@ type CharacterRepository interface {
 	Delete(ctx context.Context, char *character.Character) error
 
 	FindById(ctx context.Context, id uint) (*character.Character, error)
+
+	// FindByIdForUpdate gets the character and locks it until the transaction ends, so concurrent changes to it are
+	// not lost. It must be called on the repository given by WithTransaction.
+	FindByIdForUpdate(ctx context.Context, id uint) (*character.Character, error)
 	FindByName(ctx context.Context, name string) (*character.Character, error)
 
 	FindAllByOwner(ctx context.Context, owner string) (character.Characters, error)
 
 	FindAll(context.Context) ([]*character.Character, error)
 
+	// FindExtraSlots gets the character slots granted to the owner on top of the default maximum
+	FindExtraSlots(ctx context.Context, owner string) (uint32, error)
+
+	// AddExtraSlots grants the owner more character slots and returns its extra slots
+	AddExtraSlots(ctx context.Context, owner string, slots uint32) (uint32, error)
+
+	// Rename saves the new name of the character and records the change in its name history
+	Rename(ctx context.Context, char *character.Character, change *character.NameChange) (*character.Character, error)
+
+	// FindNameReservation gets the newest name change that still reserves the name, or nil if it is not reserved
+	FindNameReservation(ctx context.Context, name string) (*character.NameChange, error)
+
+	// FindNameHistory gets the name changes of the character, oldest first
+	FindNameHistory(ctx context.Context, characterId uint) (character.NameChanges, error)
+
+	// WithTransaction runs fn in a transaction. The repository given to fn uses the transaction.
+	WithTransaction(ctx context.Context, fn func(repo CharacterRepository) error) error
+
+	// WithSlotLock runs fn in a transaction that holds a lock on the character slots of the owner, so concurrent
+	// creations and moves cannot take more slots than the owner has. The repository given to fn uses the transaction.
+	WithSlotLock(ctx context.Context, owner string, fn func(repo CharacterRepository) error) error
+
 	WithTrx(trx *gorm.DB) CharacterRepository
 	Migrate(ctx context.Context) error
 }
@@ -130,6 +158,20 @@ func (r characterRepository) FindById(ctx context.Context, id uint) (*character.
 	return char, nil
 }
 
+func (r characterRepository) FindByIdForUpdate(ctx context.Context, id uint) (*character.Character, error) {
+	var char *character.Character
+	result := r.DB.WithContext(ctx).Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", id).Find(&char)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return nil, nil
+	}
+
+	return char, nil
+}
+
 func (r characterRepository) FindAll(ctx context.Context) ([]*character.Character, error) {
 	var chars []*character.Character
 	return chars, r.DB.WithContext(ctx).Find(&chars).Error
@@ -140,6 +182,118 @@ func (r characterRepository) FindAllByOwner(ctx context.Context, owner string) (
 	return chars, r.DB.WithContext(ctx).Where("owner_id = ?", owner).Find(&chars).Error
 }
 
+func (r characterRepository) FindExtraSlots(ctx context.Context, owner string) (uint32, error) {
+	var slots character.AccountSlots
+	result := r.DB.WithContext(ctx).Where("owner_id = ?", owner).Limit(1).Find(&slots)
+	return slots.ExtraSlots, result.Error
+}
+
+func (r characterRepository) AddExtraSlots(ctx context.Context, owner string, slots uint32) (uint32, error) {
+	err := r.DB.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "owner_id"}},
+		DoUpdates: clause.Set{
+			{
+				Column: clause.Column{Name: "extra_slots"},
+				Value:  gorm.Expr("account_slots.extra_slots + excluded.extra_slots"),
+			},
+			{
+				Column: clause.Column{Name: "updated_at"},
+				Value:  gorm.Expr("excluded.updated_at"),
+			},
+		},
+	}).Create(&character.AccountSlots{
+		OwnerId:    owner,
+		ExtraSlots: slots,
+	}).Error
+	if err != nil {
+		return 0, err
+	}
+
+	return r.FindExtraSlots(ctx, owner)
+}
+
+func (r characterRepository) Rename(
+	ctx context.Context,
+	char *character.Character,
+	change *character.NameChange,
+) (*character.Character, error) {
+	err := r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(char).Update("name", char.Name).Error
+		if err != nil {
+			return err
+		}
+
+		change.ID = 0
+		change.CharacterId = char.ID
+		return tx.Create(change).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(
+		srospan.TargetCharacterId(int(char.ID)),
+		srospan.TargetCharacterName(char.Name),
+	)
+	return char, nil
+}
+
+func (r characterRepository) FindNameReservation(ctx context.Context, name string) (*character.NameChange, error) {
+	var change *character.NameChange
+	result := r.DB.WithContext(ctx).
+		Where("old_name = ? AND reserved_until > ?", name, time.Now()).
+		Order("reserved_until DESC").
+		Limit(1).
+		Find(&change)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return nil, nil
+	}
+
+	return change, nil
+}
+
+func (r characterRepository) FindNameHistory(ctx context.Context, characterId uint) (character.NameChanges, error) {
+	var changes character.NameChanges
+	return changes, r.DB.WithContext(ctx).Where("character_id = ?", characterId).Order("id").Find(&changes).Error
+}
+
+func (r characterRepository) WithTransaction(ctx context.Context, fn func(repo CharacterRepository) error) error {
+	return r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return fn(r.WithTrx(tx))
+	})
+}
+
+func (r characterRepository) WithSlotLock(
+	ctx context.Context,
+	owner string,
+	fn func(repo CharacterRepository) error,
+) error {
+	return r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Owners without extra slots have no row yet, so create one to have something to lock
+		err := tx.Clauses(clause.OnConflict{DoNothing: true}).
+			Create(&character.AccountSlots{OwnerId: owner}).
+			Error
+		if err != nil {
+			return err
+		}
+
+		err = tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("owner_id = ?", owner).
+			First(&character.AccountSlots{}).
+			Error
+		if err != nil {
+			return err
+		}
+
+		return fn(r.WithTrx(tx))
+	})
+}
+
 func (r characterRepository) WithTrx(trx *gorm.DB) CharacterRepository {
 	if trx == nil {
 		return r
@@ -150,5 +304,5 @@ func (r characterRepository) WithTrx(trx *gorm.DB) CharacterRepository {
 }
 
 func (r characterRepository) Migrate(ctx context.Context) error {
-	return r.DB.WithContext(ctx).AutoMigrate(&character.Character{})
+	return r.DB.WithContext(ctx).AutoMigrate(&character.Character{}, &character.AccountSlots{}, &character.NameChange{})
 }
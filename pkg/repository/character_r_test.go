@@ -2,6 +2,7 @@ package repository_test
 
 import (
 	"context"
+	"sync"
 	"time"
 
 	"github.com/bxcodec/faker/v4"
@@ -11,10 +12,13 @@ import (
 
 	"github.com/ShatteredRealms/go-backend/pkg/model/character"
 	"github.com/ShatteredRealms/go-backend/pkg/model/game"
+	"github.com/ShatteredRealms/go-backend/pkg/repository"
 )
 
 var _ = Describe("Character repository", func() {
 	var (
+		creationRules = character.DefaultCreationRules()
+
 		createCharacter = func() *character.Character {
 			char := &character.Character{
 				CreatedAt: time.Now(),
@@ -39,7 +43,7 @@ var _ = Describe("Character repository", func() {
 			outCharacter, err := characterRepo.Create(nil, char)
 			Expect(err).To(BeNil())
 			Expect(outCharacter).NotTo(BeNil())
-			Expect(outCharacter.Validate()).To(Succeed())
+			Expect(outCharacter.Validate(&creationRules)).To(Succeed())
 			Expect(outCharacter.ID).To(BeEquivalentTo(char.ID))
 			char = outCharacter
 			hook.Reset()
@@ -107,7 +111,7 @@ var _ = Describe("Character repository", func() {
 				outCharacter, err := characterRepo.Create(nil, newCharacter)
 				Expect(err).To(BeNil())
 				Expect(outCharacter).NotTo(BeNil())
-				Expect(outCharacter.Validate()).To(Succeed())
+				Expect(outCharacter.Validate(&creationRules)).To(Succeed())
 			})
 		})
 
@@ -129,7 +133,7 @@ var _ = Describe("Character repository", func() {
 				outCharacter, err := characterRepo.Save(nil, character)
 				Expect(err).To(BeNil())
 				Expect(outCharacter).NotTo(BeNil())
-				Expect(outCharacter.Validate()).To(Succeed())
+				Expect(outCharacter.Validate(&creationRules)).To(Succeed())
 				Expect(outCharacter.Name).To(Equal(character.Name))
 			})
 		})
@@ -222,6 +226,45 @@ var _ = Describe("Character repository", func() {
 		})
 	})
 
+	Describe("FindByIdForUpdate", func() {
+		It("should return nil if not found", func() {
+			err := characterRepo.WithTransaction(context.Background(), func(repo repository.CharacterRepository) error {
+				out, err := repo.FindByIdForUpdate(context.Background(), 0)
+				Expect(out).To(BeNil())
+				return err
+			})
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should not lose concurrent updates", func() {
+			char := createCharacter()
+			var wg sync.WaitGroup
+			for range 5 {
+				wg.Add(1)
+				go func() {
+					defer GinkgoRecover()
+					defer wg.Done()
+					err := characterRepo.WithTransaction(context.Background(), func(repo repository.CharacterRepository) error {
+						locked, err := repo.FindByIdForUpdate(context.Background(), char.ID)
+						if err != nil {
+							return err
+						}
+
+						locked.PlayTime += 10
+						_, err = repo.Save(context.Background(), locked)
+						return err
+					})
+					Expect(err).NotTo(HaveOccurred())
+				}()
+			}
+			wg.Wait()
+
+			out, err := characterRepo.FindById(context.Background(), char.ID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.PlayTime).To(BeEquivalentTo(char.PlayTime + 50))
+		})
+	})
+
 	Describe("FindAll", func() {
 		findAll := (func(ctx context.Context) {
 			_, err := characterRepo.Create(ctx, &character.Character{
@@ -271,7 +314,7 @@ var _ = Describe("Character repository", func() {
 				outCharacter, err := characterRepo.Create(nil, newCharacter)
 				Expect(err).To(BeNil())
 				Expect(outCharacter).NotTo(BeNil())
-				Expect(outCharacter.Validate()).To(Succeed())
+				Expect(outCharacter.Validate(&creationRules)).To(Succeed())
 				all, err := characterRepo.FindAllByOwner(ctx, newCharacter.OwnerId)
 				Expect(err).NotTo(HaveOccurred())
 				Expect(all).To(HaveLen(1))
@@ -321,4 +364,103 @@ var _ = Describe("Character repository", func() {
 
 	AfterEach(func() {
 	})
+
+	Describe("ExtraSlots", func() {
+		It("should be 0 for accounts without extra slots", func() {
+			slots, err := characterRepo.FindExtraSlots(context.Background(), faker.Username())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(slots).To(BeZero())
+		})
+
+		It("should add to the extra slots", func() {
+			owner := faker.Username()
+			slots, err := characterRepo.AddExtraSlots(context.Background(), owner, 2)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(slots).To(BeEquivalentTo(2))
+
+			slots, err = characterRepo.AddExtraSlots(context.Background(), owner, 3)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(slots).To(BeEquivalentTo(5))
+
+			slots, err = characterRepo.FindExtraSlots(context.Background(), owner)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(slots).To(BeEquivalentTo(5))
+		})
+	})
+
+	Describe("WithSlotLock", func() {
+		It("should keep the extra slots of the owner", func() {
+			owner := faker.Username()
+			_, err := characterRepo.AddExtraSlots(context.Background(), owner, 2)
+			Expect(err).NotTo(HaveOccurred())
+
+			err = characterRepo.WithSlotLock(context.Background(), owner, func(repo repository.CharacterRepository) error {
+				slots, err := repo.FindExtraSlots(context.Background(), owner)
+				Expect(slots).To(BeEquivalentTo(2))
+				return err
+			})
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should roll back if fn fails", func() {
+			char := createCharacter()
+			err := characterRepo.WithSlotLock(context.Background(), char.OwnerId, func(repo repository.CharacterRepository) error {
+				char.PlayTime = 200
+				_, err := repo.Save(context.Background(), char)
+				Expect(err).NotTo(HaveOccurred())
+				return gorm.ErrInvalidData
+			})
+			Expect(err).To(MatchError(gorm.ErrInvalidData))
+
+			out, err := characterRepo.FindById(context.Background(), char.ID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.PlayTime).To(BeEquivalentTo(100))
+		})
+	})
+
+	Describe("Rename", func() {
+		It("should rename the character and record the change", func() {
+			char := createCharacter()
+			oldName := char.Name
+			char.Name = faker.Username()
+			out, err := characterRepo.Rename(context.Background(), char, &character.NameChange{
+				OldName:       oldName,
+				NewName:       char.Name,
+				ReservedUntil: time.Now().Add(time.Hour),
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.Name).To(Equal(char.Name))
+
+			found, err := characterRepo.FindById(context.Background(), char.ID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found.Name).To(Equal(char.Name))
+
+			history, err := characterRepo.FindNameHistory(context.Background(), char.ID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(history).To(HaveLen(1))
+			Expect(history[0].OldName).To(Equal(oldName))
+			Expect(history[0].NewName).To(Equal(char.Name))
+
+			reservation, err := characterRepo.FindNameReservation(context.Background(), oldName)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(reservation).NotTo(BeNil())
+			Expect(reservation.CharacterId).To(Equal(char.ID))
+		})
+
+		It("should not find expired reservations", func() {
+			char := createCharacter()
+			oldName := char.Name
+			char.Name = faker.Username()
+			_, err := characterRepo.Rename(context.Background(), char, &character.NameChange{
+				OldName:       oldName,
+				NewName:       char.Name,
+				ReservedUntil: time.Now().Add(-time.Hour),
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			reservation, err := characterRepo.FindNameReservation(context.Background(), oldName)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(reservation).To(BeNil())
+		})
+	})
 })
@@ -2,11 +2,15 @@ package repository
 
 import (
 	"context"
+	"slices"
+	"time"
 
 	"github.com/ShatteredRealms/go-backend/pkg/model/chat"
+	"github.com/ShatteredRealms/go-backend/pkg/pb"
 	"github.com/ShatteredRealms/go-backend/pkg/srospan"
 	"go.opentelemetry.io/otel/trace"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type ChatRepository interface {
@@ -22,6 +26,45 @@ type ChatRepository interface {
 	AuthorizedChannelsForCharacter(ctx context.Context, characterId uint) (chat.ChatChannels, error)
 	ChangeAuthorizationForCharacter(ctx context.Context, characterId uint, channelIds []uint, addAuth bool) error
 
+	FindFriends(ctx context.Context, userId string) (chat.UserFriends, error)
+	FindFriend(ctx context.Context, userId string, friendId string) (*chat.UserFriend, error)
+	AddFriend(ctx context.Context, userId string, friendId string) error
+	RemoveFriend(ctx context.Context, userId string, friendId string) error
+
+	FindFriendRequests(ctx context.Context, userId string) (chat.UserFriendRequests, error)
+	FindFriendRequest(ctx context.Context, senderId string, targetId string) (*chat.UserFriendRequest, error)
+	CreateFriendRequest(ctx context.Context, senderId string, targetId string) (*chat.UserFriendRequest, error)
+	DeleteFriendRequest(ctx context.Context, senderId string, targetId string) error
+
+	FindBlocks(ctx context.Context, userId string) (chat.UserBlocks, error)
+	FindBlock(ctx context.Context, userId string, blockedId string) (*chat.UserBlock, error)
+	Block(ctx context.Context, userId string, blockedId string) error
+	Unblock(ctx context.Context, userId string, blockedId string) error
+
+	FindChannelMembers(ctx context.Context, channelId uint) (chat.ChatChannelPermissions, error)
+	FindChannelMember(ctx context.Context, channelId uint, characterId uint) (*chat.ChatChannelPermission, error)
+
+	CreateChannelInvite(ctx context.Context, invite *chat.ChatChannelInvite) error
+	FindChannelInvite(ctx context.Context, channelId uint, characterId uint) (*chat.ChatChannelInvite, error)
+	DeleteChannelInvite(ctx context.Context, channelId uint, characterId uint) error
+
+	SetChannelModerator(ctx context.Context, channelId uint, characterId uint, moderator bool) error
+	IsChannelModerator(ctx context.Context, channelId uint, characterId uint) (bool, error)
+
+	CreateModeration(ctx context.Context, moderation *chat.ChatChannelModeration) (*chat.ChatChannelModeration, error)
+	FindActiveModerations(ctx context.Context, channelId uint) (chat.ChatChannelModerations, error)
+	FindActiveModeration(ctx context.Context, channelId uint, characterId uint, moderationType pb.ChatModerationType) (*chat.ChatChannelModeration, error)
+	ExpireModerations(ctx context.Context, channelId uint, characterId uint, moderationType pb.ChatModerationType) error
+
+	CreateMessage(ctx context.Context, message *chat.ChatMessage) (*chat.ChatMessage, error)
+	FindMessageById(ctx context.Context, id uint) (*chat.ChatMessage, error)
+	FindChannelMessages(ctx context.Context, channelId uint, cursor chat.HistoryCursor) (chat.ChatMessages, error)
+	FindDirectMessages(ctx context.Context, characterName string, otherCharacterName string, cursor chat.HistoryCursor) (chat.ChatMessages, error)
+	MarkDirectMessagesRead(ctx context.Context, characterName string, otherCharacterName string, messageId uint) error
+	FindUnreadDirectMessages(ctx context.Context, characterName string) (chat.UnreadConversations, error)
+	FindConversationPartners(ctx context.Context, characterName string) ([]string, error)
+	RenameDirectMessages(ctx context.Context, oldName string, newName string) error
+
 	Migrate(ctx context.Context) error
 }
 
@@ -97,7 +140,17 @@ func (r chatRepository) FullDeleteChannel(ctx context.Context, channel *chat.Cha
 }
 
 func (r chatRepository) Migrate(ctx context.Context) error {
-	return r.DB.WithContext(ctx).AutoMigrate(&chat.ChatChannel{}, &chat.ChatChannelPermission{})
+	return r.DB.WithContext(ctx).AutoMigrate(
+		&chat.ChatChannel{},
+		&chat.ChatChannelPermission{},
+		&chat.UserFriend{},
+		&chat.UserFriendRequest{},
+		&chat.UserBlock{},
+		&chat.ChatMessage{},
+		&chat.ChatChannelModeration{},
+		&chat.ChatChannelInvite{},
+		&chat.ChatDirectMessageRead{},
+	)
 }
 
 func (r chatRepository) FindChannelById(ctx context.Context, id uint) (*chat.ChatChannel, error) {
@@ -130,6 +183,521 @@ func (r chatRepository) FindDeletedWithName(ctx context.Context, name string) (*
 	return channel, nil
 }
 
+func (r chatRepository) FindFriends(ctx context.Context, userId string) (chat.UserFriends, error) {
+	var friends chat.UserFriends
+	trace.SpanFromContext(ctx).SetAttributes(srospan.SourceOwnerId(userId))
+	return friends, r.DB.WithContext(ctx).Where("owning_id = ?", userId).Find(&friends).Error
+}
+
+func (r chatRepository) FindFriend(ctx context.Context, userId string, friendId string) (*chat.UserFriend, error) {
+	var friend *chat.UserFriend
+	result := r.DB.WithContext(ctx).Where("owning_id = ? AND friend_id = ?", userId, friendId).Find(&friend)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return nil, nil
+	}
+
+	return friend, nil
+}
+
+// AddFriend creates the friendship in both directions and removes any pending
+// friend requests between the two users.
+func (r chatRepository) AddFriend(ctx context.Context, userId string, friendId string) error {
+	trace.SpanFromContext(ctx).SetAttributes(
+		srospan.SourceOwnerId(userId),
+		srospan.TargetOwnerId(friendId),
+	)
+
+	return r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Delete(
+			&chat.UserFriendRequest{},
+			"(sender_id = ? AND target_id = ?) OR (sender_id = ? AND target_id = ?)",
+			userId, friendId, friendId, userId,
+		).Error; err != nil {
+			return err
+		}
+
+		return tx.Create(&chat.UserFriends{
+			{OwningId: userId, FriendId: friendId},
+			{OwningId: friendId, FriendId: userId},
+		}).Error
+	})
+}
+
+// RemoveFriend removes the friendship in both directions
+func (r chatRepository) RemoveFriend(ctx context.Context, userId string, friendId string) error {
+	trace.SpanFromContext(ctx).SetAttributes(
+		srospan.SourceOwnerId(userId),
+		srospan.TargetOwnerId(friendId),
+	)
+
+	return r.DB.WithContext(ctx).Delete(
+		&chat.UserFriend{},
+		"(owning_id = ? AND friend_id = ?) OR (owning_id = ? AND friend_id = ?)",
+		userId, friendId, friendId, userId,
+	).Error
+}
+
+// FindFriendRequests finds all incoming and outgoing friend requests for the user
+func (r chatRepository) FindFriendRequests(ctx context.Context, userId string) (chat.UserFriendRequests, error) {
+	var requests chat.UserFriendRequests
+	trace.SpanFromContext(ctx).SetAttributes(srospan.SourceOwnerId(userId))
+	return requests, r.DB.WithContext(ctx).
+		Where("sender_id = ? OR target_id = ?", userId, userId).
+		Find(&requests).Error
+}
+
+func (r chatRepository) FindFriendRequest(ctx context.Context, senderId string, targetId string) (*chat.UserFriendRequest, error) {
+	var request *chat.UserFriendRequest
+	result := r.DB.WithContext(ctx).Where("sender_id = ? AND target_id = ?", senderId, targetId).Find(&request)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return nil, nil
+	}
+
+	return request, nil
+}
+
+func (r chatRepository) CreateFriendRequest(ctx context.Context, senderId string, targetId string) (*chat.UserFriendRequest, error) {
+	trace.SpanFromContext(ctx).SetAttributes(
+		srospan.SourceOwnerId(senderId),
+		srospan.TargetOwnerId(targetId),
+	)
+
+	request := &chat.UserFriendRequest{
+		SenderId: senderId,
+		TargetId: targetId,
+	}
+	return request, r.DB.WithContext(ctx).Create(request).Error
+}
+
+func (r chatRepository) DeleteFriendRequest(ctx context.Context, senderId string, targetId string) error {
+	trace.SpanFromContext(ctx).SetAttributes(
+		srospan.SourceOwnerId(senderId),
+		srospan.TargetOwnerId(targetId),
+	)
+
+	return r.DB.WithContext(ctx).Delete(&chat.UserFriendRequest{}, "sender_id = ? AND target_id = ?", senderId, targetId).Error
+}
+
+func (r chatRepository) FindBlocks(ctx context.Context, userId string) (chat.UserBlocks, error) {
+	var blocks chat.UserBlocks
+	trace.SpanFromContext(ctx).SetAttributes(srospan.SourceOwnerId(userId))
+	return blocks, r.DB.WithContext(ctx).Where("owning_id = ?", userId).Find(&blocks).Error
+}
+
+func (r chatRepository) FindBlock(ctx context.Context, userId string, blockedId string) (*chat.UserBlock, error) {
+	var block *chat.UserBlock
+	result := r.DB.WithContext(ctx).Where("owning_id = ? AND blocked_id = ?", userId, blockedId).Find(&block)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return nil, nil
+	}
+
+	return block, nil
+}
+
+// Block blocks the user and removes any friendship or pending friend requests
+// between the two users.
+func (r chatRepository) Block(ctx context.Context, userId string, blockedId string) error {
+	trace.SpanFromContext(ctx).SetAttributes(
+		srospan.SourceOwnerId(userId),
+		srospan.TargetOwnerId(blockedId),
+	)
+
+	return r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Delete(
+			&chat.UserFriend{},
+			"(owning_id = ? AND friend_id = ?) OR (owning_id = ? AND friend_id = ?)",
+			userId, blockedId, blockedId, userId,
+		).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Delete(
+			&chat.UserFriendRequest{},
+			"(sender_id = ? AND target_id = ?) OR (sender_id = ? AND target_id = ?)",
+			userId, blockedId, blockedId, userId,
+		).Error; err != nil {
+			return err
+		}
+
+		return tx.Create(&chat.UserBlock{
+			OwningId:  userId,
+			BlockedId: blockedId,
+		}).Error
+	})
+}
+
+func (r chatRepository) Unblock(ctx context.Context, userId string, blockedId string) error {
+	trace.SpanFromContext(ctx).SetAttributes(
+		srospan.SourceOwnerId(userId),
+		srospan.TargetOwnerId(blockedId),
+	)
+
+	return r.DB.WithContext(ctx).Delete(&chat.UserBlock{}, "owning_id = ? AND blocked_id = ?", userId, blockedId).Error
+}
+
+func (r chatRepository) FindChannelMembers(ctx context.Context, channelId uint) (chat.ChatChannelPermissions, error) {
+	trace.SpanFromContext(ctx).SetAttributes(srospan.ChatChannelId(int(channelId)))
+
+	var members chat.ChatChannelPermissions
+	return members, r.DB.WithContext(ctx).Where("channel_id = ?", channelId).Find(&members).Error
+}
+
+func (r chatRepository) FindChannelMember(ctx context.Context, channelId uint, characterId uint) (*chat.ChatChannelPermission, error) {
+	var member *chat.ChatChannelPermission
+	result := r.DB.WithContext(ctx).
+		Where("channel_id = ? AND character_id = ?", channelId, characterId).
+		Limit(1).
+		Find(&member)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return nil, nil
+	}
+
+	return member, nil
+}
+
+func (r chatRepository) CreateChannelInvite(ctx context.Context, invite *chat.ChatChannelInvite) error {
+	trace.SpanFromContext(ctx).SetAttributes(
+		srospan.ChatChannelId(int(invite.ChannelId)),
+		srospan.TargetCharacterId(int(invite.CharacterId)),
+	)
+
+	return r.DB.WithContext(ctx).Create(invite).Error
+}
+
+func (r chatRepository) FindChannelInvite(ctx context.Context, channelId uint, characterId uint) (*chat.ChatChannelInvite, error) {
+	var invite *chat.ChatChannelInvite
+	result := r.DB.WithContext(ctx).
+		Where("channel_id = ? AND character_id = ?", channelId, characterId).
+		Limit(1).
+		Find(&invite)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return nil, nil
+	}
+
+	return invite, nil
+}
+
+func (r chatRepository) DeleteChannelInvite(ctx context.Context, channelId uint, characterId uint) error {
+	return r.DB.WithContext(ctx).
+		Delete(&chat.ChatChannelInvite{}, "channel_id = ? AND character_id = ?", channelId, characterId).
+		Error
+}
+
+// SetChannelModerator changes whether the character is a moderator of the channel. The character must already be
+// authorized for the channel, otherwise gorm.ErrRecordNotFound is returned.
+func (r chatRepository) SetChannelModerator(ctx context.Context, channelId uint, characterId uint, moderator bool) error {
+	trace.SpanFromContext(ctx).SetAttributes(
+		srospan.ChatChannelId(int(channelId)),
+		srospan.TargetCharacterId(int(characterId)),
+	)
+
+	result := r.DB.WithContext(ctx).
+		Model(&chat.ChatChannelPermission{}).
+		Where("channel_id = ? AND character_id = ?", channelId, characterId).
+		Update("moderator", moderator)
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
+}
+
+func (r chatRepository) IsChannelModerator(ctx context.Context, channelId uint, characterId uint) (bool, error) {
+	var count int64
+	err := r.DB.WithContext(ctx).
+		Model(&chat.ChatChannelPermission{}).
+		Where("channel_id = ? AND character_id = ? AND moderator = ?", channelId, characterId, true).
+		Count(&count).Error
+
+	return count > 0, err
+}
+
+func (r chatRepository) CreateModeration(ctx context.Context, moderation *chat.ChatChannelModeration) (*chat.ChatChannelModeration, error) {
+	trace.SpanFromContext(ctx).SetAttributes(
+		srospan.ChatChannelId(int(moderation.ChannelId)),
+		srospan.TargetCharacterId(int(moderation.CharacterId)),
+	)
+
+	return moderation, r.DB.WithContext(ctx).Create(moderation).Error
+}
+
+// FindActiveModerations finds all moderation actions in the channel that have not expired
+func (r chatRepository) FindActiveModerations(ctx context.Context, channelId uint) (chat.ChatChannelModerations, error) {
+	trace.SpanFromContext(ctx).SetAttributes(srospan.ChatChannelId(int(channelId)))
+
+	var moderations chat.ChatChannelModerations
+	return moderations, r.DB.WithContext(ctx).
+		Where("channel_id = ?", channelId).
+		Where("expires_at IS NULL OR expires_at > ?", time.Now()).
+		Order("created_at desc").
+		Find(&moderations).Error
+}
+
+// FindActiveModeration finds the latest moderation action of the given type against the character in the channel
+// that has not expired
+func (r chatRepository) FindActiveModeration(
+	ctx context.Context,
+	channelId uint,
+	characterId uint,
+	moderationType pb.ChatModerationType,
+) (*chat.ChatChannelModeration, error) {
+	var moderation *chat.ChatChannelModeration
+	result := r.DB.WithContext(ctx).
+		Where("channel_id = ? AND character_id = ? AND type = ?", channelId, characterId, moderationType).
+		Where("expires_at IS NULL OR expires_at > ?", time.Now()).
+		Order("created_at desc").
+		Limit(1).
+		Find(&moderation)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return nil, nil
+	}
+
+	return moderation, nil
+}
+
+// ExpireModerations ends all active moderation actions of the given type against the character in the channel
+func (r chatRepository) ExpireModerations(
+	ctx context.Context,
+	channelId uint,
+	characterId uint,
+	moderationType pb.ChatModerationType,
+) error {
+	trace.SpanFromContext(ctx).SetAttributes(
+		srospan.ChatChannelId(int(channelId)),
+		srospan.TargetCharacterId(int(characterId)),
+	)
+
+	now := time.Now()
+	return r.DB.WithContext(ctx).
+		Model(&chat.ChatChannelModeration{}).
+		Where("channel_id = ? AND character_id = ? AND type = ?", channelId, characterId, moderationType).
+		Where("expires_at IS NULL OR expires_at > ?", now).
+		Update("expires_at", now).Error
+}
+
+func (r chatRepository) CreateMessage(ctx context.Context, message *chat.ChatMessage) (*chat.ChatMessage, error) {
+	trace.SpanFromContext(ctx).SetAttributes(srospan.SourceCharacterName(message.SenderCharacterName))
+	return message, r.DB.WithContext(ctx).Create(message).Error
+}
+
+func (r chatRepository) FindMessageById(ctx context.Context, id uint) (*chat.ChatMessage, error) {
+	var message *chat.ChatMessage
+	result := r.DB.WithContext(ctx).Where("id = ?", id).Find(&message)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return nil, nil
+	}
+
+	return message, nil
+}
+
+func (r chatRepository) FindChannelMessages(
+	ctx context.Context,
+	channelId uint,
+	cursor chat.HistoryCursor,
+) (chat.ChatMessages, error) {
+	trace.SpanFromContext(ctx).SetAttributes(srospan.ChatChannelId(int(channelId)))
+	return findMessagesWithCursor(
+		r.DB.WithContext(ctx).Where("channel_id = ?", channelId),
+		cursor,
+	)
+}
+
+// FindDirectMessages finds direct messages sent or received by the character. If otherCharacterName is not empty,
+// only messages between the two characters are matched.
+func (r chatRepository) FindDirectMessages(
+	ctx context.Context,
+	characterName string,
+	otherCharacterName string,
+	cursor chat.HistoryCursor,
+) (chat.ChatMessages, error) {
+	trace.SpanFromContext(ctx).SetAttributes(srospan.SourceCharacterName(characterName))
+
+	db := r.DB.WithContext(ctx).Where("target_character_name IS NOT NULL")
+	if otherCharacterName == "" {
+		db = db.Where("sender_character_name = ? OR target_character_name = ?", characterName, characterName)
+	} else {
+		trace.SpanFromContext(ctx).SetAttributes(srospan.TargetCharacterName(otherCharacterName))
+		db = db.Where(
+			"(sender_character_name = ? AND target_character_name = ?) OR (sender_character_name = ? AND target_character_name = ?)",
+			characterName, otherCharacterName, otherCharacterName, characterName,
+		)
+	}
+
+	return findMessagesWithCursor(db, cursor)
+}
+
+// MarkDirectMessagesRead marks the direct messages sent by the other character up to the message id as read. The
+// newest read message is never moved backwards.
+func (r chatRepository) MarkDirectMessagesRead(
+	ctx context.Context,
+	characterName string,
+	otherCharacterName string,
+	messageId uint,
+) error {
+	trace.SpanFromContext(ctx).SetAttributes(
+		srospan.SourceCharacterName(characterName),
+		srospan.TargetCharacterName(otherCharacterName),
+	)
+
+	return r.DB.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "character_name"}, {Name: "other_character_name"}},
+		DoUpdates: clause.Set{
+			{
+				Column: clause.Column{Name: "last_read_message_id"},
+				Value:  gorm.Expr("GREATEST(chat_direct_message_reads.last_read_message_id, excluded.last_read_message_id)"),
+			},
+			{
+				Column: clause.Column{Name: "updated_at"},
+				Value:  gorm.Expr("excluded.updated_at"),
+			},
+		},
+	}).Create(&chat.ChatDirectMessageRead{
+		CharacterName:      characterName,
+		OtherCharacterName: otherCharacterName,
+		LastReadMessageId:  messageId,
+	}).Error
+}
+
+// FindUnreadDirectMessages counts the unread direct messages sent to the character by each other character.
+// Conversations without unread messages are not included.
+func (r chatRepository) FindUnreadDirectMessages(ctx context.Context, characterName string) (chat.UnreadConversations, error) {
+	trace.SpanFromContext(ctx).SetAttributes(srospan.SourceCharacterName(characterName))
+
+	var conversations chat.UnreadConversations
+	err := r.DB.WithContext(ctx).
+		Model(&chat.ChatMessage{}).
+		Select(
+			"chat_messages.sender_character_name AS character_name, "+
+				"COUNT(*) AS count, "+
+				"COALESCE(MAX(chat_direct_message_reads.last_read_message_id), 0) AS last_read_message_id",
+		).
+		Joins(
+			"LEFT JOIN chat_direct_message_reads ON "+
+				"chat_direct_message_reads.character_name = chat_messages.target_character_name AND "+
+				"chat_direct_message_reads.other_character_name = chat_messages.sender_character_name",
+		).
+		Where("chat_messages.target_character_name = ?", characterName).
+		Where("chat_messages.id > COALESCE(chat_direct_message_reads.last_read_message_id, 0)").
+		Group("chat_messages.sender_character_name").
+		Order("chat_messages.sender_character_name").
+		Scan(&conversations).Error
+
+	return conversations, err
+}
+
+// FindConversationPartners finds the names of the characters the character sent direct messages to or received
+// direct messages from
+func (r chatRepository) FindConversationPartners(ctx context.Context, characterName string) ([]string, error) {
+	trace.SpanFromContext(ctx).SetAttributes(srospan.SourceCharacterName(characterName))
+
+	var names []string
+	err := r.DB.WithContext(ctx).
+		Raw(
+			"SELECT target_character_name FROM chat_messages WHERE sender_character_name = ? AND target_character_name IS NOT NULL "+
+				"UNION SELECT sender_character_name FROM chat_messages WHERE target_character_name = ?",
+			characterName, characterName,
+		).
+		Scan(&names).Error
+
+	return names, err
+}
+
+// RenameDirectMessages moves the direct messages and read positions of the character to its new name. Read positions
+// left under the new name by a previous character with the name are dropped.
+func (r chatRepository) RenameDirectMessages(ctx context.Context, oldName string, newName string) error {
+	trace.SpanFromContext(ctx).SetAttributes(srospan.SourceCharacterName(newName))
+
+	return r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(&chat.ChatMessage{}).
+			Where("sender_character_name = ? AND target_character_name IS NOT NULL", oldName).
+			Update("sender_character_name", newName).Error
+		if err != nil {
+			return err
+		}
+
+		err = tx.Model(&chat.ChatMessage{}).
+			Where("target_character_name = ?", oldName).
+			Update("target_character_name", newName).Error
+		if err != nil {
+			return err
+		}
+
+		err = tx.Where("character_name = ? OR other_character_name = ?", newName, newName).
+			Delete(&chat.ChatDirectMessageRead{}).Error
+		if err != nil {
+			return err
+		}
+
+		err = tx.Model(&chat.ChatDirectMessageRead{}).
+			Where("character_name = ?", oldName).
+			Update("character_name", newName).Error
+		if err != nil {
+			return err
+		}
+
+		return tx.Model(&chat.ChatDirectMessageRead{}).
+			Where("other_character_name = ?", oldName).
+			Update("other_character_name", newName).Error
+	})
+}
+
+// findMessagesWithCursor applies the cursor to the query and returns the matching messages ordered by id. If the
+// cursor has no after id, the newest messages are matched.
+func findMessagesWithCursor(db *gorm.DB, cursor chat.HistoryCursor) (chat.ChatMessages, error) {
+	if cursor.Before > 0 {
+		db = db.Where("id < ?", cursor.Before)
+	}
+
+	if cursor.After > 0 {
+		db = db.Where("id > ?", cursor.After).Order("id asc")
+	} else {
+		db = db.Order("id desc")
+	}
+
+	var messages chat.ChatMessages
+	if err := db.Limit(cursor.Limit).Find(&messages).Error; err != nil {
+		return nil, err
+	}
+
+	if cursor.After == 0 {
+		slices.Reverse(messages)
+	}
+
+	return messages, nil
+}
+
 func NewChatRepository(db *gorm.DB) ChatRepository {
 	return chatRepository{DB: db}
 }
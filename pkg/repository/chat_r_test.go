@@ -10,6 +10,7 @@ import (
 	"gorm.io/gorm"
 
 	"github.com/ShatteredRealms/go-backend/pkg/model/chat"
+	"github.com/ShatteredRealms/go-backend/pkg/pb"
 )
 
 var _ = Describe("Chat repository", func() {
@@ -226,4 +227,315 @@ var _ = Describe("Chat repository", func() {
 			Expect(chatRepo.ChangeAuthorizationForCharacter(nil, 1, []uint{1, 2}, false)).To(Succeed())
 		})
 	})
+
+	Describe("Friends", func() {
+		var userA, userB string
+		BeforeEach(func() {
+			userA = faker.UUIDHyphenated()
+			userB = faker.UUIDHyphenated()
+		})
+
+		It("should allow sending and removing friend requests", func() {
+			_, err := chatRepo.CreateFriendRequest(nil, userA, userB)
+			Expect(err).NotTo(HaveOccurred())
+
+			request, err := chatRepo.FindFriendRequest(nil, userA, userB)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(request).NotTo(BeNil())
+
+			requests, err := chatRepo.FindFriendRequests(nil, userB)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(requests).To(HaveLen(1))
+
+			Expect(chatRepo.DeleteFriendRequest(nil, userA, userB)).To(Succeed())
+			request, err = chatRepo.FindFriendRequest(nil, userA, userB)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(request).To(BeNil())
+		})
+
+		It("should add friends in both directions and clear requests", func() {
+			_, err := chatRepo.CreateFriendRequest(nil, userA, userB)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(chatRepo.AddFriend(nil, userB, userA)).To(Succeed())
+
+			for _, ids := range [][2]string{{userA, userB}, {userB, userA}} {
+				friend, err := chatRepo.FindFriend(nil, ids[0], ids[1])
+				Expect(err).NotTo(HaveOccurred())
+				Expect(friend).NotTo(BeNil())
+			}
+
+			requests, err := chatRepo.FindFriendRequests(nil, userA)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(requests).To(BeEmpty())
+
+			Expect(chatRepo.RemoveFriend(nil, userA, userB)).To(Succeed())
+			friends, err := chatRepo.FindFriends(nil, userB)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(friends).To(BeEmpty())
+		})
+	})
+
+	Describe("Blocks", func() {
+		It("should remove friendship when blocking", func() {
+			userA := faker.UUIDHyphenated()
+			userB := faker.UUIDHyphenated()
+			Expect(chatRepo.AddFriend(nil, userA, userB)).To(Succeed())
+
+			Expect(chatRepo.Block(nil, userA, userB)).To(Succeed())
+			block, err := chatRepo.FindBlock(nil, userA, userB)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(block).NotTo(BeNil())
+
+			friends, err := chatRepo.FindFriends(nil, userB)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(friends).To(BeEmpty())
+
+			Expect(chatRepo.Unblock(nil, userA, userB)).To(Succeed())
+			blocks, err := chatRepo.FindBlocks(nil, userA)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(blocks).To(BeEmpty())
+		})
+	})
+
+	Describe("Moderation", func() {
+		var channel *chat.ChatChannel
+		var characterId uint
+		BeforeEach(func() {
+			channel = createChannel()
+			characterId = uint(faker.RandomUnixTime())
+		})
+
+		It("should only set moderators for authorized characters", func() {
+			Expect(chatRepo.SetChannelModerator(nil, channel.ID, characterId, true)).To(MatchError(gorm.ErrRecordNotFound))
+
+			Expect(chatRepo.ChangeAuthorizationForCharacter(nil, characterId, []uint{channel.ID}, true)).To(Succeed())
+			Expect(chatRepo.SetChannelModerator(nil, channel.ID, characterId, true)).To(Succeed())
+			moderator, err := chatRepo.IsChannelModerator(nil, channel.ID, characterId)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(moderator).To(BeTrue())
+
+			Expect(chatRepo.SetChannelModerator(nil, channel.ID, characterId, false)).To(Succeed())
+			moderator, err = chatRepo.IsChannelModerator(nil, channel.ID, characterId)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(moderator).To(BeFalse())
+		})
+
+		It("should only find active moderations", func() {
+			expired := time.Now().Add(-time.Minute)
+			for _, expiresAt := range []*time.Time{nil, &expired} {
+				_, err := chatRepo.CreateModeration(nil, &chat.ChatChannelModeration{
+					ChannelId:   channel.ID,
+					CharacterId: characterId,
+					Type:        pb.ChatModerationType_MUTE,
+					ModeratorId: faker.UUIDHyphenated(),
+					ExpiresAt:   expiresAt,
+				})
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			moderations, err := chatRepo.FindActiveModerations(nil, channel.ID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(moderations).To(HaveLen(1))
+
+			moderation, err := chatRepo.FindActiveModeration(nil, channel.ID, characterId, pb.ChatModerationType_MUTE)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(moderation).NotTo(BeNil())
+			Expect(moderation.ExpiresAt).To(BeNil())
+
+			moderation, err = chatRepo.FindActiveModeration(nil, channel.ID, characterId, pb.ChatModerationType_BAN)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(moderation).To(BeNil())
+
+			Expect(chatRepo.ExpireModerations(nil, channel.ID, characterId, pb.ChatModerationType_MUTE)).To(Succeed())
+			moderations, err = chatRepo.FindActiveModerations(nil, channel.ID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(moderations).To(BeEmpty())
+		})
+	})
+
+	Describe("Membership", func() {
+		var channel *chat.ChatChannel
+		var characterId uint
+		BeforeEach(func() {
+			channel = createChannel()
+			characterId = uint(faker.RandomUnixTime())
+		})
+
+		It("should find channel members", func() {
+			member, err := chatRepo.FindChannelMember(nil, channel.ID, characterId)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(member).To(BeNil())
+
+			Expect(chatRepo.ChangeAuthorizationForCharacter(nil, characterId, []uint{channel.ID}, true)).To(Succeed())
+			member, err = chatRepo.FindChannelMember(nil, channel.ID, characterId)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(member).NotTo(BeNil())
+			Expect(member.CharacterId).To(Equal(characterId))
+
+			members, err := chatRepo.FindChannelMembers(nil, channel.ID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(members).To(HaveLen(1))
+		})
+
+		It("should create and delete invites", func() {
+			invite, err := chatRepo.FindChannelInvite(nil, channel.ID, characterId)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(invite).To(BeNil())
+
+			Expect(chatRepo.CreateChannelInvite(nil, &chat.ChatChannelInvite{
+				ChannelId:   channel.ID,
+				CharacterId: characterId,
+				InviterId:   characterId + 1,
+			})).To(Succeed())
+			invite, err = chatRepo.FindChannelInvite(nil, channel.ID, characterId)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(invite).NotTo(BeNil())
+			Expect(invite.InviterId).To(Equal(characterId + 1))
+
+			Expect(chatRepo.DeleteChannelInvite(nil, channel.ID, characterId)).To(Succeed())
+			invite, err = chatRepo.FindChannelInvite(nil, channel.ID, characterId)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(invite).To(BeNil())
+		})
+	})
+
+	Describe("Messages", func() {
+		It("should page through channel history", func() {
+			channel := createChannel()
+			sent := make(chat.ChatMessages, 5)
+			for idx := range sent {
+				var err error
+				sent[idx], err = chatRepo.CreateMessage(nil, &chat.ChatMessage{
+					SenderCharacterName: faker.Username(),
+					ChannelId:           &channel.ID,
+					Message:             faker.Sentence(),
+				})
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			out, err := chatRepo.FindChannelMessages(nil, channel.ID, chat.NewHistoryCursor(0, 0, 2))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out).To(HaveLen(2))
+			Expect(out[0].ID).To(Equal(sent[3].ID))
+			Expect(out[1].ID).To(Equal(sent[4].ID))
+
+			out, err = chatRepo.FindChannelMessages(nil, channel.ID, chat.NewHistoryCursor(uint64(sent[3].ID), 0, 2))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out).To(HaveLen(2))
+			Expect(out[0].ID).To(Equal(sent[1].ID))
+			Expect(out[1].ID).To(Equal(sent[2].ID))
+
+			out, err = chatRepo.FindChannelMessages(nil, channel.ID, chat.NewHistoryCursor(0, uint64(sent[0].ID), 2))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out).To(HaveLen(2))
+			Expect(out[0].ID).To(Equal(sent[1].ID))
+			Expect(out[1].ID).To(Equal(sent[2].ID))
+
+			found, err := chatRepo.FindMessageById(nil, sent[0].ID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).NotTo(BeNil())
+			Expect(found.Message).To(Equal(sent[0].Message))
+		})
+
+		It("should find direct messages between characters", func() {
+			nameA := faker.Username()
+			nameB := faker.Username()
+			nameC := faker.Username()
+			for _, names := range [][2]string{{nameA, nameB}, {nameB, nameA}, {nameC, nameA}} {
+				_, err := chatRepo.CreateMessage(nil, &chat.ChatMessage{
+					SenderCharacterName: names[0],
+					TargetCharacterName: &names[1],
+					Message:             faker.Sentence(),
+				})
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			out, err := chatRepo.FindDirectMessages(nil, nameA, nameB, chat.NewHistoryCursor(0, 0, 0))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out).To(HaveLen(2))
+
+			out, err = chatRepo.FindDirectMessages(nil, nameA, "", chat.NewHistoryCursor(0, 0, 0))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out).To(HaveLen(3))
+		})
+
+		It("should count unread direct messages", func() {
+			nameA := faker.Username()
+			nameB := faker.Username()
+			nameC := faker.Username()
+			messages := make(chat.ChatMessages, 0)
+			for _, names := range [][2]string{{nameB, nameA}, {nameB, nameA}, {nameC, nameA}, {nameA, nameB}} {
+				message, err := chatRepo.CreateMessage(nil, &chat.ChatMessage{
+					SenderCharacterName: names[0],
+					TargetCharacterName: &names[1],
+					Message:             faker.Sentence(),
+				})
+				Expect(err).NotTo(HaveOccurred())
+				messages = append(messages, message)
+			}
+
+			out, err := chatRepo.FindUnreadDirectMessages(nil, nameA)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out).To(HaveLen(2))
+
+			Expect(chatRepo.MarkDirectMessagesRead(nil, nameA, nameB, messages[0].ID)).To(Succeed())
+			out, err = chatRepo.FindUnreadDirectMessages(nil, nameA)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out).To(HaveLen(2))
+			for _, conversation := range out {
+				if conversation.CharacterName == nameB {
+					Expect(conversation.Count).To(BeEquivalentTo(1))
+					Expect(conversation.LastReadMessageId).To(Equal(messages[0].ID))
+				} else {
+					Expect(conversation.CharacterName).To(Equal(nameC))
+					Expect(conversation.Count).To(BeEquivalentTo(1))
+				}
+			}
+
+			// Marking an older message read should not move the read position back
+			Expect(chatRepo.MarkDirectMessagesRead(nil, nameA, nameB, messages[1].ID)).To(Succeed())
+			Expect(chatRepo.MarkDirectMessagesRead(nil, nameA, nameB, messages[0].ID)).To(Succeed())
+			out, err = chatRepo.FindUnreadDirectMessages(nil, nameA)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out).To(HaveLen(1))
+			Expect(out[0].CharacterName).To(Equal(nameC))
+		})
+
+		It("should move direct messages to a new name", func() {
+			nameA := faker.Username()
+			nameB := faker.Username()
+			nameC := faker.Username()
+			renamed := faker.Username()
+			messages := make(chat.ChatMessages, 0)
+			for _, names := range [][2]string{{nameA, nameB}, {nameB, nameA}, {nameC, nameA}} {
+				message, err := chatRepo.CreateMessage(nil, &chat.ChatMessage{
+					SenderCharacterName: names[0],
+					TargetCharacterName: &names[1],
+					Message:             faker.Sentence(),
+				})
+				Expect(err).NotTo(HaveOccurred())
+				messages = append(messages, message)
+			}
+			Expect(chatRepo.MarkDirectMessagesRead(nil, nameA, nameB, messages[1].ID)).To(Succeed())
+
+			partners, err := chatRepo.FindConversationPartners(nil, nameA)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(partners).To(ConsistOf(nameB, nameC))
+
+			Expect(chatRepo.RenameDirectMessages(nil, nameA, renamed)).To(Succeed())
+
+			out, err := chatRepo.FindDirectMessages(nil, renamed, "", chat.NewHistoryCursor(0, 0, 0))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out).To(HaveLen(3))
+
+			out, err = chatRepo.FindDirectMessages(nil, nameA, "", chat.NewHistoryCursor(0, 0, 0))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out).To(BeEmpty())
+
+			unread, err := chatRepo.FindUnreadDirectMessages(nil, renamed)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(unread).To(HaveLen(1))
+			Expect(unread[0].CharacterName).To(Equal(nameC))
+		})
+	})
 })
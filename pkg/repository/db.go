@@ -33,6 +33,7 @@ func ConnectDB(ctx context.Context, pgPool config.DBPoolConfig, redisPool config
 	db, err := gorm.Open(postgres.New(postgres.Config{
 		Conn: sqlDB,
 	}), &gorm.Config{
+		TranslateError: true,
 		Logger: logger.New(
 			log.Logger,
 			logger.Config{
@@ -68,14 +69,14 @@ func ConnectDB(ctx context.Context, pgPool config.DBPoolConfig, redisPool config
 		return nil, fmt.Errorf("opentelemetry: %w", err)
 	}
 
-	c, err := cacher.NewRedisCache(ctx, redisPool)
+	rdb, err := ConnectRedis(ctx, redisPool)
 	if err != nil {
 		return nil, fmt.Errorf("redis cache: %w", err)
 	}
 	cachesPlugin := caches.Caches{
 		Conf: &caches.Config{
 			Easer:  true,
-			Cacher: c,
+			Cacher: cacher.NewRedisCache(rdb),
 		},
 	}
 	if err = db.Use(&cachesPlugin); err != nil {
@@ -0,0 +1,281 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ShatteredRealms/go-backend/pkg/model/character"
+	"github.com/ShatteredRealms/go-backend/pkg/srospan"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+)
+
+type GuildRepository interface {
+	// Create creates the guild with its ranks and adds the leader as a member with the leader rank
+	Create(ctx context.Context, guild *character.Guild) (*character.Guild, error)
+	Save(ctx context.Context, guild *character.Guild) (*character.Guild, error)
+	// Delete deletes the guild along with its ranks, members and invites
+	Delete(ctx context.Context, guild *character.Guild) error
+
+	FindById(ctx context.Context, id uint) (*character.Guild, error)
+	FindByName(ctx context.Context, name string, dimension string) (*character.Guild, error)
+
+	CreateRank(ctx context.Context, rank *character.GuildRank) (*character.GuildRank, error)
+	SaveRank(ctx context.Context, rank *character.GuildRank) (*character.GuildRank, error)
+	DeleteRank(ctx context.Context, rank *character.GuildRank) error
+	CountRankMembers(ctx context.Context, rankId uint) (int64, error)
+
+	FindMember(ctx context.Context, characterId uint) (*character.GuildMember, error)
+	FindMembers(ctx context.Context, guildId uint) (character.GuildMembers, error)
+	// AddMember adds the character to the guild and removes all of its pending guild invites
+	AddMember(ctx context.Context, member *character.GuildMember) error
+	RemoveMember(ctx context.Context, guildId uint, characterId uint) error
+	SetMemberRank(ctx context.Context, guildId uint, characterId uint, rankId uint) error
+
+	CreateInvite(ctx context.Context, invite *character.GuildInvite) error
+	FindInvite(ctx context.Context, guildId uint, characterId uint) (*character.GuildInvite, error)
+	FindInvitesForCharacter(ctx context.Context, characterId uint) (character.GuildInvites, error)
+	DeleteInvite(ctx context.Context, guildId uint, characterId uint) error
+
+	Migrate(ctx context.Context) error
+}
+
+type guildRepository struct {
+	DB *gorm.DB
+}
+
+func NewGuildRepository(db *gorm.DB) GuildRepository {
+	return guildRepository{DB: db}
+}
+
+func (r guildRepository) Create(ctx context.Context, guild *character.Guild) (*character.Guild, error) {
+	// Set the ID to zero so the database can generate the value
+	guild.ID = 0
+
+	err := r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(guild).Error; err != nil {
+			return err
+		}
+
+		var leaderRank *character.GuildRank
+		for _, rank := range guild.Ranks {
+			if rank.IsLeader() {
+				leaderRank = rank
+			}
+		}
+		if leaderRank == nil {
+			return errors.New("guild has no leader rank")
+		}
+
+		return tx.Create(&character.GuildMember{
+			CharacterId: guild.LeaderId,
+			GuildId:     guild.ID,
+			RankId:      leaderRank.ID,
+		}).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	trace.SpanFromContext(ctx).SetAttributes(srospan.GuildAttributes(guild)...)
+	return guild, nil
+}
+
+func (r guildRepository) Save(ctx context.Context, guild *character.Guild) (*character.Guild, error) {
+	trace.SpanFromContext(ctx).SetAttributes(srospan.GuildAttributes(guild)...)
+	return guild, r.DB.WithContext(ctx).Omit("Ranks").Save(guild).Error
+}
+
+func (r guildRepository) Delete(ctx context.Context, guild *character.Guild) error {
+	trace.SpanFromContext(ctx).SetAttributes(srospan.GuildAttributes(guild)...)
+
+	return r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Delete(&character.GuildInvite{}, "guild_id = ?", guild.ID).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Delete(&character.GuildMember{}, "guild_id = ?", guild.ID).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Delete(&character.GuildRank{}, "guild_id = ?", guild.ID).Error; err != nil {
+			return err
+		}
+
+		return tx.Delete(&character.Guild{}, guild.ID).Error
+	})
+}
+
+func (r guildRepository) FindById(ctx context.Context, id uint) (*character.Guild, error) {
+	var guild *character.Guild
+	result := r.DB.WithContext(ctx).Preload("Ranks").Where("id = ?", id).Limit(1).Find(&guild)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return nil, nil
+	}
+
+	trace.SpanFromContext(ctx).SetAttributes(srospan.GuildAttributes(guild)...)
+	return guild, nil
+}
+
+func (r guildRepository) FindByName(ctx context.Context, name string, dimension string) (*character.Guild, error) {
+	var guild *character.Guild
+	result := r.DB.WithContext(ctx).
+		Preload("Ranks").
+		Where("name = ? AND dimension = ?", name, dimension).
+		Limit(1).
+		Find(&guild)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return nil, nil
+	}
+
+	trace.SpanFromContext(ctx).SetAttributes(srospan.GuildAttributes(guild)...)
+	return guild, nil
+}
+
+func (r guildRepository) CreateRank(ctx context.Context, rank *character.GuildRank) (*character.GuildRank, error) {
+	rank.ID = 0
+	trace.SpanFromContext(ctx).SetAttributes(srospan.GuildId(int(rank.GuildId)))
+	return rank, r.DB.WithContext(ctx).Create(rank).Error
+}
+
+func (r guildRepository) SaveRank(ctx context.Context, rank *character.GuildRank) (*character.GuildRank, error) {
+	trace.SpanFromContext(ctx).SetAttributes(srospan.GuildId(int(rank.GuildId)))
+	return rank, r.DB.WithContext(ctx).Save(rank).Error
+}
+
+func (r guildRepository) DeleteRank(ctx context.Context, rank *character.GuildRank) error {
+	trace.SpanFromContext(ctx).SetAttributes(srospan.GuildId(int(rank.GuildId)))
+	return r.DB.WithContext(ctx).Delete(rank).Error
+}
+
+func (r guildRepository) CountRankMembers(ctx context.Context, rankId uint) (int64, error) {
+	var count int64
+	return count, r.DB.WithContext(ctx).Model(&character.GuildMember{}).Where("rank_id = ?", rankId).Count(&count).Error
+}
+
+func (r guildRepository) FindMember(ctx context.Context, characterId uint) (*character.GuildMember, error) {
+	var member *character.GuildMember
+	result := r.DB.WithContext(ctx).Where("character_id = ?", characterId).Limit(1).Find(&member)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return nil, nil
+	}
+
+	return member, nil
+}
+
+func (r guildRepository) FindMembers(ctx context.Context, guildId uint) (character.GuildMembers, error) {
+	var members character.GuildMembers
+	return members, r.DB.WithContext(ctx).Where("guild_id = ?", guildId).Order("created_at").Find(&members).Error
+}
+
+func (r guildRepository) AddMember(ctx context.Context, member *character.GuildMember) error {
+	trace.SpanFromContext(ctx).SetAttributes(
+		srospan.GuildId(int(member.GuildId)),
+		srospan.TargetCharacterId(int(member.CharacterId)),
+	)
+
+	return r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Delete(&character.GuildInvite{}, "character_id = ?", member.CharacterId).Error; err != nil {
+			return err
+		}
+
+		return tx.Create(member).Error
+	})
+}
+
+func (r guildRepository) RemoveMember(ctx context.Context, guildId uint, characterId uint) error {
+	trace.SpanFromContext(ctx).SetAttributes(
+		srospan.GuildId(int(guildId)),
+		srospan.TargetCharacterId(int(characterId)),
+	)
+
+	return r.DB.WithContext(ctx).
+		Delete(&character.GuildMember{}, "guild_id = ? AND character_id = ?", guildId, characterId).
+		Error
+}
+
+// SetMemberRank changes the rank of a guild member. If the character is not a member of the guild,
+// gorm.ErrRecordNotFound is returned.
+func (r guildRepository) SetMemberRank(ctx context.Context, guildId uint, characterId uint, rankId uint) error {
+	trace.SpanFromContext(ctx).SetAttributes(
+		srospan.GuildId(int(guildId)),
+		srospan.TargetCharacterId(int(characterId)),
+	)
+
+	result := r.DB.WithContext(ctx).
+		Model(&character.GuildMember{}).
+		Where("guild_id = ? AND character_id = ?", guildId, characterId).
+		Update("rank_id", rankId)
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
+}
+
+func (r guildRepository) CreateInvite(ctx context.Context, invite *character.GuildInvite) error {
+	trace.SpanFromContext(ctx).SetAttributes(
+		srospan.GuildId(int(invite.GuildId)),
+		srospan.TargetCharacterId(int(invite.CharacterId)),
+	)
+
+	return r.DB.WithContext(ctx).Omit("Guild").Create(invite).Error
+}
+
+func (r guildRepository) FindInvite(ctx context.Context, guildId uint, characterId uint) (*character.GuildInvite, error) {
+	var invite *character.GuildInvite
+	result := r.DB.WithContext(ctx).
+		Where("guild_id = ? AND character_id = ?", guildId, characterId).
+		Limit(1).
+		Find(&invite)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return nil, nil
+	}
+
+	return invite, nil
+}
+
+func (r guildRepository) FindInvitesForCharacter(ctx context.Context, characterId uint) (character.GuildInvites, error) {
+	var invites character.GuildInvites
+	return invites, r.DB.WithContext(ctx).
+		Preload("Guild").
+		Where("character_id = ?", characterId).
+		Order("created_at").
+		Find(&invites).
+		Error
+}
+
+func (r guildRepository) DeleteInvite(ctx context.Context, guildId uint, characterId uint) error {
+	return r.DB.WithContext(ctx).
+		Delete(&character.GuildInvite{}, "guild_id = ? AND character_id = ?", guildId, characterId).
+		Error
+}
+
+func (r guildRepository) Migrate(ctx context.Context) error {
+	return r.DB.WithContext(ctx).AutoMigrate(
+		&character.Guild{},
+		&character.GuildRank{},
+		&character.GuildMember{},
+		&character.GuildInvite{},
+	)
+}
@@ -0,0 +1,192 @@
+package repository_test
+
+import (
+	"context"
+	"math/rand"
+
+	"github.com/bxcodec/faker/v4"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"gorm.io/gorm"
+
+	"github.com/ShatteredRealms/go-backend/pkg/model/character"
+)
+
+var _ = Describe("Guild repository", func() {
+	var (
+		ctx = context.Background()
+
+		createGuild = func() *character.Guild {
+			guild := &character.Guild{
+				Name:      faker.Username(),
+				Dimension: faker.Username(),
+				LeaderId:  uint(rand.Uint32()),
+				Ranks:     character.DefaultGuildRanks(),
+			}
+
+			out, err := guildRepo.Create(ctx, guild)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out).NotTo(BeNil())
+			Expect(out.ID).NotTo(BeZero())
+
+			return out
+		}
+	)
+
+	Describe("Create", func() {
+		It("should create the ranks and add the leader", func() {
+			guild := createGuild()
+			out, err := guildRepo.FindById(ctx, guild.ID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.Ranks).To(HaveLen(len(character.DefaultGuildRanks())))
+
+			member, err := guildRepo.FindMember(ctx, guild.LeaderId)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(member).NotTo(BeNil())
+			Expect(member.GuildId).To(Equal(guild.ID))
+			Expect(guild.Rank(member.RankId).IsLeader()).To(BeTrue())
+		})
+
+		It("should error if the name is taken in the dimension", func() {
+			guild := createGuild()
+			_, err := guildRepo.Create(ctx, &character.Guild{
+				Name:      guild.Name,
+				Dimension: guild.Dimension,
+				LeaderId:  uint(rand.Uint32()),
+				Ranks:     character.DefaultGuildRanks(),
+			})
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("FindByName", func() {
+		It("should find guilds in the dimension", func() {
+			guild := createGuild()
+			out, err := guildRepo.FindByName(ctx, guild.Name, guild.Dimension)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.ID).To(Equal(guild.ID))
+
+			out, err = guildRepo.FindByName(ctx, guild.Name, faker.Username())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out).To(BeNil())
+		})
+	})
+
+	Describe("Members", func() {
+		It("should add members and remove their invites", func() {
+			guild := createGuild()
+			other := createGuild()
+			characterId := uint(rand.Uint32())
+			for _, g := range []*character.Guild{guild, other} {
+				Expect(guildRepo.CreateInvite(ctx, &character.GuildInvite{
+					GuildId:     g.ID,
+					CharacterId: characterId,
+					InviterId:   g.LeaderId,
+				})).To(Succeed())
+			}
+
+			invites, err := guildRepo.FindInvitesForCharacter(ctx, characterId)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(invites).To(HaveLen(2))
+			Expect(invites[0].Guild).NotTo(BeNil())
+
+			Expect(guildRepo.AddMember(ctx, &character.GuildMember{
+				CharacterId: characterId,
+				GuildId:     guild.ID,
+				RankId:      guild.LowestRank().ID,
+			})).To(Succeed())
+
+			invites, err = guildRepo.FindInvitesForCharacter(ctx, characterId)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(invites).To(BeEmpty())
+
+			members, err := guildRepo.FindMembers(ctx, guild.ID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(members).To(HaveLen(2))
+
+			count, err := guildRepo.CountRankMembers(ctx, guild.LowestRank().ID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(count).To(BeEquivalentTo(1))
+
+			Expect(guildRepo.RemoveMember(ctx, guild.ID, characterId)).To(Succeed())
+			member, err := guildRepo.FindMember(ctx, characterId)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(member).To(BeNil())
+		})
+
+		It("should change member ranks", func() {
+			guild := createGuild()
+			characterId := uint(rand.Uint32())
+			Expect(guildRepo.AddMember(ctx, &character.GuildMember{
+				CharacterId: characterId,
+				GuildId:     guild.ID,
+				RankId:      guild.LowestRank().ID,
+			})).To(Succeed())
+
+			Expect(guildRepo.SetMemberRank(ctx, guild.ID, characterId, guild.Ranks[1].ID)).To(Succeed())
+			member, err := guildRepo.FindMember(ctx, characterId)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(member.RankId).To(Equal(guild.Ranks[1].ID))
+
+			err = guildRepo.SetMemberRank(ctx, guild.ID, uint(rand.Uint32()), guild.Ranks[1].ID)
+			Expect(err).To(MatchError(gorm.ErrRecordNotFound))
+		})
+	})
+
+	Describe("Ranks", func() {
+		It("should create, save and delete ranks", func() {
+			guild := createGuild()
+			rank, err := guildRepo.CreateRank(ctx, &character.GuildRank{GuildId: guild.ID, Name: "Recruit", Level: 10})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(rank.ID).NotTo(BeZero())
+
+			rank.Invite = true
+			_, err = guildRepo.SaveRank(ctx, rank)
+			Expect(err).NotTo(HaveOccurred())
+
+			out, err := guildRepo.FindById(ctx, guild.ID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.Rank(rank.ID).Invite).To(BeTrue())
+
+			Expect(guildRepo.DeleteRank(ctx, rank)).To(Succeed())
+			out, err = guildRepo.FindById(ctx, guild.ID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.Rank(rank.ID)).To(BeNil())
+		})
+
+		It("should not allow duplicate levels", func() {
+			guild := createGuild()
+			_, err := guildRepo.CreateRank(ctx, &character.GuildRank{GuildId: guild.ID, Name: "Recruit", Level: 1})
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("Delete", func() {
+		It("should delete the guild with its ranks, members and invites", func() {
+			guild := createGuild()
+			characterId := uint(rand.Uint32())
+			Expect(guildRepo.CreateInvite(ctx, &character.GuildInvite{
+				GuildId:     guild.ID,
+				CharacterId: characterId,
+			})).To(Succeed())
+
+			guild.Motd = faker.Sentence()
+			_, err := guildRepo.Save(ctx, guild)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(guildRepo.Delete(ctx, guild)).To(Succeed())
+
+			out, err := guildRepo.FindById(ctx, guild.ID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out).To(BeNil())
+
+			member, err := guildRepo.FindMember(ctx, guild.LeaderId)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(member).To(BeNil())
+
+			invite, err := guildRepo.FindInvite(ctx, guild.ID, characterId)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(invite).To(BeNil())
+		})
+	})
+})
@@ -2,16 +2,118 @@ package repository
 
 import (
 	"context"
+	"errors"
+	"time"
 
+	"github.com/ShatteredRealms/go-backend/pkg/auth"
 	"github.com/ShatteredRealms/go-backend/pkg/model/character"
 	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
 type InventoryRepository interface {
 	GetInventory(ctx context.Context, characterId uint) (*character.Inventory, error)
-	UpdateInventory(ctx context.Context, inventory *character.Inventory) error
+
+	// Every change to an inventory is recorded in the inventory history in the same transaction with the given reason
+	// and the requester in the context as the actor.
+
+	UpdateInventory(ctx context.Context, inventory *character.Inventory, reason string) error
+
+	// SwapInventory saves the inventory only if it has not changed since it was read, which is checked using its
+	// version. The version is incremented when saved. Returns false if the inventory was changed in the meantime.
+	SwapInventory(ctx context.Context, inventory *character.Inventory, reason string) (bool, error)
+
+	// Trade exchanges the offers of the open trade between the inventories of both traders and completes the trade
+	// in a single transaction. Nothing is changed if an offered item or gold is no longer in the inventory, a trader
+	// has no room for what they receive, either changed inventory fails validation or the trade changed since it was
+	// read. Returns the completed trade.
+	Trade(ctx context.Context, trade *character.Trade, validator InventoryValidator) (*character.Trade, error)
+
+	// SendMail saves the inventory of the sender the attachments and gold of the mail were taken from and creates the
+	// mail in a single transaction. The inventory is only saved if it has not changed since it was read, which is
+	// checked using its version. Returns false if the inventory was changed in the meantime.
+	SendMail(ctx context.Context, inventory *character.Inventory, mail *character.Mail) (bool, error)
+
+	// ClaimMail marks the mail claimed and read and saves the inventory of the recipient the attachments and gold were
+	// given to in a single transaction. The inventory is only saved if it has not changed since it was read, which is
+	// checked using its version. Returns false if the inventory was changed in the meantime, and mongo.ErrNoDocuments
+	// if the mail was already claimed.
+	ClaimMail(ctx context.Context, inventory *character.Inventory, mail *character.Mail) (bool, error)
+
+	// The item operations change the inventory in a single atomic update if its version still matches the version of
+	// the given inventory, which is the inventory the operation was validated against. The version is incremented and
+	// the changed inventory returned. Returns character.ErrInventoryVersion if the inventory changed in the meantime.
+
+	AddItem(
+		ctx context.Context,
+		inventory *character.Inventory,
+		location character.InventoryLocation,
+		item *character.InventoryItem,
+	) (*character.Inventory, error)
+	RemoveItem(
+		ctx context.Context,
+		inventory *character.Inventory,
+		location character.InventoryLocation,
+		slot uint32,
+		quantity uint64,
+	) (*character.Inventory, error)
+	MoveItem(
+		ctx context.Context,
+		inventory *character.Inventory,
+		location character.InventoryLocation,
+		from uint32,
+		to uint32,
+	) (*character.Inventory, error)
+	SplitStack(
+		ctx context.Context,
+		inventory *character.Inventory,
+		location character.InventoryLocation,
+		from uint32,
+		to uint32,
+		quantity uint64,
+	) (*character.Inventory, error)
+	MergeStack(
+		ctx context.Context,
+		inventory *character.Inventory,
+		location character.InventoryLocation,
+		from uint32,
+		to uint32,
+		quantity uint64,
+	) (*character.Inventory, error)
+	TransferItem(
+		ctx context.Context,
+		inventory *character.Inventory,
+		from character.InventoryLocation,
+		fromSlot uint32,
+		toSlot uint32,
+	) (*character.Inventory, error)
+
+	// FindHistory gets the latest changes to the inventory of the character with the newest first
+	FindHistory(ctx context.Context, characterId uint, limit int64) (character.InventoryEvents, error)
+
+	// FindLatestEvent gets the last change to the inventory of the character at or before the time, or nil if there
+	// was none
+	FindLatestEvent(ctx context.Context, characterId uint, at time.Time) (*character.InventoryEvent, error)
+
+	Migrate(ctx context.Context) error
+}
+
+// InventoryValidator checks inventories changed within a transaction before they are saved
+type InventoryValidator interface {
+	// Capacity number of inventory slots items can be given to
+	Capacity() uint32
+
+	// Validate returns an error if the inventory cannot be saved, which aborts the transaction
+	Validate(ctx context.Context, inventory *character.Inventory) error
+}
+
+// slotChange moves the item in a slot to another slot and changes its quantity by the delta
+type slotChange struct {
+	slot  uint32
+	to    uint32
+	delta int64
 }
 
 type inventoryRepository struct {
@@ -35,11 +137,509 @@ func (r *inventoryRepository) GetInventory(ctx context.Context, characterId uint
 }
 
 // UpdateInventory implements InventoryRepository.
-func (r *inventoryRepository) UpdateInventory(ctx context.Context, inventory *character.Inventory) error {
-	_, err := r.inventoryCollection().ReplaceOne(ctx, bson.D{{"_id", inventory.CharacterId}}, inventory, options.Replace().SetUpsert(true))
+func (r *inventoryRepository) UpdateInventory(ctx context.Context, inventory *character.Inventory, reason string) error {
+	_, err := r.change(ctx, inventory.CharacterId, reason, func(sc mongo.SessionContext) (*character.Inventory, error) {
+		return inventory, r.inventoryCollection().FindOneAndUpdate(
+			sc,
+			bson.D{{"_id", inventory.CharacterId}},
+			inventoryUpdate(inventory),
+			options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After),
+		).Decode(inventory)
+	})
+
+	return err
+}
+
+// SwapInventory implements InventoryRepository.
+func (r *inventoryRepository) SwapInventory(
+	ctx context.Context,
+	inventory *character.Inventory,
+	reason string,
+) (bool, error) {
+	_, err := r.change(ctx, inventory.CharacterId, reason, func(sc mongo.SessionContext) (*character.Inventory, error) {
+		return inventory, r.inventoryCollection().FindOneAndUpdate(
+			sc,
+			versionFilter(inventory),
+			inventoryUpdate(inventory),
+			options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After),
+		).Decode(inventory)
+	})
+
+	// The upsert conflicts with the existing inventory if the version changed
+	if mongo.IsDuplicateKeyError(err) {
+		return false, nil
+	}
+
+	return err == nil, err
+}
+
+// Trade implements InventoryRepository.
+func (r *inventoryRepository) Trade(
+	ctx context.Context,
+	trade *character.Trade,
+	validator InventoryValidator,
+) (*character.Trade, error) {
+	session, err := r.db.Client().StartSession()
+	if err != nil {
+		return nil, err
+	}
+	defer session.EndSession(ctx)
+
+	_, err = session.WithTransaction(ctx, func(sc mongo.SessionContext) (interface{}, error) {
+		initiator, err := r.currentInventory(sc, trade.Initiator.CharacterId)
+		if err != nil {
+			return nil, err
+		}
+
+		partner, err := r.currentInventory(sc, trade.Partner.CharacterId)
+		if err != nil {
+			return nil, err
+		}
+
+		before := []*character.Inventory{initiator.Clone(), partner.Clone()}
+		err = trade.Apply(initiator, partner, validator.Capacity())
+		if err != nil {
+			return nil, err
+		}
+
+		for idx, inventory := range []*character.Inventory{initiator, partner} {
+			err = validator.Validate(sc, inventory)
+			if err != nil {
+				return nil, err
+			}
+
+			err = r.inventoryCollection().FindOneAndUpdate(
+				sc,
+				bson.D{{"_id", inventory.CharacterId}},
+				inventoryUpdate(inventory),
+				options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After),
+			).Decode(inventory)
+			if err != nil {
+				return nil, err
+			}
+
+			err = r.record(sc, before[idx], inventory, "trade "+trade.Id.Hex())
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		completedAt := time.Now()
+		result, err := tradeCollection(r.db).UpdateOne(
+			sc,
+			bson.D{{"_id", trade.Id}, {"status", character.TradeStatusOpen}, {"revision", trade.Revision}},
+			bson.D{{"$set", bson.D{
+				{"status", character.TradeStatusCompleted},
+				{"completedAt", completedAt},
+				{"updatedAt", completedAt},
+			}}},
+		)
+		if err != nil {
+			return nil, err
+		}
+		if result.MatchedCount == 0 {
+			return nil, character.ErrTradeClosed
+		}
+
+		trade.Status = character.TradeStatusCompleted
+		trade.CompletedAt = completedAt
+		trade.UpdatedAt = completedAt
+		return nil, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return trade, nil
+}
+
+// AddItem implements InventoryRepository.
+func (r *inventoryRepository) AddItem(
+	ctx context.Context,
+	inventory *character.Inventory,
+	location character.InventoryLocation,
+	item *character.InventoryItem,
+) (*character.Inventory, error) {
+	return r.updateItems(ctx, inventory, "add item", bson.D{
+		{string(location), itemsUpdate(location, nil, item)},
+	})
+}
+
+// RemoveItem implements InventoryRepository.
+func (r *inventoryRepository) RemoveItem(
+	ctx context.Context,
+	inventory *character.Inventory,
+	location character.InventoryLocation,
+	slot uint32,
+	quantity uint64,
+) (*character.Inventory, error) {
+	return r.updateItems(ctx, inventory, "remove item", bson.D{
+		{string(location), itemsUpdate(location, []slotChange{{slot: slot, to: slot, delta: -int64(quantity)}})},
+	})
+}
+
+// MoveItem implements InventoryRepository.
+func (r *inventoryRepository) MoveItem(
+	ctx context.Context,
+	inventory *character.Inventory,
+	location character.InventoryLocation,
+	from uint32,
+	to uint32,
+) (*character.Inventory, error) {
+	// Both changes match the slots before the update, so an item in the destination is swapped
+	return r.updateItems(ctx, inventory, "move item", bson.D{
+		{string(location), itemsUpdate(location, []slotChange{{slot: from, to: to}, {slot: to, to: from}})},
+	})
+}
+
+// SplitStack implements InventoryRepository.
+func (r *inventoryRepository) SplitStack(
+	ctx context.Context,
+	inventory *character.Inventory,
+	location character.InventoryLocation,
+	from uint32,
+	to uint32,
+	quantity uint64,
+) (*character.Inventory, error) {
+	items, err := inventory.Items(location)
+	if err != nil {
+		return nil, err
+	}
+
+	item := items.Item(from)
+	if item == nil {
+		return nil, character.ErrInventorySlotEmpty
+	}
+
+	return r.updateItems(ctx, inventory, "split stack", bson.D{
+		{string(location), itemsUpdate(
+			location,
+			[]slotChange{{slot: from, to: from, delta: -int64(quantity)}},
+			&character.InventoryItem{Id: item.Id, Slot: to, Quantity: quantity},
+		)},
+	})
+}
+
+// MergeStack implements InventoryRepository.
+func (r *inventoryRepository) MergeStack(
+	ctx context.Context,
+	inventory *character.Inventory,
+	location character.InventoryLocation,
+	from uint32,
+	to uint32,
+	quantity uint64,
+) (*character.Inventory, error) {
+	return r.updateItems(ctx, inventory, "merge stack", bson.D{
+		{string(location), itemsUpdate(location, []slotChange{
+			{slot: from, to: from, delta: -int64(quantity)},
+			{slot: to, to: to, delta: int64(quantity)},
+		})},
+	})
+}
+
+// TransferItem implements InventoryRepository.
+func (r *inventoryRepository) TransferItem(
+	ctx context.Context,
+	inventory *character.Inventory,
+	from character.InventoryLocation,
+	fromSlot uint32,
+	toSlot uint32,
+) (*character.Inventory, error) {
+	items, err := inventory.Items(from)
+	if err != nil {
+		return nil, err
+	}
+
+	item := items.Item(fromSlot)
+	if item == nil {
+		return nil, character.ErrInventorySlotEmpty
+	}
+
+	to := from.Other()
+	return r.updateItems(ctx, inventory, "transfer item", bson.D{
+		{string(from), itemsUpdate(from, []slotChange{{slot: fromSlot, to: fromSlot, delta: -int64(item.Quantity)}})},
+		{string(to), itemsUpdate(to, nil, &character.InventoryItem{Id: item.Id, Slot: toSlot, Quantity: item.Quantity})},
+	})
+}
+
+// SendMail implements InventoryRepository.
+func (r *inventoryRepository) SendMail(
+	ctx context.Context,
+	inventory *character.Inventory,
+	mail *character.Mail,
+) (bool, error) {
+	mail.Id = primitive.NewObjectID()
+	mail.ScheduleDelete()
+	reason := "send mail " + mail.Id.Hex()
+	_, err := r.change(ctx, inventory.CharacterId, reason, func(sc mongo.SessionContext) (*character.Inventory, error) {
+		err := r.inventoryCollection().FindOneAndUpdate(
+			sc,
+			versionFilter(inventory),
+			inventoryUpdate(inventory),
+			options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After),
+		).Decode(inventory)
+		if err != nil {
+			return nil, err
+		}
+
+		_, err = mailCollection(r.db).InsertOne(sc, mail)
+		return inventory, err
+	})
+
+	// The upsert conflicts with the existing inventory if the version changed
+	if mongo.IsDuplicateKeyError(err) {
+		return false, nil
+	}
+
+	return err == nil, err
+}
+
+// ClaimMail implements InventoryRepository.
+func (r *inventoryRepository) ClaimMail(
+	ctx context.Context,
+	inventory *character.Inventory,
+	mail *character.Mail,
+) (bool, error) {
+	var claimed *character.Mail
+	reason := "claim mail " + mail.Id.Hex()
+	_, err := r.change(ctx, inventory.CharacterId, reason, func(sc mongo.SessionContext) (*character.Inventory, error) {
+		err := mailCollection(r.db).FindOneAndUpdate(
+			sc,
+			bson.D{{"_id", mail.Id}, {"claimed", false}},
+			claimUpdate(),
+			options.FindOneAndUpdate().SetReturnDocument(options.After),
+		).Decode(&claimed)
+		if err != nil {
+			return nil, err
+		}
+
+		return inventory, r.inventoryCollection().FindOneAndUpdate(
+			sc,
+			versionFilter(inventory),
+			inventoryUpdate(inventory),
+			options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After),
+		).Decode(inventory)
+	})
+
+	// The upsert conflicts with the existing inventory if the version changed
+	if mongo.IsDuplicateKeyError(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	*mail = *claimed
+	return true, nil
+}
+
+// updateItems sets the fields of the inventory with the version of the given inventory and increments the version
+func (r *inventoryRepository) updateItems(
+	ctx context.Context,
+	inventory *character.Inventory,
+	reason string,
+	set bson.D,
+) (*character.Inventory, error) {
+	set = append(set, bson.E{"version", bson.D{{"$add", bson.A{bson.D{{"$ifNull", bson.A{"$version", 0}}}, 1}}}})
+	out, err := r.change(ctx, inventory.CharacterId, reason, func(sc mongo.SessionContext) (*character.Inventory, error) {
+		var out *character.Inventory
+		err := r.inventoryCollection().FindOneAndUpdate(
+			sc,
+			versionFilter(inventory),
+			mongo.Pipeline{{{"$set", set}}},
+			options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After),
+		).Decode(&out)
+
+		return out, err
+	})
+
+	// The upsert conflicts with the existing inventory if the version changed
+	if mongo.IsDuplicateKeyError(err) {
+		return nil, character.ErrInventoryVersion
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+// FindHistory implements InventoryRepository.
+func (r *inventoryRepository) FindHistory(
+	ctx context.Context,
+	characterId uint,
+	limit int64,
+) (character.InventoryEvents, error) {
+	cursor, err := r.historyCollection().Find(
+		ctx,
+		bson.D{{"characterId", characterId}},
+		options.Find().SetSort(bson.D{{"createdAt", -1}, {"_id", -1}}).SetLimit(limit),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	events := character.InventoryEvents{}
+	return events, cursor.All(ctx, &events)
+}
+
+// FindLatestEvent implements InventoryRepository.
+func (r *inventoryRepository) FindLatestEvent(
+	ctx context.Context,
+	characterId uint,
+	at time.Time,
+) (event *character.InventoryEvent, err error) {
+	err = r.historyCollection().FindOne(
+		ctx,
+		bson.D{{"characterId", characterId}, {"createdAt", bson.D{{"$lte", at}}}},
+		options.FindOne().SetSort(bson.D{{"createdAt", -1}, {"_id", -1}}),
+	).Decode(&event)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return event, nil
+}
+
+// Migrate implements InventoryRepository.
+func (r *inventoryRepository) Migrate(ctx context.Context) error {
+	_, err := r.historyCollection().Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{{"characterId", 1}, {"createdAt", -1}},
+	})
+
+	return err
+}
+
+// change runs the update of the inventory of the character in a transaction and records the change from the
+// inventory before the update to the inventory the update returns
+func (r *inventoryRepository) change(
+	ctx context.Context,
+	characterId uint,
+	reason string,
+	update func(sc mongo.SessionContext) (*character.Inventory, error),
+) (*character.Inventory, error) {
+	session, err := r.db.Client().StartSession()
+	if err != nil {
+		return nil, err
+	}
+	defer session.EndSession(ctx)
+
+	out, err := session.WithTransaction(ctx, func(sc mongo.SessionContext) (interface{}, error) {
+		before, err := r.currentInventory(sc, characterId)
+		if err != nil {
+			return nil, err
+		}
+
+		after, err := update(sc)
+		if err != nil {
+			return nil, err
+		}
+
+		return after, r.record(sc, before, after, reason)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return out.(*character.Inventory), nil
+}
+
+// record saves the change to the inventory history with the requester as the actor
+func (r *inventoryRepository) record(
+	ctx context.Context,
+	before *character.Inventory,
+	after *character.Inventory,
+	reason string,
+) error {
+	event := character.NewInventoryEvent(before, after, reason)
+	if claims, ok := auth.RetrieveClaims(ctx); ok {
+		event.ActorId = claims.Subject
+		event.ActorName = claims.Username
+	}
+
+	_, err := r.historyCollection().InsertOne(ctx, event)
 	return err
 }
 
+// currentInventory gets the inventory of the character, or an empty inventory if it was never saved
+func (r *inventoryRepository) currentInventory(ctx context.Context, characterId uint) (*character.Inventory, error) {
+	inventory, err := r.GetInventory(ctx, characterId)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return &character.Inventory{CharacterId: characterId}, nil
+	}
+
+	return inventory, err
+}
+
 func (r *inventoryRepository) inventoryCollection() *mongo.Collection {
 	return r.db.Collection("inventories")
 }
+
+func (r *inventoryRepository) historyCollection() *mongo.Collection {
+	return r.db.Collection("inventoryHistory")
+}
+
+func inventoryUpdate(inventory *character.Inventory) bson.D {
+	return bson.D{
+		{"$set", bson.D{
+			{"inventory", inventory.Inventory},
+			{"bank", inventory.Bank},
+			{"gold", inventory.Gold},
+		}},
+		{"$inc", bson.D{{"version", 1}}},
+	}
+}
+
+// versionFilter matches the inventory only if it has the same version
+func versionFilter(inventory *character.Inventory) bson.D {
+	// Inventories saved before versions were added have no version
+	if inventory.Version == 0 {
+		return bson.D{{"_id", inventory.CharacterId}, {"version", bson.D{{"$in", bson.A{0, nil}}}}}
+	}
+
+	return bson.D{{"_id", inventory.CharacterId}, {"version", inventory.Version}}
+}
+
+// itemsUpdate update pipeline expression for the items in the location with the changes applied, the emptied slots
+// removed and the added items appended
+func itemsUpdate(
+	location character.InventoryLocation,
+	changes []slotChange,
+	added ...*character.InventoryItem,
+) bson.D {
+	var items interface{} = bson.D{{"$ifNull", bson.A{"$" + string(location), bson.A{}}}}
+	if len(changes) > 0 {
+		branches := make(bson.A, len(changes))
+		for idx, change := range changes {
+			branches[idx] = bson.D{
+				{"case", bson.D{{"$eq", bson.A{"$$item.slot", change.slot}}}},
+				{"then", bson.D{
+					{"id", "$$item.id"},
+					{"slot", bson.D{{"$literal", change.to}}},
+					{"quantity", bson.D{{"$add", bson.A{"$$item.quantity", change.delta}}}},
+				}},
+			}
+		}
+
+		items = bson.D{{"$map", bson.D{
+			{"input", items},
+			{"as", "item"},
+			{"in", bson.D{{"$switch", bson.D{{"branches", branches}, {"default", "$$item"}}}}},
+		}}}
+	}
+
+	if added == nil {
+		added = []*character.InventoryItem{}
+	}
+
+	return bson.D{{"$concatArrays", bson.A{
+		bson.D{{"$filter", bson.D{
+			{"input", items},
+			{"cond", bson.D{{"$gt", bson.A{"$$this.quantity", 0}}}},
+		}}},
+		bson.D{{"$literal", added}},
+	}}}
+}
@@ -2,10 +2,13 @@ package repository_test
 
 import (
 	"context"
+	"math/rand"
+	"time"
 
 	"github.com/bxcodec/faker/v4"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"go.mongodb.org/mongo-driver/mongo"
 
 	"github.com/ShatteredRealms/go-backend/pkg/model/character"
 )
@@ -14,7 +17,7 @@ var _ = Describe("Inventory repository", func() {
 	createInventory := func() *character.Inventory {
 		inv := &character.Inventory{}
 		Expect(faker.FakeData(&inv)).To(Succeed())
-		Expect(invRepo.UpdateInventory(nil, inv)).To(Succeed())
+		Expect(invRepo.UpdateInventory(context.Background(), inv, "test")).To(Succeed())
 		Expect(inv).NotTo(BeNil())
 
 		return inv
@@ -50,7 +53,7 @@ var _ = Describe("Inventory repository", func() {
 			It("should work with new values", func() {
 				inv := createInventory()
 				inv.CharacterId = inv.CharacterId + 1
-				Expect(invRepo.UpdateInventory(context.Background(), inv)).To(Succeed())
+				Expect(invRepo.UpdateInventory(context.Background(), inv, "test")).To(Succeed())
 
 				out, err := invRepo.GetInventory(nil, inv.CharacterId)
 				Expect(err).NotTo(HaveOccurred())
@@ -65,7 +68,7 @@ var _ = Describe("Inventory repository", func() {
 				Expect(faker.FakeData(newInv)).To(Succeed())
 				newInv.CharacterId = inv.CharacterId
 
-				Expect(invRepo.UpdateInventory(context.Background(), newInv)).To(Succeed())
+				Expect(invRepo.UpdateInventory(context.Background(), newInv, "test")).To(Succeed())
 
 				out, err := invRepo.GetInventory(nil, inv.CharacterId)
 				Expect(err).NotTo(HaveOccurred())
@@ -83,4 +86,284 @@ var _ = Describe("Inventory repository", func() {
 			})
 		})
 	})
+	Describe("SwapInventory", func() {
+		It("should only save unchanged inventories", func() {
+			inv := createInventory()
+			stale := *inv
+
+			inv.Gold = 10
+			ok, err := invRepo.SwapInventory(context.Background(), inv, "test")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ok).To(BeTrue())
+			Expect(inv.Version).To(Equal(stale.Version + 1))
+
+			stale.Gold = 20
+			ok, err = invRepo.SwapInventory(context.Background(), &stale, "test")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ok).To(BeFalse())
+
+			out, err := invRepo.GetInventory(nil, inv.CharacterId)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.Gold).To(BeEquivalentTo(10))
+		})
+
+		It("should create missing inventories", func() {
+			inv := &character.Inventory{CharacterId: uint(rand.Uint32()), Gold: 5}
+			ok, err := invRepo.SwapInventory(context.Background(), inv, "test")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ok).To(BeTrue())
+			Expect(inv.Version).To(BeEquivalentTo(1))
+		})
+	})
+
+	Describe("SendMail", func() {
+		newMail := func(senderId uint) *character.Mail {
+			return &character.Mail{
+				SenderId:    senderId,
+				RecipientId: uint(rand.Uint32()),
+				Subject:     faker.Word(),
+				Attachments: character.InventoryItems{{Id: "potion", Slot: 0, Quantity: 1}},
+				CreatedAt:   time.Now(),
+				ExpiresAt:   time.Now().Add(time.Hour),
+			}
+		}
+
+		It("should save the inventory and create the mail", func() {
+			inv := createInventory()
+			inv.Gold = 10
+			mail := newMail(inv.CharacterId)
+			ok, err := invRepo.SendMail(context.Background(), inv, mail)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ok).To(BeTrue())
+
+			out, err := mailRepo.FindById(context.Background(), mail.Id)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out).NotTo(BeNil())
+			Expect(out.DeleteAt).To(BeNil())
+
+			saved, err := invRepo.GetInventory(nil, inv.CharacterId)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(saved.Gold).To(BeEquivalentTo(10))
+		})
+
+		It("should not create the mail if the inventory changed", func() {
+			inv := createInventory()
+			stale := *inv
+
+			inv.Gold = 10
+			ok, err := invRepo.SwapInventory(context.Background(), inv, "test")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ok).To(BeTrue())
+
+			mail := newMail(inv.CharacterId)
+			ok, err = invRepo.SendMail(context.Background(), &stale, mail)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ok).To(BeFalse())
+
+			out, err := mailRepo.FindById(context.Background(), mail.Id)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out).To(BeNil())
+		})
+	})
+
+	Describe("ClaimMail", func() {
+		createMail := func(recipientId uint, expiresAt time.Time) *character.Mail {
+			mail, err := mailRepo.Create(context.Background(), &character.Mail{
+				SenderId:    uint(rand.Uint32()),
+				RecipientId: recipientId,
+				Subject:     faker.Word(),
+				Attachments: character.InventoryItems{{Id: "potion", Slot: 0, Quantity: 1}},
+				CreatedAt:   time.Now(),
+				ExpiresAt:   expiresAt.Round(time.Millisecond),
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			return mail
+		}
+
+		It("should claim the mail and save the inventory", func() {
+			inv := createInventory()
+			inv.Gold = 10
+			mail := createMail(inv.CharacterId, time.Now().Add(time.Hour))
+
+			ok, err := invRepo.ClaimMail(context.Background(), inv, mail)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ok).To(BeTrue())
+			Expect(mail.Claimed).To(BeTrue())
+			Expect(mail.Read).To(BeTrue())
+
+			out, err := mailRepo.FindById(context.Background(), mail.Id)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.Claimed).To(BeTrue())
+			Expect(out.DeleteAt).NotTo(BeNil())
+			Expect(*out.DeleteAt).To(BeTemporally("~", mail.ExpiresAt, time.Second))
+
+			saved, err := invRepo.GetInventory(nil, inv.CharacterId)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(saved.Gold).To(BeEquivalentTo(10))
+		})
+
+		It("should only succeed once", func() {
+			inv := createInventory()
+			mail := createMail(inv.CharacterId, time.Now().Add(time.Hour))
+
+			ok, err := invRepo.ClaimMail(context.Background(), inv, mail)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ok).To(BeTrue())
+
+			ok, err = invRepo.ClaimMail(context.Background(), inv, mail)
+			Expect(err).To(MatchError(mongo.ErrNoDocuments))
+			Expect(ok).To(BeFalse())
+		})
+
+		It("should not claim the mail if the inventory changed", func() {
+			inv := createInventory()
+			stale := *inv
+
+			inv.Gold = 10
+			ok, err := invRepo.SwapInventory(context.Background(), inv, "test")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ok).To(BeTrue())
+
+			mail := createMail(inv.CharacterId, time.Now().Add(time.Hour))
+			ok, err = invRepo.ClaimMail(context.Background(), &stale, mail)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ok).To(BeFalse())
+			Expect(mail.Claimed).To(BeFalse())
+
+			out, err := mailRepo.FindById(context.Background(), mail.Id)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.Claimed).To(BeFalse())
+			Expect(out.DeleteAt).To(BeNil())
+		})
+	})
+
+	Describe("item operations", func() {
+		var inv *character.Inventory
+
+		BeforeEach(func() {
+			inv = &character.Inventory{CharacterId: uint(rand.Uint32())}
+			var err error
+			inv, err = invRepo.AddItem(context.Background(), inv, character.InventoryLocationInventory,
+				&character.InventoryItem{Id: "potion", Slot: 0, Quantity: 10})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(inv.Version).To(BeEquivalentTo(1))
+		})
+
+		It("should add items", func() {
+			out, err := invRepo.AddItem(context.Background(), inv, character.InventoryLocationBank,
+				&character.InventoryItem{Id: "sword", Slot: 2, Quantity: 1})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.Inventory).To(HaveLen(1))
+			Expect(out.Bank).To(ConsistOf(&character.InventoryItem{Id: "sword", Slot: 2, Quantity: 1}))
+			Expect(out.Version).To(BeEquivalentTo(2))
+		})
+
+		It("should remove items and empty slots", func() {
+			out, err := invRepo.RemoveItem(context.Background(), inv, character.InventoryLocationInventory, 0, 4)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.Inventory).To(ConsistOf(&character.InventoryItem{Id: "potion", Slot: 0, Quantity: 6}))
+
+			out, err = invRepo.RemoveItem(context.Background(), out, character.InventoryLocationInventory, 0, 6)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.Inventory).To(BeEmpty())
+		})
+
+		It("should move and swap items", func() {
+			out, err := invRepo.SplitStack(context.Background(), inv, character.InventoryLocationInventory, 0, 3, 4)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.Inventory).To(ConsistOf(
+				&character.InventoryItem{Id: "potion", Slot: 0, Quantity: 6},
+				&character.InventoryItem{Id: "potion", Slot: 3, Quantity: 4},
+			))
+
+			out, err = invRepo.MoveItem(context.Background(), out, character.InventoryLocationInventory, 0, 3)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.Inventory).To(ConsistOf(
+				&character.InventoryItem{Id: "potion", Slot: 3, Quantity: 6},
+				&character.InventoryItem{Id: "potion", Slot: 0, Quantity: 4},
+			))
+
+			out, err = invRepo.MergeStack(context.Background(), out, character.InventoryLocationInventory, 0, 3, 4)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.Inventory).To(ConsistOf(&character.InventoryItem{Id: "potion", Slot: 3, Quantity: 10}))
+		})
+
+		It("should transfer items to the bank", func() {
+			out, err := invRepo.TransferItem(context.Background(), inv, character.InventoryLocationInventory, 0, 5)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.Inventory).To(BeEmpty())
+			Expect(out.Bank).To(ConsistOf(&character.InventoryItem{Id: "potion", Slot: 5, Quantity: 10}))
+		})
+
+		It("should not change inventories that changed since they were read", func() {
+			_, err := invRepo.RemoveItem(context.Background(), inv, character.InventoryLocationInventory, 0, 1)
+			Expect(err).NotTo(HaveOccurred())
+
+			out, err := invRepo.RemoveItem(context.Background(), inv, character.InventoryLocationInventory, 0, 1)
+			Expect(err).To(MatchError(character.ErrInventoryVersion))
+			Expect(out).To(BeNil())
+
+			out, err = invRepo.GetInventory(context.Background(), inv.CharacterId)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.Inventory).To(ConsistOf(&character.InventoryItem{Id: "potion", Slot: 0, Quantity: 9}))
+		})
+	})
+
+	Describe("history", func() {
+		It("should record every change", func() {
+			inv := &character.Inventory{CharacterId: uint(rand.Uint32()), Gold: 5}
+			Expect(invRepo.UpdateInventory(context.Background(), inv, "set inventory")).To(Succeed())
+
+			out, err := invRepo.AddItem(context.Background(), inv, character.InventoryLocationBank,
+				&character.InventoryItem{Id: "potion", Slot: 1, Quantity: 2})
+			Expect(err).NotTo(HaveOccurred())
+
+			events, err := invRepo.FindHistory(context.Background(), inv.CharacterId, 10)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(events).To(HaveLen(2))
+			Expect(events[0].Reason).To(Equal("add item"))
+			Expect(events[0].Changes).To(ConsistOf(&character.InventoryChange{
+				Location: character.InventoryLocationBank,
+				Slot:     1,
+				After:    &character.InventoryItem{Id: "potion", Slot: 1, Quantity: 2},
+			}))
+			Expect(events[0].After.Version).To(Equal(out.Version))
+			Expect(events[0].After.Bank).To(Equal(out.Bank))
+			Expect(events[1].Reason).To(Equal("set inventory"))
+			Expect(events[1].GoldBefore).To(BeEquivalentTo(0))
+			Expect(events[1].GoldAfter).To(BeEquivalentTo(5))
+		})
+
+		It("should not record failed changes", func() {
+			inv := &character.Inventory{CharacterId: uint(rand.Uint32())}
+			Expect(invRepo.UpdateInventory(context.Background(), inv, "set inventory")).To(Succeed())
+
+			stale := &character.Inventory{CharacterId: inv.CharacterId, Version: inv.Version + 1}
+			ok, err := invRepo.SwapInventory(context.Background(), stale, "test")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ok).To(BeFalse())
+
+			events, err := invRepo.FindHistory(context.Background(), inv.CharacterId, 10)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(events).To(HaveLen(1))
+		})
+
+		It("should find the latest event at a time", func() {
+			inv := &character.Inventory{CharacterId: uint(rand.Uint32())}
+			out, err := invRepo.FindLatestEvent(context.Background(), inv.CharacterId, time.Now())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out).To(BeNil())
+
+			Expect(invRepo.UpdateInventory(context.Background(), inv, "first")).To(Succeed())
+			at := time.Now()
+			time.Sleep(10 * time.Millisecond)
+			Expect(invRepo.UpdateInventory(context.Background(), inv, "second")).To(Succeed())
+
+			out, err = invRepo.FindLatestEvent(context.Background(), inv.CharacterId, at)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out).NotTo(BeNil())
+			Expect(out.Reason).To(Equal("first"))
+		})
+	})
 })
@@ -0,0 +1,89 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/ShatteredRealms/go-backend/pkg/model/character"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type ItemRepository interface {
+	Create(ctx context.Context, item *character.ItemDefinition) (*character.ItemDefinition, error)
+	Save(ctx context.Context, item *character.ItemDefinition) (*character.ItemDefinition, error)
+	Delete(ctx context.Context, id string) error
+
+	// Upsert creates the items or replaces the existing items with the same ids
+	Upsert(ctx context.Context, items character.ItemDefinitions) error
+
+	// FindById gets the item or nil if it does not exist
+	FindById(ctx context.Context, id string) (*character.ItemDefinition, error)
+
+	// FindByIds gets the items with the ids. Ids that do not exist are skipped.
+	FindByIds(ctx context.Context, ids []string) (character.ItemDefinitions, error)
+	FindAll(ctx context.Context) (character.ItemDefinitions, error)
+
+	Migrate(ctx context.Context) error
+}
+
+type itemRepository struct {
+	DB *gorm.DB
+}
+
+func NewItemRepository(db *gorm.DB) ItemRepository {
+	return itemRepository{DB: db}
+}
+
+func (r itemRepository) Create(ctx context.Context, item *character.ItemDefinition) (*character.ItemDefinition, error) {
+	return item, r.DB.WithContext(ctx).Create(item).Error
+}
+
+func (r itemRepository) Save(ctx context.Context, item *character.ItemDefinition) (*character.ItemDefinition, error) {
+	return item, r.DB.WithContext(ctx).Save(item).Error
+}
+
+func (r itemRepository) Delete(ctx context.Context, id string) error {
+	return r.DB.WithContext(ctx).Delete(&character.ItemDefinition{}, "id = ?", id).Error
+}
+
+func (r itemRepository) Upsert(ctx context.Context, items character.ItemDefinitions) error {
+	if len(items) == 0 {
+		return nil
+	}
+
+	return r.DB.WithContext(ctx).
+		Clauses(clause.OnConflict{UpdateAll: true}).
+		Create(&items).Error
+}
+
+func (r itemRepository) FindById(ctx context.Context, id string) (*character.ItemDefinition, error) {
+	var item *character.ItemDefinition
+	result := r.DB.WithContext(ctx).Where("id = ?", id).Limit(1).Find(&item)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return nil, nil
+	}
+
+	return item, nil
+}
+
+func (r itemRepository) FindByIds(ctx context.Context, ids []string) (character.ItemDefinitions, error) {
+	items := character.ItemDefinitions{}
+	if len(ids) == 0 {
+		return items, nil
+	}
+
+	return items, r.DB.WithContext(ctx).Where("id IN ?", ids).Find(&items).Error
+}
+
+func (r itemRepository) FindAll(ctx context.Context) (character.ItemDefinitions, error) {
+	items := character.ItemDefinitions{}
+	return items, r.DB.WithContext(ctx).Order("id").Find(&items).Error
+}
+
+func (r itemRepository) Migrate(ctx context.Context) error {
+	return r.DB.WithContext(ctx).AutoMigrate(&character.ItemDefinition{})
+}
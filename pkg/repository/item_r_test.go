@@ -0,0 +1,76 @@
+package repository_test
+
+import (
+	"context"
+
+	"github.com/bxcodec/faker/v4"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/ShatteredRealms/go-backend/pkg/model/character"
+)
+
+var _ = Describe("Item repository", func() {
+	createItem := func() *character.ItemDefinition {
+		out, err := itemRepo.Create(context.Background(), &character.ItemDefinition{
+			Id:       faker.UUIDDigit(),
+			Name:     faker.Username(),
+			Category: "material",
+			MaxStack: 20,
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out).NotTo(BeNil())
+
+		return out
+	}
+
+	Describe("FindById", func() {
+		It("should find an existing item", func() {
+			item := createItem()
+			out, err := itemRepo.FindById(context.Background(), item.Id)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out).NotTo(BeNil())
+			Expect(out.Name).To(Equal(item.Name))
+		})
+
+		It("should return nil for an unknown item", func() {
+			out, err := itemRepo.FindById(context.Background(), faker.UUIDDigit())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out).To(BeNil())
+		})
+	})
+
+	Describe("FindByIds", func() {
+		It("should skip unknown items", func() {
+			item := createItem()
+			item2 := createItem()
+			out, err := itemRepo.FindByIds(context.Background(), []string{item.Id, item2.Id, faker.UUIDDigit()})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out).To(HaveLen(2))
+		})
+	})
+
+	Describe("Upsert", func() {
+		It("should create new items and replace existing items", func() {
+			item := createItem()
+			item.Name = faker.Username()
+			newItem := &character.ItemDefinition{Id: faker.UUIDDigit(), Name: faker.Username(), MaxStack: 1}
+			Expect(itemRepo.Upsert(context.Background(), character.ItemDefinitions{item, newItem})).To(Succeed())
+
+			out, err := itemRepo.FindByIds(context.Background(), []string{item.Id, newItem.Id})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.ById()).To(HaveLen(2))
+			Expect(out.ById()[item.Id].Name).To(Equal(item.Name))
+		})
+	})
+
+	Describe("Delete", func() {
+		It("should delete the item", func() {
+			item := createItem()
+			Expect(itemRepo.Delete(context.Background(), item.Id)).To(Succeed())
+			out, err := itemRepo.FindById(context.Background(), item.Id)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out).To(BeNil())
+		})
+	})
+})
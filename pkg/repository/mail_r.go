@@ -0,0 +1,145 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/ShatteredRealms/go-backend/pkg/model/character"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	// Mongo error codes returned when dropping an index that does not exist
+	indexNotFoundCode     = 27
+	namespaceNotFoundCode = 26
+)
+
+type MailRepository interface {
+	Create(ctx context.Context, mail *character.Mail) (*character.Mail, error)
+	Delete(ctx context.Context, id primitive.ObjectID) error
+
+	// FindById gets the mail or nil if it does not exist
+	FindById(ctx context.Context, id primitive.ObjectID) (*character.Mail, error)
+
+	// FindForRecipient gets the unexpired mail of the character with the newest first. Expired mail is included while
+	// it has something to claim.
+	FindForRecipient(ctx context.Context, recipientId uint) (character.Mails, error)
+
+	MarkRead(ctx context.Context, id primitive.ObjectID) error
+
+	Migrate(ctx context.Context) error
+}
+
+type mailRepository struct {
+	db *mongo.Database
+}
+
+func NewMailRepository(db *mongo.Database) MailRepository {
+	return &mailRepository{
+		db: db,
+	}
+}
+
+// Create implements MailRepository.
+func (r *mailRepository) Create(ctx context.Context, mail *character.Mail) (*character.Mail, error) {
+	mail.Id = primitive.NewObjectID()
+	mail.ScheduleDelete()
+	_, err := mailCollection(r.db).InsertOne(ctx, mail)
+	if err != nil {
+		return nil, err
+	}
+
+	return mail, nil
+}
+
+// Delete implements MailRepository.
+func (r *mailRepository) Delete(ctx context.Context, id primitive.ObjectID) error {
+	_, err := mailCollection(r.db).DeleteOne(ctx, bson.D{{"_id", id}})
+	return err
+}
+
+// FindById implements MailRepository.
+func (r *mailRepository) FindById(ctx context.Context, id primitive.ObjectID) (mail *character.Mail, err error) {
+	err = mailCollection(r.db).FindOne(ctx, bson.D{{"_id", id}}).Decode(&mail)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return mail, nil
+}
+
+// FindForRecipient implements MailRepository.
+func (r *mailRepository) FindForRecipient(ctx context.Context, recipientId uint) (character.Mails, error) {
+	cursor, err := mailCollection(r.db).Find(
+		ctx,
+		bson.D{
+			{"recipientId", recipientId},
+			{"$or", bson.A{
+				bson.D{{"expiresAt", bson.D{{"$gt", time.Now()}}}},
+				bson.D{{"deleteAt", bson.D{{"$exists", false}}}},
+			}},
+		},
+		options.Find().SetSort(bson.D{{"createdAt", -1}}),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	mails := character.Mails{}
+	return mails, cursor.All(ctx, &mails)
+}
+
+// MarkRead implements MailRepository.
+func (r *mailRepository) MarkRead(ctx context.Context, id primitive.ObjectID) error {
+	_, err := mailCollection(r.db).UpdateByID(ctx, id, bson.D{{"$set", bson.D{{"read", true}}}})
+	return err
+}
+
+// Migrate implements MailRepository.
+func (r *mailRepository) Migrate(ctx context.Context) error {
+	// Mail used to be removed when it expired even with unclaimed attachments
+	_, err := mailCollection(r.db).Indexes().DropOne(ctx, "expiresAt_1")
+	var cmdErr mongo.CommandError
+	if errors.As(err, &cmdErr) && (cmdErr.HasErrorCode(indexNotFoundCode) || cmdErr.HasErrorCode(namespaceNotFoundCode)) {
+		err = nil
+	}
+	if err != nil {
+		return err
+	}
+
+	_, err = mailCollection(r.db).Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys: bson.D{{"recipientId", 1}, {"createdAt", -1}},
+		},
+		{
+			// Mail with nothing to claim is removed by mongo once it expires
+			Keys:    bson.D{{"deleteAt", 1}},
+			Options: options.Index().SetExpireAfterSeconds(0),
+		},
+	})
+
+	return err
+}
+
+func mailCollection(db *mongo.Database) *mongo.Collection {
+	return db.Collection("mail")
+}
+
+// claimUpdate update pipeline marking mail claimed and read. Claimed mail has nothing left to claim, so it is removed
+// when it expires or right away if it already expired.
+func claimUpdate() mongo.Pipeline {
+	return mongo.Pipeline{
+		{{"$set", bson.D{
+			{"claimed", true},
+			{"read", true},
+			{"deleteAt", bson.D{{"$max", bson.A{"$expiresAt", "$$NOW"}}}},
+		}}},
+	}
+}
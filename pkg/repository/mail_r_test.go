@@ -0,0 +1,109 @@
+package repository_test
+
+import (
+	"context"
+	"math/rand"
+	"time"
+
+	"github.com/bxcodec/faker/v4"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/ShatteredRealms/go-backend/pkg/model/character"
+)
+
+var _ = Describe("Mail repository", func() {
+	createMail := func(recipientId uint, expiresAt time.Time) *character.Mail {
+		mail := &character.Mail{
+			SenderId:    uint(rand.Uint32()) + 1,
+			SenderName:  faker.Username(),
+			RecipientId: recipientId,
+			Subject:     faker.Word(),
+			Body:        faker.Sentence(),
+			Attachments: character.InventoryItems{
+				{Id: faker.UUIDHyphenated(), Slot: 0, Quantity: 1},
+			},
+			Gold:      10,
+			CreatedAt: time.Now().Round(time.Millisecond),
+			ExpiresAt: expiresAt.Round(time.Millisecond),
+		}
+
+		out, err := mailRepo.Create(context.Background(), mail)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out).NotTo(BeNil())
+		Expect(out.Id.IsZero()).To(BeFalse())
+
+		return out
+	}
+
+	Describe("FindById", func() {
+		It("should find created mail", func() {
+			mail := createMail(uint(rand.Uint32()), time.Now().Add(time.Hour))
+			out, err := mailRepo.FindById(context.Background(), mail.Id)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out).NotTo(BeNil())
+			Expect(out.Subject).To(Equal(mail.Subject))
+			Expect(out.Attachments).To(HaveLen(1))
+		})
+
+		It("should return nil if it does not exist", func() {
+			out, err := mailRepo.FindById(context.Background(), primitive.NewObjectID())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out).To(BeNil())
+		})
+	})
+
+	Describe("FindForRecipient", func() {
+		It("should find the newest mail first", func() {
+			recipientId := uint(rand.Uint32())
+			older := createMail(recipientId, time.Now().Add(time.Hour))
+			newer := createMail(recipientId, time.Now().Add(time.Hour))
+
+			out, err := mailRepo.FindForRecipient(context.Background(), recipientId)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out).To(HaveLen(2))
+			Expect(out[0].Id).To(Equal(newer.Id))
+			Expect(out[1].Id).To(Equal(older.Id))
+		})
+
+		It("should only find expired mail until it is claimed", func() {
+			mail := createMail(uint(rand.Uint32()), time.Now().Add(-time.Hour))
+			Expect(mail.DeleteAt).To(BeNil())
+
+			out, err := mailRepo.FindForRecipient(context.Background(), mail.RecipientId)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out).To(HaveLen(1))
+
+			ok, err := invRepo.ClaimMail(context.Background(), &character.Inventory{CharacterId: mail.RecipientId}, mail)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ok).To(BeTrue())
+
+			out, err = mailRepo.FindForRecipient(context.Background(), mail.RecipientId)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out).To(BeEmpty())
+		})
+	})
+
+	Describe("MarkRead", func() {
+		It("should mark the mail as read", func() {
+			mail := createMail(uint(rand.Uint32()), time.Now().Add(time.Hour))
+			Expect(mailRepo.MarkRead(context.Background(), mail.Id)).To(Succeed())
+
+			out, err := mailRepo.FindById(context.Background(), mail.Id)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.Read).To(BeTrue())
+		})
+	})
+
+	Describe("Delete", func() {
+		It("should delete the mail", func() {
+			mail := createMail(uint(rand.Uint32()), time.Now().Add(time.Hour))
+			Expect(mailRepo.Delete(context.Background(), mail.Id)).To(Succeed())
+
+			out, err := mailRepo.FindById(context.Background(), mail.Id)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out).To(BeNil())
+		})
+	})
+})
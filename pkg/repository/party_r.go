@@ -0,0 +1,237 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/ShatteredRealms/go-backend/pkg/model/gamebackend"
+	"github.com/ShatteredRealms/go-backend/pkg/srospan"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type PartyRepository interface {
+	// Create creates the party and adds the leader as its first member
+	Create(ctx context.Context, party *gamebackend.Party) (*gamebackend.Party, error)
+	Save(ctx context.Context, party *gamebackend.Party) (*gamebackend.Party, error)
+	// Delete deletes the party along with its members and invites
+	Delete(ctx context.Context, party *gamebackend.Party) error
+
+	FindById(ctx context.Context, id uint) (*gamebackend.Party, error)
+	FindByCharacter(ctx context.Context, characterId uint) (*gamebackend.Party, error)
+
+	// AddMember adds the character to the party and removes all of its pending party invites unless the party already
+	// has maxSize members. Returns false if the party is full.
+	AddMember(ctx context.Context, member *gamebackend.PartyMember, maxSize int) (bool, error)
+	RemoveMember(ctx context.Context, partyId uint, characterId uint) error
+
+	CreateInvite(ctx context.Context, invite *gamebackend.PartyInvite) error
+	FindInvite(ctx context.Context, partyId uint, characterId uint) (*gamebackend.PartyInvite, error)
+	FindInvitesForCharacter(ctx context.Context, characterId uint) (gamebackend.PartyInvites, error)
+	FindInvitesForParty(ctx context.Context, partyId uint) (gamebackend.PartyInvites, error)
+	DeleteInvite(ctx context.Context, partyId uint, characterId uint) error
+
+	Migrate(ctx context.Context) error
+}
+
+type partyRepository struct {
+	DB *gorm.DB
+}
+
+func NewPartyRepository(db *gorm.DB) PartyRepository {
+	return partyRepository{DB: db}
+}
+
+func (r partyRepository) Create(ctx context.Context, party *gamebackend.Party) (*gamebackend.Party, error) {
+	// Set the ID to zero so the database can generate the value
+	party.ID = 0
+
+	err := r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit("Members").Create(party).Error; err != nil {
+			return err
+		}
+
+		leader := &gamebackend.PartyMember{
+			CharacterId: party.LeaderId,
+			PartyId:     party.ID,
+		}
+		if err := tx.Create(leader).Error; err != nil {
+			return err
+		}
+
+		party.Members = gamebackend.PartyMembers{leader}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	trace.SpanFromContext(ctx).SetAttributes(srospan.PartyAttributes(party)...)
+	return party, nil
+}
+
+func (r partyRepository) Save(ctx context.Context, party *gamebackend.Party) (*gamebackend.Party, error) {
+	trace.SpanFromContext(ctx).SetAttributes(srospan.PartyAttributes(party)...)
+	return party, r.DB.WithContext(ctx).Omit("Members").Save(party).Error
+}
+
+func (r partyRepository) Delete(ctx context.Context, party *gamebackend.Party) error {
+	trace.SpanFromContext(ctx).SetAttributes(srospan.PartyAttributes(party)...)
+
+	return r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Delete(&gamebackend.PartyInvite{}, "party_id = ?", party.ID).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Delete(&gamebackend.PartyMember{}, "party_id = ?", party.ID).Error; err != nil {
+			return err
+		}
+
+		return tx.Delete(&gamebackend.Party{}, party.ID).Error
+	})
+}
+
+func (r partyRepository) FindById(ctx context.Context, id uint) (*gamebackend.Party, error) {
+	var party *gamebackend.Party
+	result := r.DB.WithContext(ctx).
+		Preload("Members", func(db *gorm.DB) *gorm.DB {
+			return db.Order("created_at")
+		}).
+		Where("id = ?", id).
+		Limit(1).
+		Find(&party)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return nil, nil
+	}
+
+	trace.SpanFromContext(ctx).SetAttributes(srospan.PartyAttributes(party)...)
+	return party, nil
+}
+
+func (r partyRepository) FindByCharacter(ctx context.Context, characterId uint) (*gamebackend.Party, error) {
+	var member *gamebackend.PartyMember
+	result := r.DB.WithContext(ctx).Where("character_id = ?", characterId).Limit(1).Find(&member)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return nil, nil
+	}
+
+	return r.FindById(ctx, member.PartyId)
+}
+
+func (r partyRepository) AddMember(ctx context.Context, member *gamebackend.PartyMember, maxSize int) (bool, error) {
+	trace.SpanFromContext(ctx).SetAttributes(
+		srospan.PartyId(int(member.PartyId)),
+		srospan.TargetCharacterId(int(member.CharacterId)),
+	)
+
+	added := false
+	err := r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Lock the party so concurrent joins cannot exceed the max size
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id = ?", member.PartyId).
+			First(&gamebackend.Party{}).
+			Error
+		if err != nil {
+			return err
+		}
+
+		var count int64
+		err = tx.Model(&gamebackend.PartyMember{}).Where("party_id = ?", member.PartyId).Count(&count).Error
+		if err != nil {
+			return err
+		}
+		if count >= int64(maxSize) {
+			return nil
+		}
+
+		if err := tx.Delete(&gamebackend.PartyInvite{}, "character_id = ?", member.CharacterId).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Create(member).Error; err != nil {
+			return err
+		}
+
+		added = true
+		return nil
+	})
+
+	return added, err
+}
+
+func (r partyRepository) RemoveMember(ctx context.Context, partyId uint, characterId uint) error {
+	trace.SpanFromContext(ctx).SetAttributes(
+		srospan.PartyId(int(partyId)),
+		srospan.TargetCharacterId(int(characterId)),
+	)
+
+	return r.DB.WithContext(ctx).
+		Delete(&gamebackend.PartyMember{}, "party_id = ? AND character_id = ?", partyId, characterId).
+		Error
+}
+
+func (r partyRepository) CreateInvite(ctx context.Context, invite *gamebackend.PartyInvite) error {
+	trace.SpanFromContext(ctx).SetAttributes(
+		srospan.PartyId(int(invite.PartyId)),
+		srospan.TargetCharacterId(int(invite.CharacterId)),
+	)
+
+	return r.DB.WithContext(ctx).Omit("Party").Create(invite).Error
+}
+
+func (r partyRepository) FindInvite(ctx context.Context, partyId uint, characterId uint) (*gamebackend.PartyInvite, error) {
+	var invite *gamebackend.PartyInvite
+	result := r.DB.WithContext(ctx).
+		Where("party_id = ? AND character_id = ?", partyId, characterId).
+		Limit(1).
+		Find(&invite)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return nil, nil
+	}
+
+	return invite, nil
+}
+
+func (r partyRepository) FindInvitesForCharacter(ctx context.Context, characterId uint) (gamebackend.PartyInvites, error) {
+	var invites gamebackend.PartyInvites
+	return invites, r.DB.WithContext(ctx).
+		Where("character_id = ?", characterId).
+		Order("created_at").
+		Find(&invites).
+		Error
+}
+
+func (r partyRepository) FindInvitesForParty(ctx context.Context, partyId uint) (gamebackend.PartyInvites, error) {
+	var invites gamebackend.PartyInvites
+	return invites, r.DB.WithContext(ctx).
+		Where("party_id = ?", partyId).
+		Order("created_at").
+		Find(&invites).
+		Error
+}
+
+func (r partyRepository) DeleteInvite(ctx context.Context, partyId uint, characterId uint) error {
+	return r.DB.WithContext(ctx).
+		Delete(&gamebackend.PartyInvite{}, "party_id = ? AND character_id = ?", partyId, characterId).
+		Error
+}
+
+func (r partyRepository) Migrate(ctx context.Context) error {
+	return r.DB.WithContext(ctx).AutoMigrate(
+		&gamebackend.Party{},
+		&gamebackend.PartyMember{},
+		&gamebackend.PartyInvite{},
+	)
+}
@@ -0,0 +1,130 @@
+package repository_test
+
+import (
+	"context"
+	"math/rand"
+
+	"github.com/bxcodec/faker/v4"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/ShatteredRealms/go-backend/pkg/model/gamebackend"
+)
+
+var _ = Describe("Party repository", func() {
+	var (
+		ctx = context.Background()
+
+		createParty = func() *gamebackend.Party {
+			party, err := partyRepo.Create(ctx, &gamebackend.Party{
+				LeaderId:  uint(rand.Uint32()),
+				Dimension: faker.Username(),
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(party).NotTo(BeNil())
+			Expect(party.ID).NotTo(BeZero())
+
+			return party
+		}
+	)
+
+	Describe("Create", func() {
+		It("should add the leader", func() {
+			party := createParty()
+			out, err := partyRepo.FindByCharacter(ctx, party.LeaderId)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out).NotTo(BeNil())
+			Expect(out.ID).To(Equal(party.ID))
+			Expect(out.CharacterIds()).To(Equal([]uint{party.LeaderId}))
+		})
+
+		It("should not allow the leader to be in another party", func() {
+			party := createParty()
+			_, err := partyRepo.Create(ctx, &gamebackend.Party{
+				LeaderId:  party.LeaderId,
+				Dimension: party.Dimension,
+			})
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("Members", func() {
+		It("should add members until full and remove their invites", func() {
+			party := createParty()
+			characterId := uint(rand.Uint32())
+			Expect(partyRepo.CreateInvite(ctx, &gamebackend.PartyInvite{
+				PartyId:     party.ID,
+				CharacterId: characterId,
+				InviterId:   party.LeaderId,
+			})).To(Succeed())
+
+			invites, err := partyRepo.FindInvitesForCharacter(ctx, characterId)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(invites).To(HaveLen(1))
+
+			invites, err = partyRepo.FindInvitesForParty(ctx, party.ID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(invites).To(HaveLen(1))
+
+			added, err := partyRepo.AddMember(ctx, &gamebackend.PartyMember{
+				CharacterId: characterId,
+				PartyId:     party.ID,
+			}, 2)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(added).To(BeTrue())
+
+			invite, err := partyRepo.FindInvite(ctx, party.ID, characterId)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(invite).To(BeNil())
+
+			invites, err = partyRepo.FindInvitesForParty(ctx, party.ID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(invites).To(BeEmpty())
+
+			added, err = partyRepo.AddMember(ctx, &gamebackend.PartyMember{
+				CharacterId: uint(rand.Uint32()),
+				PartyId:     party.ID,
+			}, 2)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(added).To(BeFalse())
+
+			out, err := partyRepo.FindById(ctx, party.ID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.CharacterIds()).To(Equal([]uint{party.LeaderId, characterId}))
+
+			Expect(partyRepo.RemoveMember(ctx, party.ID, characterId)).To(Succeed())
+			out, err = partyRepo.FindByCharacter(ctx, characterId)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out).To(BeNil())
+		})
+	})
+
+	Describe("Delete", func() {
+		It("should delete the party with its members and invites", func() {
+			party := createParty()
+			characterId := uint(rand.Uint32())
+			Expect(partyRepo.CreateInvite(ctx, &gamebackend.PartyInvite{
+				PartyId:     party.ID,
+				CharacterId: characterId,
+			})).To(Succeed())
+
+			party.ChatChannelId = 10
+			_, err := partyRepo.Save(ctx, party)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(partyRepo.Delete(ctx, party)).To(Succeed())
+
+			out, err := partyRepo.FindById(ctx, party.ID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out).To(BeNil())
+
+			out, err = partyRepo.FindByCharacter(ctx, party.LeaderId)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out).To(BeNil())
+
+			invite, err := partyRepo.FindInvite(ctx, party.ID, characterId)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(invite).To(BeNil())
+		})
+	})
+})
@@ -0,0 +1,43 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ShatteredRealms/go-backend/pkg/config"
+	"github.com/ShatteredRealms/go-backend/pkg/log"
+	"github.com/redis/go-redis/extra/redisotel/v9"
+	"github.com/redis/go-redis/v9"
+)
+
+// ConnectRedis creates an instrumented client for the redis cluster
+func ConnectRedis(ctx context.Context, dbPoolConf config.DBPoolConfig) (*redis.ClusterClient, error) {
+	rdb := redis.NewClusterClient(&redis.ClusterOptions{
+		Addrs: dbPoolConf.Addresses(),
+		OnConnect: func(ctx context.Context, cn *redis.Conn) error {
+			withPassword := "no password"
+			if dbPoolConf.Master.Password != "" {
+				withPassword = "password"
+			}
+			withUsername := "no username"
+			if dbPoolConf.Master.Username != "" {
+				withUsername = "a username"
+			}
+			log.Logger.WithContext(ctx).Debugf("Connecting to redis with %s and %s", withUsername, withPassword)
+			_, err := cn.Ping(context.Background()).Result()
+			return err
+		},
+		Username: dbPoolConf.Master.Username,
+		Password: dbPoolConf.Master.Password,
+	})
+
+	if err := redisotel.InstrumentTracing(rdb); err != nil {
+		return nil, fmt.Errorf("tracing instrumentation: %w", err)
+	}
+
+	if err := redisotel.InstrumentMetrics(rdb); err != nil {
+		return nil, fmt.Errorf("metrics instrumentation: %w", err)
+	}
+
+	return rdb, nil
+}
@@ -37,7 +37,12 @@ var (
 	characterRepo   repository.CharacterRepository
 	chatRepo        repository.ChatRepository
 	gamebackendRepo repository.GamebackendRepository
+	guildRepo       repository.GuildRepository
 	invRepo         repository.InventoryRepository
+	itemRepo        repository.ItemRepository
+	mailRepo        repository.MailRepository
+	partyRepo       repository.PartyRepository
+	tradeRepo       repository.TradeRepository
 )
 
 func TestRepository(t *testing.T) {
@@ -81,6 +86,30 @@ func TestRepository(t *testing.T) {
 		Expect(gamebackendRepo).NotTo(BeNil())
 		Expect(gamebackendRepo.Migrate(context.Background())).NotTo(HaveOccurred())
 
+		guildRepo = repository.NewGuildRepository(gdb)
+		Expect(guildRepo).NotTo(BeNil())
+		Expect(guildRepo.Migrate(context.Background())).NotTo(HaveOccurred())
+
+		invRepo = repository.NewInventoryRepository(mdb)
+		Expect(invRepo).NotTo(BeNil())
+		Expect(invRepo.Migrate(context.Background())).NotTo(HaveOccurred())
+
+		itemRepo = repository.NewItemRepository(gdb)
+		Expect(itemRepo).NotTo(BeNil())
+		Expect(itemRepo.Migrate(context.Background())).NotTo(HaveOccurred())
+
+		mailRepo = repository.NewMailRepository(mdb)
+		Expect(mailRepo).NotTo(BeNil())
+		Expect(mailRepo.Migrate(context.Background())).NotTo(HaveOccurred())
+
+		partyRepo = repository.NewPartyRepository(gdb)
+		Expect(partyRepo).NotTo(BeNil())
+		Expect(partyRepo.Migrate(context.Background())).NotTo(HaveOccurred())
+
+		tradeRepo = repository.NewTradeRepository(mdb)
+		Expect(tradeRepo).NotTo(BeNil())
+		Expect(tradeRepo.Migrate(context.Background())).NotTo(HaveOccurred())
+
 		var buf bytes.Buffer
 		enc := gob.NewEncoder(&buf)
 		Expect(enc.Encode(data)).To(Succeed())
@@ -105,8 +134,18 @@ func TestRepository(t *testing.T) {
 		Expect(chatRepo).NotTo(BeNil())
 		gamebackendRepo = repository.NewGamebackendRepository(gdb)
 		Expect(gamebackendRepo).NotTo(BeNil())
+		guildRepo = repository.NewGuildRepository(gdb)
+		Expect(guildRepo).NotTo(BeNil())
 		invRepo = repository.NewInventoryRepository(mdb)
 		Expect(invRepo).NotTo(BeNil())
+		itemRepo = repository.NewItemRepository(gdb)
+		Expect(itemRepo).NotTo(BeNil())
+		mailRepo = repository.NewMailRepository(mdb)
+		Expect(mailRepo).NotTo(BeNil())
+		partyRepo = repository.NewPartyRepository(gdb)
+		Expect(partyRepo).NotTo(BeNil())
+		tradeRepo = repository.NewTradeRepository(mdb)
+		Expect(tradeRepo).NotTo(BeNil())
 	})
 
 	BeforeEach(func() {
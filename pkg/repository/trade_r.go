@@ -0,0 +1,206 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/ShatteredRealms/go-backend/pkg/model/character"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type TradeRepository interface {
+	// Create saves a new open trade. Fails with a duplicate key error if either trader already has an open trade.
+	Create(ctx context.Context, trade *character.Trade) (*character.Trade, error)
+
+	// FindById gets the trade or nil if it does not exist
+	FindById(ctx context.Context, id primitive.ObjectID) (*character.Trade, error)
+
+	// FindOpenForCharacter gets the open trade of the character or nil if it is not trading
+	FindOpenForCharacter(ctx context.Context, characterId uint) (*character.Trade, error)
+
+	// FindCompletedForCharacter gets the completed trades of the character with the newest first
+	FindCompletedForCharacter(ctx context.Context, characterId uint) (character.Trades, error)
+
+	// SetOffer replaces the offer of a trader and removes the confirmations of both sides. Returns nil if the trade
+	// is not open.
+	SetOffer(ctx context.Context, trade *character.Trade, offer *character.TradeOffer) (*character.Trade, error)
+
+	// Confirm confirms the offers for the trader if the trade is open and still at the revision. Returns nil
+	// otherwise.
+	Confirm(ctx context.Context, trade *character.Trade, characterId uint, revision uint64) (*character.Trade, error)
+
+	// Cancel cancels the trade if it is open. Returns nil if the trade is not open.
+	Cancel(ctx context.Context, id primitive.ObjectID) (*character.Trade, error)
+
+	Migrate(ctx context.Context) error
+}
+
+type tradeRepository struct {
+	db *mongo.Database
+}
+
+func NewTradeRepository(db *mongo.Database) TradeRepository {
+	return &tradeRepository{
+		db: db,
+	}
+}
+
+// Create implements TradeRepository.
+func (r *tradeRepository) Create(ctx context.Context, trade *character.Trade) (*character.Trade, error) {
+	trade.Id = primitive.NewObjectID()
+	_, err := r.tradeCollection().InsertOne(ctx, trade)
+	if err != nil {
+		return nil, err
+	}
+
+	return trade, nil
+}
+
+// FindById implements TradeRepository.
+func (r *tradeRepository) FindById(ctx context.Context, id primitive.ObjectID) (*character.Trade, error) {
+	return r.findOne(ctx, bson.D{{"_id", id}})
+}
+
+// FindOpenForCharacter implements TradeRepository.
+func (r *tradeRepository) FindOpenForCharacter(ctx context.Context, characterId uint) (*character.Trade, error) {
+	return r.findOne(ctx, bson.D{{"traders", characterId}, {"status", character.TradeStatusOpen}})
+}
+
+// FindCompletedForCharacter implements TradeRepository.
+func (r *tradeRepository) FindCompletedForCharacter(ctx context.Context, characterId uint) (character.Trades, error) {
+	cursor, err := r.tradeCollection().Find(
+		ctx,
+		bson.D{{"traders", characterId}, {"status", character.TradeStatusCompleted}},
+		options.Find().SetSort(bson.D{{"completedAt", -1}}),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	trades := character.Trades{}
+	return trades, cursor.All(ctx, &trades)
+}
+
+// SetOffer implements TradeRepository.
+func (r *tradeRepository) SetOffer(
+	ctx context.Context,
+	trade *character.Trade,
+	offer *character.TradeOffer,
+) (*character.Trade, error) {
+	side := tradeSide(trade, offer.CharacterId)
+	return r.findOneAndUpdate(
+		ctx,
+		bson.D{{"_id", trade.Id}, {"status", character.TradeStatusOpen}},
+		bson.D{
+			{"$set", bson.D{
+				{side + ".items", offer.Items},
+				{side + ".gold", offer.Gold},
+				{"initiator.confirmed", false},
+				{"partner.confirmed", false},
+				{"updatedAt", time.Now()},
+			}},
+			{"$inc", bson.D{{"revision", 1}}},
+		},
+	)
+}
+
+// Confirm implements TradeRepository.
+func (r *tradeRepository) Confirm(
+	ctx context.Context,
+	trade *character.Trade,
+	characterId uint,
+	revision uint64,
+) (*character.Trade, error) {
+	return r.findOneAndUpdate(
+		ctx,
+		bson.D{{"_id", trade.Id}, {"status", character.TradeStatusOpen}, {"revision", revision}},
+		bson.D{{"$set", bson.D{
+			{tradeSide(trade, characterId) + ".confirmed", true},
+			{"updatedAt", time.Now()},
+		}}},
+	)
+}
+
+// Cancel implements TradeRepository.
+func (r *tradeRepository) Cancel(ctx context.Context, id primitive.ObjectID) (*character.Trade, error) {
+	return r.findOneAndUpdate(
+		ctx,
+		bson.D{{"_id", id}, {"status", character.TradeStatusOpen}},
+		bson.D{{"$set", bson.D{
+			{"status", character.TradeStatusCancelled},
+			{"updatedAt", time.Now()},
+		}}},
+	)
+}
+
+// Migrate implements TradeRepository.
+func (r *tradeRepository) Migrate(ctx context.Context) error {
+	_, err := r.tradeCollection().Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			// A character can only be in one open trade
+			Keys: bson.D{{"traders", 1}},
+			Options: options.Index().
+				SetUnique(true).
+				SetPartialFilterExpression(bson.D{{"status", character.TradeStatusOpen}}),
+		},
+		{
+			Keys: bson.D{{"traders", 1}, {"status", 1}, {"completedAt", -1}},
+		},
+	})
+
+	return err
+}
+
+func (r *tradeRepository) findOne(ctx context.Context, filter bson.D) (trade *character.Trade, err error) {
+	err = r.tradeCollection().FindOne(ctx, filter).Decode(&trade)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return trade, nil
+}
+
+func (r *tradeRepository) findOneAndUpdate(
+	ctx context.Context,
+	filter bson.D,
+	update bson.D,
+) (trade *character.Trade, err error) {
+	err = r.tradeCollection().FindOneAndUpdate(
+		ctx,
+		filter,
+		update,
+		options.FindOneAndUpdate().SetReturnDocument(options.After),
+	).Decode(&trade)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return trade, nil
+}
+
+func (r *tradeRepository) tradeCollection() *mongo.Collection {
+	return tradeCollection(r.db)
+}
+
+func tradeCollection(db *mongo.Database) *mongo.Collection {
+	return db.Collection("trades")
+}
+
+// tradeSide name of the field holding the offer of the character
+func tradeSide(trade *character.Trade, characterId uint) string {
+	if trade.Initiator.CharacterId == characterId {
+		return "initiator"
+	}
+
+	return "partner"
+}
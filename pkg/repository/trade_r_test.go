@@ -0,0 +1,196 @@
+package repository_test
+
+import (
+	"context"
+	"math/rand"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/ShatteredRealms/go-backend/pkg/model/character"
+)
+
+// testValidator accepts every inventory unless it has an error to return
+type testValidator struct {
+	capacity uint32
+	err      error
+}
+
+func (v testValidator) Capacity() uint32 {
+	return v.capacity
+}
+
+func (v testValidator) Validate(context.Context, *character.Inventory) error {
+	return v.err
+}
+
+var _ = Describe("Trade repository", func() {
+	validator := testValidator{capacity: 10}
+
+	createTrade := func() *character.Trade {
+		initiatorId := uint(rand.Uint32()) + 1
+		partnerId := initiatorId + 1
+		out, err := tradeRepo.Create(context.Background(), &character.Trade{
+			Status:    character.TradeStatusOpen,
+			Initiator: &character.TradeOffer{CharacterId: initiatorId, Items: character.InventoryItems{}},
+			Partner:   &character.TradeOffer{CharacterId: partnerId, Items: character.InventoryItems{}},
+			Traders:   []uint{initiatorId, partnerId},
+			CreatedAt: time.Now(),
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out).NotTo(BeNil())
+
+		return out
+	}
+
+	Describe("Create", func() {
+		It("should not allow two open trades for a character", func() {
+			trade := createTrade()
+			_, err := tradeRepo.Create(context.Background(), &character.Trade{
+				Status:    character.TradeStatusOpen,
+				Initiator: &character.TradeOffer{CharacterId: trade.Partner.CharacterId},
+				Partner:   &character.TradeOffer{CharacterId: 1},
+				Traders:   []uint{trade.Partner.CharacterId, 1},
+			})
+			Expect(mongo.IsDuplicateKeyError(err)).To(BeTrue())
+		})
+
+		It("should allow a new trade once the previous closed", func() {
+			trade := createTrade()
+			out, err := tradeRepo.Cancel(context.Background(), trade.Id)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.Status).To(Equal(character.TradeStatusCancelled))
+
+			_, err = tradeRepo.Create(context.Background(), &character.Trade{
+				Status:    character.TradeStatusOpen,
+				Initiator: &character.TradeOffer{CharacterId: trade.Initiator.CharacterId},
+				Partner:   &character.TradeOffer{CharacterId: trade.Partner.CharacterId},
+				Traders:   trade.Traders,
+			})
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+
+	Describe("FindOpenForCharacter", func() {
+		It("should find the trade for either trader", func() {
+			trade := createTrade()
+			for _, id := range trade.Traders {
+				out, err := tradeRepo.FindOpenForCharacter(context.Background(), id)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(out).NotTo(BeNil())
+				Expect(out.Id).To(Equal(trade.Id))
+			}
+		})
+	})
+
+	Describe("SetOffer", func() {
+		It("should replace the offer and remove confirmations", func() {
+			trade := createTrade()
+			confirmed, err := tradeRepo.Confirm(context.Background(), trade, trade.Partner.CharacterId, trade.Revision)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(confirmed.Partner.Confirmed).To(BeTrue())
+
+			out, err := tradeRepo.SetOffer(context.Background(), trade, &character.TradeOffer{
+				CharacterId: trade.Initiator.CharacterId,
+				Items:       character.InventoryItems{{Id: "potion", Slot: 1, Quantity: 2}},
+				Gold:        5,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.Revision).To(Equal(trade.Revision + 1))
+			Expect(out.Initiator.Items).To(HaveLen(1))
+			Expect(out.Initiator.Gold).To(BeEquivalentTo(5))
+			Expect(out.Partner.Confirmed).To(BeFalse())
+		})
+	})
+
+	Describe("Confirm", func() {
+		It("should not confirm an old revision", func() {
+			trade := createTrade()
+			out, err := tradeRepo.Confirm(context.Background(), trade, trade.Initiator.CharacterId, trade.Revision+1)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out).To(BeNil())
+		})
+	})
+
+	Describe("Trade", func() {
+		It("should exchange the offers and complete the trade", func() {
+			trade := createTrade()
+			Expect(invRepo.UpdateInventory(context.Background(), &character.Inventory{
+				CharacterId: trade.Initiator.CharacterId,
+				Inventory:   character.InventoryItems{{Id: "potion", Slot: 1, Quantity: 5}},
+				Gold:        10,
+			}, "test")).To(Succeed())
+
+			trade, err := tradeRepo.SetOffer(context.Background(), trade, &character.TradeOffer{
+				CharacterId: trade.Initiator.CharacterId,
+				Items:       character.InventoryItems{{Id: "potion", Slot: 1, Quantity: 2}},
+				Gold:        10,
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			out, err := invRepo.Trade(context.Background(), trade, validator)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.Status).To(Equal(character.TradeStatusCompleted))
+
+			initiator, err := invRepo.GetInventory(context.Background(), trade.Initiator.CharacterId)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(initiator.Gold).To(BeZero())
+			Expect(initiator.Item(1).Quantity).To(BeEquivalentTo(3))
+
+			partner, err := invRepo.GetInventory(context.Background(), trade.Partner.CharacterId)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(partner.Gold).To(BeEquivalentTo(10))
+			Expect(partner.Item(0).Id).To(Equal("potion"))
+
+			history, err := tradeRepo.FindCompletedForCharacter(context.Background(), trade.Partner.CharacterId)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(history).To(HaveLen(1))
+
+			_, err = invRepo.Trade(context.Background(), trade, validator)
+			Expect(err).To(MatchError(character.ErrTradeClosed))
+		})
+
+		It("should not change either inventory if an item is missing", func() {
+			trade := createTrade()
+			trade.Partner.Items = character.InventoryItems{{Id: "shield", Slot: 0, Quantity: 1}}
+
+			_, err := invRepo.Trade(context.Background(), trade, validator)
+			Expect(err).To(MatchError(character.ErrTradeItemChanged))
+
+			_, err = invRepo.GetInventory(context.Background(), trade.Initiator.CharacterId)
+			Expect(err).To(MatchError(mongo.ErrNoDocuments))
+
+			out, err := tradeRepo.FindById(context.Background(), trade.Id)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.IsOpen()).To(BeTrue())
+		})
+
+		It("should not change either inventory if a trader has no room", func() {
+			trade := createTrade()
+			Expect(invRepo.UpdateInventory(context.Background(), &character.Inventory{
+				CharacterId: trade.Initiator.CharacterId,
+				Inventory:   character.InventoryItems{{Id: "potion", Slot: 0, Quantity: 5}},
+			}, "test")).To(Succeed())
+			trade.Initiator.Items = character.InventoryItems{{Id: "potion", Slot: 0, Quantity: 2}}
+
+			_, err := invRepo.Trade(context.Background(), trade, testValidator{capacity: 0})
+			Expect(err).To(MatchError(character.ErrInventoryFull))
+
+			initiator, err := invRepo.GetInventory(context.Background(), trade.Initiator.CharacterId)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(initiator.Item(0).Quantity).To(BeEquivalentTo(5))
+		})
+
+		It("should not change either inventory if validation fails", func() {
+			trade := createTrade()
+			_, err := invRepo.Trade(context.Background(), trade, testValidator{capacity: 10, err: character.ErrInventoryUnknownItem})
+			Expect(err).To(MatchError(character.ErrInventoryUnknownItem))
+
+			out, err := tradeRepo.FindById(context.Background(), trade.Id)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.IsOpen()).To(BeTrue())
+		})
+	})
+})
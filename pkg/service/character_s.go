@@ -2,18 +2,69 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/ShatteredRealms/go-backend/pkg/common"
+	"github.com/ShatteredRealms/go-backend/pkg/config"
 	"github.com/ShatteredRealms/go-backend/pkg/log"
 	"github.com/ShatteredRealms/go-backend/pkg/model/character"
+	"github.com/ShatteredRealms/go-backend/pkg/model/game"
 	"github.com/ShatteredRealms/go-backend/pkg/pb"
 	"github.com/ShatteredRealms/go-backend/pkg/repository"
+	"gorm.io/gorm"
 )
 
+var (
+	// ErrCharacterNotFound thrown when acting on a character that does not exist
+	ErrCharacterNotFound = errors.New("character not found")
+
+	// ErrCharacterNameTaken thrown when renaming a character to the name of another character
+	ErrCharacterNameTaken = errors.New("name is taken")
+
+	// ErrCharacterNameReserved thrown when using a name another character was renamed from until its reservation expires
+	ErrCharacterNameReserved = errors.New("name is reserved")
+
+	// ErrCharacterNameUnchanged thrown when renaming a character to its current name
+	ErrCharacterNameUnchanged = errors.New("name is the current name")
+)
+
+// CharacterLimitError thrown when creating a character for an account that has no character slots left, either in
+// total or in the dimension
+type CharacterLimitError struct {
+	// Dimension the limit was reached in. Empty if the limit of the account was reached.
+	Dimension string
+	Limit     uint32
+}
+
+func (err *CharacterLimitError) Error() string {
+	if err.Dimension != "" {
+		return fmt.Sprintf("limit of %d characters in the dimension reached", err.Limit)
+	}
+
+	return fmt.Sprintf("limit of %d characters reached", err.Limit)
+}
+
 type CharacterService interface {
-	Create(ctx context.Context, ownerId string, name string, gender string, realm string, dimension string) (*character.Character, error)
+	// Create creates a character with the appearance, or the default appearance of the creation rules if it is nil
+	Create(
+		ctx context.Context,
+		ownerId string,
+		name string,
+		gender string,
+		realm string,
+		dimension string,
+		appearance *character.Appearance,
+	) (*character.Character, error)
 	Save(ctx context.Context, char *character.Character) (*character.Character, error)
+
+	// Edit applies the set fields of the request to the target character in one transaction. A new name is renamed to
+	// like Rename does, so it is kept in the name history and reserved names are rejected. A dimension is applied by
+	// its id. The gender and realm must be allowed by the creation rules, and the new owner or dimension must have a
+	// free character slot.
+	Edit(ctx context.Context, request *pb.EditCharacterRequest) (*character.Character, error)
+
 	Delete(ctx context.Context, id uint) error
 
 	FindById(ctx context.Context, id uint) (*character.Character, error)
@@ -25,30 +76,71 @@ type CharacterService interface {
 	FindAll(context.Context) (character.Characters, error)
 
 	AddPlayTime(ctx context.Context, characterId uint, amount uint64) (*character.Character, error)
+
+	// AddExperience gives the character experience and applies any level ups
+	AddExperience(ctx context.Context, characterId uint, amount uint64) (*character.Character, error)
+
+	// ExperienceCurve gets the experience required for each level
+	ExperienceCurve() character.ExperienceCurve
+
+	// EditAppearance replaces the appearance of the character after validating it against the creation rules
+	EditAppearance(ctx context.Context, characterId uint, appearance *character.Appearance) (*character.Character, error)
+
+	// CreationRules gets the realms, genders and appearance options characters can be created with
+	CreationRules() *character.CreationRules
+
+	// Slots gets how many characters the owner has and can have
+	Slots(ctx context.Context, ownerId string) (*character.CharacterSlots, error)
+
+	// GrantSlots gives the owner extra character slots
+	GrantSlots(ctx context.Context, ownerId string, slots uint32) (*character.CharacterSlots, error)
+
+	// Rename validates the name and renames the character if no other character has or reserved it. The old name is
+	// reserved for the character for the configured reservation period.
+	Rename(ctx context.Context, characterId uint, name string) (*character.Character, error)
+
+	// NameHistory gets the name changes of the character, oldest first
+	NameHistory(ctx context.Context, characterId uint) (character.NameChanges, error)
 }
 
 type characterService struct {
-	repo repository.CharacterRepository
+	repo        repository.CharacterRepository
+	progression config.ProgressionConfig
+	creation    *character.CreationRules
+	slots       config.SlotsConfig
+	rename      config.RenameConfig
 }
 
 func NewCharacterService(
 	ctx context.Context,
 	r repository.CharacterRepository,
+	progression config.ProgressionConfig,
+	creation character.CreationRules,
+	slots config.SlotsConfig,
+	rename config.RenameConfig,
 ) (CharacterService, error) {
+	err := creation.Validate()
+	if err != nil {
+		return nil, fmt.Errorf("creation rules: %w", err)
+	}
 
-	err := r.Migrate(ctx)
+	err = r.Migrate(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("migrate db: %w", err)
 	}
 
 	return characterService{
-		repo: r,
+		repo:        r,
+		progression: progression,
+		creation:    &creation,
+		slots:       slots,
+		rename:      rename,
 	}, nil
 }
 
 // Save implements CharacterService.
 func (s characterService) Save(ctx context.Context, char *character.Character) (*character.Character, error) {
-	err := char.Validate()
+	err := char.Validate(s.creation)
 	if err != nil {
 		return nil, err
 	}
@@ -56,6 +148,86 @@ func (s characterService) Save(ctx context.Context, char *character.Character) (
 	return s.repo.Save(ctx, char)
 }
 
+// Edit implements CharacterService.
+func (s characterService) Edit(ctx context.Context, request *pb.EditCharacterRequest) (*character.Character, error) {
+	target, err := s.FindByTarget(ctx, request.Target)
+	if err != nil {
+		return nil, err
+	}
+	if target == nil {
+		return nil, ErrCharacterNotFound
+	}
+
+	return s.update(ctx, target.ID, func(repo repository.CharacterRepository, char *character.Character) error {
+		return s.edit(ctx, repo, char, request)
+	})
+}
+
+// edit applies the request to the locked character and saves the new name. Moving the character to another owner or
+// dimension takes up a slot there, so the slots of the new owner are locked until the transaction ends.
+func (s characterService) edit(
+	ctx context.Context,
+	repo repository.CharacterRepository,
+	char *character.Character,
+	request *pb.EditCharacterRequest,
+) error {
+	ownerId, dimension, name := char.OwnerId, char.Dimension, char.Name
+
+	if request.OptionalOwnerId != nil &&
+		request.GetOwnerId() != "" {
+		char.OwnerId = request.GetOwnerId()
+	}
+
+	if request.OptionalNewName != nil &&
+		request.GetNewName() != "" {
+		char.Name = request.GetNewName()
+	}
+
+	if request.OptionalGender != nil &&
+		request.GetGender() != "" {
+		char.Gender = request.GetGender()
+	}
+
+	if request.OptionalRealm != nil &&
+		request.GetRealm() != "" {
+		char.Realm = request.GetRealm()
+	}
+
+	if request.OptionalPlayTime != nil {
+		char.PlayTime = request.GetPlayTime()
+	}
+
+	if request.OptionalLocation != nil &&
+		request.GetLocation().World != "" {
+		char.Location = *game.LocationFromPb(request.GetLocation())
+	}
+
+	if request.OptionalDimension != nil &&
+		request.GetDimension().GetId() != "" {
+		char.Dimension = request.GetDimension().GetId()
+	}
+
+	err := char.Validate(s.creation)
+	if err != nil {
+		return err
+	}
+
+	if char.OwnerId != ownerId || char.Dimension != dimension {
+		err = repo.WithSlotLock(ctx, char.OwnerId, func(repo repository.CharacterRepository) error {
+			return s.checkSlots(ctx, repo, char.OwnerId, char.Dimension, char.ID)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	if char.Name != name {
+		return s.saveName(ctx, repo, char, name)
+	}
+
+	return nil
+}
+
 // FindByTarget implements CharacterService.
 func (s characterService) FindByTarget(ctx context.Context, target *pb.CharacterTarget) (*character.Character, error) {
 	var char *character.Character
@@ -83,21 +255,64 @@ func (s characterService) FindByName(ctx context.Context, name string) (*charact
 	return s.repo.FindByName(ctx, name)
 }
 
-func (s characterService) Create(ctx context.Context, ownerId string, name string, gender string, realm string, dimension string) (*character.Character, error) {
-	character := character.Character{
-		OwnerId:   ownerId,
-		Name:      name,
-		Gender:    gender,
-		Realm:     realm,
-		Dimension: dimension,
-		PlayTime:  0,
+func (s characterService) Create(
+	ctx context.Context,
+	ownerId string,
+	name string,
+	gender string,
+	realm string,
+	dimension string,
+	appearance *character.Appearance,
+) (*character.Character, error) {
+	if appearance == nil {
+		appearance = s.creation.Appearance.DefaultAppearance()
+	}
+	err := s.validateAppearance(appearance)
+	if err != nil {
+		return nil, err
+	}
+
+	char := character.Character{
+		OwnerId:    ownerId,
+		Name:       name,
+		Gender:     gender,
+		Realm:      realm,
+		Dimension:  dimension,
+		PlayTime:   0,
+		Level:      1,
+		Attributes: s.progression.BaseAttributes,
+		Appearance: *appearance,
 	}
 
-	if err := character.Validate(); err != nil {
+	if err := char.Validate(s.creation); err != nil {
 		return nil, err
 	}
+	char.Location = s.creation.Realm(realm).StartingLocation
 
-	return s.repo.Create(ctx, &character)
+	err = s.checkNameReservation(ctx, s.repo, 0, name)
+	if err != nil {
+		return nil, err
+	}
+
+	var created *character.Character
+	err = s.repo.WithSlotLock(ctx, ownerId, func(repo repository.CharacterRepository) error {
+		err := s.checkSlots(ctx, repo, ownerId, dimension, 0)
+		if err != nil {
+			return err
+		}
+
+		created, err = repo.Create(ctx, &char)
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return ErrCharacterNameTaken
+		}
+
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
 }
 
 func (s characterService) FindById(ctx context.Context, id uint) (*character.Character, error) {
@@ -126,13 +341,248 @@ func (s characterService) FindAllByOwner(ctx context.Context, owner string) (cha
 }
 
 func (s characterService) AddPlayTime(ctx context.Context, characterId uint, amount uint64) (*character.Character, error) {
-	character, err := s.FindById(ctx, characterId)
+	return s.update(ctx, characterId, func(_ repository.CharacterRepository, char *character.Character) error {
+		char.PlayTime += amount
+		return nil
+	})
+}
+
+// AddExperience implements CharacterService.
+func (s characterService) AddExperience(ctx context.Context, characterId uint, amount uint64) (*character.Character, error) {
+	return s.update(ctx, characterId, func(_ repository.CharacterRepository, char *character.Character) error {
+		char.AddExperience(amount, s.progression.ExperienceCurve, s.progression.AttributesPerLevel)
+		return nil
+	})
+}
+
+// ExperienceCurve implements CharacterService.
+func (s characterService) ExperienceCurve() character.ExperienceCurve {
+	return s.progression.ExperienceCurve
+}
+
+// EditAppearance implements CharacterService.
+func (s characterService) EditAppearance(
+	ctx context.Context,
+	characterId uint,
+	appearance *character.Appearance,
+) (*character.Character, error) {
+	err := s.validateAppearance(appearance)
 	if err != nil {
 		return nil, err
 	}
 
-	character.PlayTime += amount
-	character, err = s.repo.Save(ctx, character)
+	return s.update(ctx, characterId, func(_ repository.CharacterRepository, char *character.Character) error {
+		char.Appearance = *appearance
+		return nil
+	})
+}
 
-	return character, err
+// CreationRules implements CharacterService.
+func (s characterService) CreationRules() *character.CreationRules {
+	return s.creation
+}
+
+// validateAppearance validates the appearance against the creation rules and saves it with the current version
+func (s characterService) validateAppearance(appearance *character.Appearance) error {
+	err := appearance.Validate(&s.creation.Appearance)
+	if err != nil {
+		return err
+	}
+
+	appearance.Version = character.AppearanceVersion
+	return nil
+}
+
+// Slots implements CharacterService.
+func (s characterService) Slots(ctx context.Context, ownerId string) (*character.CharacterSlots, error) {
+	chars, err := s.repo.FindAllByOwner(ctx, ownerId)
+	if err != nil {
+		return nil, err
+	}
+
+	extra, err := s.repo.FindExtraSlots(ctx, ownerId)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.characterSlots(uint32(len(chars)), extra), nil
+}
+
+// GrantSlots implements CharacterService.
+func (s characterService) GrantSlots(ctx context.Context, ownerId string, slots uint32) (*character.CharacterSlots, error) {
+	_, err := s.repo.AddExtraSlots(ctx, ownerId, slots)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.Slots(ctx, ownerId)
+}
+
+// Rename implements CharacterService.
+func (s characterService) Rename(ctx context.Context, characterId uint, name string) (*character.Character, error) {
+	char, err := s.FindById(ctx, characterId)
+	if err != nil {
+		return nil, err
+	}
+	if char == nil {
+		return nil, ErrCharacterNotFound
+	}
+	if char.Name == name {
+		return nil, ErrCharacterNameUnchanged
+	}
+
+	oldName := char.Name
+	char.Name = name
+	err = char.ValidateName()
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.repo.WithTransaction(ctx, func(repo repository.CharacterRepository) error {
+		return s.saveName(ctx, repo, char, oldName)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return char, nil
+}
+
+// NameHistory implements CharacterService.
+func (s characterService) NameHistory(ctx context.Context, characterId uint) (character.NameChanges, error) {
+	return s.repo.FindNameHistory(ctx, characterId)
+}
+
+// update locks the character, applies fn to it and saves it in one transaction. Saving writes the whole row, so
+// without the lock concurrent updates of the same character would overwrite each other.
+func (s characterService) update(
+	ctx context.Context,
+	characterId uint,
+	fn func(repo repository.CharacterRepository, char *character.Character) error,
+) (*character.Character, error) {
+	var saved *character.Character
+	err := s.repo.WithTransaction(ctx, func(repo repository.CharacterRepository) error {
+		char, err := repo.FindByIdForUpdate(ctx, characterId)
+		if err != nil {
+			return err
+		}
+		if char == nil {
+			return ErrCharacterNotFound
+		}
+
+		err = fn(repo, char)
+		if err != nil {
+			return err
+		}
+
+		saved, err = repo.Save(ctx, char)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return saved, nil
+}
+
+// saveName saves the new name of the character and records the change from the old name in its name history. The
+// name is written before the reservations are checked: the unique name index makes the write wait for a concurrent
+// rename away from the name to commit, and the reservation that rename made is only visible once it has. The repo must
+// use a transaction so the name is rolled back if it is taken or reserved.
+func (s characterService) saveName(
+	ctx context.Context,
+	repo repository.CharacterRepository,
+	char *character.Character,
+	oldName string,
+) error {
+	_, err := repo.Rename(ctx, char, &character.NameChange{
+		OldName:       oldName,
+		NewName:       char.Name,
+		ReservedUntil: time.Now().Add(s.rename.ReservationPeriod),
+	})
+	if errors.Is(err, gorm.ErrDuplicatedKey) {
+		return ErrCharacterNameTaken
+	}
+	if err != nil {
+		return err
+	}
+
+	return s.checkNameReservation(ctx, repo, char.ID, char.Name)
+}
+
+// checkNameReservation verifies the name is not reserved for another character. A character can take back a name
+// it reserved.
+func (s characterService) checkNameReservation(
+	ctx context.Context,
+	repo repository.CharacterRepository,
+	characterId uint,
+	name string,
+) error {
+	reservation, err := repo.FindNameReservation(ctx, name)
+	if err != nil {
+		return err
+	}
+	if reservation != nil && reservation.CharacterId != characterId {
+		return ErrCharacterNameReserved
+	}
+
+	return nil
+}
+
+// checkSlots verifies the owner has a slot in the dimension for a new character, or for the existing character with the
+// id if it is not 0. The repo should hold the slot lock of the owner until the character is saved.
+func (s characterService) checkSlots(
+	ctx context.Context,
+	repo repository.CharacterRepository,
+	ownerId string,
+	dimension string,
+	characterId uint,
+) error {
+	all, err := repo.FindAllByOwner(ctx, ownerId)
+	if err != nil {
+		return err
+	}
+
+	// The character being moved does not need a slot in addition to the one it already has
+	chars := make(character.Characters, 0, len(all))
+	for _, char := range all {
+		if characterId == 0 || char.ID != characterId {
+			chars = append(chars, char)
+		}
+	}
+
+	extra, err := repo.FindExtraSlots(ctx, ownerId)
+	if err != nil {
+		return err
+	}
+
+	slots := s.characterSlots(uint32(len(chars)), extra)
+	if slots.Available() == 0 {
+		return &CharacterLimitError{Limit: slots.Max}
+	}
+
+	if slots.MaxPerDimension == 0 {
+		return nil
+	}
+
+	inDimension := uint32(0)
+	for _, char := range chars {
+		if char.Dimension == dimension {
+			inDimension++
+		}
+	}
+	if inDimension >= slots.MaxPerDimension {
+		return &CharacterLimitError{Dimension: dimension, Limit: slots.MaxPerDimension}
+	}
+
+	return nil
+}
+
+func (s characterService) characterSlots(used uint32, extra uint32) *character.CharacterSlots {
+	return &character.CharacterSlots{
+		Used:            used,
+		Max:             s.slots.MaxCharacters + extra,
+		MaxPerDimension: s.slots.MaxPerDimension,
+		Extra:           extra,
+	}
 }
@@ -10,13 +10,16 @@ import (
 	. "github.com/onsi/gomega"
 	"github.com/sirupsen/logrus/hooks/test"
 	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
 
 	"github.com/ShatteredRealms/go-backend/pkg/common"
+	"github.com/ShatteredRealms/go-backend/pkg/config"
 	"github.com/ShatteredRealms/go-backend/pkg/log"
 	"github.com/ShatteredRealms/go-backend/pkg/mocks"
 	"github.com/ShatteredRealms/go-backend/pkg/model/character"
 	"github.com/ShatteredRealms/go-backend/pkg/model/game"
 	"github.com/ShatteredRealms/go-backend/pkg/pb"
+	"github.com/ShatteredRealms/go-backend/pkg/repository"
 	"github.com/ShatteredRealms/go-backend/pkg/service"
 )
 
@@ -30,6 +33,10 @@ var _ = Describe("Character service", func() {
 		charService    service.CharacterService
 		ctx            context.Context
 		char           *character.Character
+		progression    config.ProgressionConfig
+		creation       character.CreationRules
+		slots          config.SlotsConfig
+		rename         config.RenameConfig
 
 		fakeError = fmt.Errorf("error")
 	)
@@ -42,7 +49,28 @@ var _ = Describe("Character service", func() {
 		mockController = gomock.NewController(GinkgoT())
 		mockRepository = mocks.NewMockCharacterRepository(mockController)
 		mockRepository.EXPECT().Migrate(ctx).Return(nil)
-		charService, err = service.NewCharacterService(ctx, mockRepository)
+		mockRepository.EXPECT().WithSlotLock(ctx, gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ string, fn func(repository.CharacterRepository) error) error {
+				return fn(mockRepository)
+			}).
+			AnyTimes()
+		mockRepository.EXPECT().WithTransaction(ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, fn func(repository.CharacterRepository) error) error {
+				return fn(mockRepository)
+			}).
+			AnyTimes()
+		progression = config.ProgressionConfig{
+			ExperienceCurve: character.ExperienceCurve{MaxLevel: 10, BaseExperience: 100, Growth: 2},
+		}
+		slots = config.SlotsConfig{MaxCharacters: 3, MaxPerDimension: 2}
+		rename = config.RenameConfig{ReservationPeriod: time.Hour}
+		creation = character.DefaultCreationRules()
+		creation.Realms[0].StartingLocation = game.Location{World: "start", X: 1}
+		creation.Appearance = character.AppearanceRules{
+			BodyTypes:  []string{"slim", "broad"},
+			ColorParts: []string{"hair"},
+		}
+		charService, err = service.NewCharacterService(ctx, mockRepository, progression, creation, slots, rename)
 		Expect(err).NotTo(HaveOccurred())
 		Expect(charService).NotTo(BeNil())
 
@@ -57,6 +85,7 @@ var _ = Describe("Character service", func() {
 			Realm:     "Human",
 			Dimension: "default",
 			PlayTime:  100,
+			Level:     1,
 			Location: game.Location{
 				World: faker.Username(),
 				X:     1.1,
@@ -75,10 +104,17 @@ var _ = Describe("Character service", func() {
 		When("given invalid input", func() {
 			It("should fail due to migration fail", func() {
 				mockRepository.EXPECT().Migrate(ctx).Return(fakeError)
-				s, err := service.NewCharacterService(ctx, mockRepository)
+				s, err := service.NewCharacterService(ctx, mockRepository, progression, creation, slots, rename)
 				Expect(err).To(MatchError(fakeError))
 				Expect(s).To(BeNil())
 			})
+
+			It("should fail due to invalid creation rules", func() {
+				creation.Genders = nil
+				s, err := service.NewCharacterService(ctx, mockRepository, progression, creation, slots, rename)
+				Expect(err).To(MatchError(character.ErrCreationNoGenders))
+				Expect(s).To(BeNil())
+			})
 		})
 	})
 
@@ -93,20 +129,114 @@ var _ = Describe("Character service", func() {
 
 	Describe("Create", func() {
 		When("given valid input", func() {
+			BeforeEach(func() {
+				mockRepository.EXPECT().FindNameReservation(ctx, char.Name).Return(nil, nil)
+				mockRepository.EXPECT().FindAllByOwner(ctx, char.OwnerId).Return(character.Characters{char}, nil)
+				mockRepository.EXPECT().FindExtraSlots(ctx, char.OwnerId).Return(uint32(0), nil)
+			})
+
 			It("should succeed", func() {
-				mockRepository.EXPECT().Create(ctx, gomock.Any()).Return(char, fakeError)
-				out, err := charService.Create(ctx, char.OwnerId, char.Name, char.Gender, char.Realm, char.Dimension)
+				mockRepository.EXPECT().Create(ctx, gomock.Any()).Return(char, nil)
+				out, err := charService.Create(ctx, char.OwnerId, char.Name, char.Gender, char.Realm, char.Dimension, nil)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(out).To(Equal(char))
+			})
+
+			It("should fail if the character cannot be created", func() {
+				mockRepository.EXPECT().Create(ctx, gomock.Any()).Return(nil, fakeError)
+				out, err := charService.Create(ctx, char.OwnerId, char.Name, char.Gender, char.Realm, char.Dimension, nil)
 				Expect(err).To(MatchError(fakeError))
+				Expect(out).To(BeNil())
+			})
+
+			It("should start at the starting location of the realm", func() {
+				mockRepository.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(
+					func(_ context.Context, c *character.Character) (*character.Character, error) {
+						return c, nil
+					},
+				)
+				out, err := charService.Create(ctx, char.OwnerId, char.Name, char.Gender, "Human", char.Dimension, nil)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(out.Location).To(Equal(creation.Realms[0].StartingLocation))
+				Expect(out.Appearance.BodyType).To(Equal("slim"))
+				Expect(out.Appearance.Version).To(BeEquivalentTo(character.AppearanceVersion))
+			})
+		})
+
+		When("the account has no slots left", func() {
+			var owned character.Characters
+
+			BeforeEach(func() {
+				mockRepository.EXPECT().FindNameReservation(ctx, char.Name).Return(nil, nil)
+				owned = character.Characters{
+					{Dimension: faker.Username()},
+					{Dimension: faker.Username()},
+					{Dimension: faker.Username()},
+				}
+			})
+
+			It("should fail when the account limit is reached", func() {
+				mockRepository.EXPECT().FindAllByOwner(ctx, char.OwnerId).Return(owned, nil)
+				mockRepository.EXPECT().FindExtraSlots(ctx, char.OwnerId).Return(uint32(0), nil)
+				out, err := charService.Create(ctx, char.OwnerId, char.Name, char.Gender, char.Realm, char.Dimension, nil)
+				Expect(err).To(MatchError(&service.CharacterLimitError{Limit: 3}))
+				Expect(out).To(BeNil())
+			})
+
+			It("should succeed with extra slots", func() {
+				mockRepository.EXPECT().FindAllByOwner(ctx, char.OwnerId).Return(owned, nil)
+				mockRepository.EXPECT().FindExtraSlots(ctx, char.OwnerId).Return(uint32(1), nil)
+				mockRepository.EXPECT().Create(ctx, gomock.Any()).Return(char, nil)
+				out, err := charService.Create(ctx, char.OwnerId, char.Name, char.Gender, char.Realm, char.Dimension, nil)
+				Expect(err).NotTo(HaveOccurred())
 				Expect(out).To(Equal(char))
 			})
+
+			It("should fail when the dimension limit is reached", func() {
+				owned[0].Dimension = char.Dimension
+				owned[1].Dimension = char.Dimension
+				mockRepository.EXPECT().FindAllByOwner(ctx, char.OwnerId).Return(owned, nil)
+				mockRepository.EXPECT().FindExtraSlots(ctx, char.OwnerId).Return(uint32(5), nil)
+				out, err := charService.Create(ctx, char.OwnerId, char.Name, char.Gender, char.Realm, char.Dimension, nil)
+				Expect(err).To(MatchError(&service.CharacterLimitError{Dimension: char.Dimension, Limit: 2}))
+				Expect(out).To(BeNil())
+			})
+
+			It("should fail when finding the slots fails", func() {
+				mockRepository.EXPECT().FindAllByOwner(ctx, char.OwnerId).Return(owned, nil)
+				mockRepository.EXPECT().FindExtraSlots(ctx, char.OwnerId).Return(uint32(0), fakeError)
+				out, err := charService.Create(ctx, char.OwnerId, char.Name, char.Gender, char.Realm, char.Dimension, nil)
+				Expect(err).To(MatchError(fakeError))
+				Expect(out).To(BeNil())
+			})
 		})
 
 		When("given invalid input", func() {
+			It("should fail on an appearance not allowed by the creation rules", func() {
+				appearance := &character.Appearance{BodyType: "tall"}
+				out, err := charService.Create(ctx, char.OwnerId, char.Name, char.Gender, char.Realm, char.Dimension, appearance)
+				Expect(err).To(MatchError(character.ErrAppearanceBodyType))
+				Expect(out).To(BeNil())
+			})
+
+			It("should fail on a realm not in the creation rules", func() {
+				out, err := charService.Create(ctx, char.OwnerId, char.Name, char.Gender, "Elf", char.Dimension, nil)
+				Expect(err).To(MatchError(common.ErrInvalidRealm))
+				Expect(out).To(BeNil())
+			})
+
 			It("should fail on invalid character", func() {
-				out, err := charService.Create(ctx, char.OwnerId, char.Name, "", char.Realm, char.Dimension)
+				out, err := charService.Create(ctx, char.OwnerId, char.Name, "", char.Realm, char.Dimension, nil)
 				Expect(err).To(MatchError(common.ErrInvalidGender))
 				Expect(out).To(BeNil())
 			})
+
+			It("should fail on a name reserved by another character", func() {
+				mockRepository.EXPECT().FindNameReservation(ctx, char.Name).Return(&character.NameChange{CharacterId: 7}, nil)
+				out, err := charService.Create(ctx, char.OwnerId, char.Name, char.Gender, char.Realm, char.Dimension, nil)
+				Expect(err).To(MatchError(service.ErrCharacterNameReserved))
+				Expect(out).To(BeNil())
+			})
 		})
 	})
 
@@ -155,6 +285,7 @@ var _ = Describe("Character service", func() {
 						},
 					},
 				}
+				oldName := char.Name
 				expectCharacter := new(character.Character)
 				*expectCharacter = *char
 				expectCharacter.OwnerId = editReq.GetOwnerId()
@@ -164,10 +295,20 @@ var _ = Describe("Character service", func() {
 				expectCharacter.PlayTime = editReq.GetPlayTime()
 				expectCharacter.Location = *game.LocationFromPb(editReq.GetLocation())
 				mockRepository.EXPECT().FindByName(ctx, editReq.Target.GetName()).Return(char, nil)
-				mockRepository.EXPECT().Save(ctx, expectCharacter).Return(char, fakeError)
+				mockRepository.EXPECT().FindByIdForUpdate(ctx, char.ID).Return(char, nil)
+				mockRepository.EXPECT().FindAllByOwner(ctx, editReq.GetOwnerId()).Return(character.Characters{}, nil)
+				mockRepository.EXPECT().FindExtraSlots(ctx, editReq.GetOwnerId()).Return(uint32(0), nil)
+				mockRepository.EXPECT().Rename(ctx, gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, c *character.Character, change *character.NameChange) (*character.Character, error) {
+						Expect(change.OldName).To(Equal(oldName))
+						Expect(change.NewName).To(Equal(editReq.GetNewName()))
+						return c, nil
+					})
+				mockRepository.EXPECT().FindNameReservation(ctx, editReq.GetNewName()).Return(nil, nil)
+				mockRepository.EXPECT().Save(ctx, expectCharacter).Return(char, nil)
 
 				out, err := charService.Edit(ctx, editReq)
-				Expect(err).To(MatchError(fakeError))
+				Expect(err).NotTo(HaveOccurred())
 				Expect(out).To(Equal(char))
 			})
 
@@ -205,6 +346,7 @@ var _ = Describe("Character service", func() {
 						},
 					},
 				}
+				oldName := char.Name
 				expectCharacter := new(character.Character)
 				*expectCharacter = *char
 				expectCharacter.OwnerId = editReq.GetOwnerId()
@@ -214,12 +356,39 @@ var _ = Describe("Character service", func() {
 				expectCharacter.PlayTime = editReq.GetPlayTime()
 				expectCharacter.Location = *game.LocationFromPb(editReq.GetLocation())
 				mockRepository.EXPECT().FindById(ctx, uint(editReq.Target.GetId())).Return(char, nil)
-				mockRepository.EXPECT().Save(ctx, expectCharacter).Return(char, fakeError)
+				mockRepository.EXPECT().FindByIdForUpdate(ctx, char.ID).Return(char, nil)
+				mockRepository.EXPECT().FindAllByOwner(ctx, editReq.GetOwnerId()).Return(character.Characters{}, nil)
+				mockRepository.EXPECT().FindExtraSlots(ctx, editReq.GetOwnerId()).Return(uint32(0), nil)
+				mockRepository.EXPECT().Rename(ctx, gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, c *character.Character, change *character.NameChange) (*character.Character, error) {
+						Expect(change.OldName).To(Equal(oldName))
+						Expect(change.NewName).To(Equal(editReq.GetNewName()))
+						return c, nil
+					})
+				mockRepository.EXPECT().FindNameReservation(ctx, editReq.GetNewName()).Return(nil, nil)
+				mockRepository.EXPECT().Save(ctx, expectCharacter).Return(char, nil)
 
 				out, err := charService.Edit(ctx, editReq)
-				Expect(err).To(MatchError(fakeError))
+				Expect(err).NotTo(HaveOccurred())
 				Expect(out).To(Equal(char))
 			})
+
+			It("should not count the character against the slots when moving it to another dimension", func() {
+				char.ID = 1
+				other := &character.Character{ID: 2, OwnerId: char.OwnerId, Dimension: "other"}
+				mockRepository.EXPECT().FindById(ctx, char.ID).Return(char, nil)
+				mockRepository.EXPECT().FindByIdForUpdate(ctx, char.ID).Return(char, nil)
+				mockRepository.EXPECT().FindAllByOwner(ctx, char.OwnerId).
+					Return(character.Characters{char, other, {ID: 3, OwnerId: char.OwnerId}}, nil)
+				mockRepository.EXPECT().FindExtraSlots(ctx, char.OwnerId).Return(uint32(0), nil)
+				mockRepository.EXPECT().Save(ctx, char).Return(char, nil)
+				out, err := charService.Edit(ctx, &pb.EditCharacterRequest{
+					Target:            &pb.CharacterTarget{Type: &pb.CharacterTarget_Id{Id: uint64(char.ID)}},
+					OptionalDimension: &pb.EditCharacterRequest_Dimension{Dimension: &pb.DimensionTarget{FindBy: &pb.DimensionTarget_Id{Id: "other"}}},
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(out.Dimension).To(Equal("other"))
+			})
 		})
 
 		When("given invalid input", func() {
@@ -266,11 +435,83 @@ var _ = Describe("Character service", func() {
 				expectCharacter.PlayTime = editReq.GetPlayTime()
 				expectCharacter.Location = *game.LocationFromPb(editReq.GetLocation())
 				mockRepository.EXPECT().FindByName(ctx, editReq.Target.GetName()).Return(char, nil)
+				mockRepository.EXPECT().FindByIdForUpdate(ctx, char.ID).Return(char, nil)
 				out, err := charService.Edit(ctx, editReq)
 				Expect(err).To(MatchError(common.ErrInvalidGender))
 				Expect(out).To(BeNil())
 			})
 
+			It("should fail on a realm not in the creation rules", func() {
+				mockRepository.EXPECT().FindById(ctx, char.ID).Return(char, nil)
+				mockRepository.EXPECT().FindByIdForUpdate(ctx, char.ID).Return(char, nil)
+				out, err := charService.Edit(ctx, &pb.EditCharacterRequest{
+					Target:        &pb.CharacterTarget{Type: &pb.CharacterTarget_Id{Id: uint64(char.ID)}},
+					OptionalRealm: &pb.EditCharacterRequest_Realm{Realm: faker.Username()},
+				})
+				Expect(err).To(MatchError(common.ErrInvalidRealm))
+				Expect(out).To(BeNil())
+			})
+
+			It("should fail when the new dimension has no free slot", func() {
+				char.ID = 1
+				other := &character.Character{ID: 2, OwnerId: char.OwnerId, Dimension: "other"}
+				full := &character.Character{ID: 3, OwnerId: char.OwnerId, Dimension: "other"}
+				mockRepository.EXPECT().FindById(ctx, char.ID).Return(char, nil)
+				mockRepository.EXPECT().FindByIdForUpdate(ctx, char.ID).Return(char, nil)
+				mockRepository.EXPECT().FindAllByOwner(ctx, char.OwnerId).
+					Return(character.Characters{char, other, full}, nil)
+				mockRepository.EXPECT().FindExtraSlots(ctx, char.OwnerId).Return(uint32(0), nil)
+				out, err := charService.Edit(ctx, &pb.EditCharacterRequest{
+					Target:            &pb.CharacterTarget{Type: &pb.CharacterTarget_Id{Id: uint64(char.ID)}},
+					OptionalDimension: &pb.EditCharacterRequest_Dimension{Dimension: &pb.DimensionTarget{FindBy: &pb.DimensionTarget_Id{Id: "other"}}},
+				})
+				Expect(err).To(MatchError(&service.CharacterLimitError{Dimension: "other", Limit: slots.MaxPerDimension}))
+				Expect(out).To(BeNil())
+			})
+
+			It("should not rename when the new dimension has no free slot", func() {
+				char.ID = 1
+				other := &character.Character{ID: 2, OwnerId: char.OwnerId, Dimension: "other"}
+				full := &character.Character{ID: 3, OwnerId: char.OwnerId, Dimension: "other"}
+				mockRepository.EXPECT().FindById(ctx, char.ID).Return(char, nil)
+				mockRepository.EXPECT().FindByIdForUpdate(ctx, char.ID).Return(char, nil)
+				mockRepository.EXPECT().FindAllByOwner(ctx, char.OwnerId).
+					Return(character.Characters{char, other, full}, nil)
+				mockRepository.EXPECT().FindExtraSlots(ctx, char.OwnerId).Return(uint32(0), nil)
+				out, err := charService.Edit(ctx, &pb.EditCharacterRequest{
+					Target:            &pb.CharacterTarget{Type: &pb.CharacterTarget_Id{Id: uint64(char.ID)}},
+					OptionalNewName:   &pb.EditCharacterRequest_NewName{NewName: "renamed"},
+					OptionalDimension: &pb.EditCharacterRequest_Dimension{Dimension: &pb.DimensionTarget{FindBy: &pb.DimensionTarget_Id{Id: "other"}}},
+				})
+				Expect(err).To(MatchError(&service.CharacterLimitError{Dimension: "other", Limit: slots.MaxPerDimension}))
+				Expect(out).To(BeNil())
+			})
+
+			It("should fail on a name reserved by another character", func() {
+				mockRepository.EXPECT().FindById(ctx, char.ID).Return(char, nil)
+				mockRepository.EXPECT().FindByIdForUpdate(ctx, char.ID).Return(char, nil)
+				mockRepository.EXPECT().Rename(ctx, char, gomock.Any()).Return(char, nil)
+				mockRepository.EXPECT().FindNameReservation(ctx, "renamed").Return(&character.NameChange{CharacterId: char.ID + 1}, nil)
+				out, err := charService.Edit(ctx, &pb.EditCharacterRequest{
+					Target:          &pb.CharacterTarget{Type: &pb.CharacterTarget_Id{Id: uint64(char.ID)}},
+					OptionalNewName: &pb.EditCharacterRequest_NewName{NewName: "renamed"},
+				})
+				Expect(err).To(MatchError(service.ErrCharacterNameReserved))
+				Expect(out).To(BeNil())
+			})
+
+			It("should fail on a name that is taken", func() {
+				mockRepository.EXPECT().FindById(ctx, char.ID).Return(char, nil)
+				mockRepository.EXPECT().FindByIdForUpdate(ctx, char.ID).Return(char, nil)
+				mockRepository.EXPECT().Rename(ctx, char, gomock.Any()).Return(nil, gorm.ErrDuplicatedKey)
+				out, err := charService.Edit(ctx, &pb.EditCharacterRequest{
+					Target:          &pb.CharacterTarget{Type: &pb.CharacterTarget_Id{Id: uint64(char.ID)}},
+					OptionalNewName: &pb.EditCharacterRequest_NewName{NewName: "renamed"},
+				})
+				Expect(err).To(MatchError(service.ErrCharacterNameTaken))
+				Expect(out).To(BeNil())
+			})
+
 			It("should fail if finding character fails", func() {
 				editReq := &pb.EditCharacterRequest{
 					Target: &pb.CharacterTarget{
@@ -319,6 +560,17 @@ var _ = Describe("Character service", func() {
 				Expect(out).To(BeNil())
 			})
 
+			It("should fail if the character was deleted meanwhile", func() {
+				mockRepository.EXPECT().FindById(ctx, char.ID).Return(char, nil)
+				mockRepository.EXPECT().FindByIdForUpdate(ctx, char.ID).Return(nil, nil)
+				out, err := charService.Edit(ctx, &pb.EditCharacterRequest{
+					Target:         &pb.CharacterTarget{Type: &pb.CharacterTarget_Id{Id: uint64(char.ID)}},
+					OptionalGender: &pb.EditCharacterRequest_Gender{Gender: "Female"},
+				})
+				Expect(err).To(MatchError(service.ErrCharacterNotFound))
+				Expect(out).To(BeNil())
+			})
+
 			It("should fail if unknown target", func() {
 				editReq := &pb.EditCharacterRequest{
 					Target: &pb.CharacterTarget{},
@@ -386,25 +638,233 @@ var _ = Describe("Character service", func() {
 
 		When("given valid input", func() {
 			It("should try to update playtime", func() {
-				mockRepository.EXPECT().FindById(ctx, char.ID).Return(char, nil)
+				mockRepository.EXPECT().FindByIdForUpdate(ctx, char.ID).Return(char, nil)
 				charOut := new(character.Character)
 				*charOut = *char
 				charOut.PlayTime += amount
-				mockRepository.EXPECT().Save(ctx, gomock.Any()).Return(charOut, fakeError)
+				mockRepository.EXPECT().Save(ctx, gomock.Any()).Return(charOut, nil)
 				out, err := charService.AddPlayTime(ctx, char.ID, amount)
-				Expect(err).To(MatchError(fakeError))
+				Expect(err).NotTo(HaveOccurred())
 				Expect(out.PlayTime).To(BeEquivalentTo(charOut.PlayTime))
 			})
 		})
 
 		When("given invalid input", func() {
 			It("should error on find error", func() {
-				mockRepository.EXPECT().FindById(ctx, char.ID).Return(nil, fakeError)
+				mockRepository.EXPECT().FindByIdForUpdate(ctx, char.ID).Return(nil, fakeError)
+				out, err := charService.AddPlayTime(ctx, char.ID, amount)
+				Expect(err).To(MatchError(fakeError))
+				Expect(out).To(BeNil())
+			})
+
+			It("should error on save error", func() {
+				mockRepository.EXPECT().FindByIdForUpdate(ctx, char.ID).Return(char, nil)
+				mockRepository.EXPECT().Save(ctx, gomock.Any()).Return(nil, fakeError)
 				out, err := charService.AddPlayTime(ctx, char.ID, amount)
 				Expect(err).To(MatchError(fakeError))
 				Expect(out).To(BeNil())
 			})
+
+			It("should error if the character does not exist", func() {
+				mockRepository.EXPECT().FindByIdForUpdate(ctx, char.ID).Return(nil, nil)
+				out, err := charService.AddPlayTime(ctx, char.ID, amount)
+				Expect(err).To(MatchError(service.ErrCharacterNotFound))
+				Expect(out).To(BeNil())
+			})
+		})
+	})
+
+	Describe("AddExperience", func() {
+		When("given valid input", func() {
+			It("should level up and save", func() {
+				mockRepository.EXPECT().FindByIdForUpdate(ctx, char.ID).Return(char, nil)
+				mockRepository.EXPECT().Save(ctx, gomock.Any()).DoAndReturn(
+					func(_ context.Context, c *character.Character) (*character.Character, error) {
+						return c, nil
+					},
+				)
+				out, err := charService.AddExperience(ctx, char.ID, progression.Required(1)+1)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(out.Level).To(BeEquivalentTo(2))
+				Expect(out.Experience).To(BeEquivalentTo(1))
+			})
+		})
+
+		When("given invalid input", func() {
+			It("should error on find error", func() {
+				mockRepository.EXPECT().FindByIdForUpdate(ctx, char.ID).Return(nil, fakeError)
+				out, err := charService.AddExperience(ctx, char.ID, 10)
+				Expect(err).To(MatchError(fakeError))
+				Expect(out).To(BeNil())
+			})
+
+			It("should error if the character does not exist", func() {
+				mockRepository.EXPECT().FindByIdForUpdate(ctx, char.ID).Return(nil, nil)
+				out, err := charService.AddExperience(ctx, char.ID, 10)
+				Expect(err).To(MatchError(service.ErrCharacterNotFound))
+				Expect(out).To(BeNil())
+			})
 		})
 	})
 
+	Describe("EditAppearance", func() {
+		var appearance *character.Appearance
+
+		BeforeEach(func() {
+			appearance = &character.Appearance{
+				BodyType: "broad",
+				Colors:   map[string]string{"hair": "#112233"},
+			}
+		})
+
+		When("given valid input", func() {
+			It("should replace the appearance", func() {
+				mockRepository.EXPECT().FindByIdForUpdate(ctx, char.ID).Return(char, nil)
+				mockRepository.EXPECT().Save(ctx, gomock.Any()).DoAndReturn(
+					func(_ context.Context, c *character.Character) (*character.Character, error) {
+						return c, nil
+					},
+				)
+				out, err := charService.EditAppearance(ctx, char.ID, appearance)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(out.Appearance.BodyType).To(Equal("broad"))
+				Expect(out.Appearance.Colors).To(Equal(appearance.Colors))
+				Expect(out.Appearance.Version).To(BeEquivalentTo(character.AppearanceVersion))
+			})
+		})
+
+		When("given invalid input", func() {
+			It("should error on an appearance not allowed by the creation rules", func() {
+				appearance.Colors["skin"] = "#112233"
+				out, err := charService.EditAppearance(ctx, char.ID, appearance)
+				Expect(err).To(MatchError(character.ErrAppearanceColorPart))
+				Expect(out).To(BeNil())
+			})
+
+			It("should error if the character does not exist", func() {
+				mockRepository.EXPECT().FindByIdForUpdate(ctx, char.ID).Return(nil, nil)
+				out, err := charService.EditAppearance(ctx, char.ID, appearance)
+				Expect(err).To(MatchError(service.ErrCharacterNotFound))
+				Expect(out).To(BeNil())
+			})
+		})
+	})
+
+	Describe("Slots", func() {
+		It("should count the characters and extra slots", func() {
+			mockRepository.EXPECT().FindAllByOwner(ctx, char.OwnerId).Return(character.Characters{char}, nil)
+			mockRepository.EXPECT().FindExtraSlots(ctx, char.OwnerId).Return(uint32(2), nil)
+			out, err := charService.Slots(ctx, char.OwnerId)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out).To(Equal(&character.CharacterSlots{Used: 1, Max: 5, MaxPerDimension: 2, Extra: 2}))
+			Expect(out.Available()).To(BeEquivalentTo(4))
+		})
+
+		It("should error when finding the characters fails", func() {
+			mockRepository.EXPECT().FindAllByOwner(ctx, char.OwnerId).Return(nil, fakeError)
+			out, err := charService.Slots(ctx, char.OwnerId)
+			Expect(err).To(MatchError(fakeError))
+			Expect(out).To(BeNil())
+		})
+	})
+
+	Describe("GrantSlots", func() {
+		It("should add the extra slots", func() {
+			mockRepository.EXPECT().AddExtraSlots(ctx, char.OwnerId, uint32(2)).Return(uint32(2), nil)
+			mockRepository.EXPECT().FindAllByOwner(ctx, char.OwnerId).Return(character.Characters{}, nil)
+			mockRepository.EXPECT().FindExtraSlots(ctx, char.OwnerId).Return(uint32(2), nil)
+			out, err := charService.GrantSlots(ctx, char.OwnerId, 2)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.Max).To(BeEquivalentTo(5))
+		})
+
+		It("should error when adding fails", func() {
+			mockRepository.EXPECT().AddExtraSlots(ctx, char.OwnerId, uint32(2)).Return(uint32(0), fakeError)
+			out, err := charService.GrantSlots(ctx, char.OwnerId, 2)
+			Expect(err).To(MatchError(fakeError))
+			Expect(out).To(BeNil())
+		})
+	})
+
+	Describe("Rename", func() {
+		var newName string
+
+		BeforeEach(func() {
+			char.ID = 3
+			newName = "renamed"
+		})
+
+		It("should rename the character and reserve the old name", func() {
+			oldName := char.Name
+			mockRepository.EXPECT().FindById(ctx, char.ID).Return(char, nil)
+			mockRepository.EXPECT().FindNameReservation(ctx, newName).Return(nil, nil)
+			mockRepository.EXPECT().Rename(ctx, char, gomock.Any()).DoAndReturn(
+				func(_ context.Context, char *character.Character, change *character.NameChange) (*character.Character, error) {
+					Expect(char.Name).To(Equal(newName))
+					Expect(change.OldName).To(Equal(oldName))
+					Expect(change.NewName).To(Equal(newName))
+					Expect(change.ReservedUntil).To(BeTemporally("~", time.Now().Add(time.Hour), time.Minute))
+					return char, nil
+				})
+			out, err := charService.Rename(ctx, char.ID, newName)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.Name).To(Equal(newName))
+		})
+
+		It("should allow taking back a name the character reserved", func() {
+			mockRepository.EXPECT().FindById(ctx, char.ID).Return(char, nil)
+			mockRepository.EXPECT().FindNameReservation(ctx, newName).Return(&character.NameChange{CharacterId: char.ID}, nil)
+			mockRepository.EXPECT().Rename(ctx, char, gomock.Any()).Return(char, nil)
+			_, err := charService.Rename(ctx, char.ID, newName)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should fail on a name reserved by another character", func() {
+			mockRepository.EXPECT().FindById(ctx, char.ID).Return(char, nil)
+			mockRepository.EXPECT().Rename(ctx, char, gomock.Any()).Return(char, nil)
+			mockRepository.EXPECT().FindNameReservation(ctx, newName).Return(&character.NameChange{CharacterId: char.ID + 1}, nil)
+			out, err := charService.Rename(ctx, char.ID, newName)
+			Expect(err).To(MatchError(service.ErrCharacterNameReserved))
+			Expect(out).To(BeNil())
+		})
+
+		It("should fail on a name that is taken", func() {
+			mockRepository.EXPECT().FindById(ctx, char.ID).Return(char, nil)
+			mockRepository.EXPECT().Rename(ctx, char, gomock.Any()).Return(nil, gorm.ErrDuplicatedKey)
+			out, err := charService.Rename(ctx, char.ID, newName)
+			Expect(err).To(MatchError(service.ErrCharacterNameTaken))
+			Expect(out).To(BeNil())
+		})
+
+		It("should fail on an invalid name", func() {
+			mockRepository.EXPECT().FindById(ctx, char.ID).Return(char, nil)
+			out, err := charService.Rename(ctx, char.ID, "a")
+			Expect(err).To(MatchError(character.ErrCharacterNameToShort))
+			Expect(out).To(BeNil())
+		})
+
+		It("should fail on the current name", func() {
+			mockRepository.EXPECT().FindById(ctx, char.ID).Return(char, nil)
+			out, err := charService.Rename(ctx, char.ID, char.Name)
+			Expect(err).To(MatchError(service.ErrCharacterNameUnchanged))
+			Expect(out).To(BeNil())
+		})
+
+		It("should fail if the character does not exist", func() {
+			mockRepository.EXPECT().FindById(ctx, char.ID).Return(nil, nil)
+			out, err := charService.Rename(ctx, char.ID, newName)
+			Expect(err).To(MatchError(service.ErrCharacterNotFound))
+			Expect(out).To(BeNil())
+		})
+	})
+
+	Describe("NameHistory", func() {
+		It("should call repo directly", func() {
+			history := character.NameChanges{{CharacterId: char.ID, OldName: faker.Username()}}
+			mockRepository.EXPECT().FindNameHistory(ctx, char.ID).Return(history, fakeError)
+			out, err := charService.NameHistory(ctx, char.ID)
+			Expect(err).To(MatchError(fakeError))
+			Expect(out).To(Equal(history))
+		})
+	})
 })
@@ -4,13 +4,28 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strconv"
+	"strings"
+	"time"
 
+	"github.com/ShatteredRealms/go-backend/pkg/chatfilter"
 	"github.com/ShatteredRealms/go-backend/pkg/config"
+	"github.com/ShatteredRealms/go-backend/pkg/log"
+	"github.com/ShatteredRealms/go-backend/pkg/messagebus"
 	"github.com/ShatteredRealms/go-backend/pkg/model/chat"
 	"github.com/ShatteredRealms/go-backend/pkg/pb"
+	"github.com/ShatteredRealms/go-backend/pkg/ratelimit"
 	"github.com/ShatteredRealms/go-backend/pkg/repository"
-	"github.com/segmentio/kafka-go"
 	"go.opentelemetry.io/otel"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	channelTopicPrefix   = "channel-"
+	characterTopicPrefix = "character-"
+
+	// blocksTopic topic notified when a user blocks or unblocks another user
+	blocksTopic = "chat-blocks"
 )
 
 var (
@@ -20,6 +35,66 @@ var (
 	ErrEmptyMessage = errors.New("message cannot be empty")
 
 	ErrUserNotFound = errors.New("character not found")
+
+	// ErrFriendSelf thrown when a user attempts to friend or block themselves
+	ErrFriendSelf = errors.New("cannot target self")
+
+	// ErrAlreadyFriends thrown when a friend request is sent to an existing friend
+	ErrAlreadyFriends = errors.New("already friends")
+
+	// ErrNotFriends thrown when removing a friend that is not a friend
+	ErrNotFriends = errors.New("not friends")
+
+	// ErrFriendRequestExists thrown when a friend request has already been sent
+	ErrFriendRequestExists = errors.New("friend request already sent")
+
+	// ErrFriendRequestNotFound thrown when a friend request does not exist
+	ErrFriendRequestNotFound = errors.New("friend request not found")
+
+	// ErrBlocked thrown when an action is not allowed because one user blocked the other
+	ErrBlocked = errors.New("user is blocked")
+
+	// ErrAlreadyBlocked thrown when blocking a user that is already blocked
+	ErrAlreadyBlocked = errors.New("user already blocked")
+
+	// ErrNotBlocked thrown when unblocking a user that is not blocked
+	ErrNotBlocked = errors.New("user not blocked")
+
+	// ErrMessageNotFound thrown when a chat message used as a history cursor or reply does not exist
+	ErrMessageNotFound = errors.New("message not found")
+
+	// ErrChannelNotFound thrown when acting on a chat channel that does not exist
+	ErrChannelNotFound = errors.New("channel not found")
+
+	// ErrBanned thrown when authorizing a character for a channel they are banned from
+	ErrBanned = errors.New("character is banned from the channel")
+
+	// ErrChannelReadOnly thrown when sending a player message to a system channel
+	ErrChannelReadOnly = errors.New("channel only accepts system messages and announcements")
+
+	// ErrChannelNotJoinable thrown when joining a system channel
+	ErrChannelNotJoinable = errors.New("channel cannot be joined")
+
+	// ErrWrongDimension thrown when joining a public channel in another dimension
+	ErrWrongDimension = errors.New("channel is in another dimension")
+
+	// ErrNotInvited thrown when joining a private channel without an invite
+	ErrNotInvited = errors.New("not invited to the channel")
+
+	// ErrAlreadyMember thrown when joining or inviting to a channel the character is already a member of
+	ErrAlreadyMember = errors.New("already a member of the channel")
+
+	// ErrNotMember thrown when leaving a channel the character is not a member of
+	ErrNotMember = errors.New("not a member of the channel")
+
+	// ErrChannelNotInvitable thrown when inviting to a channel that is not private
+	ErrChannelNotInvitable = errors.New("only private channels accept invites")
+
+	// ErrAlreadyInvited thrown when inviting a character that already has an invite
+	ErrAlreadyInvited = errors.New("already invited to the channel")
+
+	// ErrInvalidMessageType thrown when sending an event type as a chat message
+	ErrInvalidMessageType = errors.New("message type cannot be sent")
 )
 
 type ChatService interface {
@@ -30,30 +105,285 @@ type ChatService interface {
 	DeleteChannel(ctx context.Context, channel *chat.ChatChannel) error
 
 	RegisterCharacterChatTopic(ctx context.Context, username string) error
+	UnregisterCharacterChatTopic(ctx context.Context, username string) error
+	MigrateCharacterName(ctx context.Context, characterId uint, oldName string, newName string) error
+	ReconcileTopics(ctx context.Context, characterNames func(ctx context.Context) ([]string, error)) error
+
+	SendChannelMessage(ctx context.Context, channelId uint, message *chat.ChatMessage) (*chat.ChatMessage, error)
+	SendDirectMessage(ctx context.Context, targetCharacterName string, message *chat.ChatMessage) (*chat.ChatMessage, error)
+	NotifyTyping(ctx context.Context, characterId uint, characterName string, targetCharacterName string) error
+	MarkRead(ctx context.Context, characterId uint, characterName string, messageId uint) error
+
+	ChannelMessagesReader(ctx context.Context, channelId uint, afterMessageId uint) (messagebus.Subscription, error)
+	DirectMessagesReader(ctx context.Context, username string) (messagebus.Subscription, error)
 
-	SendChannelMessage(ctx context.Context, username string, message string, channelId uint) error
-	SendDirectMessage(ctx context.Context, senderCharacter string, message string, targetCharacter string) error
+	// BlockChangesReader subscribes to changes to the blocked users of every user. Each message is keyed by the id of
+	// the user that blocked or unblocked another user.
+	BlockChangesReader(ctx context.Context) (messagebus.Subscription, error)
 
-	ChannelMessagesReader(ctx context.Context, channelId uint) *kafka.Reader
-	DirectMessagesReader(ctx context.Context, username string) *kafka.Reader
+	GetChannelHistory(ctx context.Context, channelId uint, cursor chat.HistoryCursor) (chat.ChatMessages, error)
+	GetDirectMessageHistory(ctx context.Context, characterName string, otherCharacterName string, cursor chat.HistoryCursor) (chat.ChatMessages, error)
+	UnreadDirectMessages(ctx context.Context, characterName string) (chat.UnreadConversations, error)
 
 	AuthorizedChannelsForCharacter(ctx context.Context, characterId uint) (chat.ChatChannels, error)
 	ChangeAuthorizationForCharacter(ctx context.Context, characterId uint, channelIds []uint, addAuth bool) error
 	// SetAuthorizationForCharacter(ctx context.Context, characterId uint, channelsIds []uint) error
+
+	JoinChannel(ctx context.Context, channelId uint, characterId uint, dimension string) error
+	LeaveChannel(ctx context.Context, channelId uint, characterId uint) error
+	InviteToChannel(ctx context.Context, channelId uint, characterId uint, inviterId uint) error
+	ChannelMembers(ctx context.Context, channelId uint) (chat.ChatChannelPermissions, error)
+	IsChannelMember(ctx context.Context, channelId uint, characterId uint) (bool, error)
+
+	SetChannelModerator(ctx context.Context, channelId uint, characterId uint, moderator bool) error
+	IsChannelModerator(ctx context.Context, channelId uint, characterId uint) (bool, error)
+	ModerateCharacter(ctx context.Context, moderation *chat.ChatChannelModeration, duration time.Duration) (*chat.ChatChannelModeration, error)
+	RevokeModeration(ctx context.Context, channelId uint, characterId uint, moderationType pb.ChatModerationType) error
+	ActiveModerations(ctx context.Context, channelId uint) (chat.ChatChannelModerations, error)
+	ActiveModeration(ctx context.Context, channelId uint, characterId uint, moderationType pb.ChatModerationType) (*chat.ChatChannelModeration, error)
+
+	GetFriends(ctx context.Context, userId string) (chat.UserFriends, error)
+	GetFriendRequests(ctx context.Context, userId string) (chat.UserFriendRequests, error)
+	SendFriendRequest(ctx context.Context, senderId string, targetId string) error
+	AcceptFriendRequest(ctx context.Context, userId string, senderId string) error
+	DeclineFriendRequest(ctx context.Context, userId string, otherId string) error
+	RemoveFriend(ctx context.Context, userId string, friendId string) error
+
+	GetBlockedUsers(ctx context.Context, userId string) (chat.UserBlocks, error)
+	BlockUser(ctx context.Context, userId string, blockedId string) error
+	UnblockUser(ctx context.Context, userId string, blockedId string) error
+	HasBlocked(ctx context.Context, userId string, blockedId string) (bool, error)
 }
 
 type chatService struct {
-	chatRepo  repository.ChatRepository
-	kafkaConn *kafka.Conn
+	chatRepo repository.ChatRepository
+	bus      messagebus.Bus
+	topics   config.ChatTopicsConfig
+
+	// filters message filter chains by profile name
+	filters map[string]chatfilter.Filter
 
-	channelMessageWriters map[uint]*kafka.Writer
-	directMessageWriters  map[string]*kafka.Writer
+	limiter    ratelimit.Limiter
+	rateLimits config.ChatRateLimitConfig
 }
 
+// ChangeAuthorizationForCharacter adds or removes authorization for the character to the given channels. Characters
+// cannot be authorized for channels they are actively banned from.
 func (s chatService) ChangeAuthorizationForCharacter(ctx context.Context, characterId uint, channelIds []uint, addAuth bool) error {
+	if addAuth {
+		for _, channelId := range channelIds {
+			ban, err := s.chatRepo.FindActiveModeration(ctx, channelId, characterId, pb.ChatModerationType_BAN)
+			if err != nil {
+				return err
+			}
+			if ban != nil {
+				return ErrBanned
+			}
+		}
+	}
+
 	return s.chatRepo.ChangeAuthorizationForCharacter(ctx, characterId, channelIds, addAuth)
 }
 
+// JoinChannel adds the character to the channel. Public channels can be joined by characters in the same dimension,
+// or any dimension if the channel has none. Private channels require an invite, which is used up by joining.
+func (s chatService) JoinChannel(ctx context.Context, channelId uint, characterId uint, dimension string) error {
+	ctx, span := tracer.Start(ctx, "JoinChannel")
+	defer span.End()
+
+	channel, err := s.chatRepo.FindChannelById(ctx, channelId)
+	if err != nil {
+		return err
+	}
+	if channel == nil {
+		return ErrChannelNotFound
+	}
+
+	member, err := s.chatRepo.FindChannelMember(ctx, channelId, characterId)
+	if err != nil {
+		return err
+	}
+	if member != nil {
+		return ErrAlreadyMember
+	}
+
+	switch channel.Type {
+	case pb.ChatChannelType_CHANNEL_PUBLIC:
+		if channel.Dimension != "" && channel.Dimension != dimension {
+			return ErrWrongDimension
+		}
+
+	case pb.ChatChannelType_CHANNEL_PRIVATE:
+		invite, err := s.chatRepo.FindChannelInvite(ctx, channelId, characterId)
+		if err != nil {
+			return err
+		}
+		if invite == nil {
+			return ErrNotInvited
+		}
+
+	default:
+		return ErrChannelNotJoinable
+	}
+
+	if err := s.ChangeAuthorizationForCharacter(ctx, characterId, []uint{channelId}, true); err != nil {
+		return err
+	}
+
+	return s.chatRepo.DeleteChannelInvite(ctx, channelId, characterId)
+}
+
+// LeaveChannel removes the character from the channel. If the character owns the channel, the channel is given to
+// the server.
+func (s chatService) LeaveChannel(ctx context.Context, channelId uint, characterId uint) error {
+	ctx, span := tracer.Start(ctx, "LeaveChannel")
+	defer span.End()
+
+	member, err := s.chatRepo.FindChannelMember(ctx, channelId, characterId)
+	if err != nil {
+		return err
+	}
+	if member == nil {
+		return ErrNotMember
+	}
+
+	err = s.chatRepo.ChangeAuthorizationForCharacter(ctx, characterId, []uint{channelId}, false)
+	if err != nil {
+		return err
+	}
+
+	channel, err := s.chatRepo.FindChannelById(ctx, channelId)
+	if err != nil {
+		return err
+	}
+
+	if channel != nil && channel.IsOwner(characterId) {
+		channel.OwnerId = nil
+		_, err = s.chatRepo.UpdateChannel(ctx, channel)
+		return err
+	}
+
+	return nil
+}
+
+// InviteToChannel invites the character to the private channel. Validating the inviter can invite to the channel is
+// left to the caller.
+func (s chatService) InviteToChannel(ctx context.Context, channelId uint, characterId uint, inviterId uint) error {
+	ctx, span := tracer.Start(ctx, "InviteToChannel")
+	defer span.End()
+
+	channel, err := s.chatRepo.FindChannelById(ctx, channelId)
+	if err != nil {
+		return err
+	}
+	if channel == nil {
+		return ErrChannelNotFound
+	}
+	if channel.Type != pb.ChatChannelType_CHANNEL_PRIVATE {
+		return ErrChannelNotInvitable
+	}
+
+	member, err := s.chatRepo.FindChannelMember(ctx, channelId, characterId)
+	if err != nil {
+		return err
+	}
+	if member != nil {
+		return ErrAlreadyMember
+	}
+
+	invite, err := s.chatRepo.FindChannelInvite(ctx, channelId, characterId)
+	if err != nil {
+		return err
+	}
+	if invite != nil {
+		return ErrAlreadyInvited
+	}
+
+	return s.chatRepo.CreateChannelInvite(ctx, &chat.ChatChannelInvite{
+		ChannelId:   channelId,
+		CharacterId: characterId,
+		InviterId:   inviterId,
+	})
+}
+
+func (s chatService) ChannelMembers(ctx context.Context, channelId uint) (chat.ChatChannelPermissions, error) {
+	return s.chatRepo.FindChannelMembers(ctx, channelId)
+}
+
+func (s chatService) IsChannelMember(ctx context.Context, channelId uint, characterId uint) (bool, error) {
+	member, err := s.chatRepo.FindChannelMember(ctx, channelId, characterId)
+	return member != nil, err
+}
+
+func (s chatService) SetChannelModerator(ctx context.Context, channelId uint, characterId uint, moderator bool) error {
+	return s.chatRepo.SetChannelModerator(ctx, channelId, characterId, moderator)
+}
+
+func (s chatService) IsChannelModerator(ctx context.Context, channelId uint, characterId uint) (bool, error) {
+	return s.chatRepo.IsChannelModerator(ctx, channelId, characterId)
+}
+
+// ModerateCharacter records the moderation action. Mutes and bans expire after the duration, or never if the duration
+// is zero. Kicks and bans also remove the character's authorization for the channel, and send a removal event on the
+// channel so the streams of the character's owner are closed on every replica.
+func (s chatService) ModerateCharacter(
+	ctx context.Context,
+	moderation *chat.ChatChannelModeration,
+	duration time.Duration,
+) (*chat.ChatChannelModeration, error) {
+	ctx, span := tracer.Start(ctx, "ModerateCharacter")
+	defer span.End()
+
+	now := time.Now()
+	moderation.ExpiresAt = nil
+	if moderation.Type == pb.ChatModerationType_KICK {
+		moderation.ExpiresAt = &now
+	} else if duration > 0 {
+		expiresAt := now.Add(duration)
+		moderation.ExpiresAt = &expiresAt
+	}
+
+	moderation, err := s.chatRepo.CreateModeration(ctx, moderation)
+	if err != nil {
+		return nil, err
+	}
+
+	if moderation.Type == pb.ChatModerationType_KICK || moderation.Type == pb.ChatModerationType_BAN {
+		err = s.chatRepo.ChangeAuthorizationForCharacter(ctx, moderation.CharacterId, []uint{moderation.ChannelId}, false)
+		if err != nil {
+			return nil, fmt.Errorf("removing authorization: %w", err)
+		}
+
+		err = s.publishTopicEvent(ctx, topicNameFromChannel(moderation.ChannelId), &pb.ChatMessage{
+			Type:        pb.ChatMessageType_CHARACTER_REMOVED,
+			CharacterId: uint64(moderation.CharacterId),
+			ChannelId:   uint64(moderation.ChannelId),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("publishing removal: %w", err)
+		}
+	}
+
+	return moderation, nil
+}
+
+func (s chatService) RevokeModeration(ctx context.Context, channelId uint, characterId uint, moderationType pb.ChatModerationType) error {
+	return s.chatRepo.ExpireModerations(ctx, channelId, characterId, moderationType)
+}
+
+func (s chatService) ActiveModerations(ctx context.Context, channelId uint) (chat.ChatChannelModerations, error) {
+	return s.chatRepo.FindActiveModerations(ctx, channelId)
+}
+
+func (s chatService) ActiveModeration(
+	ctx context.Context,
+	channelId uint,
+	characterId uint,
+	moderationType pb.ChatModerationType,
+) (*chat.ChatChannelModeration, error) {
+	return s.chatRepo.FindActiveModeration(ctx, channelId, characterId, moderationType)
+}
+
 func (s chatService) AuthorizedChannelsForCharacter(ctx context.Context, characterId uint) (chat.ChatChannels, error) {
 	return s.chatRepo.AuthorizedChannelsForCharacter(ctx, characterId)
 }
@@ -67,6 +397,10 @@ func (s chatService) UpdateChannel(ctx context.Context, request *pb.UpdateChatCh
 		return nil, err
 	}
 
+	if channel == nil {
+		return nil, ErrChannelNotFound
+	}
+
 	if request.OptionalName != nil {
 		channel.Name = request.OptionalName.(*pb.UpdateChatChannelRequest_Name).Name
 	}
@@ -75,88 +409,390 @@ func (s chatService) UpdateChannel(ctx context.Context, request *pb.UpdateChatCh
 		channel.Dimension = request.OptionalDimension.(*pb.UpdateChatChannelRequest_Dimension).Dimension
 	}
 
+	if request.OptionalFilterProfile != nil {
+		channel.FilterProfile = request.OptionalFilterProfile.(*pb.UpdateChatChannelRequest_FilterProfile).FilterProfile
+	}
+
+	if request.OptionalType != nil {
+		channel.Type = request.OptionalType.(*pb.UpdateChatChannelRequest_Type).Type
+	}
+
 	return s.chatRepo.UpdateChannel(ctx, channel)
 }
 
+func (s chatService) UnreadDirectMessages(ctx context.Context, characterName string) (chat.UnreadConversations, error) {
+	return s.chatRepo.FindUnreadDirectMessages(ctx, characterName)
+}
+
 func (s chatService) GetChannel(ctx context.Context, id uint) (*chat.ChatChannel, error) {
 	return s.chatRepo.FindChannelById(ctx, id)
 }
 
-func (s chatService) ChannelMessagesReader(ctx context.Context, channelId uint) *kafka.Reader {
+// ChannelMessagesReader subscribes to new messages sent to the channel. If afterMessageId is non-zero, the
+// subscription starts at the time the message was sent so no messages are missed after reading the channel history.
+// Messages with an id less than or equal to afterMessageId may still be read and should be skipped by the caller.
+func (s chatService) ChannelMessagesReader(ctx context.Context, channelId uint, afterMessageId uint) (messagebus.Subscription, error) {
 	ctx, span := tracer.Start(ctx, "ChannelMessagesReader")
 	defer span.End()
 
-	r := kafka.NewReader(kafka.ReaderConfig{
-		Brokers:  []string{s.kafkaConn.RemoteAddr().String()},
-		Topic:    topicNameFromChannel(channelId),
-		MinBytes: 1,
-		MaxBytes: 10e3,
-	})
-	_ = r.SetOffset(kafka.LastOffset)
+	var from time.Time
+	if afterMessageId != 0 {
+		message, err := s.chatRepo.FindMessageById(ctx, afterMessageId)
+		if err != nil {
+			return nil, err
+		}
+		if message == nil || message.ChannelId == nil || *message.ChannelId != channelId {
+			return nil, ErrMessageNotFound
+		}
+		from = message.CreatedAt
+	}
 
-	return r
+	return s.bus.Subscribe(ctx, topicNameFromChannel(channelId), from)
 }
 
-func (s chatService) DirectMessagesReader(ctx context.Context, characterName string) *kafka.Reader {
+// DirectMessagesReader subscribes to new direct messages and events sent to the character. The character's topic is
+// created if it does not exist yet.
+func (s chatService) DirectMessagesReader(ctx context.Context, characterName string) (messagebus.Subscription, error) {
 	ctx, span := tracer.Start(ctx, "DirectMessagesReader")
 	defer span.End()
 
-	r := kafka.NewReader(kafka.ReaderConfig{
-		Brokers:  []string{s.kafkaConn.RemoteAddr().String()},
-		Topic:    topicNameFromCharacter(characterName),
-		MinBytes: 1,
-		MaxBytes: 10e3,
-	})
-	_ = r.SetOffset(kafka.LastOffset)
+	if err := s.RegisterCharacterChatTopic(ctx, characterName); err != nil {
+		return nil, err
+	}
 
-	return r
+	return s.bus.Subscribe(ctx, topicNameFromCharacter(characterName), time.Time{})
 }
-func (s chatService) SendChannelMessage(ctx context.Context, characterName string, message string, channelId uint) error {
+
+// BlockChangesReader implements ChatService.
+func (s chatService) BlockChangesReader(ctx context.Context) (messagebus.Subscription, error) {
+	return s.bus.Subscribe(ctx, blocksTopic, time.Time{})
+}
+
+// SendChannelMessage saves the message and publishes it to the channel. The id and creation time of the message are
+// set by the server.
+func (s chatService) SendChannelMessage(ctx context.Context, channelId uint, message *chat.ChatMessage) (*chat.ChatMessage, error) {
 	ctx, span := tracer.Start(ctx, "SendChannelMessage")
 	defer span.End()
 
-	if len(message) == 0 {
-		return ErrEmptyMessage
+	if chat.IsEventType(message.Type) {
+		return nil, ErrInvalidMessageType
+	}
+
+	if err := s.checkRateLimit(ctx, message.SenderCharacterId, &channelId); err != nil {
+		return nil, err
+	}
+
+	channel, err := s.chatRepo.FindChannelById(ctx, channelId)
+	if err != nil {
+		return nil, err
+	}
+	if channel == nil {
+		return nil, ErrChannelNotFound
+	}
+
+	if channel.Type == pb.ChatChannelType_CHANNEL_SYSTEM &&
+		message.Type != pb.ChatMessageType_SYSTEM &&
+		message.Type != pb.ChatMessageType_ANNOUNCEMENT {
+		return nil, ErrChannelReadOnly
+	}
+
+	if err := s.filter(channel.FilterProfile).Filter(message); err != nil {
+		return nil, err
+	}
+
+	if len(message.Message) == 0 {
+		return nil, ErrEmptyMessage
 	}
 
-	w := s.getChannelMessageWriter(ctx, channelId)
+	message.ID = 0
+	message.ChannelId = &channelId
+	message.TargetCharacterName = nil
+	if err := s.verifyReply(ctx, message); err != nil {
+		return nil, err
+	}
 
-	return w.WriteMessages(ctx,
-		kafka.Message{
-			Key:   []byte(characterName),
-			Value: []byte(message),
-		},
-	)
+	return s.publish(ctx, topicNameFromChannel(channelId), message)
 }
 
-func (s chatService) SendDirectMessage(ctx context.Context, characterName string, message string, targetCharacterName string) error {
+// SendDirectMessage saves the message and publishes it to the target character. Direct messages are always whispers.
+func (s chatService) SendDirectMessage(ctx context.Context, targetCharacterName string, message *chat.ChatMessage) (*chat.ChatMessage, error) {
 	ctx, span := tracer.Start(ctx, "SendDirectMessage")
 	defer span.End()
 
-	if len(message) == 0 {
-		return ErrEmptyMessage
+	if err := s.checkRateLimit(ctx, message.SenderCharacterId, nil); err != nil {
+		return nil, err
+	}
+
+	if err := s.filter(config.DefaultChatFilterProfile).Filter(message); err != nil {
+		return nil, err
+	}
+
+	if len(message.Message) == 0 {
+		return nil, ErrEmptyMessage
+	}
+
+	message.ID = 0
+	message.ChannelId = nil
+	message.TargetCharacterName = &targetCharacterName
+	message.Type = pb.ChatMessageType_WHISPER
+	if err := s.verifyReply(ctx, message); err != nil {
+		return nil, err
+	}
+
+	return s.publish(ctx, topicNameFromCharacter(targetCharacterName), message)
+}
+
+// NotifyTyping sends a typing event to the target's direct message stream
+func (s chatService) NotifyTyping(
+	ctx context.Context,
+	characterId uint,
+	characterName string,
+	targetCharacterName string,
+) error {
+	ctx, span := tracer.Start(ctx, "NotifyTyping")
+	defer span.End()
+
+	return s.publishEvent(ctx, targetCharacterName, &pb.ChatMessage{
+		Type:                pb.ChatMessageType_TYPING,
+		CharacterId:         uint64(characterId),
+		CharacterName:       characterName,
+		TargetCharacterName: targetCharacterName,
+	})
+}
+
+// MarkRead marks the direct messages sent to the character up to the message as read, and sends a read receipt to
+// the sender's direct message stream. Only messages sent to the character can be marked as read.
+func (s chatService) MarkRead(ctx context.Context, characterId uint, characterName string, messageId uint) error {
+	ctx, span := tracer.Start(ctx, "MarkRead")
+	defer span.End()
+
+	message, err := s.chatRepo.FindMessageById(ctx, messageId)
+	if err != nil {
+		return err
+	}
+	if message == nil || message.TargetCharacterName == nil || *message.TargetCharacterName != characterName {
+		return ErrMessageNotFound
+	}
+
+	err = s.chatRepo.MarkDirectMessagesRead(ctx, characterName, message.SenderCharacterName, messageId)
+	if err != nil {
+		return err
+	}
+
+	return s.publishEvent(ctx, message.SenderCharacterName, &pb.ChatMessage{
+		Type:                pb.ChatMessageType_READ_RECEIPT,
+		CharacterId:         uint64(characterId),
+		CharacterName:       characterName,
+		TargetCharacterName: message.SenderCharacterName,
+		ReadMessageId:       uint64(messageId),
+	})
+}
+
+// checkRateLimit takes a token from the character's bucket, and the channel's bucket if the message is sent to a
+// channel. A *ratelimit.Error is returned if either is empty. If the limiter fails, messages are allowed so chat keeps
+// working without it.
+func (s chatService) checkRateLimit(ctx context.Context, characterId uint, channelId *uint) error {
+	allowed, wait, err := s.limiter.Allow(ctx, fmt.Sprintf("chat:ratelimit:character:%d", characterId), s.rateLimits.Character)
+	if err != nil {
+		log.Logger.WithContext(ctx).Errorf("character rate limit: %v", err)
+		return nil
+	}
+	if !allowed {
+		s.recordRateLimitViolation(ctx, characterId, channelId)
+		return &ratelimit.Error{RetryAfter: wait}
+	}
+
+	if channelId == nil {
+		return nil
+	}
+
+	allowed, wait, err = s.limiter.Allow(ctx, fmt.Sprintf("chat:ratelimit:channel:%d", *channelId), s.rateLimits.Channel)
+	if err != nil {
+		log.Logger.WithContext(ctx).Errorf("channel rate limit: %v", err)
+		return nil
+	}
+	if !allowed {
+		return &ratelimit.Error{RetryAfter: wait}
+	}
+
+	return nil
+}
+
+// recordRateLimitViolation counts the violation using a bucket that allows MuteAfter violations per MuteWindow. Once
+// it is empty, the character is muted in the channel. Direct messages are only rate limited since mutes are per
+// channel.
+func (s chatService) recordRateLimitViolation(ctx context.Context, characterId uint, channelId *uint) {
+	if s.rateLimits.MuteAfter <= 0 || s.rateLimits.MuteWindow <= 0 {
+		return
+	}
+
+	allowed, _, err := s.limiter.Allow(ctx, fmt.Sprintf("chat:ratelimit:violations:%d", characterId), config.RateLimit{
+		Rate:  float64(s.rateLimits.MuteAfter) / s.rateLimits.MuteWindow.Seconds(),
+		Burst: s.rateLimits.MuteAfter,
+	})
+	if err != nil {
+		log.Logger.WithContext(ctx).Errorf("rate limit violations: %v", err)
+		return
+	}
+
+	if allowed || channelId == nil {
+		return
+	}
+
+	_, err = s.ModerateCharacter(ctx, &chat.ChatChannelModeration{
+		ChannelId:   *channelId,
+		CharacterId: characterId,
+		Type:        pb.ChatModerationType_MUTE,
+		Reason:      "automatic mute for flooding the channel",
+	}, s.rateLimits.MuteDuration)
+	if err != nil {
+		log.Logger.WithContext(ctx).Errorf("automatic mute: %v", err)
+		return
+	}
+
+	log.Logger.WithContext(ctx).Infof("automatically muted character %d in channel %d", characterId, *channelId)
+}
+
+// filter gets the filter chain for the profile, falling back to the default profile. If no default profile is
+// configured, messages are not filtered.
+func (s chatService) filter(profile string) chatfilter.Filter {
+	if filter, ok := s.filters[profile]; ok {
+		return filter
+	}
+
+	if filter, ok := s.filters[config.DefaultChatFilterProfile]; ok {
+		return filter
+	}
+
+	return chatfilter.Chain{}
+}
+
+func (s chatService) publish(ctx context.Context, topic string, message *chat.ChatMessage) (*chat.ChatMessage, error) {
+	message, err := s.chatRepo.CreateMessage(ctx, message)
+	if err != nil {
+		return nil, fmt.Errorf("saving message: %w", err)
+	}
+
+	busMessage, err := chatBusMessage(message)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.bus.Publish(ctx, topic, busMessage)
+
+	// Characters that never connected have no topic yet, and get the saved direct message from their history
+	if err != nil && (message.TargetCharacterName == nil || !errors.Is(err, messagebus.ErrTopicNotFound)) {
+		return nil, fmt.Errorf("publishing message: %w", err)
+	}
+
+	return message, nil
+}
+
+// publishEvent publishes the event to the direct message topic of the character without saving it. Characters that
+// never connected have no topic to publish to, and are not sent the event.
+func (s chatService) publishEvent(ctx context.Context, characterName string, event *pb.ChatMessage) error {
+	return s.publishTopicEvent(ctx, topicNameFromCharacter(characterName), event)
+}
+
+// publishTopicEvent publishes the event to the topic without saving it. A topic that does not exist has no streams to
+// send the event to.
+func (s chatService) publishTopicEvent(ctx context.Context, topic string, event *pb.ChatMessage) error {
+	now := time.Now()
+	event.SentAt = now.UnixMilli()
+
+	value, err := proto.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("encoding event: %w", err)
+	}
+
+	err = s.bus.Publish(ctx, topic, messagebus.Message{
+		Key:   []byte(strconv.FormatUint(event.CharacterId, 10)),
+		Value: value,
+		Time:  now,
+	})
+	if errors.Is(err, messagebus.ErrTopicNotFound) {
+		return nil
+	}
+
+	return err
+}
+
+// verifyReply checks the message being replied to exists in the same channel or conversation
+func (s chatService) verifyReply(ctx context.Context, message *chat.ChatMessage) error {
+	if message.ReplyToId == nil {
+		return nil
+	}
+
+	replyTo, err := s.chatRepo.FindMessageById(ctx, *message.ReplyToId)
+	if err != nil {
+		return err
+	}
+	if replyTo == nil {
+		return ErrMessageNotFound
+	}
+
+	if message.ChannelId != nil {
+		if replyTo.ChannelId == nil || *replyTo.ChannelId != *message.ChannelId {
+			return ErrMessageNotFound
+		}
+
+		return nil
 	}
-	w := s.getCharacterMessageWriter(ctx, targetCharacterName)
 
-	return w.WriteMessages(ctx,
-		kafka.Message{
-			Key:   []byte(characterName),
-			Value: []byte(message),
-		},
-	)
+	if replyTo.TargetCharacterName == nil {
+		return ErrMessageNotFound
+	}
+
+	sender, target := message.SenderCharacterName, *message.TargetCharacterName
+	if (replyTo.SenderCharacterName == sender && *replyTo.TargetCharacterName == target) ||
+		(replyTo.SenderCharacterName == target && *replyTo.TargetCharacterName == sender) {
+		return nil
+	}
+
+	return ErrMessageNotFound
+}
+
+func (s chatService) GetChannelHistory(ctx context.Context, channelId uint, cursor chat.HistoryCursor) (chat.ChatMessages, error) {
+	return s.chatRepo.FindChannelMessages(ctx, channelId, cursor)
+}
+
+func (s chatService) GetDirectMessageHistory(
+	ctx context.Context,
+	characterName string,
+	otherCharacterName string,
+	cursor chat.HistoryCursor,
+) (chat.ChatMessages, error) {
+	return s.chatRepo.FindDirectMessages(ctx, characterName, otherCharacterName, cursor)
 }
 
 func (s chatService) AllChannels(ctx context.Context) (chat.ChatChannels, error) {
 	return s.chatRepo.AllChannels(ctx)
 }
 
+// CreateChannel creates the channel and its topic. If the channel has an owner, the owner is added as a
+// moderator of the channel.
 func (s chatService) CreateChannel(ctx context.Context, channel *chat.ChatChannel) (*chat.ChatChannel, error) {
 	newChannel, err := s.chatRepo.CreateChannel(ctx, channel)
 	if err != nil {
 		return nil, err
 	}
 
-	_ = s.kafkaConn.CreateTopics(createTopicConfigFromChannel(newChannel))
+	if newChannel.OwnerId != nil {
+		err = s.chatRepo.ChangeAuthorizationForCharacter(ctx, *newChannel.OwnerId, []uint{newChannel.ID}, true)
+		if err != nil {
+			return nil, fmt.Errorf("adding owner: %w", err)
+		}
+
+		err = s.chatRepo.SetChannelModerator(ctx, newChannel.ID, *newChannel.OwnerId, true)
+		if err != nil {
+			return nil, fmt.Errorf("adding owner as moderator: %w", err)
+		}
+	}
+
+	if err := s.bus.CreateTopics(ctx, s.channelTopic(newChannel)); err != nil {
+		log.Logger.WithContext(ctx).Errorf("create topic for channel %d: %v", newChannel.ID, err)
+	}
+
 	return newChannel, nil
 }
 
@@ -166,108 +802,429 @@ func (s chatService) DeleteChannel(ctx context.Context, channel *chat.ChatChanne
 		return err
 	}
 
-	_ = s.kafkaConn.DeleteTopics(topicNameFromChannel(channel.ID))
+	if err := s.bus.DeleteTopics(ctx, topicNameFromChannel(channel.ID)); err != nil {
+		log.Logger.WithContext(ctx).Errorf("delete topic for channel %d: %v", channel.ID, err)
+	}
 
 	return nil
 }
 
-func NewChatService(ctx context.Context, chatRepo repository.ChatRepository, kafkaAddress config.ServerAddress) (ChatService, error) {
-	ctx, span := tracer.Start(ctx, "NewChatService")
+func (s chatService) GetFriends(ctx context.Context, userId string) (chat.UserFriends, error) {
+	return s.chatRepo.FindFriends(ctx, userId)
+}
+
+func (s chatService) GetFriendRequests(ctx context.Context, userId string) (chat.UserFriendRequests, error) {
+	return s.chatRepo.FindFriendRequests(ctx, userId)
+}
+
+// SendFriendRequest creates a friend request from the sender to the target. If the target has already sent a
+// friend request to the sender, the request is accepted instead.
+func (s chatService) SendFriendRequest(ctx context.Context, senderId string, targetId string) error {
+	ctx, span := tracer.Start(ctx, "SendFriendRequest")
 	defer span.End()
 
-	err := chatRepo.Migrate(ctx)
+	if senderId == targetId {
+		return ErrFriendSelf
+	}
+
+	if err := s.verifyNotBlocked(ctx, senderId, targetId); err != nil {
+		return err
+	}
+
+	friend, err := s.chatRepo.FindFriend(ctx, senderId, targetId)
 	if err != nil {
-		return nil, fmt.Errorf("migrate db: %w", err)
+		return err
+	}
+	if friend != nil {
+		return ErrAlreadyFriends
 	}
 
-	conn, err := repository.ConnectKafka(kafkaAddress)
+	request, err := s.chatRepo.FindFriendRequest(ctx, targetId, senderId)
 	if err != nil {
-		return nil, fmt.Errorf("connecting kafka: %w", err)
+		return err
+	}
+	if request != nil {
+		return s.chatRepo.AddFriend(ctx, senderId, targetId)
 	}
 
-	service := chatService{
-		chatRepo:              chatRepo,
-		kafkaConn:             conn,
-		channelMessageWriters: map[uint]*kafka.Writer{},
-		directMessageWriters:  map[string]*kafka.Writer{},
+	request, err = s.chatRepo.FindFriendRequest(ctx, senderId, targetId)
+	if err != nil {
+		return err
 	}
+	if request != nil {
+		return ErrFriendRequestExists
+	}
+
+	_, err = s.chatRepo.CreateFriendRequest(ctx, senderId, targetId)
+	return err
+}
 
-	channels, err := service.AllChannels(ctx)
+func (s chatService) AcceptFriendRequest(ctx context.Context, userId string, senderId string) error {
+	ctx, span := tracer.Start(ctx, "AcceptFriendRequest")
+	defer span.End()
+
+	request, err := s.chatRepo.FindFriendRequest(ctx, senderId, userId)
 	if err != nil {
-		return nil, err
+		return err
 	}
+	if request == nil {
+		return ErrFriendRequestNotFound
+	}
+
+	return s.chatRepo.AddFriend(ctx, userId, senderId)
+}
+
+// DeclineFriendRequest removes a pending friend request between the two users. Both incoming and outgoing
+// requests are removed so a sender is able to cancel their own request.
+func (s chatService) DeclineFriendRequest(ctx context.Context, userId string, otherId string) error {
+	ctx, span := tracer.Start(ctx, "DeclineFriendRequest")
+	defer span.End()
+
+	found := false
+	for _, ids := range [][2]string{{otherId, userId}, {userId, otherId}} {
+		request, err := s.chatRepo.FindFriendRequest(ctx, ids[0], ids[1])
+		if err != nil {
+			return err
+		}
+		if request == nil {
+			continue
+		}
 
-	topicConfigs := make([]kafka.TopicConfig, len(channels))
+		found = true
+		if err := s.chatRepo.DeleteFriendRequest(ctx, ids[0], ids[1]); err != nil {
+			return err
+		}
+	}
 
-	for idx, channel := range channels {
-		topicConfigs[idx] = createTopicConfigFromChannel(channel)
+	if !found {
+		return ErrFriendRequestNotFound
 	}
 
-	err = service.kafkaConn.CreateTopics(topicConfigs...)
+	return nil
+}
+
+func (s chatService) RemoveFriend(ctx context.Context, userId string, friendId string) error {
+	ctx, span := tracer.Start(ctx, "RemoveFriend")
+	defer span.End()
+
+	friend, err := s.chatRepo.FindFriend(ctx, userId, friendId)
 	if err != nil {
-		return nil, err
+		return err
+	}
+	if friend == nil {
+		return ErrNotFriends
 	}
 
-	return service, nil
+	return s.chatRepo.RemoveFriend(ctx, userId, friendId)
 }
 
-// RegisterCharacterChatTopic implements ChatService.
-func (service chatService) RegisterCharacterChatTopic(ctx context.Context, username string) error {
-	return service.kafkaConn.CreateTopics(createTopicConfigFromCharacter(username))
+func (s chatService) GetBlockedUsers(ctx context.Context, userId string) (chat.UserBlocks, error) {
+	return s.chatRepo.FindBlocks(ctx, userId)
+}
+
+// BlockUser blocks the target user which also removes any friendship or pending friend requests between them
+func (s chatService) BlockUser(ctx context.Context, userId string, blockedId string) error {
+	ctx, span := tracer.Start(ctx, "BlockUser")
+	defer span.End()
+
+	if userId == blockedId {
+		return ErrFriendSelf
+	}
+
+	block, err := s.chatRepo.FindBlock(ctx, userId, blockedId)
+	if err != nil {
+		return err
+	}
+	if block != nil {
+		return ErrAlreadyBlocked
+	}
+
+	err = s.chatRepo.Block(ctx, userId, blockedId)
+	if err != nil {
+		return err
+	}
+
+	return s.publishBlockChange(ctx, userId)
+}
+
+func (s chatService) UnblockUser(ctx context.Context, userId string, blockedId string) error {
+	ctx, span := tracer.Start(ctx, "UnblockUser")
+	defer span.End()
+
+	block, err := s.chatRepo.FindBlock(ctx, userId, blockedId)
+	if err != nil {
+		return err
+	}
+	if block == nil {
+		return ErrNotBlocked
+	}
+
+	err = s.chatRepo.Unblock(ctx, userId, blockedId)
+	if err != nil {
+		return err
+	}
+
+	return s.publishBlockChange(ctx, userId)
 }
 
-func createTopicConfigFromChannel(channel *chat.ChatChannel) kafka.TopicConfig {
-	return kafka.TopicConfig{
-		Topic:             topicNameFromChannel(channel.ID),
-		NumPartitions:     1,
-		ReplicationFactor: 1,
+// publishBlockChange notifies the streams of the user on every replica that the users they blocked changed
+func (s chatService) publishBlockChange(ctx context.Context, userId string) error {
+	err := s.bus.Publish(ctx, blocksTopic, messagebus.Message{Key: []byte(userId)})
+	if err != nil {
+		return fmt.Errorf("publishing block change: %w", err)
 	}
+
+	return nil
 }
 
-func createTopicConfigFromCharacter(name string) kafka.TopicConfig {
-	return kafka.TopicConfig{
-		Topic:             topicNameFromCharacter(name),
-		NumPartitions:     1,
-		ReplicationFactor: 1,
+// HasBlocked checks whether the user has blocked the other user
+func (s chatService) HasBlocked(ctx context.Context, userId string, blockedId string) (bool, error) {
+	block, err := s.chatRepo.FindBlock(ctx, userId, blockedId)
+	if err != nil {
+		return false, err
 	}
+
+	return block != nil, nil
 }
 
-func topicNameFromChannel(channelId uint) string {
-	return fmt.Sprintf("channel-%d", channelId)
+// verifyNotBlocked returns ErrBlocked if either user has blocked the other
+func (s chatService) verifyNotBlocked(ctx context.Context, userId string, otherId string) error {
+	for _, ids := range [][2]string{{userId, otherId}, {otherId, userId}} {
+		blocked, err := s.HasBlocked(ctx, ids[0], ids[1])
+		if err != nil {
+			return err
+		}
+		if blocked {
+			return ErrBlocked
+		}
+	}
+
+	return nil
 }
 
-func topicNameFromCharacter(name string) string {
-	return fmt.Sprintf("character-%s", name)
+func NewChatService(
+	ctx context.Context,
+	chatRepo repository.ChatRepository,
+	bus messagebus.Bus,
+	limiter ratelimit.Limiter,
+	conf config.ChatServer,
+) (ChatService, error) {
+	ctx, span := tracer.Start(ctx, "NewChatService")
+	defer span.End()
+
+	err := chatRepo.Migrate(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("migrate db: %w", err)
+	}
+
+	service := chatService{
+		chatRepo:   chatRepo,
+		bus:        bus,
+		topics:     conf.Topics,
+		filters:    chatfilter.NewProfiles(conf.Filters),
+		limiter:    limiter,
+		rateLimits: conf.RateLimits,
+	}
+
+	err = service.ReconcileTopics(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return service, nil
 }
 
-func (s chatService) getChannelMessageWriter(ctx context.Context, channelId uint) *kafka.Writer {
-	ctx, span := tracer.Start(ctx, "getChannelMessageWriter")
+// RegisterCharacterChatTopic implements ChatService.
+func (service chatService) RegisterCharacterChatTopic(ctx context.Context, username string) error {
+	return service.bus.CreateTopics(ctx, service.characterTopic(username))
+}
+
+// UnregisterCharacterChatTopic deletes the topic of the character along with its undelivered direct messages
+func (service chatService) UnregisterCharacterChatTopic(ctx context.Context, username string) error {
+	return service.bus.DeleteTopics(ctx, topicNameFromCharacter(username))
+}
+
+// MigrateCharacterName moves the direct messages of a renamed character to its new name, replaces its topic and sends
+// a rename event to the characters it has conversations with. Undelivered messages on the old topic are not moved
+// since they remain in the direct message history.
+func (s chatService) MigrateCharacterName(ctx context.Context, characterId uint, oldName string, newName string) error {
+	ctx, span := tracer.Start(ctx, "MigrateCharacterName")
 	defer span.End()
 
-	if s.channelMessageWriters[channelId] == nil {
-		s.channelMessageWriters[channelId] = &kafka.Writer{
-			Addr:     s.kafkaConn.RemoteAddr(),
-			Topic:    topicNameFromChannel(channelId),
-			Balancer: &kafka.LeastBytes{},
-			Async:    true,
+	partners, err := s.chatRepo.FindConversationPartners(ctx, oldName)
+	if err != nil {
+		return fmt.Errorf("find conversations: %w", err)
+	}
+
+	err = s.chatRepo.RenameDirectMessages(ctx, oldName, newName)
+	if err != nil {
+		return fmt.Errorf("rename direct messages: %w", err)
+	}
+
+	err = s.RegisterCharacterChatTopic(ctx, newName)
+	if err != nil {
+		return fmt.Errorf("create topic: %w", err)
+	}
+
+	err = s.UnregisterCharacterChatTopic(ctx, oldName)
+	if err != nil {
+		return fmt.Errorf("delete topic: %w", err)
+	}
+
+	for _, partner := range partners {
+		err = s.publishEvent(ctx, partner, &pb.ChatMessage{
+			Type:                  pb.ChatMessageType_CHARACTER_RENAMED,
+			CharacterId:           uint64(characterId),
+			CharacterName:         newName,
+			TargetCharacterName:   partner,
+			PreviousCharacterName: oldName,
+		})
+		if err != nil {
+			log.Logger.WithContext(ctx).Warnf("rename event to %s: %v", partner, err)
 		}
 	}
 
-	return s.channelMessageWriters[channelId]
+	return nil
 }
 
-func (s chatService) getCharacterMessageWriter(ctx context.Context, name string) *kafka.Writer {
-	ctx, span := tracer.Start(ctx, "getCharacterMessageWriter")
+// ReconcileTopics creates missing channel topics and deletes the topics of channels that no longer exist. If
+// characterNames is not nil, the topics of characters that are not in the names it gets are deleted as well. Character
+// topics are never created since they are created when the character connects. The topics are listed before the
+// channels and characters are loaded, so topics of channels and characters created in the meantime are not deleted.
+func (s chatService) ReconcileTopics(
+	ctx context.Context,
+	characterNames func(ctx context.Context) ([]string, error),
+) error {
+	ctx, span := tracer.Start(ctx, "ReconcileTopics")
 	defer span.End()
 
-	if s.directMessageWriters[name] == nil {
-		s.directMessageWriters[name] = &kafka.Writer{
-			Addr:     s.kafkaConn.RemoteAddr(),
-			Topic:    topicNameFromCharacter(name),
-			Balancer: &kafka.LeastBytes{},
-			Async:    true,
+	existing, err := s.bus.ListTopics(ctx)
+	if err != nil {
+		return fmt.Errorf("list topics: %w", err)
+	}
+
+	channels, err := s.AllChannels(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Character topics are only reconciled if all characters could be found
+	var names []string
+	if characterNames != nil {
+		names, err = characterNames(ctx)
+		if err != nil {
+			log.Logger.WithContext(ctx).Warnf("skipping character topics: %v", err)
+			names = nil
+		}
+	}
+
+	expected := make(map[string]struct{}, len(channels)+len(names))
+	for _, name := range names {
+		expected[topicNameFromCharacter(name)] = struct{}{}
+	}
+
+	var missing []messagebus.Topic
+	existingSet := make(map[string]struct{}, len(existing))
+	for _, topic := range existing {
+		existingSet[topic] = struct{}{}
+	}
+	// Block changes are short-lived notifications like the events sent to characters
+	expected[blocksTopic] = struct{}{}
+	if _, ok := existingSet[blocksTopic]; !ok {
+		missing = append(missing, messagebus.Topic{Name: blocksTopic, TopicConfig: s.topics.Character})
+	}
+	for _, channel := range channels {
+		topic := s.channelTopic(channel)
+		expected[topic.Name] = struct{}{}
+		if _, ok := existingSet[topic.Name]; !ok {
+			missing = append(missing, topic)
+		}
+	}
+
+	var stale []string
+	for _, topic := range existing {
+		if _, ok := expected[topic]; ok {
+			continue
+		}
+
+		if isChannelTopic(topic) || (names != nil && strings.HasPrefix(topic, characterTopicPrefix)) {
+			stale = append(stale, topic)
+		}
+	}
+
+	if len(missing) > 0 {
+		log.Logger.WithContext(ctx).Infof("creating %d missing chat topics", len(missing))
+		if err := s.bus.CreateTopics(ctx, missing...); err != nil {
+			return fmt.Errorf("create topics: %w", err)
+		}
+	}
+
+	if len(stale) > 0 {
+		log.Logger.WithContext(ctx).Infof("deleting %d stale chat topics", len(stale))
+		if err := s.bus.DeleteTopics(ctx, stale...); err != nil {
+			return fmt.Errorf("delete topics: %w", err)
 		}
 	}
 
-	return s.directMessageWriters[name]
+	return nil
+}
+
+// channelTopic gets the topic for the channel using the channel topic config and any override for the channel
+func (s chatService) channelTopic(channel *chat.ChatChannel) messagebus.Topic {
+	return messagebus.Topic{
+		Name:        topicNameFromChannel(channel.ID),
+		TopicConfig: s.topics.Channel.Merge(s.topics.Channels[channel.Name]),
+	}
+}
+
+func (s chatService) characterTopic(name string) messagebus.Topic {
+	return messagebus.Topic{
+		Name:        topicNameFromCharacter(name),
+		TopicConfig: s.topics.Character,
+	}
+}
+
+// chatBusMessage creates the bus message for a persisted chat message. The value is the protobuf encoded chat
+// message, and the bus message time is set to the time the chat message was created so subscriptions can be started
+// at a message from history.
+func chatBusMessage(message *chat.ChatMessage) (messagebus.Message, error) {
+	value, err := proto.Marshal(message.ToPb())
+	if err != nil {
+		return messagebus.Message{}, fmt.Errorf("encoding message: %w", err)
+	}
+
+	return messagebus.Message{
+		Key:   []byte(strconv.FormatUint(uint64(message.SenderCharacterId), 10)),
+		Value: value,
+		Time:  message.CreatedAt,
+	}, nil
+}
+
+// DecodeChatMessage decodes a chat message read from a chat topic
+func DecodeChatMessage(msg messagebus.Message) (*pb.ChatMessage, error) {
+	message := &pb.ChatMessage{}
+	if err := proto.Unmarshal(msg.Value, message); err != nil {
+		return nil, fmt.Errorf("decoding message: %w", err)
+	}
+
+	return message, nil
+}
+
+func topicNameFromChannel(channelId uint) string {
+	return fmt.Sprintf("%s%d", channelTopicPrefix, channelId)
+}
+
+func topicNameFromCharacter(name string) string {
+	return characterTopicPrefix + name
+}
+
+// isChannelTopic checks if the topic is named after a channel
+func isChannelTopic(topic string) bool {
+	id, ok := strings.CutPrefix(topic, channelTopicPrefix)
+	if !ok {
+		return false
+	}
+
+	_, err := strconv.ParseUint(id, 10, 64)
+	return err == nil
 }
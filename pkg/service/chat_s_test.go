@@ -2,16 +2,20 @@ package service_test
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 	"time"
 
+	"github.com/ShatteredRealms/go-backend/pkg/chatfilter"
 	"github.com/ShatteredRealms/go-backend/pkg/config"
 	"github.com/ShatteredRealms/go-backend/pkg/log"
+	"github.com/ShatteredRealms/go-backend/pkg/messagebus"
 	"github.com/ShatteredRealms/go-backend/pkg/mocks"
 	"github.com/ShatteredRealms/go-backend/pkg/model/chat"
 	"github.com/ShatteredRealms/go-backend/pkg/pb"
+	"github.com/ShatteredRealms/go-backend/pkg/ratelimit"
 	"github.com/ShatteredRealms/go-backend/pkg/service"
-	testdb "github.com/ShatteredRealms/go-backend/test/db"
 	"github.com/bxcodec/faker/v4"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -27,11 +31,10 @@ var _ = Describe("Chat service", Ordered, func() {
 
 		mockController *gomock.Controller
 		mockRepository *mocks.MockChatRepository
-		cleanupFunc    func()
 
 		chatService service.ChatService
 
-		kafkaPort string
+		bus messagebus.Bus
 
 		err       error
 		fakeError = fmt.Errorf("error")
@@ -55,47 +58,44 @@ var _ = Describe("Chat service", Ordered, func() {
 				Dimension: faker.Username(),
 			},
 		}
+		filters = map[string]config.ChatFilterConfig{
+			config.DefaultChatFilterProfile: {
+				MaxLength:    128,
+				StripControl: true,
+				SpamRepeats:  2,
+				SpamWindow:   time.Minute,
+			},
+		}
 	)
 
 	BeforeAll(func() {
 		log.Logger, hook = test.NewNullLogger()
 		mockController = gomock.NewController(GinkgoT())
 		mockRepository = mocks.NewMockChatRepository(mockController)
-		cleanupFunc, kafkaPort = testdb.SetupKafkaWithDocker()
+		bus = messagebus.NewMemoryBus()
 		hook.Reset()
 	})
 
+	chatConfig := func() config.ChatServer {
+		return config.ChatServer{
+			Filters: filters,
+		}
+	}
+
 	Describe("NewChatService", Ordered, func() {
 		When("given invalid input", func() {
 			It("should fail if migration fails", func() {
 				mockRepository.EXPECT().Migrate(gomock.Any()).Return(fakeError)
-				chatService, err = service.NewChatService(context.Background(), mockRepository, config.ServerAddress{
-					Port: kafkaPort,
-					Host: "127.0.0.1",
-				})
+				chatService, err = service.NewChatService(context.Background(), mockRepository, bus, ratelimit.NewMemoryLimiter(), chatConfig())
 
 				Expect(err).To(MatchError(fakeError))
 				Expect(chatService).To(BeNil())
 			})
 
-			It("should fail if kafka connect fails", func() {
-				mockRepository.EXPECT().Migrate(gomock.Any()).Return(nil)
-				chatService, err = service.NewChatService(context.Background(), mockRepository, config.ServerAddress{
-					Port: "0",
-					Host: "nowhere",
-				})
-
-				Expect(err).To(HaveOccurred())
-				Expect(chatService).To(BeNil())
-			})
-
-			It("should fail if kafka all channels", func() {
+			It("should fail if getting all channels fails", func() {
 				mockRepository.EXPECT().Migrate(gomock.Any()).Return(nil)
 				mockRepository.EXPECT().AllChannels(gomock.Any()).Return(chat.ChatChannels{}, fakeError)
-				chatService, err = service.NewChatService(context.Background(), mockRepository, config.ServerAddress{
-					Port: kafkaPort,
-					Host: "127.0.0.1",
-				})
+				chatService, err = service.NewChatService(context.Background(), mockRepository, bus, ratelimit.NewMemoryLimiter(), chatConfig())
 
 				Expect(err).To(HaveOccurred())
 				Expect(chatService).To(BeNil())
@@ -107,10 +107,7 @@ var _ = Describe("Chat service", Ordered, func() {
 				Eventually(func(g Gomega) error {
 					mockRepository.EXPECT().Migrate(gomock.Any()).Return(nil).AnyTimes()
 					mockRepository.EXPECT().AllChannels(gomock.Any()).Return(channels, nil).AnyTimes()
-					chatService, err = service.NewChatService(context.Background(), mockRepository, config.ServerAddress{
-						Port: kafkaPort,
-						Host: "127.0.0.1",
-					})
+					chatService, err = service.NewChatService(context.Background(), mockRepository, bus, ratelimit.NewMemoryLimiter(), chatConfig())
 					return err
 				}).Within(time.Minute).Should(Succeed())
 			})
@@ -122,10 +119,103 @@ var _ = Describe("Chat service", Ordered, func() {
 			ctx := context.Background()
 			id := uint(1)
 			ids := []uint{1, 2, 3}
+			for _, channelId := range ids {
+				mockRepository.EXPECT().FindActiveModeration(ctx, channelId, id, pb.ChatModerationType_BAN).Return(nil, nil)
+			}
 			mockRepository.EXPECT().ChangeAuthorizationForCharacter(ctx, id, ids, true).Return(fakeError)
 			err := chatService.ChangeAuthorizationForCharacter(ctx, id, ids, true)
 			Expect(err).To(MatchError(fakeError))
 		})
+
+		It("should not authorize banned characters", func() {
+			ctx := context.Background()
+			id := uint(1)
+			mockRepository.EXPECT().FindActiveModeration(ctx, uint(1), id, pb.ChatModerationType_BAN).
+				Return(&chat.ChatChannelModeration{Type: pb.ChatModerationType_BAN}, nil)
+			err := chatService.ChangeAuthorizationForCharacter(ctx, id, []uint{1}, true)
+			Expect(err).To(MatchError(service.ErrBanned))
+		})
+
+		It("should not check bans when removing authorization", func() {
+			ctx := context.Background()
+			id := uint(1)
+			ids := []uint{1}
+			mockRepository.EXPECT().ChangeAuthorizationForCharacter(ctx, id, ids, false).Return(nil)
+			Expect(chatService.ChangeAuthorizationForCharacter(ctx, id, ids, false)).To(Succeed())
+		})
+	})
+
+	Describe("ModerateCharacter", func() {
+		createModeration := func(_ context.Context, moderation *chat.ChatChannelModeration) (*chat.ChatChannelModeration, error) {
+			return moderation, nil
+		}
+
+		It("should mute until the duration expires", func() {
+			mockRepository.EXPECT().CreateModeration(gomock.Any(), gomock.Any()).DoAndReturn(createModeration)
+			out, err := chatService.ModerateCharacter(context.Background(), &chat.ChatChannelModeration{
+				ChannelId:   channels[0].ID,
+				CharacterId: 1,
+				Type:        pb.ChatModerationType_MUTE,
+			}, time.Hour)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.ExpiresAt).NotTo(BeNil())
+			Expect(*out.ExpiresAt).To(BeTemporally("~", time.Now().Add(time.Hour), time.Minute))
+		})
+
+		It("should remove authorization when kicking", func() {
+			mockRepository.EXPECT().CreateModeration(gomock.Any(), gomock.Any()).DoAndReturn(createModeration)
+			mockRepository.EXPECT().ChangeAuthorizationForCharacter(gomock.Any(), uint(1), []uint{channels[0].ID}, false)
+			out, err := chatService.ModerateCharacter(context.Background(), &chat.ChatChannelModeration{
+				ChannelId:   channels[0].ID,
+				CharacterId: 1,
+				Type:        pb.ChatModerationType_KICK,
+			}, time.Hour)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.ExpiresAt).NotTo(BeNil())
+			Expect(out.IsActive()).To(BeFalse())
+		})
+
+		It("should send a removal event on the channel when kicking", func() {
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+			defer cancel()
+			sub, err := chatService.ChannelMessagesReader(ctx, channels[0].ID, 0)
+			Expect(err).NotTo(HaveOccurred())
+			defer sub.Close()
+
+			mockRepository.EXPECT().CreateModeration(gomock.Any(), gomock.Any()).DoAndReturn(createModeration)
+			mockRepository.EXPECT().ChangeAuthorizationForCharacter(gomock.Any(), uint(1), []uint{channels[0].ID}, false)
+			_, err = chatService.ModerateCharacter(context.Background(), &chat.ChatChannelModeration{
+				ChannelId:   channels[0].ID,
+				CharacterId: 1,
+				Type:        pb.ChatModerationType_KICK,
+			}, 0)
+			Expect(err).NotTo(HaveOccurred())
+
+			msg, err := sub.ReadMessage(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			event, err := service.DecodeChatMessage(msg)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(event.Type).To(Equal(pb.ChatMessageType_CHARACTER_REMOVED))
+			Expect(event.CharacterId).To(BeEquivalentTo(1))
+			Expect(event.ChannelId).To(BeEquivalentTo(channels[0].ID))
+		})
+
+		It("should ban permanently without a duration", func() {
+			mockRepository.EXPECT().CreateModeration(gomock.Any(), gomock.Any()).DoAndReturn(createModeration)
+			mockRepository.EXPECT().ChangeAuthorizationForCharacter(gomock.Any(), uint(1), []uint{channels[0].ID}, false).Return(fakeError)
+			_, err := chatService.ModerateCharacter(context.Background(), &chat.ChatChannelModeration{
+				ChannelId:   channels[0].ID,
+				CharacterId: 1,
+				Type:        pb.ChatModerationType_BAN,
+			}, 0)
+			Expect(err).To(MatchError(fakeError))
+		})
+
+		It("should fail if saving fails", func() {
+			mockRepository.EXPECT().CreateModeration(gomock.Any(), gomock.Any()).Return(nil, fakeError)
+			_, err := chatService.ModerateCharacter(context.Background(), &chat.ChatChannelModeration{}, 0)
+			Expect(err).To(MatchError(fakeError))
+		})
 	})
 
 	Describe("AuthorizedChannelsForCharacter", func() {
@@ -174,6 +264,17 @@ var _ = Describe("Chat service", Ordered, func() {
 				Expect(err).To(MatchError(fakeError))
 				Expect(out).To(BeNil())
 			})
+
+			It("should error if the channel does not exist", func() {
+				ctx := context.Background()
+				req := &pb.UpdateChatChannelRequest{
+					ChannelId: uint64(channels[0].ID),
+				}
+				mockRepository.EXPECT().FindChannelById(gomock.Any(), channels[0].ID).Return(nil, nil)
+				out, err := chatService.UpdateChannel(ctx, req)
+				Expect(err).To(MatchError(service.ErrChannelNotFound))
+				Expect(out).To(BeNil())
+			})
 		})
 	})
 
@@ -229,69 +330,683 @@ var _ = Describe("Chat service", Ordered, func() {
 				mockRepository.EXPECT().DeleteChannel(gomock.Any(), channels[1]).Return(nil)
 				err := chatService.DeleteChannel(nil, channels[1])
 				Expect(err).NotTo(HaveOccurred())
+				Expect(bus.ListTopics(context.Background())).NotTo(ContainElement(fmt.Sprintf("channel-%d", channels[1].ID)))
 			})
 		})
 	})
 
+	Describe("Topic lifecycle", func() {
+		topics := func() []string {
+			out, err := bus.ListTopics(context.Background())
+			Expect(err).NotTo(HaveOccurred())
+			return out
+		}
+
+		It("should create and delete character topics", func() {
+			name := faker.Username()
+			Expect(chatService.RegisterCharacterChatTopic(context.Background(), name)).To(Succeed())
+			Expect(topics()).To(ContainElement("character-" + name))
+
+			Expect(chatService.UnregisterCharacterChatTopic(context.Background(), name)).To(Succeed())
+			Expect(topics()).NotTo(ContainElement("character-" + name))
+		})
+
+		It("should reconcile channel topics", func() {
+			Expect(bus.CreateTopics(context.Background(),
+				messagebus.Topic{Name: "channel-999"},
+				messagebus.Topic{Name: "channel-other"},
+			)).To(Succeed())
+			Expect(bus.DeleteTopics(context.Background(), fmt.Sprintf("channel-%d", channels[0].ID))).To(Succeed())
+
+			Expect(chatService.ReconcileTopics(context.Background(), nil)).To(Succeed())
+			Expect(topics()).To(ContainElements(
+				fmt.Sprintf("channel-%d", channels[0].ID),
+				fmt.Sprintf("channel-%d", channels[1].ID),
+				"channel-other",
+			))
+			Expect(topics()).NotTo(ContainElement("channel-999"))
+		})
+
+		It("should create the block changes topic", func() {
+			Expect(bus.DeleteTopics(context.Background(), "chat-blocks")).To(Succeed())
+			Expect(chatService.ReconcileTopics(context.Background(), nil)).To(Succeed())
+			Expect(topics()).To(ContainElement("chat-blocks"))
+		})
+
+		It("should only reconcile character topics when characters are given", func() {
+			kept := faker.Username()
+			deleted := faker.Username() + "a"
+			Expect(chatService.RegisterCharacterChatTopic(context.Background(), kept)).To(Succeed())
+			Expect(chatService.RegisterCharacterChatTopic(context.Background(), deleted)).To(Succeed())
+
+			Expect(chatService.ReconcileTopics(context.Background(), nil)).To(Succeed())
+			Expect(topics()).To(ContainElements("character-"+kept, "character-"+deleted))
+
+			Expect(chatService.ReconcileTopics(context.Background(), func(context.Context) ([]string, error) {
+				return []string{kept}, nil
+			})).To(Succeed())
+			Expect(topics()).To(ContainElement("character-" + kept))
+			Expect(topics()).NotTo(ContainElement("character-" + deleted))
+		})
+
+		It("should skip character topics if the characters cannot be found", func() {
+			name := faker.Username()
+			Expect(chatService.RegisterCharacterChatTopic(context.Background(), name)).To(Succeed())
+
+			Expect(chatService.ReconcileTopics(context.Background(), func(context.Context) ([]string, error) {
+				return nil, fakeError
+			})).To(Succeed())
+			Expect(topics()).To(ContainElement("character-" + name))
+		})
+
+		It("should not delete topics created while loading the characters", func() {
+			name := faker.Username()
+			Expect(chatService.ReconcileTopics(context.Background(), func(ctx context.Context) ([]string, error) {
+				Expect(chatService.RegisterCharacterChatTopic(ctx, name)).To(Succeed())
+				return []string{}, nil
+			})).To(Succeed())
+			Expect(topics()).To(ContainElement("character-" + name))
+		})
+
+		It("should fail if getting all channels fails", func() {
+			failingRepo := mocks.NewMockChatRepository(gomock.NewController(GinkgoT()))
+			failingRepo.EXPECT().Migrate(gomock.Any()).Return(nil)
+			failingRepo.EXPECT().AllChannels(gomock.Any()).Return(channels, nil)
+			failingService, err := service.NewChatService(context.Background(), failingRepo, bus, ratelimit.NewMemoryLimiter(), chatConfig())
+			Expect(err).NotTo(HaveOccurred())
+
+			failingRepo.EXPECT().AllChannels(gomock.Any()).Return(nil, fakeError)
+			Expect(failingService.ReconcileTopics(context.Background(), nil)).To(MatchError(fakeError))
+		})
+	})
+
+	Describe("Channel membership", func() {
+		var (
+			ctx         = context.Background()
+			characterId = uint(5)
+			ownerId     = uint(6)
+			channel     *chat.ChatChannel
+		)
+		BeforeEach(func() {
+			channel = &chat.ChatChannel{
+				Model:     gorm.Model{ID: 10},
+				Name:      faker.Username(),
+				Dimension: faker.Username(),
+				OwnerId:   &ownerId,
+			}
+		})
+
+		It("should add the owner as a moderator when creating a channel", func() {
+			mockRepository.EXPECT().CreateChannel(gomock.Any(), channel).Return(channel, nil)
+			mockRepository.EXPECT().ChangeAuthorizationForCharacter(gomock.Any(), ownerId, []uint{channel.ID}, true).Return(nil)
+			mockRepository.EXPECT().SetChannelModerator(gomock.Any(), channel.ID, ownerId, true).Return(nil)
+			Expect(chatService.CreateChannel(ctx, channel)).To(Equal(channel))
+		})
+
+		It("should join public channels in the same dimension", func() {
+			channel.Type = pb.ChatChannelType_CHANNEL_PUBLIC
+			mockRepository.EXPECT().FindChannelById(gomock.Any(), channel.ID).Return(channel, nil).Times(2)
+			mockRepository.EXPECT().FindChannelMember(gomock.Any(), channel.ID, characterId).Return(nil, nil).Times(2)
+			Expect(chatService.JoinChannel(ctx, channel.ID, characterId, faker.Username())).To(MatchError(service.ErrWrongDimension))
+
+			mockRepository.EXPECT().FindActiveModeration(gomock.Any(), channel.ID, characterId, pb.ChatModerationType_BAN).Return(nil, nil)
+			mockRepository.EXPECT().ChangeAuthorizationForCharacter(gomock.Any(), characterId, []uint{channel.ID}, true).Return(nil)
+			mockRepository.EXPECT().DeleteChannelInvite(gomock.Any(), channel.ID, characterId).Return(nil)
+			Expect(chatService.JoinChannel(ctx, channel.ID, characterId, channel.Dimension)).To(Succeed())
+		})
+
+		It("should only join private channels when invited", func() {
+			mockRepository.EXPECT().FindChannelById(gomock.Any(), channel.ID).Return(channel, nil).Times(2)
+			mockRepository.EXPECT().FindChannelMember(gomock.Any(), channel.ID, characterId).Return(nil, nil).Times(2)
+			mockRepository.EXPECT().FindChannelInvite(gomock.Any(), channel.ID, characterId).Return(nil, nil)
+			Expect(chatService.JoinChannel(ctx, channel.ID, characterId, channel.Dimension)).To(MatchError(service.ErrNotInvited))
+
+			mockRepository.EXPECT().FindChannelInvite(gomock.Any(), channel.ID, characterId).
+				Return(&chat.ChatChannelInvite{ChannelId: channel.ID, CharacterId: characterId}, nil)
+			mockRepository.EXPECT().FindActiveModeration(gomock.Any(), channel.ID, characterId, pb.ChatModerationType_BAN).
+				Return(&chat.ChatChannelModeration{Type: pb.ChatModerationType_BAN}, nil)
+			Expect(chatService.JoinChannel(ctx, channel.ID, characterId, channel.Dimension)).To(MatchError(service.ErrBanned))
+		})
+
+		It("should not join system channels", func() {
+			channel.Type = pb.ChatChannelType_CHANNEL_SYSTEM
+			mockRepository.EXPECT().FindChannelById(gomock.Any(), channel.ID).Return(channel, nil)
+			mockRepository.EXPECT().FindChannelMember(gomock.Any(), channel.ID, characterId).Return(nil, nil)
+			Expect(chatService.JoinChannel(ctx, channel.ID, characterId, channel.Dimension)).To(MatchError(service.ErrChannelNotJoinable))
+		})
+
+		It("should not join channels twice", func() {
+			mockRepository.EXPECT().FindChannelById(gomock.Any(), channel.ID).Return(channel, nil)
+			mockRepository.EXPECT().FindChannelMember(gomock.Any(), channel.ID, characterId).
+				Return(&chat.ChatChannelPermission{ChannelId: channel.ID, CharacterId: characterId}, nil)
+			Expect(chatService.JoinChannel(ctx, channel.ID, characterId, channel.Dimension)).To(MatchError(service.ErrAlreadyMember))
+		})
+
+		It("should give up ownership when the owner leaves", func() {
+			mockRepository.EXPECT().FindChannelMember(gomock.Any(), channel.ID, ownerId).
+				Return(&chat.ChatChannelPermission{ChannelId: channel.ID, CharacterId: ownerId}, nil)
+			mockRepository.EXPECT().ChangeAuthorizationForCharacter(gomock.Any(), ownerId, []uint{channel.ID}, false).Return(nil)
+			mockRepository.EXPECT().FindChannelById(gomock.Any(), channel.ID).Return(channel, nil)
+			mockRepository.EXPECT().UpdateChannel(gomock.Any(), channel).DoAndReturn(
+				func(_ context.Context, updated *chat.ChatChannel) (*chat.ChatChannel, error) {
+					Expect(updated.OwnerId).To(BeNil())
+					return updated, nil
+				},
+			)
+			Expect(chatService.LeaveChannel(ctx, channel.ID, ownerId)).To(Succeed())
+		})
+
+		It("should not leave channels the character is not a member of", func() {
+			mockRepository.EXPECT().FindChannelMember(gomock.Any(), channel.ID, characterId).Return(nil, nil)
+			Expect(chatService.LeaveChannel(ctx, channel.ID, characterId)).To(MatchError(service.ErrNotMember))
+		})
+
+		It("should only invite to private channels", func() {
+			mockRepository.EXPECT().FindChannelById(gomock.Any(), channel.ID).Return(channel, nil)
+			mockRepository.EXPECT().FindChannelMember(gomock.Any(), channel.ID, characterId).Return(nil, nil)
+			mockRepository.EXPECT().FindChannelInvite(gomock.Any(), channel.ID, characterId).Return(nil, nil)
+			mockRepository.EXPECT().CreateChannelInvite(gomock.Any(), &chat.ChatChannelInvite{
+				ChannelId:   channel.ID,
+				CharacterId: characterId,
+				InviterId:   ownerId,
+			}).Return(nil)
+			Expect(chatService.InviteToChannel(ctx, channel.ID, characterId, ownerId)).To(Succeed())
+
+			channel.Type = pb.ChatChannelType_CHANNEL_PUBLIC
+			mockRepository.EXPECT().FindChannelById(gomock.Any(), channel.ID).Return(channel, nil)
+			Expect(chatService.InviteToChannel(ctx, channel.ID, characterId, ownerId)).To(MatchError(service.ErrChannelNotInvitable))
+		})
+	})
+
+	newMessage := func(sender string, message string) *chat.ChatMessage {
+		return &chat.ChatMessage{
+			SenderCharacterName: sender,
+			Message:             message,
+		}
+	}
+
+	readMessage := func(reader messagebus.Subscription) func(g Gomega) string {
+		return func(g Gomega) string {
+			message, err := reader.ReadMessage(context.Background())
+			g.Expect(err).NotTo(HaveOccurred())
+			decoded, err := service.DecodeChatMessage(message)
+			g.Expect(err).NotTo(HaveOccurred())
+			return fmt.Sprintf("%s: %s", decoded.CharacterName, decoded.Message)
+		}
+	}
+
+	var sentMessages chat.ChatMessages
+	createMessage := func(_ context.Context, message *chat.ChatMessage) (*chat.ChatMessage, error) {
+		message.ID = uint(len(sentMessages) + 1)
+		message.CreatedAt = time.Now()
+		sentMessages = append(sentMessages, message)
+		return message, nil
+	}
+
 	Describe("Sending channel messages", func() {
+		BeforeEach(func() {
+			mockRepository.EXPECT().CreateMessage(gomock.Any(), gomock.Any()).DoAndReturn(createMessage).AnyTimes()
+			mockRepository.EXPECT().FindChannelById(gomock.Any(), channels[0].ID).Return(channels[0], nil).AnyTimes()
+		})
+
 		It("should work", func() {
-			reader := chatService.ChannelMessagesReader(context.Background(), channels[0].ID)
+			reader, err := chatService.ChannelMessagesReader(context.Background(), channels[0].ID, 0)
+			Expect(err).NotTo(HaveOccurred())
 			Expect(reader).NotTo(BeNil())
 
 			user := faker.Username()
 			messageA := faker.Email()
 			messageB := faker.Email()
-			Expect(chatService.SendChannelMessage(context.Background(), user, messageA, channels[0].ID)).To(Succeed())
-			Expect(chatService.SendChannelMessage(context.Background(), user, messageB, channels[0].ID)).To(Succeed())
-			eventuallyFunc := func(g Gomega) (string, error) {
-				message, err := reader.ReadMessage(context.Background())
+			Expect(chatService.SendChannelMessage(context.Background(), channels[0].ID, newMessage(user, messageA))).Error().To(Succeed())
+			Expect(chatService.SendChannelMessage(context.Background(), channels[0].ID, newMessage(user, messageB))).Error().To(Succeed())
+			Eventually(readMessage(reader)).Within(time.Second).Should(Equal(fmt.Sprintf("%s: %s", user, messageA)))
+			Eventually(readMessage(reader)).Within(time.Second).Should(Equal(fmt.Sprintf("%s: %s", user, messageB)))
+		})
+
+		It("should resume from a message", func() {
+			user := faker.Username()
+			messageA := faker.Email()
+			messageB := faker.Email()
+			Expect(chatService.SendChannelMessage(context.Background(), channels[0].ID, newMessage(user, messageA))).Error().To(Succeed())
+			Expect(chatService.SendChannelMessage(context.Background(), channels[0].ID, newMessage(user, messageB))).Error().To(Succeed())
+
+			resumeFrom := sentMessages[len(sentMessages)-2]
+			mockRepository.EXPECT().FindMessageById(gomock.Any(), resumeFrom.ID).Return(resumeFrom, nil)
+			reader, err := chatService.ChannelMessagesReader(context.Background(), channels[0].ID, resumeFrom.ID)
+			Expect(err).NotTo(HaveOccurred())
+			readCtx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+			defer cancel()
+			for _, expected := range []string{messageA, messageB} {
+				message, err := reader.ReadMessage(readCtx)
 				Expect(err).NotTo(HaveOccurred())
-				return fmt.Sprintf("%s: %s", string(message.Key), string(message.Value)), err
+				decoded, err := service.DecodeChatMessage(message)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(decoded.Message).To(Equal(expected))
 			}
-			Eventually(eventuallyFunc).Within(time.Second).Should(Equal(fmt.Sprintf("%s: %s", user, messageA)))
-			Eventually(eventuallyFunc).Within(time.Second).Should(Equal(fmt.Sprintf("%s: %s", user, messageB)))
+		})
+
+		It("should fail to resume from an unknown message", func() {
+			mockRepository.EXPECT().FindMessageById(gomock.Any(), uint(1)).Return(nil, nil)
+			reader, err := chatService.ChannelMessagesReader(context.Background(), channels[0].ID, 1)
+			Expect(err).To(MatchError(service.ErrMessageNotFound))
+			Expect(reader).To(BeNil())
+		})
+
+		It("should fail if saving the message fails", func() {
+			failingRepo := mocks.NewMockChatRepository(gomock.NewController(GinkgoT()))
+			failingRepo.EXPECT().Migrate(gomock.Any()).Return(nil)
+			failingRepo.EXPECT().AllChannels(gomock.Any()).Return(channels, nil)
+			failingRepo.EXPECT().FindChannelById(gomock.Any(), channels[0].ID).Return(channels[0], nil)
+			failingRepo.EXPECT().CreateMessage(gomock.Any(), gomock.Any()).Return(nil, fakeError)
+			failingService, err := service.NewChatService(context.Background(), failingRepo, bus, ratelimit.NewMemoryLimiter(), chatConfig())
+			Expect(err).NotTo(HaveOccurred())
+			_, err = failingService.SendChannelMessage(context.Background(), channels[0].ID, newMessage(faker.Username(), faker.Email()))
+			Expect(err).To(MatchError(fakeError))
 		})
 
 		It("should fail with empty message", func() {
-			Expect(chatService.SendChannelMessage(context.Background(), faker.Username(), "", channels[0].ID)).NotTo(Succeed())
+			_, err := chatService.SendChannelMessage(context.Background(), channels[0].ID, newMessage(faker.Username(), ""))
+			Expect(err).To(MatchError(service.ErrEmptyMessage))
+
+			_, err = chatService.SendChannelMessage(context.Background(), channels[0].ID, newMessage(faker.Username(), "\n\t"))
+			Expect(err).To(MatchError(service.ErrEmptyMessage))
+		})
+
+		It("should rate limit and automatically mute characters", func() {
+			limitedRepo := mocks.NewMockChatRepository(gomock.NewController(GinkgoT()))
+			limitedRepo.EXPECT().Migrate(gomock.Any()).Return(nil)
+			limitedRepo.EXPECT().AllChannels(gomock.Any()).Return(channels, nil)
+			limitedRepo.EXPECT().FindChannelById(gomock.Any(), channels[0].ID).Return(channels[0], nil)
+			limitedRepo.EXPECT().CreateMessage(gomock.Any(), gomock.Any()).DoAndReturn(createMessage)
+			limitedRepo.EXPECT().CreateModeration(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, moderation *chat.ChatChannelModeration) (*chat.ChatChannelModeration, error) {
+					Expect(moderation.Type).To(Equal(pb.ChatModerationType_MUTE))
+					Expect(moderation.CharacterId).To(BeEquivalentTo(300))
+					Expect(moderation.ExpiresAt).NotTo(BeNil())
+					return moderation, nil
+				},
+			)
+
+			conf := chatConfig()
+			conf.RateLimits = config.ChatRateLimitConfig{
+				Character:    config.RateLimit{Rate: 0.001, Burst: 1},
+				MuteAfter:    1,
+				MuteWindow:   time.Hour,
+				MuteDuration: time.Minute,
+			}
+			limitedService, err := service.NewChatService(context.Background(), limitedRepo, bus, ratelimit.NewMemoryLimiter(), conf)
+			Expect(err).NotTo(HaveOccurred())
+
+			message := func() *chat.ChatMessage {
+				message := newMessage(faker.Username(), faker.Email())
+				message.SenderCharacterId = 300
+				return message
+			}
+			Expect(limitedService.SendChannelMessage(context.Background(), channels[0].ID, message())).Error().To(Succeed())
+
+			var rateLimitErr *ratelimit.Error
+			for i := 0; i < 2; i++ {
+				_, err = limitedService.SendChannelMessage(context.Background(), channels[0].ID, message())
+				Expect(errors.As(err, &rateLimitErr)).To(BeTrue())
+				Expect(rateLimitErr.RetryAfter).To(BeNumerically(">", time.Minute))
+			}
+		})
+
+		It("should only send system messages to system channels", func() {
+			systemChannel := &chat.ChatChannel{Model: gorm.Model{ID: 20}, Type: pb.ChatChannelType_CHANNEL_SYSTEM}
+			mockRepository.EXPECT().FindChannelById(gomock.Any(), systemChannel.ID).Return(systemChannel, nil)
+			_, err := chatService.SendChannelMessage(context.Background(), systemChannel.ID, newMessage(faker.Username(), faker.Email()))
+			Expect(err).To(MatchError(service.ErrChannelReadOnly))
+		})
+
+		It("should fail with unknown channel", func() {
+			mockRepository.EXPECT().FindChannelById(gomock.Any(), uint(100)).Return(nil, nil)
+			_, err := chatService.SendChannelMessage(context.Background(), 100, newMessage(faker.Username(), faker.Email()))
+			Expect(err).To(MatchError(service.ErrChannelNotFound))
+		})
+
+		It("should filter messages", func() {
+			_, err := chatService.SendChannelMessage(context.Background(), channels[0].ID, newMessage(faker.Username(), strings.Repeat("a", 129)))
+			Expect(err).To(MatchError(chatfilter.ErrMessageTooLong))
+
+			message := newMessage(faker.Username(), faker.Email())
+			message.SenderCharacterId = 100
+			for i := 0; i < 2; i++ {
+				Expect(chatService.SendChannelMessage(context.Background(), channels[0].ID, message)).Error().To(Succeed())
+			}
+			_, err = chatService.SendChannelMessage(context.Background(), channels[0].ID, message)
+			Expect(err).To(MatchError(chatfilter.ErrSpam))
+		})
+
+		It("should fail replying to a message in another channel", func() {
+			replyToId := uint(1)
+			otherChannelId := channels[1].ID
+			mockRepository.EXPECT().FindMessageById(gomock.Any(), replyToId).Return(&chat.ChatMessage{ChannelId: &otherChannelId}, nil)
+			message := newMessage(faker.Username(), faker.Email())
+			message.ReplyToId = &replyToId
+			_, err := chatService.SendChannelMessage(context.Background(), channels[0].ID, message)
+			Expect(err).To(MatchError(service.ErrMessageNotFound))
 		})
 	})
 
-	Describe("Sending channel messages", func() {
+	Describe("Sending direct messages", func() {
+		BeforeEach(func() {
+			mockRepository.EXPECT().CreateMessage(gomock.Any(), gomock.Any()).DoAndReturn(createMessage).AnyTimes()
+		})
+
 		It("should work", func() {
 			user := faker.Username()
 			sender := faker.Username() + "a"
 			Expect(chatService.RegisterCharacterChatTopic(context.Background(), user)).To(Succeed())
-			reader := chatService.DirectMessagesReader(context.Background(), user)
-			Expect(reader).NotTo(BeNil())
+			reader, err := chatService.DirectMessagesReader(context.Background(), user)
+			Expect(err).NotTo(HaveOccurred())
 
 			messageA := faker.Email()
 			messageB := faker.Email()
-			Eventually(func(g Gomega) error {
-				Expect(chatService.RegisterCharacterChatTopic(context.Background(), user)).To(Succeed())
-				err := chatService.SendDirectMessage(context.Background(), sender, messageA, user)
-				return err
-			}).WithTimeout(time.Second * 15).WithPolling(time.Second).Should(Succeed())
-			Expect(chatService.SendDirectMessage(context.Background(), sender, messageB, user)).To(Succeed())
-			eventuallyFunc := func(g Gomega) (string, error) {
+			Expect(chatService.SendDirectMessage(context.Background(), user, newMessage(sender, messageA))).Error().To(Succeed())
+			Expect(chatService.SendDirectMessage(context.Background(), user, newMessage(sender, messageB))).Error().To(Succeed())
+			Eventually(readMessage(reader)).Within(time.Second).Should(Equal(fmt.Sprintf("%s: %s", sender, messageA)))
+			Eventually(readMessage(reader)).Within(time.Second).Should(Equal(fmt.Sprintf("%s: %s", sender, messageB)))
+		})
+
+		It("should fail with empty message", func() {
+			Expect(chatService.SendDirectMessage(context.Background(), faker.Username(), newMessage(faker.Username(), ""))).Error().NotTo(Succeed())
+		})
+
+		It("should save direct messages to characters that never connected", func() {
+			target := faker.Username()
+			out, err := chatService.SendDirectMessage(context.Background(), target, newMessage(faker.Username(), faker.Email()))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.TargetCharacterName).To(Equal(&target))
+		})
+	})
+
+	Describe("Direct message events", func() {
+		readEvent := func(reader messagebus.Subscription) func(g Gomega) *pb.ChatMessage {
+			return func(g Gomega) *pb.ChatMessage {
 				message, err := reader.ReadMessage(context.Background())
-				Expect(err).NotTo(HaveOccurred())
-				return fmt.Sprintf("%s: %s", string(message.Key), string(message.Value)), err
+				g.Expect(err).NotTo(HaveOccurred())
+				decoded, err := service.DecodeChatMessage(message)
+				g.Expect(err).NotTo(HaveOccurred())
+				return decoded
 			}
-			Eventually(eventuallyFunc).Within(time.Second).Should(Equal(fmt.Sprintf("%s: %s", sender, messageA)))
-			Eventually(eventuallyFunc).Within(time.Second).Should(Equal(fmt.Sprintf("%s: %s", sender, messageB)))
+		}
+
+		It("should send typing events and read receipts", func() {
+			user := faker.Username()
+			sender := faker.Username() + "a"
+			Expect(chatService.RegisterCharacterChatTopic(context.Background(), user)).To(Succeed())
+			Expect(chatService.RegisterCharacterChatTopic(context.Background(), sender)).To(Succeed())
+			userReader, err := chatService.DirectMessagesReader(context.Background(), user)
+			Expect(err).NotTo(HaveOccurred())
+			senderReader, err := chatService.DirectMessagesReader(context.Background(), sender)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(chatService.NotifyTyping(context.Background(), 1, sender, user)).To(Succeed())
+			Eventually(readEvent(userReader)).Within(time.Second).Should(And(
+				HaveField("Type", pb.ChatMessageType_TYPING),
+				HaveField("CharacterName", sender),
+			))
+
+			message := &chat.ChatMessage{ID: 40, SenderCharacterName: sender, TargetCharacterName: &user}
+			mockRepository.EXPECT().FindMessageById(gomock.Any(), message.ID).Return(message, nil)
+			mockRepository.EXPECT().MarkDirectMessagesRead(gomock.Any(), user, sender, message.ID).Return(nil)
+			Expect(chatService.MarkRead(context.Background(), 2, user, message.ID)).To(Succeed())
+			Eventually(readEvent(senderReader)).Within(time.Second).Should(And(
+				HaveField("Type", pb.ChatMessageType_READ_RECEIPT),
+				HaveField("CharacterName", user),
+				HaveField("ReadMessageId", uint64(message.ID)),
+			))
 		})
 
-		It("should fail with empty message", func() {
-			Expect(chatService.SendDirectMessage(context.Background(), faker.Username(), "", faker.Username())).NotTo(Succeed())
+		It("should not fail sending events to characters that never connected", func() {
+			target := faker.Username()
+			Expect(chatService.NotifyTyping(context.Background(), 1, faker.Username(), target)).To(Succeed())
+
+			message := &chat.ChatMessage{ID: 43, SenderCharacterName: faker.Username(), TargetCharacterName: &target}
+			mockRepository.EXPECT().FindMessageById(gomock.Any(), message.ID).Return(message, nil)
+			mockRepository.EXPECT().MarkDirectMessagesRead(gomock.Any(), target, message.SenderCharacterName, message.ID).Return(nil)
+			Expect(chatService.MarkRead(context.Background(), 2, target, message.ID)).To(Succeed())
+		})
+
+		It("should only mark messages sent to the character as read", func() {
+			other := faker.Username()
+			mockRepository.EXPECT().FindMessageById(gomock.Any(), uint(41)).Return(nil, nil)
+			Expect(chatService.MarkRead(context.Background(), 2, other, 41)).To(MatchError(service.ErrMessageNotFound))
+
+			target := faker.Username()
+			mockRepository.EXPECT().FindMessageById(gomock.Any(), uint(42)).
+				Return(&chat.ChatMessage{ID: 42, SenderCharacterName: faker.Username(), TargetCharacterName: &target}, nil)
+			Expect(chatService.MarkRead(context.Background(), 2, other, 42)).To(MatchError(service.ErrMessageNotFound))
+		})
+
+		It("should migrate the direct messages of a renamed character", func() {
+			oldName := faker.Username()
+			newName := faker.Username() + "b"
+			partner := faker.Username() + "c"
+			Expect(chatService.RegisterCharacterChatTopic(context.Background(), oldName)).To(Succeed())
+			Expect(chatService.RegisterCharacterChatTopic(context.Background(), partner)).To(Succeed())
+			partnerReader, err := chatService.DirectMessagesReader(context.Background(), partner)
+			Expect(err).NotTo(HaveOccurred())
+
+			mockRepository.EXPECT().FindConversationPartners(gomock.Any(), oldName).Return([]string{partner, faker.Username()}, nil)
+			mockRepository.EXPECT().RenameDirectMessages(gomock.Any(), oldName, newName).Return(nil)
+			Expect(chatService.MigrateCharacterName(context.Background(), 3, oldName, newName)).To(Succeed())
+
+			topics, err := bus.ListTopics(context.Background())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(topics).To(ContainElement("character-" + newName))
+			Expect(topics).NotTo(ContainElement("character-" + oldName))
+			Eventually(readEvent(partnerReader)).Within(time.Second).Should(And(
+				HaveField("Type", pb.ChatMessageType_CHARACTER_RENAMED),
+				HaveField("CharacterName", newName),
+				HaveField("PreviousCharacterName", oldName),
+			))
+		})
+
+		It("should not migrate the topic if renaming the direct messages fails", func() {
+			oldName := faker.Username()
+			newName := faker.Username() + "b"
+			mockRepository.EXPECT().FindConversationPartners(gomock.Any(), oldName).Return(nil, nil)
+			mockRepository.EXPECT().RenameDirectMessages(gomock.Any(), oldName, newName).Return(fakeError)
+			Expect(chatService.MigrateCharacterName(context.Background(), 3, oldName, newName)).To(MatchError(fakeError))
+		})
+
+		It("should not send events as messages", func() {
+			message := newMessage(faker.Username(), faker.Email())
+			message.Type = pb.ChatMessageType_TYPING
+			_, err := chatService.SendChannelMessage(context.Background(), channels[0].ID, message)
+			Expect(err).To(MatchError(service.ErrInvalidMessageType))
+		})
+	})
+
+	Describe("SendFriendRequest", func() {
+		var userA, userB string
+		BeforeEach(func() {
+			userA = faker.UUIDHyphenated()
+			userB = faker.UUIDHyphenated()
+		})
+
+		It("should error when targeting self", func() {
+			Expect(chatService.SendFriendRequest(context.Background(), userA, userA)).To(MatchError(service.ErrFriendSelf))
+		})
+
+		It("should error when blocked", func() {
+			mockRepository.EXPECT().FindBlock(gomock.Any(), userA, userB).Return(nil, nil)
+			mockRepository.EXPECT().FindBlock(gomock.Any(), userB, userA).Return(&chat.UserBlock{}, nil)
+			Expect(chatService.SendFriendRequest(context.Background(), userA, userB)).To(MatchError(service.ErrBlocked))
+		})
+
+		It("should error when already friends", func() {
+			mockRepository.EXPECT().FindBlock(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).Times(2)
+			mockRepository.EXPECT().FindFriend(gomock.Any(), userA, userB).Return(&chat.UserFriend{}, nil)
+			Expect(chatService.SendFriendRequest(context.Background(), userA, userB)).To(MatchError(service.ErrAlreadyFriends))
+		})
+
+		It("should accept an existing request from the target", func() {
+			mockRepository.EXPECT().FindBlock(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).Times(2)
+			mockRepository.EXPECT().FindFriend(gomock.Any(), userA, userB).Return(nil, nil)
+			mockRepository.EXPECT().FindFriendRequest(gomock.Any(), userB, userA).Return(&chat.UserFriendRequest{}, nil)
+			mockRepository.EXPECT().AddFriend(gomock.Any(), userA, userB).Return(nil)
+			Expect(chatService.SendFriendRequest(context.Background(), userA, userB)).To(Succeed())
+		})
+
+		It("should error if the request was already sent", func() {
+			mockRepository.EXPECT().FindBlock(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).Times(2)
+			mockRepository.EXPECT().FindFriend(gomock.Any(), userA, userB).Return(nil, nil)
+			mockRepository.EXPECT().FindFriendRequest(gomock.Any(), userB, userA).Return(nil, nil)
+			mockRepository.EXPECT().FindFriendRequest(gomock.Any(), userA, userB).Return(&chat.UserFriendRequest{}, nil)
+			Expect(chatService.SendFriendRequest(context.Background(), userA, userB)).To(MatchError(service.ErrFriendRequestExists))
+		})
+
+		It("should create the request", func() {
+			mockRepository.EXPECT().FindBlock(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).Times(2)
+			mockRepository.EXPECT().FindFriend(gomock.Any(), userA, userB).Return(nil, nil)
+			mockRepository.EXPECT().FindFriendRequest(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).Times(2)
+			mockRepository.EXPECT().CreateFriendRequest(gomock.Any(), userA, userB).Return(&chat.UserFriendRequest{}, fakeError)
+			Expect(chatService.SendFriendRequest(context.Background(), userA, userB)).To(MatchError(fakeError))
+		})
+	})
+
+	Describe("AcceptFriendRequest", func() {
+		It("should error if no request exists", func() {
+			mockRepository.EXPECT().FindFriendRequest(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+			Expect(chatService.AcceptFriendRequest(context.Background(), faker.UUIDHyphenated(), faker.UUIDHyphenated())).
+				To(MatchError(service.ErrFriendRequestNotFound))
+		})
+
+		It("should add the friend", func() {
+			userA := faker.UUIDHyphenated()
+			userB := faker.UUIDHyphenated()
+			mockRepository.EXPECT().FindFriendRequest(gomock.Any(), userB, userA).Return(&chat.UserFriendRequest{}, nil)
+			mockRepository.EXPECT().AddFriend(gomock.Any(), userA, userB).Return(nil)
+			Expect(chatService.AcceptFriendRequest(context.Background(), userA, userB)).To(Succeed())
+		})
+	})
+
+	Describe("DeclineFriendRequest", func() {
+		It("should error if no request exists", func() {
+			mockRepository.EXPECT().FindFriendRequest(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).Times(2)
+			Expect(chatService.DeclineFriendRequest(context.Background(), faker.UUIDHyphenated(), faker.UUIDHyphenated())).
+				To(MatchError(service.ErrFriendRequestNotFound))
+		})
+
+		It("should allow cancelling outgoing requests", func() {
+			userA := faker.UUIDHyphenated()
+			userB := faker.UUIDHyphenated()
+			mockRepository.EXPECT().FindFriendRequest(gomock.Any(), userB, userA).Return(nil, nil)
+			mockRepository.EXPECT().FindFriendRequest(gomock.Any(), userA, userB).Return(&chat.UserFriendRequest{}, nil)
+			mockRepository.EXPECT().DeleteFriendRequest(gomock.Any(), userA, userB).Return(nil)
+			Expect(chatService.DeclineFriendRequest(context.Background(), userA, userB)).To(Succeed())
+		})
+	})
+
+	Describe("RemoveFriend", func() {
+		It("should error if not friends", func() {
+			mockRepository.EXPECT().FindFriend(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+			Expect(chatService.RemoveFriend(context.Background(), faker.UUIDHyphenated(), faker.UUIDHyphenated())).
+				To(MatchError(service.ErrNotFriends))
+		})
+	})
+
+	Describe("BlockUser", func() {
+		It("should error when targeting self", func() {
+			userA := faker.UUIDHyphenated()
+			Expect(chatService.BlockUser(context.Background(), userA, userA)).To(MatchError(service.ErrFriendSelf))
+		})
+
+		It("should error if already blocked", func() {
+			mockRepository.EXPECT().FindBlock(gomock.Any(), gomock.Any(), gomock.Any()).Return(&chat.UserBlock{}, nil)
+			Expect(chatService.BlockUser(context.Background(), faker.UUIDHyphenated(), faker.UUIDHyphenated())).
+				To(MatchError(service.ErrAlreadyBlocked))
+		})
+
+		It("should error if blocking fails", func() {
+			mockRepository.EXPECT().FindBlock(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+			mockRepository.EXPECT().Block(gomock.Any(), gomock.Any(), gomock.Any()).Return(fakeError)
+			Expect(chatService.BlockUser(context.Background(), faker.UUIDHyphenated(), faker.UUIDHyphenated())).
+				To(MatchError(fakeError))
+		})
+
+		It("should block the user and publish the block change", func() {
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+			defer cancel()
+			sub, err := chatService.BlockChangesReader(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			defer sub.Close()
+
+			userA := faker.UUIDHyphenated()
+			userB := faker.UUIDHyphenated()
+			mockRepository.EXPECT().FindBlock(gomock.Any(), userA, userB).Return(nil, nil)
+			mockRepository.EXPECT().Block(gomock.Any(), userA, userB).Return(nil)
+			Expect(chatService.BlockUser(context.Background(), userA, userB)).To(Succeed())
+
+			msg, err := sub.ReadMessage(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(msg.Key)).To(Equal(userA))
+		})
+	})
+
+	Describe("GetChannelHistory", func() {
+		It("should directly call the repo", func() {
+			cursor := chat.NewHistoryCursor(10, 0, 20)
+			mockRepository.EXPECT().FindChannelMessages(gomock.Any(), uint(1), cursor).Return(chat.ChatMessages{}, fakeError)
+			_, err := chatService.GetChannelHistory(context.Background(), 1, cursor)
+			Expect(err).To(MatchError(fakeError))
+		})
+	})
+
+	Describe("GetDirectMessageHistory", func() {
+		It("should directly call the repo", func() {
+			cursor := chat.NewHistoryCursor(0, 10, 20)
+			name := faker.Username()
+			mockRepository.EXPECT().FindDirectMessages(gomock.Any(), name, "", cursor).Return(chat.ChatMessages{}, fakeError)
+			_, err := chatService.GetDirectMessageHistory(context.Background(), name, "", cursor)
+			Expect(err).To(MatchError(fakeError))
+		})
+	})
+
+	Describe("HasBlocked", func() {
+		It("should be true if a block exists", func() {
+			mockRepository.EXPECT().FindBlock(gomock.Any(), gomock.Any(), gomock.Any()).Return(&chat.UserBlock{}, nil)
+			Expect(chatService.HasBlocked(context.Background(), faker.UUIDHyphenated(), faker.UUIDHyphenated())).To(BeTrue())
+		})
+
+		It("should be false if no block exists", func() {
+			mockRepository.EXPECT().FindBlock(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+			Expect(chatService.HasBlocked(context.Background(), faker.UUIDHyphenated(), faker.UUIDHyphenated())).To(BeFalse())
 		})
 
-		It("should fail if no character exists", func() {
-			Expect(chatService.SendDirectMessage(context.Background(), faker.Username(), faker.Email(), faker.Username())).NotTo(Succeed())
+		It("should return repository errors", func() {
+			mockRepository.EXPECT().FindBlock(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, fakeError)
+			_, err := chatService.HasBlocked(context.Background(), faker.UUIDHyphenated(), faker.UUIDHyphenated())
+			Expect(err).To(MatchError(fakeError))
 		})
 	})
 
-	AfterAll(func() {
-		cleanupFunc()
+	Describe("UnblockUser", func() {
+		It("should error if not blocked", func() {
+			mockRepository.EXPECT().FindBlock(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+			Expect(chatService.UnblockUser(context.Background(), faker.UUIDHyphenated(), faker.UUIDHyphenated())).
+				To(MatchError(service.ErrNotBlocked))
+		})
+
+		It("should unblock the user and publish the block change", func() {
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+			defer cancel()
+			sub, err := chatService.BlockChangesReader(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			defer sub.Close()
+
+			userA := faker.UUIDHyphenated()
+			userB := faker.UUIDHyphenated()
+			mockRepository.EXPECT().FindBlock(gomock.Any(), userA, userB).Return(&chat.UserBlock{}, nil)
+			mockRepository.EXPECT().Unblock(gomock.Any(), userA, userB).Return(nil)
+			Expect(chatService.UnblockUser(context.Background(), userA, userB)).To(Succeed())
+
+			msg, err := sub.ReadMessage(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(msg.Key)).To(Equal(userA))
+		})
 	})
 })
@@ -0,0 +1,516 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ShatteredRealms/go-backend/pkg/model/character"
+	"github.com/ShatteredRealms/go-backend/pkg/repository"
+	"go.opentelemetry.io/otel"
+	"gorm.io/gorm"
+)
+
+const (
+	// GuildAdmin actor id used for administrative actions, which skip the guild rank checks
+	GuildAdmin uint = 0
+)
+
+var (
+	guildTracer = otel.Tracer("Inner-GuildService")
+
+	// ErrGuildNotFound thrown when acting on a guild that does not exist
+	ErrGuildNotFound = errors.New("guild not found")
+
+	// ErrGuildNameTaken thrown when creating a guild with a name already used in the dimension
+	ErrGuildNameTaken = errors.New("guild name is already taken in this dimension")
+
+	// ErrGuildAlreadyMember thrown when a character that is already in a guild creates, joins or is invited to a guild
+	ErrGuildAlreadyMember = errors.New("already a member of a guild")
+
+	// ErrGuildNotMember thrown when acting on a character that is not a member of the guild
+	ErrGuildNotMember = errors.New("not a member of the guild")
+
+	// ErrGuildPermission thrown when the character's guild rank does not allow the action
+	ErrGuildPermission = errors.New("guild rank does not allow the action")
+
+	// ErrGuildWrongDimension thrown when inviting a character from another dimension
+	ErrGuildWrongDimension = errors.New("guild is in another dimension")
+
+	// ErrGuildAlreadyInvited thrown when inviting a character that already has an invite to the guild
+	ErrGuildAlreadyInvited = errors.New("already invited to the guild")
+
+	// ErrGuildNotInvited thrown when accepting or declining an invite that does not exist
+	ErrGuildNotInvited = errors.New("not invited to the guild")
+
+	// ErrGuildLeaderLeave thrown when the guild leader attempts to leave the guild
+	ErrGuildLeaderLeave = errors.New("guild leader cannot leave the guild")
+
+	// ErrGuildRankNotFound thrown when using a rank that does not belong to the guild
+	ErrGuildRankNotFound = errors.New("guild rank not found")
+
+	// ErrGuildRankLevel thrown when a rank uses the leader level or the level of another rank
+	ErrGuildRankLevel = errors.New("guild rank level is unavailable")
+
+	// ErrGuildRankProtected thrown when deleting the leader or lowest rank, or changing a member to or from the leader
+	// rank
+	ErrGuildRankProtected = errors.New("guild rank cannot be changed")
+
+	// ErrGuildRankInUse thrown when deleting a rank that still has members
+	ErrGuildRankInUse = errors.New("guild rank has members")
+)
+
+type GuildService interface {
+	Create(ctx context.Context, leader *character.Character, name string) (*character.Guild, error)
+	Disband(ctx context.Context, guildId uint, actorId uint) (*character.Guild, error)
+	SetChatChannel(ctx context.Context, guildId uint, channelId uint) (*character.Guild, error)
+
+	FindById(ctx context.Context, id uint) (*character.Guild, error)
+	FindByCharacter(ctx context.Context, characterId uint) (*character.Guild, error)
+	Members(ctx context.Context, guildId uint) (character.GuildMembers, error)
+
+	Invite(ctx context.Context, guildId uint, actorId uint, target *character.Character) error
+	Invites(ctx context.Context, characterId uint) (character.GuildInvites, error)
+	AcceptInvite(ctx context.Context, guildId uint, characterId uint) (*character.Guild, error)
+	DeclineInvite(ctx context.Context, guildId uint, characterId uint) error
+	Leave(ctx context.Context, guildId uint, characterId uint) (*character.Guild, error)
+	Kick(ctx context.Context, guildId uint, actorId uint, targetId uint) (*character.Guild, error)
+
+	SetMotd(ctx context.Context, guildId uint, actorId uint, motd string) (*character.Guild, error)
+	CreateRank(ctx context.Context, guildId uint, actorId uint, rank *character.GuildRank) (*character.GuildRank, error)
+	EditRank(ctx context.Context, guildId uint, actorId uint, rank *character.GuildRank) (*character.GuildRank, error)
+	DeleteRank(ctx context.Context, guildId uint, actorId uint, rankId uint) error
+	SetMemberRank(ctx context.Context, guildId uint, actorId uint, targetId uint, rankId uint) error
+}
+
+type guildService struct {
+	repo repository.GuildRepository
+}
+
+func NewGuildService(ctx context.Context, r repository.GuildRepository) (GuildService, error) {
+	err := r.Migrate(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("migrate db: %w", err)
+	}
+
+	return guildService{
+		repo: r,
+	}, nil
+}
+
+func (s guildService) Create(ctx context.Context, leader *character.Character, name string) (*character.Guild, error) {
+	ctx, span := guildTracer.Start(ctx, "CreateGuild")
+	defer span.End()
+
+	guild := &character.Guild{
+		Name:      name,
+		Dimension: leader.Dimension,
+		LeaderId:  leader.ID,
+		Ranks:     character.DefaultGuildRanks(),
+	}
+	if err := guild.Validate(); err != nil {
+		return nil, err
+	}
+
+	member, err := s.repo.FindMember(ctx, leader.ID)
+	if err != nil {
+		return nil, err
+	}
+	if member != nil {
+		return nil, ErrGuildAlreadyMember
+	}
+
+	existing, err := s.repo.FindByName(ctx, guild.Name, guild.Dimension)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return nil, ErrGuildNameTaken
+	}
+
+	guild, err = s.repo.Create(ctx, guild)
+	if errors.Is(err, gorm.ErrDuplicatedKey) {
+		return nil, ErrGuildNameTaken
+	}
+
+	return guild, err
+}
+
+func (s guildService) Disband(ctx context.Context, guildId uint, actorId uint) (*character.Guild, error) {
+	ctx, span := guildTracer.Start(ctx, "DisbandGuild")
+	defer span.End()
+
+	guild, _, err := s.authorize(ctx, guildId, actorId, leaderOnly)
+	if err != nil {
+		return nil, err
+	}
+
+	return guild, s.repo.Delete(ctx, guild)
+}
+
+func (s guildService) SetChatChannel(ctx context.Context, guildId uint, channelId uint) (*character.Guild, error) {
+	guild, err := s.FindById(ctx, guildId)
+	if err != nil {
+		return nil, err
+	}
+	if guild == nil {
+		return nil, ErrGuildNotFound
+	}
+
+	guild.ChatChannelId = channelId
+	return s.repo.Save(ctx, guild)
+}
+
+func (s guildService) FindById(ctx context.Context, id uint) (*character.Guild, error) {
+	return s.repo.FindById(ctx, id)
+}
+
+// FindByCharacter gets the guild the character is a member of, or nil if it is not in a guild
+func (s guildService) FindByCharacter(ctx context.Context, characterId uint) (*character.Guild, error) {
+	member, err := s.repo.FindMember(ctx, characterId)
+	if err != nil || member == nil {
+		return nil, err
+	}
+
+	return s.repo.FindById(ctx, member.GuildId)
+}
+
+func (s guildService) Members(ctx context.Context, guildId uint) (character.GuildMembers, error) {
+	return s.repo.FindMembers(ctx, guildId)
+}
+
+func (s guildService) Invite(ctx context.Context, guildId uint, actorId uint, target *character.Character) error {
+	ctx, span := guildTracer.Start(ctx, "InviteToGuild")
+	defer span.End()
+
+	guild, _, err := s.authorize(ctx, guildId, actorId, func(rank *character.GuildRank) bool { return rank.Invite })
+	if err != nil {
+		return err
+	}
+
+	if target.Dimension != guild.Dimension {
+		return ErrGuildWrongDimension
+	}
+
+	member, err := s.repo.FindMember(ctx, target.ID)
+	if err != nil {
+		return err
+	}
+	if member != nil {
+		return ErrGuildAlreadyMember
+	}
+
+	invite, err := s.repo.FindInvite(ctx, guild.ID, target.ID)
+	if err != nil {
+		return err
+	}
+	if invite != nil {
+		return ErrGuildAlreadyInvited
+	}
+
+	return s.repo.CreateInvite(ctx, &character.GuildInvite{
+		GuildId:     guild.ID,
+		CharacterId: target.ID,
+		InviterId:   actorId,
+	})
+}
+
+func (s guildService) Invites(ctx context.Context, characterId uint) (character.GuildInvites, error) {
+	return s.repo.FindInvitesForCharacter(ctx, characterId)
+}
+
+// AcceptInvite adds the character to the guild with the lowest rank. Any other guild invites for the character are
+// removed.
+func (s guildService) AcceptInvite(ctx context.Context, guildId uint, characterId uint) (*character.Guild, error) {
+	ctx, span := guildTracer.Start(ctx, "AcceptGuildInvite")
+	defer span.End()
+
+	guild, err := s.findGuild(ctx, guildId)
+	if err != nil {
+		return nil, err
+	}
+
+	invite, err := s.repo.FindInvite(ctx, guild.ID, characterId)
+	if err != nil {
+		return nil, err
+	}
+	if invite == nil {
+		return nil, ErrGuildNotInvited
+	}
+
+	member, err := s.repo.FindMember(ctx, characterId)
+	if err != nil {
+		return nil, err
+	}
+	if member != nil {
+		return nil, ErrGuildAlreadyMember
+	}
+
+	rank := guild.LowestRank()
+	if rank == nil {
+		return nil, ErrGuildRankNotFound
+	}
+
+	return guild, s.repo.AddMember(ctx, &character.GuildMember{
+		CharacterId: characterId,
+		GuildId:     guild.ID,
+		RankId:      rank.ID,
+	})
+}
+
+func (s guildService) DeclineInvite(ctx context.Context, guildId uint, characterId uint) error {
+	invite, err := s.repo.FindInvite(ctx, guildId, characterId)
+	if err != nil {
+		return err
+	}
+	if invite == nil {
+		return ErrGuildNotInvited
+	}
+
+	return s.repo.DeleteInvite(ctx, guildId, characterId)
+}
+
+func (s guildService) Leave(ctx context.Context, guildId uint, characterId uint) (*character.Guild, error) {
+	ctx, span := guildTracer.Start(ctx, "LeaveGuild")
+	defer span.End()
+
+	guild, err := s.findGuild(ctx, guildId)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.findMember(ctx, guild, characterId); err != nil {
+		return nil, err
+	}
+
+	if guild.IsLeader(characterId) {
+		return nil, ErrGuildLeaderLeave
+	}
+
+	return guild, s.repo.RemoveMember(ctx, guild.ID, characterId)
+}
+
+// Kick removes the target from the guild. Unless done by GuildAdmin, the actor must outrank the target.
+func (s guildService) Kick(ctx context.Context, guildId uint, actorId uint, targetId uint) (*character.Guild, error) {
+	ctx, span := guildTracer.Start(ctx, "KickFromGuild")
+	defer span.End()
+
+	guild, actorRank, err := s.authorize(ctx, guildId, actorId, func(rank *character.GuildRank) bool { return rank.Kick })
+	if err != nil {
+		return nil, err
+	}
+
+	target, err := s.findMember(ctx, guild, targetId)
+	if err != nil {
+		return nil, err
+	}
+
+	if guild.IsLeader(targetId) {
+		return nil, ErrGuildPermission
+	}
+
+	targetRank := guild.Rank(target.RankId)
+	if actorRank != nil && targetRank != nil && !actorRank.Outranks(targetRank) {
+		return nil, ErrGuildPermission
+	}
+
+	return guild, s.repo.RemoveMember(ctx, guild.ID, targetId)
+}
+
+func (s guildService) SetMotd(ctx context.Context, guildId uint, actorId uint, motd string) (*character.Guild, error) {
+	guild, _, err := s.authorize(ctx, guildId, actorId, func(rank *character.GuildRank) bool { return rank.EditMotd })
+	if err != nil {
+		return nil, err
+	}
+
+	guild.Motd = motd
+	if len(guild.Motd) > character.MaxGuildMotdLength {
+		return nil, character.ErrGuildMotdToLong
+	}
+
+	return s.repo.Save(ctx, guild)
+}
+
+func (s guildService) CreateRank(
+	ctx context.Context,
+	guildId uint,
+	actorId uint,
+	rank *character.GuildRank,
+) (*character.GuildRank, error) {
+	guild, _, err := s.authorize(ctx, guildId, actorId, leaderOnly)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := rank.Validate(); err != nil {
+		return nil, err
+	}
+
+	rank.ID = 0
+	if rank.IsLeader() || levelTaken(guild, rank) {
+		return nil, ErrGuildRankLevel
+	}
+
+	rank.GuildId = guild.ID
+	return s.repo.CreateRank(ctx, rank)
+}
+
+// EditRank changes the name, level and permissions of the rank. Only the name of the leader rank can be changed.
+func (s guildService) EditRank(
+	ctx context.Context,
+	guildId uint,
+	actorId uint,
+	rank *character.GuildRank,
+) (*character.GuildRank, error) {
+	guild, _, err := s.authorize(ctx, guildId, actorId, leaderOnly)
+	if err != nil {
+		return nil, err
+	}
+
+	existing := guild.Rank(rank.ID)
+	if existing == nil {
+		return nil, ErrGuildRankNotFound
+	}
+
+	if err := rank.Validate(); err != nil {
+		return nil, err
+	}
+
+	existing.Name = rank.Name
+	if !existing.IsLeader() {
+		if rank.IsLeader() || levelTaken(guild, rank) {
+			return nil, ErrGuildRankLevel
+		}
+
+		existing.Level = rank.Level
+		existing.Invite = rank.Invite
+		existing.Kick = rank.Kick
+		existing.EditMotd = rank.EditMotd
+		existing.ManageBank = rank.ManageBank
+	}
+
+	return s.repo.SaveRank(ctx, existing)
+}
+
+func (s guildService) DeleteRank(ctx context.Context, guildId uint, actorId uint, rankId uint) error {
+	guild, _, err := s.authorize(ctx, guildId, actorId, leaderOnly)
+	if err != nil {
+		return err
+	}
+
+	rank := guild.Rank(rankId)
+	if rank == nil {
+		return ErrGuildRankNotFound
+	}
+
+	if rank.IsLeader() || rank == guild.LowestRank() {
+		return ErrGuildRankProtected
+	}
+
+	count, err := s.repo.CountRankMembers(ctx, rank.ID)
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return ErrGuildRankInUse
+	}
+
+	return s.repo.DeleteRank(ctx, rank)
+}
+
+func (s guildService) SetMemberRank(ctx context.Context, guildId uint, actorId uint, targetId uint, rankId uint) error {
+	guild, _, err := s.authorize(ctx, guildId, actorId, leaderOnly)
+	if err != nil {
+		return err
+	}
+
+	rank := guild.Rank(rankId)
+	if rank == nil {
+		return ErrGuildRankNotFound
+	}
+
+	if rank.IsLeader() || guild.IsLeader(targetId) {
+		return ErrGuildRankProtected
+	}
+
+	err = s.repo.SetMemberRank(ctx, guild.ID, targetId, rank.ID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return ErrGuildNotMember
+	}
+
+	return err
+}
+
+// authorize gets the guild and checks the actor is a member whose rank allows the action. The guild leader is allowed
+// every action. If the actor is GuildAdmin, the checks are skipped and the returned rank is nil.
+func (s guildService) authorize(
+	ctx context.Context,
+	guildId uint,
+	actorId uint,
+	allowed func(rank *character.GuildRank) bool,
+) (*character.Guild, *character.GuildRank, error) {
+	guild, err := s.findGuild(ctx, guildId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if actorId == GuildAdmin {
+		return guild, nil, nil
+	}
+
+	member, err := s.findMember(ctx, guild, actorId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rank := guild.Rank(member.RankId)
+	if rank == nil {
+		return nil, nil, ErrGuildRankNotFound
+	}
+
+	if !rank.IsLeader() && !allowed(rank) {
+		return nil, nil, ErrGuildPermission
+	}
+
+	return guild, rank, nil
+}
+
+func (s guildService) findGuild(ctx context.Context, guildId uint) (*character.Guild, error) {
+	guild, err := s.repo.FindById(ctx, guildId)
+	if err != nil {
+		return nil, err
+	}
+	if guild == nil {
+		return nil, ErrGuildNotFound
+	}
+
+	return guild, nil
+}
+
+func (s guildService) findMember(ctx context.Context, guild *character.Guild, characterId uint) (*character.GuildMember, error) {
+	member, err := s.repo.FindMember(ctx, characterId)
+	if err != nil {
+		return nil, err
+	}
+	if member == nil || member.GuildId != guild.ID {
+		return nil, ErrGuildNotMember
+	}
+
+	return member, nil
+}
+
+// leaderOnly only allows the guild leader to perform the action
+func leaderOnly(*character.GuildRank) bool {
+	return false
+}
+
+// levelTaken checks if another rank in the guild uses the level of the rank
+func levelTaken(guild *character.Guild, rank *character.GuildRank) bool {
+	for _, other := range guild.Ranks {
+		if other.ID != rank.ID && other.Level == rank.Level {
+			return true
+		}
+	}
+
+	return false
+}
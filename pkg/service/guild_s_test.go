@@ -0,0 +1,399 @@
+package service_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ShatteredRealms/go-backend/pkg/log"
+	"github.com/ShatteredRealms/go-backend/pkg/mocks"
+	"github.com/ShatteredRealms/go-backend/pkg/model/character"
+	"github.com/ShatteredRealms/go-backend/pkg/service"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/sirupsen/logrus/hooks/test"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+)
+
+var _ = Describe("Guild service", func() {
+	const (
+		leaderId  uint = 10
+		officerId uint = 11
+		memberId  uint = 12
+		otherId   uint = 13
+	)
+
+	var (
+		mockController *gomock.Controller
+		mockRepository *mocks.MockGuildRepository
+
+		guildService service.GuildService
+
+		ctx       = context.Background()
+		fakeError = fmt.Errorf("error")
+
+		guild   *character.Guild
+		members map[uint]*character.GuildMember
+	)
+
+	BeforeEach(func() {
+		log.Logger, _ = test.NewNullLogger()
+		mockController = gomock.NewController(GinkgoT())
+		mockRepository = mocks.NewMockGuildRepository(mockController)
+
+		mockRepository.EXPECT().Migrate(gomock.Any()).Return(nil)
+		var err error
+		guildService, err = service.NewGuildService(ctx, mockRepository)
+		Expect(err).NotTo(HaveOccurred())
+
+		guild = &character.Guild{
+			ID:        1,
+			Name:      "The Guild",
+			Dimension: "dimension",
+			LeaderId:  leaderId,
+			Ranks:     character.DefaultGuildRanks(),
+		}
+		for idx, rank := range guild.Ranks {
+			rank.ID = uint(idx + 1)
+			rank.GuildId = guild.ID
+		}
+
+		members = map[uint]*character.GuildMember{
+			leaderId:  {CharacterId: leaderId, GuildId: guild.ID, RankId: 1},
+			officerId: {CharacterId: officerId, GuildId: guild.ID, RankId: 2},
+			memberId:  {CharacterId: memberId, GuildId: guild.ID, RankId: 3},
+		}
+
+		mockRepository.EXPECT().FindById(gomock.Any(), guild.ID).Return(guild, nil).AnyTimes()
+		mockRepository.EXPECT().FindById(gomock.Any(), gomock.Not(guild.ID)).Return(nil, nil).AnyTimes()
+		mockRepository.EXPECT().FindMember(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, characterId uint) (*character.GuildMember, error) {
+				return members[characterId], nil
+			}).AnyTimes()
+	})
+
+	It("should error if migrating fails", func() {
+		mockRepository.EXPECT().Migrate(gomock.Any()).Return(fakeError)
+		out, err := service.NewGuildService(ctx, mockRepository)
+		Expect(err).To(MatchError(fakeError))
+		Expect(out).To(BeNil())
+	})
+
+	Describe("Create", func() {
+		var leader *character.Character
+
+		BeforeEach(func() {
+			leader = &character.Character{ID: otherId, Name: "leader", Dimension: "other"}
+		})
+
+		It("should create the guild with the default ranks in the leader's dimension", func() {
+			mockRepository.EXPECT().FindByName(gomock.Any(), "New Guild", leader.Dimension).Return(nil, nil)
+			mockRepository.EXPECT().Create(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, g *character.Guild) (*character.Guild, error) {
+					return g, nil
+				})
+
+			out, err := guildService.Create(ctx, leader, "New Guild")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.Dimension).To(Equal(leader.Dimension))
+			Expect(out.LeaderId).To(Equal(leader.ID))
+			Expect(out.Ranks).To(HaveLen(len(character.DefaultGuildRanks())))
+		})
+
+		It("should validate the name", func() {
+			out, err := guildService.Create(ctx, leader, "a")
+			Expect(err).To(MatchError(character.ErrGuildNameToShort))
+			Expect(out).To(BeNil())
+		})
+
+		It("should error if the leader is already in a guild", func() {
+			out, err := guildService.Create(ctx, &character.Character{ID: memberId}, "New Guild")
+			Expect(err).To(MatchError(service.ErrGuildAlreadyMember))
+			Expect(out).To(BeNil())
+		})
+
+		It("should error if the name is taken in the dimension", func() {
+			mockRepository.EXPECT().FindByName(gomock.Any(), guild.Name, leader.Dimension).Return(guild, nil)
+			out, err := guildService.Create(ctx, leader, guild.Name)
+			Expect(err).To(MatchError(service.ErrGuildNameTaken))
+			Expect(out).To(BeNil())
+		})
+
+		It("should error if the name is taken while creating", func() {
+			mockRepository.EXPECT().FindByName(gomock.Any(), guild.Name, leader.Dimension).Return(nil, nil)
+			mockRepository.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil, gorm.ErrDuplicatedKey)
+			out, err := guildService.Create(ctx, leader, guild.Name)
+			Expect(err).To(MatchError(service.ErrGuildNameTaken))
+			Expect(out).To(BeNil())
+		})
+	})
+
+	Describe("Disband", func() {
+		It("should allow the leader", func() {
+			mockRepository.EXPECT().Delete(gomock.Any(), guild).Return(nil)
+			out, err := guildService.Disband(ctx, guild.ID, leaderId)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out).To(Equal(guild))
+		})
+
+		It("should allow admins", func() {
+			mockRepository.EXPECT().Delete(gomock.Any(), guild).Return(nil)
+			_, err := guildService.Disband(ctx, guild.ID, service.GuildAdmin)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should not allow other ranks", func() {
+			_, err := guildService.Disband(ctx, guild.ID, officerId)
+			Expect(err).To(MatchError(service.ErrGuildPermission))
+		})
+
+		It("should not allow non members", func() {
+			_, err := guildService.Disband(ctx, guild.ID, otherId)
+			Expect(err).To(MatchError(service.ErrGuildNotMember))
+		})
+
+		It("should error if the guild does not exist", func() {
+			_, err := guildService.Disband(ctx, 100, leaderId)
+			Expect(err).To(MatchError(service.ErrGuildNotFound))
+		})
+	})
+
+	Describe("FindByCharacter", func() {
+		It("should find the character's guild", func() {
+			out, err := guildService.FindByCharacter(ctx, memberId)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out).To(Equal(guild))
+		})
+
+		It("should return nil if the character is not in a guild", func() {
+			out, err := guildService.FindByCharacter(ctx, otherId)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out).To(BeNil())
+		})
+	})
+
+	Describe("Invite", func() {
+		var target *character.Character
+
+		BeforeEach(func() {
+			target = &character.Character{ID: otherId, Dimension: guild.Dimension}
+		})
+
+		It("should allow ranks with the invite permission", func() {
+			mockRepository.EXPECT().FindInvite(gomock.Any(), guild.ID, target.ID).Return(nil, nil)
+			mockRepository.EXPECT().CreateInvite(gomock.Any(), &character.GuildInvite{
+				GuildId:     guild.ID,
+				CharacterId: target.ID,
+				InviterId:   officerId,
+			}).Return(nil)
+			Expect(guildService.Invite(ctx, guild.ID, officerId, target)).To(Succeed())
+		})
+
+		It("should not allow ranks without the invite permission", func() {
+			Expect(guildService.Invite(ctx, guild.ID, memberId, target)).To(MatchError(service.ErrGuildPermission))
+		})
+
+		It("should not invite characters in other dimensions", func() {
+			target.Dimension = "other"
+			Expect(guildService.Invite(ctx, guild.ID, leaderId, target)).To(MatchError(service.ErrGuildWrongDimension))
+		})
+
+		It("should not invite characters in a guild", func() {
+			target.ID = memberId
+			Expect(guildService.Invite(ctx, guild.ID, leaderId, target)).To(MatchError(service.ErrGuildAlreadyMember))
+		})
+
+		It("should not invite characters twice", func() {
+			mockRepository.EXPECT().FindInvite(gomock.Any(), guild.ID, target.ID).Return(&character.GuildInvite{}, nil)
+			Expect(guildService.Invite(ctx, guild.ID, leaderId, target)).To(MatchError(service.ErrGuildAlreadyInvited))
+		})
+	})
+
+	Describe("AcceptInvite", func() {
+		It("should add the character with the lowest rank", func() {
+			mockRepository.EXPECT().FindInvite(gomock.Any(), guild.ID, otherId).Return(&character.GuildInvite{}, nil)
+			mockRepository.EXPECT().AddMember(gomock.Any(), &character.GuildMember{
+				CharacterId: otherId,
+				GuildId:     guild.ID,
+				RankId:      3,
+			}).Return(nil)
+			out, err := guildService.AcceptInvite(ctx, guild.ID, otherId)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out).To(Equal(guild))
+		})
+
+		It("should error without an invite", func() {
+			mockRepository.EXPECT().FindInvite(gomock.Any(), guild.ID, otherId).Return(nil, nil)
+			_, err := guildService.AcceptInvite(ctx, guild.ID, otherId)
+			Expect(err).To(MatchError(service.ErrGuildNotInvited))
+		})
+
+		It("should error if the character joined another guild", func() {
+			mockRepository.EXPECT().FindInvite(gomock.Any(), guild.ID, memberId).Return(&character.GuildInvite{}, nil)
+			_, err := guildService.AcceptInvite(ctx, guild.ID, memberId)
+			Expect(err).To(MatchError(service.ErrGuildAlreadyMember))
+		})
+	})
+
+	Describe("DeclineInvite", func() {
+		It("should delete the invite", func() {
+			mockRepository.EXPECT().FindInvite(gomock.Any(), guild.ID, otherId).Return(&character.GuildInvite{}, nil)
+			mockRepository.EXPECT().DeleteInvite(gomock.Any(), guild.ID, otherId).Return(nil)
+			Expect(guildService.DeclineInvite(ctx, guild.ID, otherId)).To(Succeed())
+		})
+
+		It("should error without an invite", func() {
+			mockRepository.EXPECT().FindInvite(gomock.Any(), guild.ID, otherId).Return(nil, nil)
+			Expect(guildService.DeclineInvite(ctx, guild.ID, otherId)).To(MatchError(service.ErrGuildNotInvited))
+		})
+	})
+
+	Describe("Leave", func() {
+		It("should remove members", func() {
+			mockRepository.EXPECT().RemoveMember(gomock.Any(), guild.ID, memberId).Return(nil)
+			_, err := guildService.Leave(ctx, guild.ID, memberId)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should not allow the leader to leave", func() {
+			_, err := guildService.Leave(ctx, guild.ID, leaderId)
+			Expect(err).To(MatchError(service.ErrGuildLeaderLeave))
+		})
+
+		It("should error for non members", func() {
+			_, err := guildService.Leave(ctx, guild.ID, otherId)
+			Expect(err).To(MatchError(service.ErrGuildNotMember))
+		})
+	})
+
+	Describe("Kick", func() {
+		It("should allow kicking lower ranks", func() {
+			mockRepository.EXPECT().RemoveMember(gomock.Any(), guild.ID, memberId).Return(nil)
+			_, err := guildService.Kick(ctx, guild.ID, officerId, memberId)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should not allow kicking equal ranks", func() {
+			members[otherId] = &character.GuildMember{CharacterId: otherId, GuildId: guild.ID, RankId: 2}
+			_, err := guildService.Kick(ctx, guild.ID, officerId, otherId)
+			Expect(err).To(MatchError(service.ErrGuildPermission))
+		})
+
+		It("should not allow kicking the leader", func() {
+			_, err := guildService.Kick(ctx, guild.ID, service.GuildAdmin, leaderId)
+			Expect(err).To(MatchError(service.ErrGuildPermission))
+		})
+
+		It("should not allow ranks without the kick permission", func() {
+			_, err := guildService.Kick(ctx, guild.ID, memberId, officerId)
+			Expect(err).To(MatchError(service.ErrGuildPermission))
+		})
+
+		It("should allow admins to kick any rank", func() {
+			mockRepository.EXPECT().RemoveMember(gomock.Any(), guild.ID, officerId).Return(nil)
+			_, err := guildService.Kick(ctx, guild.ID, service.GuildAdmin, officerId)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should error if the target is not a member", func() {
+			_, err := guildService.Kick(ctx, guild.ID, leaderId, otherId)
+			Expect(err).To(MatchError(service.ErrGuildNotMember))
+		})
+	})
+
+	Describe("SetMotd", func() {
+		It("should allow ranks with the edit motd permission", func() {
+			mockRepository.EXPECT().Save(gomock.Any(), guild).Return(guild, nil)
+			out, err := guildService.SetMotd(ctx, guild.ID, officerId, "hello")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.Motd).To(Equal("hello"))
+		})
+
+		It("should not allow ranks without the edit motd permission", func() {
+			_, err := guildService.SetMotd(ctx, guild.ID, memberId, "hello")
+			Expect(err).To(MatchError(service.ErrGuildPermission))
+		})
+
+		It("should limit the length", func() {
+			_, err := guildService.SetMotd(ctx, guild.ID, leaderId, string(make([]byte, character.MaxGuildMotdLength+1)))
+			Expect(err).To(MatchError(character.ErrGuildMotdToLong))
+		})
+	})
+
+	Describe("Ranks", func() {
+		It("should create ranks", func() {
+			rank := &character.GuildRank{ID: 50, Name: "Recruit", Level: 3, Invite: true}
+			mockRepository.EXPECT().CreateRank(gomock.Any(), rank).Return(rank, nil)
+			out, err := guildService.CreateRank(ctx, guild.ID, leaderId, rank)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.GuildId).To(Equal(guild.ID))
+			Expect(out.ID).To(BeZero())
+		})
+
+		It("should only allow the leader to manage ranks", func() {
+			_, err := guildService.CreateRank(ctx, guild.ID, officerId, &character.GuildRank{Name: "Recruit", Level: 3})
+			Expect(err).To(MatchError(service.ErrGuildPermission))
+		})
+
+		It("should not create ranks with a used level", func() {
+			for _, level := range []uint32{0, 1} {
+				_, err := guildService.CreateRank(ctx, guild.ID, leaderId, &character.GuildRank{Name: "Recruit", Level: level})
+				Expect(err).To(MatchError(service.ErrGuildRankLevel))
+			}
+		})
+
+		It("should edit ranks", func() {
+			mockRepository.EXPECT().SaveRank(gomock.Any(), guild.Ranks[2]).Return(guild.Ranks[2], nil)
+			out, err := guildService.EditRank(ctx, guild.ID, leaderId, &character.GuildRank{ID: 3, Name: "Peon", Level: 5, Kick: true})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.Name).To(Equal("Peon"))
+			Expect(out.Level).To(BeEquivalentTo(5))
+			Expect(out.Kick).To(BeTrue())
+		})
+
+		It("should only rename the leader rank", func() {
+			mockRepository.EXPECT().SaveRank(gomock.Any(), guild.Ranks[0]).Return(guild.Ranks[0], nil)
+			out, err := guildService.EditRank(ctx, guild.ID, leaderId, &character.GuildRank{ID: 1, Name: "Chief", Level: 4})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.Name).To(Equal("Chief"))
+			Expect(out.IsLeader()).To(BeTrue())
+			Expect(out.Invite && out.Kick && out.EditMotd && out.ManageBank).To(BeTrue())
+		})
+
+		It("should not edit ranks of other guilds", func() {
+			_, err := guildService.EditRank(ctx, guild.ID, leaderId, &character.GuildRank{ID: 100, Name: "Peon", Level: 5})
+			Expect(err).To(MatchError(service.ErrGuildRankNotFound))
+		})
+
+		It("should delete unused ranks", func() {
+			mockRepository.EXPECT().CountRankMembers(gomock.Any(), uint(2)).Return(int64(0), nil)
+			mockRepository.EXPECT().DeleteRank(gomock.Any(), guild.Ranks[1]).Return(nil)
+			Expect(guildService.DeleteRank(ctx, guild.ID, leaderId, 2)).To(Succeed())
+		})
+
+		It("should not delete ranks with members", func() {
+			mockRepository.EXPECT().CountRankMembers(gomock.Any(), uint(2)).Return(int64(1), nil)
+			Expect(guildService.DeleteRank(ctx, guild.ID, leaderId, 2)).To(MatchError(service.ErrGuildRankInUse))
+		})
+
+		It("should not delete the leader or lowest rank", func() {
+			Expect(guildService.DeleteRank(ctx, guild.ID, leaderId, 1)).To(MatchError(service.ErrGuildRankProtected))
+			Expect(guildService.DeleteRank(ctx, guild.ID, leaderId, 3)).To(MatchError(service.ErrGuildRankProtected))
+		})
+
+		It("should change member ranks", func() {
+			mockRepository.EXPECT().SetMemberRank(gomock.Any(), guild.ID, memberId, uint(2)).Return(nil)
+			Expect(guildService.SetMemberRank(ctx, guild.ID, leaderId, memberId, 2)).To(Succeed())
+		})
+
+		It("should not change ranks to or from the leader rank", func() {
+			Expect(guildService.SetMemberRank(ctx, guild.ID, leaderId, memberId, 1)).To(MatchError(service.ErrGuildRankProtected))
+			Expect(guildService.SetMemberRank(ctx, guild.ID, leaderId, leaderId, 2)).To(MatchError(service.ErrGuildRankProtected))
+		})
+
+		It("should error changing the rank of non members", func() {
+			mockRepository.EXPECT().SetMemberRank(gomock.Any(), guild.ID, otherId, uint(2)).Return(gorm.ErrRecordNotFound)
+			Expect(guildService.SetMemberRank(ctx, guild.ID, leaderId, otherId, 2)).To(MatchError(service.ErrGuildNotMember))
+		})
+	})
+})
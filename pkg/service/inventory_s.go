@@ -2,24 +2,132 @@ package service
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"time"
 
+	"github.com/ShatteredRealms/go-backend/pkg/config"
 	"github.com/ShatteredRealms/go-backend/pkg/model/character"
 	"github.com/ShatteredRealms/go-backend/pkg/repository"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.opentelemetry.io/otel"
+)
+
+const (
+	// InventoryHistoryLimit number of inventory events listed if no limit is given
+	InventoryHistoryLimit = 100
+
+	// MaxInventoryHistoryLimit maximum number of inventory events listed at once
+	MaxInventoryHistoryLimit = 1000
+)
+
+var (
+	inventoryTracer = otel.Tracer("Inner-InventoryService")
+
+	// ErrInventoryHistoryNotFound thrown when restoring an inventory to a time before its first recorded change
+	ErrInventoryHistoryNotFound = errors.New("no inventory history at or before the time")
+
+	// ErrInventoryRestoreReason thrown when restoring an inventory without a reason
+	ErrInventoryRestoreReason = errors.New("a reason is required to restore an inventory")
 )
 
 type InventoryService interface {
 	GetInventory(ctx context.Context, characterId uint) (*character.Inventory, error)
+
+	// UpdateInventory replaces the inventory after validating its items against the item catalog and the inventory
+	// capacity
 	UpdateInventory(ctx context.Context, inventory *character.Inventory) error
+
+	// SetStartingInventory replaces the inventory of a new character with the starting items after validating them
+	// like UpdateInventory
+	SetStartingInventory(ctx context.Context, characterId uint, items character.InventoryItems) error
+
+	// The item operations change the inventory only if it still has the given version. Otherwise
+	// character.ErrInventoryVersion is returned. The changed inventory is returned.
+
+	AddItem(
+		ctx context.Context,
+		characterId uint,
+		version uint64,
+		location character.InventoryLocation,
+		item *character.InventoryItem,
+	) (*character.Inventory, error)
+	RemoveItem(
+		ctx context.Context,
+		characterId uint,
+		version uint64,
+		location character.InventoryLocation,
+		slot uint32,
+		quantity uint64,
+	) (*character.Inventory, error)
+	MoveItem(
+		ctx context.Context,
+		characterId uint,
+		version uint64,
+		location character.InventoryLocation,
+		from uint32,
+		to uint32,
+	) (*character.Inventory, error)
+	SplitStack(
+		ctx context.Context,
+		characterId uint,
+		version uint64,
+		location character.InventoryLocation,
+		from uint32,
+		to uint32,
+		quantity uint64,
+	) (*character.Inventory, error)
+	MergeStack(
+		ctx context.Context,
+		characterId uint,
+		version uint64,
+		location character.InventoryLocation,
+		from uint32,
+		to uint32,
+	) (*character.Inventory, error)
+	TransferItem(
+		ctx context.Context,
+		characterId uint,
+		version uint64,
+		from character.InventoryLocation,
+		fromSlot uint32,
+		toSlot uint32,
+	) (*character.Inventory, error)
+
+	// History gets the latest changes to the inventory with the newest first. The default limit is used if the limit
+	// is 0, and it is capped at the max limit.
+	History(ctx context.Context, characterId uint, limit uint32) (character.InventoryEvents, error)
+
+	// Restore changes the inventory back to how it was after its last change at or before the time
+	Restore(ctx context.Context, characterId uint, at time.Time, reason string) (*character.Inventory, error)
 }
 
 type inventoryService struct {
+	inventoryValidator
 	repo repository.InventoryRepository
 }
 
-func NewInventoryService(repo repository.InventoryRepository) InventoryService {
-	return &inventoryService{
-		repo: repo,
+// inventoryValidator validates inventories against the item catalog and the inventory capacity
+type inventoryValidator struct {
+	itemRepo repository.ItemRepository
+	conf     config.InventoryConfig
+}
+
+func NewInventoryService(
+	ctx context.Context,
+	repo repository.InventoryRepository,
+	itemRepo repository.ItemRepository,
+	conf config.InventoryConfig,
+) (InventoryService, error) {
+	err := repo.Migrate(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("migrate db: %w", err)
 	}
+
+	return &inventoryService{
+		inventoryValidator: inventoryValidator{itemRepo: itemRepo, conf: conf},
+		repo:               repo,
+	}, nil
 }
 
 // GetInventory implements InventoryService.
@@ -29,5 +137,344 @@ func (s *inventoryService) GetInventory(ctx context.Context, characterId uint) (
 
 // UpdateInventory implements InventoryService.
 func (s *inventoryService) UpdateInventory(ctx context.Context, inventory *character.Inventory) error {
-	return s.repo.UpdateInventory(ctx, inventory)
+	return s.validateAndUpdate(ctx, inventory, "set inventory")
+}
+
+// SetStartingInventory implements InventoryService.
+func (s *inventoryService) SetStartingInventory(
+	ctx context.Context,
+	characterId uint,
+	items character.InventoryItems,
+) error {
+	inventory := &character.Inventory{CharacterId: characterId, Inventory: items}
+	return s.validateAndUpdate(ctx, inventory.Clone(), "starting inventory")
+}
+
+// AddItem implements InventoryService.
+func (s *inventoryService) AddItem(
+	ctx context.Context,
+	characterId uint,
+	version uint64,
+	location character.InventoryLocation,
+	item *character.InventoryItem,
+) (*character.Inventory, error) {
+	ctx, span := inventoryTracer.Start(ctx, "AddItem")
+	defer span.End()
+
+	inventory, err := s.versionedInventory(ctx, characterId, version)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.checkSlot(location, item.Slot)
+	if err != nil {
+		return nil, err
+	}
+
+	definition, err := s.itemDefinition(ctx, item.Id)
+	if err != nil {
+		return nil, err
+	}
+	if item.Quantity > definition.MaxStack {
+		return nil, character.ErrInventoryOverStacked
+	}
+
+	err = inventory.Clone().AddItem(location, item)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.repo.AddItem(ctx, inventory, location, item)
+}
+
+// RemoveItem implements InventoryService.
+func (s *inventoryService) RemoveItem(
+	ctx context.Context,
+	characterId uint,
+	version uint64,
+	location character.InventoryLocation,
+	slot uint32,
+	quantity uint64,
+) (*character.Inventory, error) {
+	ctx, span := inventoryTracer.Start(ctx, "RemoveItem")
+	defer span.End()
+
+	inventory, err := s.versionedInventory(ctx, characterId, version)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = inventory.Clone().RemoveItem(location, slot, quantity)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.repo.RemoveItem(ctx, inventory, location, slot, quantity)
+}
+
+// MoveItem implements InventoryService.
+func (s *inventoryService) MoveItem(
+	ctx context.Context,
+	characterId uint,
+	version uint64,
+	location character.InventoryLocation,
+	from uint32,
+	to uint32,
+) (*character.Inventory, error) {
+	ctx, span := inventoryTracer.Start(ctx, "MoveItem")
+	defer span.End()
+
+	inventory, err := s.versionedInventory(ctx, characterId, version)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.checkSlot(location, to)
+	if err != nil {
+		return nil, err
+	}
+
+	err = inventory.Clone().MoveItem(location, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.repo.MoveItem(ctx, inventory, location, from, to)
+}
+
+// SplitStack implements InventoryService.
+func (s *inventoryService) SplitStack(
+	ctx context.Context,
+	characterId uint,
+	version uint64,
+	location character.InventoryLocation,
+	from uint32,
+	to uint32,
+	quantity uint64,
+) (*character.Inventory, error) {
+	ctx, span := inventoryTracer.Start(ctx, "SplitStack")
+	defer span.End()
+
+	inventory, err := s.versionedInventory(ctx, characterId, version)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.checkSlot(location, to)
+	if err != nil {
+		return nil, err
+	}
+
+	err = inventory.Clone().SplitStack(location, from, to, quantity)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.repo.SplitStack(ctx, inventory, location, from, to, quantity)
+}
+
+// MergeStack implements InventoryService.
+func (s *inventoryService) MergeStack(
+	ctx context.Context,
+	characterId uint,
+	version uint64,
+	location character.InventoryLocation,
+	from uint32,
+	to uint32,
+) (*character.Inventory, error) {
+	ctx, span := inventoryTracer.Start(ctx, "MergeStack")
+	defer span.End()
+
+	inventory, err := s.versionedInventory(ctx, characterId, version)
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := inventory.Items(location)
+	if err != nil {
+		return nil, err
+	}
+
+	item := items.Item(from)
+	if item == nil {
+		return nil, character.ErrInventorySlotEmpty
+	}
+
+	definition, err := s.itemDefinition(ctx, item.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	quantity, err := inventory.Clone().MergeStack(location, from, to, definition.MaxStack)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.repo.MergeStack(ctx, inventory, location, from, to, quantity)
+}
+
+// TransferItem implements InventoryService.
+func (s *inventoryService) TransferItem(
+	ctx context.Context,
+	characterId uint,
+	version uint64,
+	from character.InventoryLocation,
+	fromSlot uint32,
+	toSlot uint32,
+) (*character.Inventory, error) {
+	ctx, span := inventoryTracer.Start(ctx, "TransferItem")
+	defer span.End()
+
+	inventory, err := s.versionedInventory(ctx, characterId, version)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.checkSlot(from.Other(), toSlot)
+	if err != nil {
+		return nil, err
+	}
+
+	err = inventory.Clone().TransferItem(from, fromSlot, toSlot)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.repo.TransferItem(ctx, inventory, from, fromSlot, toSlot)
+}
+
+// History implements InventoryService.
+func (s *inventoryService) History(
+	ctx context.Context,
+	characterId uint,
+	limit uint32,
+) (character.InventoryEvents, error) {
+	if limit == 0 {
+		limit = InventoryHistoryLimit
+	}
+
+	return s.repo.FindHistory(ctx, characterId, int64(min(limit, MaxInventoryHistoryLimit)))
+}
+
+// Restore implements InventoryService.
+func (s *inventoryService) Restore(
+	ctx context.Context,
+	characterId uint,
+	at time.Time,
+	reason string,
+) (*character.Inventory, error) {
+	ctx, span := inventoryTracer.Start(ctx, "Restore")
+	defer span.End()
+
+	if reason == "" {
+		return nil, ErrInventoryRestoreReason
+	}
+
+	event, err := s.repo.FindLatestEvent(ctx, characterId, at)
+	if err != nil {
+		return nil, err
+	}
+	if event == nil {
+		return nil, ErrInventoryHistoryNotFound
+	}
+
+	inventory := event.After.Clone()
+	inventory.CharacterId = characterId
+	err = s.repo.UpdateInventory(
+		ctx,
+		inventory,
+		fmt.Sprintf("restore to %s: %s", event.CreatedAt.UTC().Format(time.RFC3339), reason),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return inventory, nil
+}
+
+func (s *inventoryService) validateAndUpdate(ctx context.Context, inventory *character.Inventory, reason string) error {
+	err := s.Validate(ctx, inventory)
+	if err != nil {
+		return err
+	}
+
+	return s.repo.UpdateInventory(ctx, inventory, reason)
+}
+
+// versionedInventory gets the inventory of the character, or an empty inventory if it was never saved, and verifies
+// it has the version
+func (s *inventoryService) versionedInventory(
+	ctx context.Context,
+	characterId uint,
+	version uint64,
+) (*character.Inventory, error) {
+	inventory, err := s.repo.GetInventory(ctx, characterId)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		inventory, err = &character.Inventory{CharacterId: characterId}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if inventory.Version != version {
+		return nil, character.ErrInventoryVersion
+	}
+
+	return inventory, nil
+}
+
+// checkSlot verifies the slot is within the capacity of the location
+func (s *inventoryService) checkSlot(location character.InventoryLocation, slot uint32) error {
+	capacity := s.conf.Capacity
+	if location == character.InventoryLocationBank {
+		capacity = s.conf.BankCapacity
+	}
+
+	if slot >= capacity {
+		return character.ErrInventorySlotCapacity
+	}
+
+	return nil
+}
+
+func (v inventoryValidator) itemDefinition(ctx context.Context, id string) (*character.ItemDefinition, error) {
+	definition, err := v.itemRepo.FindById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if definition == nil {
+		return nil, character.ErrInventoryUnknownItem
+	}
+
+	return definition, nil
+}
+
+// Capacity implements repository.InventoryValidator.
+func (v inventoryValidator) Capacity() uint32 {
+	return v.conf.Capacity
+}
+
+// Validate implements repository.InventoryValidator.
+func (v inventoryValidator) Validate(ctx context.Context, inventory *character.Inventory) error {
+	items, err := v.itemRepo.FindByIds(ctx, inventory.ItemIds())
+	if err != nil {
+		return err
+	}
+
+	return inventory.Validate(items.ById(), v.conf.Capacity, v.conf.BankCapacity)
+}
+
+// ValidateTransfer checks the items can be given to another character against the item catalog. See
+// character.InventoryItems.ValidateTransfer.
+func (v inventoryValidator) ValidateTransfer(ctx context.Context, items character.InventoryItems, tradable bool) error {
+	if len(items) == 0 {
+		return nil
+	}
+
+	catalog, err := v.itemRepo.FindByIds(ctx, items.ItemIds())
+	if err != nil {
+		return err
+	}
+
+	return items.ValidateTransfer(catalog.ById(), tradable)
 }
@@ -3,6 +3,7 @@ package service_test
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/bxcodec/faker/v4"
 	. "github.com/onsi/ginkgo/v2"
@@ -10,6 +11,7 @@ import (
 	"github.com/sirupsen/logrus/hooks/test"
 	"go.uber.org/mock/gomock"
 
+	"github.com/ShatteredRealms/go-backend/pkg/config"
 	"github.com/ShatteredRealms/go-backend/pkg/log"
 	"github.com/ShatteredRealms/go-backend/pkg/mocks"
 	"github.com/ShatteredRealms/go-backend/pkg/model/character"
@@ -21,6 +23,7 @@ var _ = Describe("Inventory service", func() {
 		hook           *test.Hook
 		mockController *gomock.Controller
 		mockRepository *mocks.MockInventoryRepository
+		mockItemRepo   *mocks.MockItemRepository
 
 		invService service.InventoryService
 
@@ -28,6 +31,7 @@ var _ = Describe("Inventory service", func() {
 		invItem2 = &character.InventoryItem{}
 		invItem3 = &character.InventoryItem{}
 		charInv  = &character.Inventory{}
+		catalog  character.ItemDefinitions
 		ctx      context.Context
 		fakeErr  error
 	)
@@ -36,14 +40,20 @@ var _ = Describe("Inventory service", func() {
 		log.Logger, hook = test.NewNullLogger()
 		mockController = gomock.NewController(GinkgoT())
 		mockRepository = mocks.NewMockInventoryRepository(mockController)
+		mockItemRepo = mocks.NewMockItemRepository(mockController)
 		hook.Reset()
+		ctx = context.Background()
 
 		var err error
-		invService = service.NewInventoryService(mockRepository)
+		mockRepository.EXPECT().Migrate(gomock.Any()).Return(nil)
+		invService, err = service.NewInventoryService(ctx, mockRepository, mockItemRepo, config.InventoryConfig{
+			Capacity:     10,
+			BankCapacity: 20,
+		})
+		Expect(err).NotTo(HaveOccurred())
 		Expect(invService).NotTo(BeNil())
 		hook.Reset()
 
-		ctx = context.Background()
 		fakeErr = fmt.Errorf("error: %s", faker.Username())
 		Expect(faker.FakeData(invItem)).To(Succeed())
 		Expect(faker.FakeData(invItem2)).To(Succeed())
@@ -54,6 +64,23 @@ var _ = Describe("Inventory service", func() {
 		charInv.CharacterId = uint(ints[0])
 		charInv.Inventory = []*character.InventoryItem{invItem}
 		charInv.Bank = []*character.InventoryItem{invItem2}
+
+		invItem.Slot, invItem.Quantity = 0, 5
+		invItem2.Slot, invItem2.Quantity = 19, 1
+		invItem3.Slot, invItem3.Quantity = 1, 1
+		catalog = character.ItemDefinitions{
+			{Id: invItem.Id, Name: faker.Username(), MaxStack: 10},
+			{Id: invItem2.Id, Name: faker.Username(), MaxStack: 1},
+		}
+	})
+
+	Describe("NewInventoryService", func() {
+		It("should error if migrating fails", func() {
+			mockRepository.EXPECT().Migrate(gomock.Any()).Return(fakeErr)
+			out, err := service.NewInventoryService(ctx, mockRepository, mockItemRepo, config.InventoryConfig{})
+			Expect(err).To(MatchError(fakeErr))
+			Expect(out).To(BeNil())
+		})
 	})
 
 	Describe("GetInventory", func() {
@@ -67,9 +94,181 @@ var _ = Describe("Inventory service", func() {
 
 	Describe("UpdateInventory", func() {
 		It("should work", func() {
-			mockRepository.EXPECT().UpdateInventory(ctx, charInv).Return(fakeErr)
+			mockItemRepo.EXPECT().FindByIds(ctx, charInv.ItemIds()).Return(catalog, nil)
+			mockRepository.EXPECT().UpdateInventory(ctx, charInv, "set inventory").Return(fakeErr)
 			err := invService.UpdateInventory(ctx, charInv)
 			Expect(err).To(MatchError(fakeErr))
 		})
+
+		It("should error when the catalog fails", func() {
+			mockItemRepo.EXPECT().FindByIds(ctx, charInv.ItemIds()).Return(nil, fakeErr)
+			err := invService.UpdateInventory(ctx, charInv)
+			Expect(err).To(MatchError(fakeErr))
+		})
+
+		It("should error on items not in the catalog", func() {
+			charInv.Inventory = append(charInv.Inventory, invItem3)
+			mockItemRepo.EXPECT().FindByIds(ctx, charInv.ItemIds()).Return(catalog, nil)
+			err := invService.UpdateInventory(ctx, charInv)
+			Expect(err).To(MatchError(character.ErrInventoryUnknownItem))
+		})
+
+		It("should error on items over their max stack", func() {
+			invItem2.Quantity = 2
+			mockItemRepo.EXPECT().FindByIds(ctx, charInv.ItemIds()).Return(catalog, nil)
+			err := invService.UpdateInventory(ctx, charInv)
+			Expect(err).To(MatchError(character.ErrInventoryOverStacked))
+		})
+
+		It("should error on slots beyond the capacity", func() {
+			invItem.Slot = 10
+			mockItemRepo.EXPECT().FindByIds(ctx, charInv.ItemIds()).Return(catalog, nil)
+			err := invService.UpdateInventory(ctx, charInv)
+			Expect(err).To(MatchError(character.ErrInventorySlotCapacity))
+		})
+	})
+
+	Describe("SetStartingInventory", func() {
+		It("should replace the inventory with the items", func() {
+			mockItemRepo.EXPECT().FindByIds(ctx, []string{invItem.Id}).Return(catalog, nil)
+			mockRepository.EXPECT().UpdateInventory(ctx, gomock.Any(), "starting inventory").DoAndReturn(
+				func(_ context.Context, inventory *character.Inventory, _ string) error {
+					Expect(inventory.CharacterId).To(Equal(charInv.CharacterId))
+					Expect(inventory.Inventory).To(Equal(charInv.Inventory))
+					Expect(inventory.Bank).To(BeEmpty())
+					return nil
+				},
+			)
+			Expect(invService.SetStartingInventory(ctx, charInv.CharacterId, charInv.Inventory)).To(Succeed())
+		})
+
+		It("should error on items not in the catalog", func() {
+			items := append(charInv.Inventory, invItem3)
+			mockItemRepo.EXPECT().FindByIds(ctx, gomock.Any()).Return(catalog, nil)
+			err := invService.SetStartingInventory(ctx, charInv.CharacterId, items)
+			Expect(err).To(MatchError(character.ErrInventoryUnknownItem))
+		})
+	})
+
+	Describe("item operations", func() {
+		var stored *character.Inventory
+
+		BeforeEach(func() {
+			stored = &character.Inventory{
+				CharacterId: charInv.CharacterId,
+				Inventory:   character.InventoryItems{{Id: "potion", Slot: 0, Quantity: 10}},
+				Version:     3,
+			}
+			mockRepository.EXPECT().GetInventory(gomock.Any(), stored.CharacterId).Return(stored, nil)
+		})
+
+		It("should error if the inventory changed", func() {
+			out, err := invService.MoveItem(ctx, stored.CharacterId, 2, character.InventoryLocationInventory, 0, 1)
+			Expect(err).To(MatchError(character.ErrInventoryVersion))
+			Expect(out).To(BeNil())
+		})
+
+		It("should error on slots beyond the capacity", func() {
+			out, err := invService.TransferItem(ctx, stored.CharacterId, 3, character.InventoryLocationInventory, 0, 20)
+			Expect(err).To(MatchError(character.ErrInventorySlotCapacity))
+			Expect(out).To(BeNil())
+		})
+
+		It("should error on invalid operations without changing the inventory", func() {
+			out, err := invService.SplitStack(ctx, stored.CharacterId, 3, character.InventoryLocationInventory, 0, 1, 10)
+			Expect(err).To(MatchError(character.ErrInventoryQuantity))
+			Expect(out).To(BeNil())
+			Expect(stored.Inventory.Item(0).Quantity).To(BeEquivalentTo(10))
+		})
+
+		It("should add items in the catalog", func() {
+			item := &character.InventoryItem{Id: "potion", Slot: 1, Quantity: 5}
+			mockItemRepo.EXPECT().FindById(gomock.Any(), "potion").Return(&character.ItemDefinition{MaxStack: 5}, nil)
+			mockRepository.EXPECT().AddItem(gomock.Any(), stored, character.InventoryLocationInventory, item).Return(stored, nil)
+			out, err := invService.AddItem(ctx, stored.CharacterId, 3, character.InventoryLocationInventory, item)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out).To(Equal(stored))
+		})
+
+		It("should error adding unknown items", func() {
+			item := &character.InventoryItem{Id: "unknown", Slot: 1, Quantity: 5}
+			mockItemRepo.EXPECT().FindById(gomock.Any(), "unknown").Return(nil, nil)
+			out, err := invService.AddItem(ctx, stored.CharacterId, 3, character.InventoryLocationInventory, item)
+			Expect(err).To(MatchError(character.ErrInventoryUnknownItem))
+			Expect(out).To(BeNil())
+		})
+
+		It("should merge up to the max stack", func() {
+			stored.Inventory = append(stored.Inventory, &character.InventoryItem{Id: "potion", Slot: 1, Quantity: 5})
+			mockItemRepo.EXPECT().FindById(gomock.Any(), "potion").Return(&character.ItemDefinition{MaxStack: 12}, nil)
+			mockRepository.EXPECT().MergeStack(gomock.Any(), stored, character.InventoryLocationInventory, uint32(1), uint32(0), uint64(2)).
+				Return(stored, nil)
+			_, err := invService.MergeStack(ctx, stored.CharacterId, 3, character.InventoryLocationInventory, 1, 0)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should error if the repository fails", func() {
+			mockRepository.EXPECT().RemoveItem(gomock.Any(), stored, character.InventoryLocationInventory, uint32(0), uint64(1)).
+				Return(nil, character.ErrInventoryVersion)
+			out, err := invService.RemoveItem(ctx, stored.CharacterId, 3, character.InventoryLocationInventory, 0, 1)
+			Expect(err).To(MatchError(character.ErrInventoryVersion))
+			Expect(out).To(BeNil())
+		})
+	})
+
+	Describe("History", func() {
+		It("should use the default limit", func() {
+			mockRepository.EXPECT().FindHistory(ctx, charInv.CharacterId, int64(service.InventoryHistoryLimit)).
+				Return(character.InventoryEvents{}, nil)
+			_, err := invService.History(ctx, charInv.CharacterId, 0)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should cap the limit", func() {
+			mockRepository.EXPECT().FindHistory(ctx, charInv.CharacterId, int64(service.MaxInventoryHistoryLimit)).
+				Return(character.InventoryEvents{}, nil)
+			_, err := invService.History(ctx, charInv.CharacterId, service.MaxInventoryHistoryLimit+1)
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+
+	Describe("Restore", func() {
+		var at time.Time
+
+		BeforeEach(func() {
+			at = time.Now()
+		})
+
+		It("should require a reason", func() {
+			out, err := invService.Restore(ctx, charInv.CharacterId, at, "")
+			Expect(err).To(MatchError(service.ErrInventoryRestoreReason))
+			Expect(out).To(BeNil())
+		})
+
+		It("should error without history", func() {
+			mockRepository.EXPECT().FindLatestEvent(gomock.Any(), charInv.CharacterId, at).Return(nil, nil)
+			out, err := invService.Restore(ctx, charInv.CharacterId, at, "lost items")
+			Expect(err).To(MatchError(service.ErrInventoryHistoryNotFound))
+			Expect(out).To(BeNil())
+		})
+
+		It("should save the inventory after the event", func() {
+			event := &character.InventoryEvent{
+				CharacterId: charInv.CharacterId,
+				After:       charInv,
+				CreatedAt:   at.Add(-time.Hour),
+			}
+			mockRepository.EXPECT().FindLatestEvent(gomock.Any(), charInv.CharacterId, at).Return(event, nil)
+			mockRepository.EXPECT().UpdateInventory(gomock.Any(), charInv, gomock.Any()).DoAndReturn(
+				func(_ context.Context, inv *character.Inventory, reason string) error {
+					Expect(reason).To(ContainSubstring("lost items"))
+					return nil
+				},
+			)
+			out, err := invService.Restore(ctx, charInv.CharacterId, at, "lost items")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out).To(Equal(charInv))
+			Expect(out).NotTo(BeIdenticalTo(charInv))
+		})
 	})
 })
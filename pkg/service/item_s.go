@@ -0,0 +1,152 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/ShatteredRealms/go-backend/pkg/model/character"
+	"github.com/ShatteredRealms/go-backend/pkg/repository"
+	"go.opentelemetry.io/otel"
+	"gopkg.in/yaml.v3"
+)
+
+var (
+	itemTracer = otel.Tracer("Inner-ItemService")
+
+	// ErrItemNotFound thrown when acting on an item definition that does not exist
+	ErrItemNotFound = errors.New("item not found")
+
+	// ErrItemExists thrown when creating an item definition with an id that is already used
+	ErrItemExists = errors.New("item already exists")
+)
+
+type ItemService interface {
+	Create(ctx context.Context, item *character.ItemDefinition) (*character.ItemDefinition, error)
+
+	// Edit replaces the item definition with the same id
+	Edit(ctx context.Context, item *character.ItemDefinition) (*character.ItemDefinition, error)
+	Delete(ctx context.Context, id string) error
+
+	FindById(ctx context.Context, id string) (*character.ItemDefinition, error)
+	FindAll(ctx context.Context) (character.ItemDefinitions, error)
+
+	// LoadFile creates or replaces the item definitions in the YAML or JSON file, which contains a list of item
+	// definitions
+	LoadFile(ctx context.Context, path string) error
+}
+
+type itemService struct {
+	repo repository.ItemRepository
+}
+
+func NewItemService(ctx context.Context, repo repository.ItemRepository) (ItemService, error) {
+	err := repo.Migrate(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("migrate db: %w", err)
+	}
+
+	return itemService{
+		repo: repo,
+	}, nil
+}
+
+// Create implements ItemService.
+func (s itemService) Create(ctx context.Context, item *character.ItemDefinition) (*character.ItemDefinition, error) {
+	ctx, span := itemTracer.Start(ctx, "CreateItem")
+	defer span.End()
+
+	err := item.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := s.repo.FindById(ctx, item.Id)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return nil, ErrItemExists
+	}
+
+	return s.repo.Create(ctx, item)
+}
+
+// Edit implements ItemService.
+func (s itemService) Edit(ctx context.Context, item *character.ItemDefinition) (*character.ItemDefinition, error) {
+	ctx, span := itemTracer.Start(ctx, "EditItem")
+	defer span.End()
+
+	err := item.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := s.repo.FindById(ctx, item.Id)
+	if err != nil {
+		return nil, err
+	}
+	if existing == nil {
+		return nil, ErrItemNotFound
+	}
+
+	item.CreatedAt = existing.CreatedAt
+	return s.repo.Save(ctx, item)
+}
+
+// Delete implements ItemService.
+func (s itemService) Delete(ctx context.Context, id string) error {
+	existing, err := s.repo.FindById(ctx, id)
+	if err != nil {
+		return err
+	}
+	if existing == nil {
+		return ErrItemNotFound
+	}
+
+	return s.repo.Delete(ctx, id)
+}
+
+// FindById implements ItemService.
+func (s itemService) FindById(ctx context.Context, id string) (*character.ItemDefinition, error) {
+	return s.repo.FindById(ctx, id)
+}
+
+// FindAll implements ItemService.
+func (s itemService) FindAll(ctx context.Context) (character.ItemDefinitions, error) {
+	return s.repo.FindAll(ctx)
+}
+
+// LoadFile implements ItemService.
+func (s itemService) LoadFile(ctx context.Context, path string) error {
+	ctx, span := itemTracer.Start(ctx, "LoadItemFile")
+	defer span.End()
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	// JSON is valid YAML, so both formats are parsed the same way
+	var items character.ItemDefinitions
+	err = yaml.Unmarshal(data, &items)
+	if err != nil {
+		return fmt.Errorf("parse %s: %w", path, err)
+	}
+
+	ids := make(map[string]struct{}, len(items))
+	for _, item := range items {
+		err = item.Validate()
+		if err != nil {
+			return fmt.Errorf("item '%s': %w", item.Id, err)
+		}
+
+		if _, ok := ids[item.Id]; ok {
+			return fmt.Errorf("item '%s': %w", item.Id, ErrItemExists)
+		}
+		ids[item.Id] = struct{}{}
+	}
+
+	return s.repo.Upsert(ctx, items)
+}
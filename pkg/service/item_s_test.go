@@ -0,0 +1,179 @@
+package service_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/ShatteredRealms/go-backend/pkg/log"
+	"github.com/ShatteredRealms/go-backend/pkg/mocks"
+	"github.com/ShatteredRealms/go-backend/pkg/model/character"
+	"github.com/ShatteredRealms/go-backend/pkg/service"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/sirupsen/logrus/hooks/test"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("Item service", func() {
+	var (
+		mockController *gomock.Controller
+		mockRepository *mocks.MockItemRepository
+
+		itemService service.ItemService
+
+		ctx       = context.Background()
+		fakeError = fmt.Errorf("error")
+
+		item *character.ItemDefinition
+	)
+
+	BeforeEach(func() {
+		log.Logger, _ = test.NewNullLogger()
+		mockController = gomock.NewController(GinkgoT())
+		mockRepository = mocks.NewMockItemRepository(mockController)
+
+		mockRepository.EXPECT().Migrate(gomock.Any()).Return(nil)
+		var err error
+		itemService, err = service.NewItemService(ctx, mockRepository)
+		Expect(err).NotTo(HaveOccurred())
+
+		item = &character.ItemDefinition{
+			Id:       "health_potion",
+			Name:     "Health Potion",
+			Category: "consumable",
+			MaxStack: 20,
+			Tradable: true,
+			Value:    5,
+		}
+	})
+
+	Describe("NewItemService", func() {
+		It("should error if migrating fails", func() {
+			mockRepository.EXPECT().Migrate(gomock.Any()).Return(fakeError)
+			out, err := service.NewItemService(ctx, mockRepository)
+			Expect(err).To(MatchError(fakeError))
+			Expect(out).To(BeNil())
+		})
+	})
+
+	Describe("Create", func() {
+		It("should create a new item", func() {
+			mockRepository.EXPECT().FindById(gomock.Any(), item.Id).Return(nil, nil)
+			mockRepository.EXPECT().Create(gomock.Any(), item).Return(item, nil)
+			out, err := itemService.Create(ctx, item)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out).To(Equal(item))
+		})
+
+		It("should error on invalid items", func() {
+			item.MaxStack = 0
+			out, err := itemService.Create(ctx, item)
+			Expect(err).To(MatchError(character.ErrItemMaxStack))
+			Expect(out).To(BeNil())
+		})
+
+		It("should error if the item exists", func() {
+			mockRepository.EXPECT().FindById(gomock.Any(), item.Id).Return(item, nil)
+			out, err := itemService.Create(ctx, item)
+			Expect(err).To(MatchError(service.ErrItemExists))
+			Expect(out).To(BeNil())
+		})
+	})
+
+	Describe("Edit", func() {
+		It("should keep the creation time", func() {
+			createdAt := time.Now().Add(-time.Hour)
+			mockRepository.EXPECT().FindById(gomock.Any(), item.Id).Return(&character.ItemDefinition{
+				Id:        item.Id,
+				CreatedAt: createdAt,
+			}, nil)
+			mockRepository.EXPECT().Save(gomock.Any(), item).Return(item, nil)
+			out, err := itemService.Edit(ctx, item)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.CreatedAt).To(Equal(createdAt))
+		})
+
+		It("should error if the item does not exist", func() {
+			mockRepository.EXPECT().FindById(gomock.Any(), item.Id).Return(nil, nil)
+			out, err := itemService.Edit(ctx, item)
+			Expect(err).To(MatchError(service.ErrItemNotFound))
+			Expect(out).To(BeNil())
+		})
+	})
+
+	Describe("Delete", func() {
+		It("should error if the item does not exist", func() {
+			mockRepository.EXPECT().FindById(gomock.Any(), item.Id).Return(nil, nil)
+			Expect(itemService.Delete(ctx, item.Id)).To(MatchError(service.ErrItemNotFound))
+		})
+
+		It("should delete the item", func() {
+			mockRepository.EXPECT().FindById(gomock.Any(), item.Id).Return(item, nil)
+			mockRepository.EXPECT().Delete(gomock.Any(), item.Id).Return(nil)
+			Expect(itemService.Delete(ctx, item.Id)).To(Succeed())
+		})
+	})
+
+	Describe("LoadFile", func() {
+		var path string
+
+		write := func(name string, data string) {
+			path = filepath.Join(GinkgoT().TempDir(), name)
+			Expect(os.WriteFile(path, []byte(data), 0600)).To(Succeed())
+		}
+
+		It("should load yaml files", func() {
+			write("items.yaml", `
+- id: health_potion
+  name: Health Potion
+  category: consumable
+  maxStack: 20
+  tradable: true
+  value: 5
+- id: iron_sword
+  name: Iron Sword
+  maxStack: 1
+  equipSlot: main_hand
+`)
+			mockRepository.EXPECT().Upsert(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, items character.ItemDefinitions) error {
+					Expect(items).To(HaveLen(2))
+					Expect(items[0]).To(Equal(item))
+					Expect(items[1].IsEquippable()).To(BeTrue())
+					return nil
+				},
+			)
+			Expect(itemService.LoadFile(ctx, path)).To(Succeed())
+		})
+
+		It("should load json files", func() {
+			write("items.json", `[{"id": "health_potion", "name": "Health Potion", "maxStack": 20}]`)
+			mockRepository.EXPECT().Upsert(gomock.Any(), gomock.Any()).Return(nil)
+			Expect(itemService.LoadFile(ctx, path)).To(Succeed())
+		})
+
+		It("should error on invalid items", func() {
+			write("items.yaml", "- id: health_potion\n  name: Health Potion\n")
+			Expect(itemService.LoadFile(ctx, path)).To(MatchError(character.ErrItemMaxStack))
+		})
+
+		It("should error on duplicate items", func() {
+			write("items.yaml", `
+- id: health_potion
+  name: Health Potion
+  maxStack: 1
+- id: health_potion
+  name: Health Potion
+  maxStack: 1
+`)
+			Expect(itemService.LoadFile(ctx, path)).To(MatchError(service.ErrItemExists))
+		})
+
+		It("should error on missing files", func() {
+			Expect(itemService.LoadFile(ctx, filepath.Join(GinkgoT().TempDir(), "missing.yaml"))).NotTo(Succeed())
+		})
+	})
+})
@@ -0,0 +1,344 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ShatteredRealms/go-backend/pkg/config"
+	"github.com/ShatteredRealms/go-backend/pkg/model/character"
+	"github.com/ShatteredRealms/go-backend/pkg/repository"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.opentelemetry.io/otel"
+)
+
+const (
+	// MailInventoryAttempts times changing an inventory is retried when it is changed concurrently
+	MailInventoryAttempts = 3
+)
+
+var (
+	mailTracer = otel.Tracer("Inner-MailService")
+
+	// ErrMailNotFound thrown when acting on mail that does not exist or was not sent to the character
+	ErrMailNotFound = errors.New("mail not found")
+
+	// ErrMailSelf thrown when a character sends mail to itself
+	ErrMailSelf = errors.New("cannot send mail to yourself")
+
+	// ErrMailWrongDimension thrown when sending mail to a character in another dimension
+	ErrMailWrongDimension = errors.New("recipient is in another dimension")
+
+	// ErrMailClaimed thrown when claiming mail that was already claimed
+	ErrMailClaimed = errors.New("mail already claimed")
+
+	// ErrMailNothingToClaim thrown when claiming mail without attachments or gold
+	ErrMailNothingToClaim = errors.New("mail has nothing to claim")
+
+	// ErrMailHasAttachments thrown when deleting mail with attachments or gold that were not claimed
+	ErrMailHasAttachments = errors.New("mail has unclaimed attachments")
+
+	// ErrMailConflict thrown when an inventory kept changing while sending or claiming mail
+	ErrMailConflict = errors.New("inventory changed, try again")
+)
+
+type MailService interface {
+	// Send sends mail from the sender. The attachments and gold are taken from the inventory of the sender and the
+	// attachments must be tradable.
+	Send(
+		ctx context.Context,
+		sender *character.Character,
+		recipient *character.Character,
+		subject string,
+		body string,
+		attachments character.MailAttachments,
+		gold uint64,
+	) (*character.Mail, error)
+
+	// SendSystem sends mail from the backend. The attachments and gold are created instead of taken from an inventory.
+	// The attachments must be in the item catalog, but do not have to be tradable.
+	SendSystem(
+		ctx context.Context,
+		recipient *character.Character,
+		senderName string,
+		subject string,
+		body string,
+		attachments character.InventoryItems,
+		gold uint64,
+	) (*character.Mail, error)
+
+	Inbox(ctx context.Context, recipientId uint) (character.Mails, error)
+	Read(ctx context.Context, id primitive.ObjectID, recipientId uint) (*character.Mail, error)
+
+	// Claim moves the attachments and gold of the mail into the inventory of the recipient
+	Claim(ctx context.Context, id primitive.ObjectID, recipientId uint) (*character.Mail, error)
+	Delete(ctx context.Context, id primitive.ObjectID, recipientId uint) error
+}
+
+type mailService struct {
+	repo      repository.MailRepository
+	invRepo   repository.InventoryRepository
+	validator inventoryValidator
+	expiry    time.Duration
+}
+
+func NewMailService(
+	ctx context.Context,
+	repo repository.MailRepository,
+	invRepo repository.InventoryRepository,
+	itemRepo repository.ItemRepository,
+	conf config.InventoryConfig,
+	expiry time.Duration,
+) (MailService, error) {
+	if expiry <= 0 {
+		return nil, errors.New("mail expiry must be positive")
+	}
+
+	err := repo.Migrate(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("migrate db: %w", err)
+	}
+
+	return mailService{
+		repo:      repo,
+		invRepo:   invRepo,
+		validator: inventoryValidator{itemRepo: itemRepo, conf: conf},
+		expiry:    expiry,
+	}, nil
+}
+
+// Send implements MailService. The attachments are taken from the inventory of the sender and the mail is created in
+// a single transaction, so items are never duplicated or lost.
+func (s mailService) Send(
+	ctx context.Context,
+	sender *character.Character,
+	recipient *character.Character,
+	subject string,
+	body string,
+	attachments character.MailAttachments,
+	gold uint64,
+) (*character.Mail, error) {
+	ctx, span := mailTracer.Start(ctx, "SendMail")
+	defer span.End()
+
+	if sender.ID == recipient.ID {
+		return nil, ErrMailSelf
+	}
+
+	if sender.Dimension != recipient.Dimension {
+		return nil, ErrMailWrongDimension
+	}
+
+	if len(attachments) > character.MaxMailAttachments {
+		return nil, character.ErrMailAttachments
+	}
+
+	for attempt := 0; attempt < MailInventoryAttempts; attempt++ {
+		inventory, err := s.inventory(ctx, sender.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		mail := s.newMail(recipient, subject, body, gold)
+		mail.SenderId = sender.ID
+		mail.SenderName = sender.Name
+		for _, attachment := range attachments {
+			item, err := inventory.Take(attachment.Slot, attachment.Quantity)
+			if err != nil {
+				return nil, err
+			}
+			mail.Attachments = append(mail.Attachments, item)
+		}
+
+		err = inventory.TakeGold(gold)
+		if err != nil {
+			return nil, err
+		}
+
+		err = s.validator.ValidateTransfer(ctx, mail.Attachments, true)
+		if err != nil {
+			return nil, err
+		}
+
+		err = mail.Validate()
+		if err != nil {
+			return nil, err
+		}
+
+		ok, err := s.invRepo.SendMail(ctx, inventory, mail)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			return mail, nil
+		}
+	}
+
+	return nil, ErrMailConflict
+}
+
+// SendSystem implements MailService.
+func (s mailService) SendSystem(
+	ctx context.Context,
+	recipient *character.Character,
+	senderName string,
+	subject string,
+	body string,
+	attachments character.InventoryItems,
+	gold uint64,
+) (*character.Mail, error) {
+	ctx, span := mailTracer.Start(ctx, "SendSystemMail")
+	defer span.End()
+
+	mail := s.newMail(recipient, subject, body, gold)
+	mail.SenderId = character.SystemMailSenderId
+	mail.SenderName = senderName
+	mail.Attachments = attachments
+
+	err := mail.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.validator.ValidateTransfer(ctx, mail.Attachments, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.repo.Create(ctx, mail)
+}
+
+// Inbox implements MailService.
+func (s mailService) Inbox(ctx context.Context, recipientId uint) (character.Mails, error) {
+	return s.repo.FindForRecipient(ctx, recipientId)
+}
+
+// Read implements MailService.
+func (s mailService) Read(ctx context.Context, id primitive.ObjectID, recipientId uint) (*character.Mail, error) {
+	mail, err := s.findMail(ctx, id, recipientId)
+	if err != nil {
+		return nil, err
+	}
+
+	if mail.Read {
+		return mail, nil
+	}
+
+	mail.Read = true
+	return mail, s.repo.MarkRead(ctx, id)
+}
+
+// Claim implements MailService. The mail is marked claimed and the attachments are given to the recipient in a single
+// transaction, so only one claim can succeed and items are never duplicated or lost.
+func (s mailService) Claim(ctx context.Context, id primitive.ObjectID, recipientId uint) (*character.Mail, error) {
+	ctx, span := mailTracer.Start(ctx, "ClaimMail")
+	defer span.End()
+
+	mail, err := s.findMail(ctx, id, recipientId)
+	if err != nil {
+		return nil, err
+	}
+
+	if mail.Claimed {
+		return nil, ErrMailClaimed
+	}
+
+	if !mail.HasUnclaimed() {
+		return nil, ErrMailNothingToClaim
+	}
+
+	for attempt := 0; attempt < MailInventoryAttempts; attempt++ {
+		inventory, err := s.inventory(ctx, recipientId)
+		if err != nil {
+			return nil, err
+		}
+
+		err = s.giveAttachments(ctx, inventory, mail)
+		if err != nil {
+			return nil, err
+		}
+
+		ok, err := s.invRepo.ClaimMail(ctx, inventory, mail)
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, ErrMailClaimed
+		}
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			return mail, nil
+		}
+	}
+
+	return nil, ErrMailConflict
+}
+
+// Delete implements MailService.
+func (s mailService) Delete(ctx context.Context, id primitive.ObjectID, recipientId uint) error {
+	mail, err := s.findMail(ctx, id, recipientId)
+	if err != nil {
+		return err
+	}
+
+	if mail.HasUnclaimed() {
+		return ErrMailHasAttachments
+	}
+
+	return s.repo.Delete(ctx, id)
+}
+
+// giveAttachments places the attachments and gold of the mail in the inventory and validates the inventory against the
+// item catalog and capacity
+func (s mailService) giveAttachments(ctx context.Context, inventory *character.Inventory, mail *character.Mail) error {
+	for _, item := range mail.Attachments {
+		err := inventory.Give(item, s.validator.Capacity())
+		if err != nil {
+			return err
+		}
+	}
+
+	err := inventory.GiveGold(mail.Gold)
+	if err != nil {
+		return err
+	}
+
+	return s.validator.Validate(ctx, inventory)
+}
+
+// inventory gets the inventory of the character, or an empty inventory if it was never saved
+func (s mailService) inventory(ctx context.Context, characterId uint) (*character.Inventory, error) {
+	inventory, err := s.invRepo.GetInventory(ctx, characterId)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return &character.Inventory{CharacterId: characterId}, nil
+	}
+
+	return inventory, err
+}
+
+func (s mailService) findMail(ctx context.Context, id primitive.ObjectID, recipientId uint) (*character.Mail, error) {
+	mail, err := s.repo.FindById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if mail == nil || mail.RecipientId != recipientId || mail.IsExpired() {
+		return nil, ErrMailNotFound
+	}
+
+	return mail, nil
+}
+
+func (s mailService) newMail(recipient *character.Character, subject string, body string, gold uint64) *character.Mail {
+	now := time.Now()
+	return &character.Mail{
+		RecipientId: recipient.ID,
+		Subject:     subject,
+		Body:        body,
+		Attachments: character.InventoryItems{},
+		Gold:        gold,
+		CreatedAt:   now,
+		ExpiresAt:   now.Add(s.expiry),
+	}
+}
@@ -0,0 +1,364 @@
+package service_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ShatteredRealms/go-backend/pkg/config"
+	"github.com/ShatteredRealms/go-backend/pkg/log"
+	"github.com/ShatteredRealms/go-backend/pkg/mocks"
+	"github.com/ShatteredRealms/go-backend/pkg/model/character"
+	"github.com/ShatteredRealms/go-backend/pkg/service"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/sirupsen/logrus/hooks/test"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("Mail service", func() {
+	var (
+		mockController *gomock.Controller
+		mockRepository *mocks.MockMailRepository
+		mockInvRepo    *mocks.MockInventoryRepository
+		mockItemRepo   *mocks.MockItemRepository
+
+		mailService service.MailService
+
+		ctx           = context.Background()
+		fakeError     = fmt.Errorf("error")
+		inventoryConf = config.InventoryConfig{Capacity: 10, BankCapacity: 10}
+
+		sender    *character.Character
+		recipient *character.Character
+		inventory *character.Inventory
+		mail      *character.Mail
+	)
+
+	BeforeEach(func() {
+		log.Logger, _ = test.NewNullLogger()
+		mockController = gomock.NewController(GinkgoT())
+		mockRepository = mocks.NewMockMailRepository(mockController)
+		mockInvRepo = mocks.NewMockInventoryRepository(mockController)
+		mockItemRepo = mocks.NewMockItemRepository(mockController)
+
+		mockRepository.EXPECT().Migrate(gomock.Any()).Return(nil)
+		var err error
+		mailService, err = service.NewMailService(ctx, mockRepository, mockInvRepo, mockItemRepo, inventoryConf, time.Hour)
+		Expect(err).NotTo(HaveOccurred())
+
+		sender = &character.Character{ID: 1, Name: "sender", Dimension: "dimension"}
+		recipient = &character.Character{ID: 2, Name: "recipient", Dimension: "dimension"}
+		inventory = &character.Inventory{
+			CharacterId: sender.ID,
+			Inventory: character.InventoryItems{
+				{Id: "sword", Slot: 0, Quantity: 1},
+				{Id: "potion", Slot: 1, Quantity: 5},
+			},
+			Gold:    100,
+			Version: 3,
+		}
+		mail = &character.Mail{
+			Id:          primitive.NewObjectID(),
+			SenderId:    sender.ID,
+			SenderName:  sender.Name,
+			RecipientId: recipient.ID,
+			Subject:     "subject",
+			Attachments: character.InventoryItems{{Id: "potion", Slot: 1, Quantity: 2}},
+			Gold:        10,
+			ExpiresAt:   time.Now().Add(time.Hour),
+		}
+	})
+
+	Describe("NewMailService", func() {
+		It("should require a positive expiry", func() {
+			out, err := service.NewMailService(ctx, mockRepository, mockInvRepo, mockItemRepo, inventoryConf, 0)
+			Expect(err).To(HaveOccurred())
+			Expect(out).To(BeNil())
+		})
+
+		It("should error if migrating fails", func() {
+			mockRepository.EXPECT().Migrate(gomock.Any()).Return(fakeError)
+			out, err := service.NewMailService(ctx, mockRepository, mockInvRepo, mockItemRepo, inventoryConf, time.Hour)
+			Expect(err).To(MatchError(fakeError))
+			Expect(out).To(BeNil())
+		})
+	})
+
+	Describe("Send", func() {
+		attachments := character.MailAttachments{{Slot: 1, Quantity: 2}}
+
+		It("should not send to itself", func() {
+			out, err := mailService.Send(ctx, sender, sender, "subject", "", nil, 0)
+			Expect(err).To(MatchError(service.ErrMailSelf))
+			Expect(out).To(BeNil())
+		})
+
+		It("should not send to another dimension", func() {
+			recipient.Dimension = "other"
+			out, err := mailService.Send(ctx, sender, recipient, "subject", "", nil, 0)
+			Expect(err).To(MatchError(service.ErrMailWrongDimension))
+			Expect(out).To(BeNil())
+		})
+
+		It("should error for missing items", func() {
+			mockInvRepo.EXPECT().GetInventory(gomock.Any(), sender.ID).Return(inventory, nil)
+			out, err := mailService.Send(ctx, sender, recipient, "subject", "", character.MailAttachments{{Slot: 5, Quantity: 1}}, 0)
+			Expect(err).To(MatchError(character.ErrInventorySlotEmpty))
+			Expect(out).To(BeNil())
+		})
+
+		It("should error for missing gold", func() {
+			mockInvRepo.EXPECT().GetInventory(gomock.Any(), sender.ID).Return(inventory, nil)
+			out, err := mailService.Send(ctx, sender, recipient, "subject", "", nil, 1000)
+			Expect(err).To(MatchError(character.ErrInventoryGold))
+			Expect(out).To(BeNil())
+		})
+
+		It("should error for invalid subject", func() {
+			mockInvRepo.EXPECT().GetInventory(gomock.Any(), sender.ID).Return(inventory, nil)
+			out, err := mailService.Send(ctx, sender, recipient, "", "", nil, 0)
+			Expect(err).To(MatchError(character.ErrMailSubject))
+			Expect(out).To(BeNil())
+		})
+
+		It("should not send items that are not tradable", func() {
+			mockInvRepo.EXPECT().GetInventory(gomock.Any(), sender.ID).Return(inventory, nil)
+			mockItemRepo.EXPECT().FindByIds(gomock.Any(), []string{"potion"}).
+				Return(character.ItemDefinitions{{Id: "potion", MaxStack: 10}}, nil)
+			out, err := mailService.Send(ctx, sender, recipient, "subject", "", attachments, 0)
+			Expect(err).To(MatchError(character.ErrItemNotTradable))
+			Expect(out).To(BeNil())
+		})
+
+		It("should take the attachments and send the mail", func() {
+			mockInvRepo.EXPECT().GetInventory(gomock.Any(), sender.ID).Return(inventory, nil)
+			mockItemRepo.EXPECT().FindByIds(gomock.Any(), []string{"potion"}).
+				Return(character.ItemDefinitions{{Id: "potion", MaxStack: 10, Tradable: true}}, nil)
+			mockInvRepo.EXPECT().SendMail(gomock.Any(), inventory, gomock.Any()).DoAndReturn(
+				func(_ context.Context, inv *character.Inventory, m *character.Mail) (bool, error) {
+					Expect(inv.Gold).To(BeEquivalentTo(90))
+					Expect(inv.Inventory[1].Quantity).To(BeEquivalentTo(3))
+					Expect(m.Gold).To(BeEquivalentTo(10))
+					return true, nil
+				})
+
+			out, err := mailService.Send(ctx, sender, recipient, "subject", "body", attachments, 10)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.SenderId).To(Equal(sender.ID))
+			Expect(out.RecipientId).To(Equal(recipient.ID))
+			Expect(out.Attachments).To(HaveLen(1))
+			Expect(out.Attachments[0].Id).To(Equal("potion"))
+			Expect(out.ExpiresAt).To(BeTemporally("~", time.Now().Add(time.Hour), time.Minute))
+		})
+
+		It("should retry when the inventory changed", func() {
+			mockInvRepo.EXPECT().GetInventory(gomock.Any(), sender.ID).
+				DoAndReturn(func(context.Context, uint) (*character.Inventory, error) {
+					inv := *inventory
+					inv.Inventory = character.InventoryItems{{Id: "potion", Slot: 1, Quantity: 5}}
+					return &inv, nil
+				}).Times(service.MailInventoryAttempts)
+			mockItemRepo.EXPECT().FindByIds(gomock.Any(), []string{"potion"}).
+				Return(character.ItemDefinitions{{Id: "potion", MaxStack: 10, Tradable: true}}, nil).
+				Times(service.MailInventoryAttempts)
+			mockInvRepo.EXPECT().SendMail(gomock.Any(), gomock.Any(), gomock.Any()).Return(false, nil).Times(service.MailInventoryAttempts)
+
+			out, err := mailService.Send(ctx, sender, recipient, "subject", "body", attachments, 10)
+			Expect(err).To(MatchError(service.ErrMailConflict))
+			Expect(out).To(BeNil())
+		})
+
+		It("should error if sending fails", func() {
+			mockInvRepo.EXPECT().GetInventory(gomock.Any(), sender.ID).Return(inventory, nil)
+			mockItemRepo.EXPECT().FindByIds(gomock.Any(), []string{"potion"}).
+				Return(character.ItemDefinitions{{Id: "potion", MaxStack: 10, Tradable: true}}, nil)
+			mockInvRepo.EXPECT().SendMail(gomock.Any(), inventory, gomock.Any()).Return(false, fakeError)
+
+			out, err := mailService.Send(ctx, sender, recipient, "subject", "body", attachments, 10)
+			Expect(err).To(MatchError(fakeError))
+			Expect(out).To(BeNil())
+		})
+	})
+
+	Describe("SendSystem", func() {
+		It("should not send items missing from the catalog", func() {
+			mockItemRepo.EXPECT().FindByIds(gomock.Any(), []string{"potion"}).Return(character.ItemDefinitions{}, nil)
+			out, err := mailService.SendSystem(ctx, recipient, "Realm", "reward", "", mail.Attachments, 5)
+			Expect(err).To(MatchError(character.ErrInventoryUnknownItem))
+			Expect(out).To(BeNil())
+		})
+
+		It("should not send over-stacked items", func() {
+			mockItemRepo.EXPECT().FindByIds(gomock.Any(), []string{"potion"}).
+				Return(character.ItemDefinitions{{Id: "potion", MaxStack: 1}}, nil)
+			out, err := mailService.SendSystem(ctx, recipient, "Realm", "reward", "", mail.Attachments, 5)
+			Expect(err).To(MatchError(character.ErrInventoryOverStacked))
+			Expect(out).To(BeNil())
+		})
+
+		It("should create delivered mail with items that are not tradable", func() {
+			mockItemRepo.EXPECT().FindByIds(gomock.Any(), []string{"potion"}).
+				Return(character.ItemDefinitions{{Id: "potion", MaxStack: 10, BindOnPickup: true}}, nil)
+			mockRepository.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, m *character.Mail) (*character.Mail, error) {
+					return m, nil
+				})
+
+			out, err := mailService.SendSystem(ctx, recipient, "Realm", "reward", "", mail.Attachments, 5)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.IsSystem()).To(BeTrue())
+			Expect(out.SenderName).To(Equal("Realm"))
+			Expect(out.Gold).To(BeEquivalentTo(5))
+		})
+	})
+
+	Describe("Read", func() {
+		It("should not find mail of another character", func() {
+			mockRepository.EXPECT().FindById(gomock.Any(), mail.Id).Return(mail, nil)
+			out, err := mailService.Read(ctx, mail.Id, sender.ID)
+			Expect(err).To(MatchError(service.ErrMailNotFound))
+			Expect(out).To(BeNil())
+		})
+
+		It("should not find expired mail without anything to claim", func() {
+			mail.Attachments = nil
+			mail.Gold = 0
+			mail.ExpiresAt = time.Now().Add(-time.Hour)
+			mockRepository.EXPECT().FindById(gomock.Any(), mail.Id).Return(mail, nil)
+			out, err := mailService.Read(ctx, mail.Id, recipient.ID)
+			Expect(err).To(MatchError(service.ErrMailNotFound))
+			Expect(out).To(BeNil())
+		})
+
+		It("should find expired mail with unclaimed attachments", func() {
+			mail.ExpiresAt = time.Now().Add(-time.Hour)
+			mockRepository.EXPECT().FindById(gomock.Any(), mail.Id).Return(mail, nil)
+			mockRepository.EXPECT().MarkRead(gomock.Any(), mail.Id).Return(nil)
+			out, err := mailService.Read(ctx, mail.Id, recipient.ID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.Read).To(BeTrue())
+		})
+
+		It("should mark the mail read", func() {
+			mockRepository.EXPECT().FindById(gomock.Any(), mail.Id).Return(mail, nil)
+			mockRepository.EXPECT().MarkRead(gomock.Any(), mail.Id).Return(nil)
+			out, err := mailService.Read(ctx, mail.Id, recipient.ID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.Read).To(BeTrue())
+		})
+	})
+
+	Describe("Claim", func() {
+		It("should not claim twice", func() {
+			mail.Claimed = true
+			mockRepository.EXPECT().FindById(gomock.Any(), mail.Id).Return(mail, nil)
+			out, err := mailService.Claim(ctx, mail.Id, recipient.ID)
+			Expect(err).To(MatchError(service.ErrMailClaimed))
+			Expect(out).To(BeNil())
+		})
+
+		It("should error when there is nothing to claim", func() {
+			mail.Attachments = nil
+			mail.Gold = 0
+			mockRepository.EXPECT().FindById(gomock.Any(), mail.Id).Return(mail, nil)
+			out, err := mailService.Claim(ctx, mail.Id, recipient.ID)
+			Expect(err).To(MatchError(service.ErrMailNothingToClaim))
+			Expect(out).To(BeNil())
+		})
+
+		It("should error when claimed concurrently", func() {
+			mockRepository.EXPECT().FindById(gomock.Any(), mail.Id).Return(mail, nil)
+			mockInvRepo.EXPECT().GetInventory(gomock.Any(), recipient.ID).Return(nil, mongo.ErrNoDocuments)
+			mockItemRepo.EXPECT().FindByIds(gomock.Any(), []string{"potion"}).
+				Return(character.ItemDefinitions{{Id: "potion", MaxStack: 10}}, nil)
+			mockInvRepo.EXPECT().ClaimMail(gomock.Any(), gomock.Any(), mail).Return(false, mongo.ErrNoDocuments)
+			out, err := mailService.Claim(ctx, mail.Id, recipient.ID)
+			Expect(err).To(MatchError(service.ErrMailClaimed))
+			Expect(out).To(BeNil())
+		})
+
+		It("should give the attachments to a new inventory", func() {
+			mockRepository.EXPECT().FindById(gomock.Any(), mail.Id).Return(mail, nil)
+			mockInvRepo.EXPECT().GetInventory(gomock.Any(), recipient.ID).Return(nil, mongo.ErrNoDocuments)
+			mockItemRepo.EXPECT().FindByIds(gomock.Any(), []string{"potion"}).
+				Return(character.ItemDefinitions{{Id: "potion", MaxStack: 10}}, nil)
+			mockInvRepo.EXPECT().ClaimMail(gomock.Any(), gomock.Any(), mail).DoAndReturn(
+				func(_ context.Context, inv *character.Inventory, claimed *character.Mail) (bool, error) {
+					Expect(inv.CharacterId).To(Equal(recipient.ID))
+					Expect(inv.Gold).To(BeEquivalentTo(10))
+					Expect(inv.Inventory).To(HaveLen(1))
+					Expect(inv.Inventory[0].Slot).To(BeEquivalentTo(0))
+					Expect(inv.Inventory[0].Quantity).To(BeEquivalentTo(2))
+					claimed.Claimed = true
+					claimed.Read = true
+					return true, nil
+				})
+
+			out, err := mailService.Claim(ctx, mail.Id, recipient.ID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.Claimed).To(BeTrue())
+			Expect(out.Read).To(BeTrue())
+		})
+
+		It("should not claim the mail if the recipient has no room", func() {
+			inventory.Inventory = make(character.InventoryItems, inventoryConf.Capacity)
+			for idx := range inventory.Inventory {
+				inventory.Inventory[idx] = &character.InventoryItem{Id: "potion", Slot: uint32(idx), Quantity: 1}
+			}
+			mockRepository.EXPECT().FindById(gomock.Any(), mail.Id).Return(mail, nil)
+			mockInvRepo.EXPECT().GetInventory(gomock.Any(), recipient.ID).Return(inventory, nil)
+
+			out, err := mailService.Claim(ctx, mail.Id, recipient.ID)
+			Expect(err).To(MatchError(character.ErrInventoryFull))
+			Expect(out).To(BeNil())
+		})
+
+		It("should not claim attachments missing from the catalog", func() {
+			mockRepository.EXPECT().FindById(gomock.Any(), mail.Id).Return(mail, nil)
+			mockInvRepo.EXPECT().GetInventory(gomock.Any(), recipient.ID).Return(nil, mongo.ErrNoDocuments)
+			mockItemRepo.EXPECT().FindByIds(gomock.Any(), []string{"potion"}).Return(character.ItemDefinitions{}, nil)
+
+			out, err := mailService.Claim(ctx, mail.Id, recipient.ID)
+			Expect(err).To(MatchError(character.ErrInventoryUnknownItem))
+			Expect(out).To(BeNil())
+		})
+
+		It("should error if the inventory kept changing", func() {
+			inventory.Inventory = nil
+			mockRepository.EXPECT().FindById(gomock.Any(), mail.Id).Return(mail, nil)
+			mockInvRepo.EXPECT().GetInventory(gomock.Any(), recipient.ID).Return(inventory, nil).
+				Times(service.MailInventoryAttempts)
+			mockItemRepo.EXPECT().FindByIds(gomock.Any(), []string{"potion"}).
+				Return(character.ItemDefinitions{{Id: "potion", MaxStack: 10}}, nil).
+				Times(service.MailInventoryAttempts)
+			mockInvRepo.EXPECT().ClaimMail(gomock.Any(), gomock.Any(), mail).Return(false, nil).
+				Times(service.MailInventoryAttempts)
+
+			out, err := mailService.Claim(ctx, mail.Id, recipient.ID)
+			Expect(err).To(MatchError(service.ErrMailConflict))
+			Expect(out).To(BeNil())
+		})
+	})
+
+	Describe("Delete", func() {
+		It("should not delete unclaimed attachments", func() {
+			mockRepository.EXPECT().FindById(gomock.Any(), mail.Id).Return(mail, nil)
+			Expect(mailService.Delete(ctx, mail.Id, recipient.ID)).To(MatchError(service.ErrMailHasAttachments))
+		})
+
+		It("should delete claimed mail", func() {
+			mail.Claimed = true
+			mockRepository.EXPECT().FindById(gomock.Any(), mail.Id).Return(mail, nil)
+			mockRepository.EXPECT().Delete(gomock.Any(), mail.Id).Return(nil)
+			Expect(mailService.Delete(ctx, mail.Id, recipient.ID)).To(Succeed())
+		})
+
+		It("should error if the mail does not exist", func() {
+			mockRepository.EXPECT().FindById(gomock.Any(), mail.Id).Return(nil, nil)
+			Expect(mailService.Delete(ctx, mail.Id, recipient.ID)).To(MatchError(service.ErrMailNotFound))
+		})
+	})
+})
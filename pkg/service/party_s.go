@@ -0,0 +1,365 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ShatteredRealms/go-backend/pkg/model/gamebackend"
+	"github.com/ShatteredRealms/go-backend/pkg/pb"
+	"github.com/ShatteredRealms/go-backend/pkg/repository"
+	"go.opentelemetry.io/otel"
+)
+
+const (
+	// MinPartySize parties with fewer members are disbanded
+	MinPartySize = 2
+)
+
+var (
+	partyTracer = otel.Tracer("Inner-PartyService")
+
+	// ErrPartyNotFound thrown when acting on a party that does not exist
+	ErrPartyNotFound = errors.New("party not found")
+
+	// ErrPartyAlreadyMember thrown when a character that is already in a party joins or is invited to a party
+	ErrPartyAlreadyMember = errors.New("already a member of a party")
+
+	// ErrPartyNotMember thrown when acting on a character that is not a member of the party
+	ErrPartyNotMember = errors.New("not a member of the party")
+
+	// ErrPartyNotLeader thrown when a party member that is not the leader performs a leader action
+	ErrPartyNotLeader = errors.New("only the party leader can do that")
+
+	// ErrPartySelf thrown when a character invites, kicks or promotes itself
+	ErrPartySelf = errors.New("cannot target yourself")
+
+	// ErrPartyFull thrown when inviting to or joining a party that has the max number of members
+	ErrPartyFull = errors.New("party is full")
+
+	// ErrPartyWrongDimension thrown when inviting a character from another dimension
+	ErrPartyWrongDimension = errors.New("party is in another dimension")
+
+	// ErrPartyAlreadyInvited thrown when inviting a character that already has an invite to the party
+	ErrPartyAlreadyInvited = errors.New("already invited to the party")
+
+	// ErrPartyNotInvited thrown when accepting or declining an invite that does not exist
+	ErrPartyNotInvited = errors.New("not invited to the party")
+)
+
+type PartyService interface {
+	FindById(ctx context.Context, id uint) (*gamebackend.Party, error)
+	FindByCharacter(ctx context.Context, characterId uint) (*gamebackend.Party, error)
+	SetChatChannel(ctx context.Context, partyId uint, channelId uint) (*gamebackend.Party, error)
+
+	Invite(ctx context.Context, inviter *pb.CharacterDetails, target *pb.CharacterDetails) (*gamebackend.Party, error)
+	Invites(ctx context.Context, characterId uint) (gamebackend.PartyInvites, error)
+	AcceptInvite(ctx context.Context, partyId uint, characterId uint) (*gamebackend.Party, error)
+	DeclineInvite(ctx context.Context, partyId uint, characterId uint) (party *gamebackend.Party, disbanded bool, err error)
+
+	Leave(ctx context.Context, characterId uint) (party *gamebackend.Party, disbanded bool, err error)
+	Kick(ctx context.Context, leaderId uint, targetId uint) (party *gamebackend.Party, disbanded bool, err error)
+	Promote(ctx context.Context, leaderId uint, targetId uint) (*gamebackend.Party, error)
+}
+
+type partyService struct {
+	repo    repository.PartyRepository
+	maxSize int
+}
+
+func NewPartyService(ctx context.Context, r repository.PartyRepository, maxSize int) (PartyService, error) {
+	if maxSize < MinPartySize {
+		return nil, fmt.Errorf("max party size must be at least %d", MinPartySize)
+	}
+
+	err := r.Migrate(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("migrate db: %w", err)
+	}
+
+	return partyService{
+		repo:    r,
+		maxSize: maxSize,
+	}, nil
+}
+
+func (s partyService) FindById(ctx context.Context, id uint) (*gamebackend.Party, error) {
+	return s.repo.FindById(ctx, id)
+}
+
+// FindByCharacter gets the party the character is a member of, or nil if it is not in a party
+func (s partyService) FindByCharacter(ctx context.Context, characterId uint) (*gamebackend.Party, error) {
+	return s.repo.FindByCharacter(ctx, characterId)
+}
+
+func (s partyService) SetChatChannel(ctx context.Context, partyId uint, channelId uint) (*gamebackend.Party, error) {
+	party, err := s.findParty(ctx, partyId)
+	if err != nil {
+		return nil, err
+	}
+
+	party.ChatChannelId = channelId
+	return s.repo.Save(ctx, party)
+}
+
+// Invite invites the target to the party of the inviter. If the inviter is not in a party, a new party is created with
+// the inviter as the leader. The new party is disbanded again if all invites to it are declined.
+func (s partyService) Invite(
+	ctx context.Context,
+	inviter *pb.CharacterDetails,
+	target *pb.CharacterDetails,
+) (*gamebackend.Party, error) {
+	ctx, span := partyTracer.Start(ctx, "InviteToParty")
+	defer span.End()
+
+	if inviter.Id == target.Id {
+		return nil, ErrPartySelf
+	}
+
+	if inviter.Dimension != target.Dimension {
+		return nil, ErrPartyWrongDimension
+	}
+
+	targetParty, err := s.repo.FindByCharacter(ctx, uint(target.Id))
+	if err != nil {
+		return nil, err
+	}
+	if targetParty != nil {
+		return nil, ErrPartyAlreadyMember
+	}
+
+	party, err := s.repo.FindByCharacter(ctx, uint(inviter.Id))
+	if err != nil {
+		return nil, err
+	}
+
+	if party == nil {
+		party, err = s.repo.Create(ctx, &gamebackend.Party{
+			LeaderId:  uint(inviter.Id),
+			Dimension: inviter.Dimension,
+		})
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		if !party.IsLeader(uint(inviter.Id)) {
+			return nil, ErrPartyNotLeader
+		}
+
+		if len(party.Members) >= s.maxSize {
+			return nil, ErrPartyFull
+		}
+
+		invite, err := s.repo.FindInvite(ctx, party.ID, uint(target.Id))
+		if err != nil {
+			return nil, err
+		}
+		if invite != nil {
+			return nil, ErrPartyAlreadyInvited
+		}
+	}
+
+	return party, s.repo.CreateInvite(ctx, &gamebackend.PartyInvite{
+		PartyId:     party.ID,
+		CharacterId: uint(target.Id),
+		InviterId:   uint(inviter.Id),
+	})
+}
+
+func (s partyService) Invites(ctx context.Context, characterId uint) (gamebackend.PartyInvites, error) {
+	return s.repo.FindInvitesForCharacter(ctx, characterId)
+}
+
+// AcceptInvite adds the character to the party. Any other party invites for the character are removed.
+func (s partyService) AcceptInvite(ctx context.Context, partyId uint, characterId uint) (*gamebackend.Party, error) {
+	ctx, span := partyTracer.Start(ctx, "AcceptPartyInvite")
+	defer span.End()
+
+	invite, err := s.repo.FindInvite(ctx, partyId, characterId)
+	if err != nil {
+		return nil, err
+	}
+	if invite == nil {
+		return nil, ErrPartyNotInvited
+	}
+
+	current, err := s.repo.FindByCharacter(ctx, characterId)
+	if err != nil {
+		return nil, err
+	}
+	if current != nil {
+		return nil, ErrPartyAlreadyMember
+	}
+
+	party, err := s.repo.FindById(ctx, partyId)
+	if err != nil {
+		return nil, err
+	}
+	if party == nil {
+		return nil, errors.Join(ErrPartyNotFound, s.repo.DeleteInvite(ctx, partyId, characterId))
+	}
+
+	added, err := s.repo.AddMember(ctx, &gamebackend.PartyMember{
+		CharacterId: characterId,
+		PartyId:     party.ID,
+	}, s.maxSize)
+	if err != nil {
+		return nil, err
+	}
+	if !added {
+		return nil, ErrPartyFull
+	}
+
+	return s.findParty(ctx, party.ID)
+}
+
+// DeclineInvite removes the invite of the character. A party created by inviting is disbanded if nobody joined it and
+// no invites are left, so the inviter is not left in a party of its own.
+func (s partyService) DeclineInvite(ctx context.Context, partyId uint, characterId uint) (*gamebackend.Party, bool, error) {
+	ctx, span := partyTracer.Start(ctx, "DeclinePartyInvite")
+	defer span.End()
+
+	invite, err := s.repo.FindInvite(ctx, partyId, characterId)
+	if err != nil {
+		return nil, false, err
+	}
+	if invite == nil {
+		return nil, false, ErrPartyNotInvited
+	}
+
+	err = s.repo.DeleteInvite(ctx, partyId, characterId)
+	if err != nil {
+		return nil, false, err
+	}
+
+	party, err := s.repo.FindById(ctx, partyId)
+	if err != nil {
+		return nil, false, err
+	}
+	if party == nil || len(party.Members) >= MinPartySize {
+		return party, false, nil
+	}
+
+	invites, err := s.repo.FindInvitesForParty(ctx, partyId)
+	if err != nil {
+		return nil, false, err
+	}
+	if len(invites) > 0 {
+		return party, false, nil
+	}
+
+	return party, true, s.repo.Delete(ctx, party)
+}
+
+// Leave removes the character from its party. If the leader leaves, the member that joined first becomes the leader.
+func (s partyService) Leave(ctx context.Context, characterId uint) (*gamebackend.Party, bool, error) {
+	ctx, span := partyTracer.Start(ctx, "LeaveParty")
+	defer span.End()
+
+	party, err := s.repo.FindByCharacter(ctx, characterId)
+	if err != nil {
+		return nil, false, err
+	}
+	if party == nil {
+		return nil, false, ErrPartyNotMember
+	}
+
+	return s.removeMember(ctx, party, characterId)
+}
+
+// Kick removes the target from the party of the leader
+func (s partyService) Kick(ctx context.Context, leaderId uint, targetId uint) (*gamebackend.Party, bool, error) {
+	ctx, span := partyTracer.Start(ctx, "KickFromParty")
+	defer span.End()
+
+	party, err := s.leaderAction(ctx, leaderId, targetId)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return s.removeMember(ctx, party, targetId)
+}
+
+// Promote makes the target the leader of the party
+func (s partyService) Promote(ctx context.Context, leaderId uint, targetId uint) (*gamebackend.Party, error) {
+	ctx, span := partyTracer.Start(ctx, "PromotePartyLeader")
+	defer span.End()
+
+	party, err := s.leaderAction(ctx, leaderId, targetId)
+	if err != nil {
+		return nil, err
+	}
+
+	party.LeaderId = targetId
+	return s.repo.Save(ctx, party)
+}
+
+// leaderAction gets the party led by the leader and checks the target is another member of it
+func (s partyService) leaderAction(ctx context.Context, leaderId uint, targetId uint) (*gamebackend.Party, error) {
+	if leaderId == targetId {
+		return nil, ErrPartySelf
+	}
+
+	party, err := s.repo.FindByCharacter(ctx, leaderId)
+	if err != nil {
+		return nil, err
+	}
+	if party == nil {
+		return nil, ErrPartyNotMember
+	}
+
+	if !party.IsLeader(leaderId) {
+		return nil, ErrPartyNotLeader
+	}
+
+	if !party.IsMember(targetId) {
+		return nil, ErrPartyNotMember
+	}
+
+	return party, nil
+}
+
+// removeMember removes the character from the party and returns the remaining party. The party is disbanded if too
+// few members remain, otherwise a new leader is chosen if the leader was removed.
+func (s partyService) removeMember(
+	ctx context.Context,
+	party *gamebackend.Party,
+	characterId uint,
+) (*gamebackend.Party, bool, error) {
+	if err := s.repo.RemoveMember(ctx, party.ID, characterId); err != nil {
+		return nil, false, err
+	}
+
+	remaining := make(gamebackend.PartyMembers, 0, len(party.Members))
+	for _, member := range party.Members {
+		if member.CharacterId != characterId {
+			remaining = append(remaining, member)
+		}
+	}
+	party.Members = remaining
+
+	if len(party.Members) < MinPartySize {
+		return party, true, s.repo.Delete(ctx, party)
+	}
+
+	if party.IsLeader(characterId) {
+		party.LeaderId = party.Members[0].CharacterId
+		if _, err := s.repo.Save(ctx, party); err != nil {
+			return nil, false, err
+		}
+	}
+
+	return party, false, nil
+}
+
+func (s partyService) findParty(ctx context.Context, partyId uint) (*gamebackend.Party, error) {
+	party, err := s.repo.FindById(ctx, partyId)
+	if err != nil {
+		return nil, err
+	}
+	if party == nil {
+		return nil, ErrPartyNotFound
+	}
+
+	return party, nil
+}
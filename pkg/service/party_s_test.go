@@ -0,0 +1,324 @@
+package service_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ShatteredRealms/go-backend/pkg/log"
+	"github.com/ShatteredRealms/go-backend/pkg/mocks"
+	"github.com/ShatteredRealms/go-backend/pkg/model/gamebackend"
+	"github.com/ShatteredRealms/go-backend/pkg/pb"
+	"github.com/ShatteredRealms/go-backend/pkg/service"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/sirupsen/logrus/hooks/test"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("Party service", func() {
+	const (
+		leaderId uint = 10
+		memberId uint = 11
+		otherId  uint = 12
+		maxSize       = 3
+	)
+
+	var (
+		mockController *gomock.Controller
+		mockRepository *mocks.MockPartyRepository
+
+		partyService service.PartyService
+
+		ctx       = context.Background()
+		fakeError = fmt.Errorf("error")
+
+		party   *gamebackend.Party
+		parties map[uint]*gamebackend.Party
+
+		details = func(id uint) *pb.CharacterDetails {
+			return &pb.CharacterDetails{Id: uint64(id), Dimension: "dimension"}
+		}
+	)
+
+	BeforeEach(func() {
+		log.Logger, _ = test.NewNullLogger()
+		mockController = gomock.NewController(GinkgoT())
+		mockRepository = mocks.NewMockPartyRepository(mockController)
+
+		mockRepository.EXPECT().Migrate(gomock.Any()).Return(nil)
+		var err error
+		partyService, err = service.NewPartyService(ctx, mockRepository, maxSize)
+		Expect(err).NotTo(HaveOccurred())
+
+		party = &gamebackend.Party{
+			ID:        1,
+			LeaderId:  leaderId,
+			Dimension: "dimension",
+			Members: gamebackend.PartyMembers{
+				{CharacterId: leaderId, PartyId: 1},
+				{CharacterId: memberId, PartyId: 1},
+			},
+		}
+		parties = map[uint]*gamebackend.Party{
+			leaderId: party,
+			memberId: party,
+		}
+
+		mockRepository.EXPECT().FindById(gomock.Any(), party.ID).Return(party, nil).AnyTimes()
+		mockRepository.EXPECT().FindById(gomock.Any(), gomock.Not(party.ID)).Return(nil, nil).AnyTimes()
+		mockRepository.EXPECT().FindByCharacter(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, characterId uint) (*gamebackend.Party, error) {
+				return parties[characterId], nil
+			}).AnyTimes()
+	})
+
+	It("should error if migrating fails", func() {
+		mockRepository.EXPECT().Migrate(gomock.Any()).Return(fakeError)
+		out, err := service.NewPartyService(ctx, mockRepository, maxSize)
+		Expect(err).To(MatchError(fakeError))
+		Expect(out).To(BeNil())
+	})
+
+	It("should error if the max size is too small", func() {
+		out, err := service.NewPartyService(ctx, mockRepository, 1)
+		Expect(err).To(HaveOccurred())
+		Expect(out).To(BeNil())
+	})
+
+	Describe("Invite", func() {
+		It("should create a party for inviters without one", func() {
+			delete(parties, leaderId)
+			delete(parties, memberId)
+			created := &gamebackend.Party{ID: 2, LeaderId: leaderId, Dimension: "dimension"}
+			mockRepository.EXPECT().Create(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, p *gamebackend.Party) (*gamebackend.Party, error) {
+					Expect(p.LeaderId).To(Equal(leaderId))
+					Expect(p.Dimension).To(Equal("dimension"))
+					return created, nil
+				})
+			mockRepository.EXPECT().CreateInvite(gomock.Any(), &gamebackend.PartyInvite{
+				PartyId:     created.ID,
+				CharacterId: otherId,
+				InviterId:   leaderId,
+			}).Return(nil)
+
+			out, err := partyService.Invite(ctx, details(leaderId), details(otherId))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out).To(Equal(created))
+		})
+
+		It("should invite to the leaders party", func() {
+			mockRepository.EXPECT().FindInvite(gomock.Any(), party.ID, otherId).Return(nil, nil)
+			mockRepository.EXPECT().CreateInvite(gomock.Any(), gomock.Any()).Return(nil)
+			out, err := partyService.Invite(ctx, details(leaderId), details(otherId))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out).To(Equal(party))
+		})
+
+		It("should only allow the leader to invite", func() {
+			_, err := partyService.Invite(ctx, details(memberId), details(otherId))
+			Expect(err).To(MatchError(service.ErrPartyNotLeader))
+		})
+
+		It("should error inviting yourself", func() {
+			_, err := partyService.Invite(ctx, details(leaderId), details(leaderId))
+			Expect(err).To(MatchError(service.ErrPartySelf))
+		})
+
+		It("should error inviting characters in another dimension", func() {
+			target := details(otherId)
+			target.Dimension = "other"
+			_, err := partyService.Invite(ctx, details(leaderId), target)
+			Expect(err).To(MatchError(service.ErrPartyWrongDimension))
+		})
+
+		It("should error inviting characters already in a party", func() {
+			parties[otherId] = &gamebackend.Party{ID: 2}
+			_, err := partyService.Invite(ctx, details(leaderId), details(otherId))
+			Expect(err).To(MatchError(service.ErrPartyAlreadyMember))
+		})
+
+		It("should error if the party is full", func() {
+			party.Members = append(party.Members, &gamebackend.PartyMember{CharacterId: 20, PartyId: party.ID})
+			_, err := partyService.Invite(ctx, details(leaderId), details(otherId))
+			Expect(err).To(MatchError(service.ErrPartyFull))
+		})
+
+		It("should error if already invited", func() {
+			mockRepository.EXPECT().FindInvite(gomock.Any(), party.ID, otherId).Return(&gamebackend.PartyInvite{}, nil)
+			_, err := partyService.Invite(ctx, details(leaderId), details(otherId))
+			Expect(err).To(MatchError(service.ErrPartyAlreadyInvited))
+		})
+	})
+
+	Describe("AcceptInvite", func() {
+		It("should add the member", func() {
+			mockRepository.EXPECT().FindInvite(gomock.Any(), party.ID, otherId).Return(&gamebackend.PartyInvite{}, nil)
+			mockRepository.EXPECT().AddMember(gomock.Any(), &gamebackend.PartyMember{
+				CharacterId: otherId,
+				PartyId:     party.ID,
+			}, maxSize).Return(true, nil)
+			out, err := partyService.AcceptInvite(ctx, party.ID, otherId)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out).To(Equal(party))
+		})
+
+		It("should error without an invite", func() {
+			mockRepository.EXPECT().FindInvite(gomock.Any(), party.ID, otherId).Return(nil, nil)
+			_, err := partyService.AcceptInvite(ctx, party.ID, otherId)
+			Expect(err).To(MatchError(service.ErrPartyNotInvited))
+		})
+
+		It("should error if the party is full", func() {
+			mockRepository.EXPECT().FindInvite(gomock.Any(), party.ID, otherId).Return(&gamebackend.PartyInvite{}, nil)
+			mockRepository.EXPECT().AddMember(gomock.Any(), gomock.Any(), maxSize).Return(false, nil)
+			_, err := partyService.AcceptInvite(ctx, party.ID, otherId)
+			Expect(err).To(MatchError(service.ErrPartyFull))
+		})
+
+		It("should remove invites to disbanded parties", func() {
+			mockRepository.EXPECT().FindInvite(gomock.Any(), uint(2), otherId).Return(&gamebackend.PartyInvite{}, nil)
+			mockRepository.EXPECT().DeleteInvite(gomock.Any(), uint(2), otherId).Return(nil)
+			_, err := partyService.AcceptInvite(ctx, 2, otherId)
+			Expect(err).To(MatchError(service.ErrPartyNotFound))
+		})
+	})
+
+	Describe("DeclineInvite", func() {
+		It("should delete the invite", func() {
+			mockRepository.EXPECT().FindInvite(gomock.Any(), party.ID, otherId).Return(&gamebackend.PartyInvite{}, nil)
+			mockRepository.EXPECT().DeleteInvite(gomock.Any(), party.ID, otherId).Return(nil)
+			out, disbanded, err := partyService.DeclineInvite(ctx, party.ID, otherId)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(disbanded).To(BeFalse())
+			Expect(out).To(Equal(party))
+		})
+
+		It("should error without an invite", func() {
+			mockRepository.EXPECT().FindInvite(gomock.Any(), party.ID, otherId).Return(nil, nil)
+			_, _, err := partyService.DeclineInvite(ctx, party.ID, otherId)
+			Expect(err).To(MatchError(service.ErrPartyNotInvited))
+		})
+
+		When("nobody joined the party", func() {
+			BeforeEach(func() {
+				party.Members = party.Members[:1]
+				delete(parties, memberId)
+				mockRepository.EXPECT().FindInvite(gomock.Any(), party.ID, otherId).Return(&gamebackend.PartyInvite{}, nil)
+				mockRepository.EXPECT().DeleteInvite(gomock.Any(), party.ID, otherId).Return(nil)
+			})
+
+			It("should disband the party once no invites are left", func() {
+				mockRepository.EXPECT().FindInvitesForParty(gomock.Any(), party.ID).Return(gamebackend.PartyInvites{}, nil)
+				mockRepository.EXPECT().Delete(gomock.Any(), party).Return(nil)
+				out, disbanded, err := partyService.DeclineInvite(ctx, party.ID, otherId)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(disbanded).To(BeTrue())
+				Expect(out).To(Equal(party))
+			})
+
+			It("should keep the party while invites are pending", func() {
+				mockRepository.EXPECT().FindInvitesForParty(gomock.Any(), party.ID).
+					Return(gamebackend.PartyInvites{{PartyId: party.ID, CharacterId: memberId}}, nil)
+				out, disbanded, err := partyService.DeclineInvite(ctx, party.ID, otherId)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(disbanded).To(BeFalse())
+				Expect(out).To(Equal(party))
+			})
+		})
+	})
+
+	Describe("Leave", func() {
+		BeforeEach(func() {
+			party.Members = append(party.Members, &gamebackend.PartyMember{CharacterId: otherId, PartyId: party.ID})
+			parties[otherId] = party
+		})
+
+		It("should promote the oldest member when the leader leaves", func() {
+			mockRepository.EXPECT().RemoveMember(gomock.Any(), party.ID, leaderId).Return(nil)
+			mockRepository.EXPECT().Save(gomock.Any(), party).Return(party, nil)
+			out, disbanded, err := partyService.Leave(ctx, leaderId)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(disbanded).To(BeFalse())
+			Expect(out.LeaderId).To(Equal(memberId))
+			Expect(out.CharacterIds()).To(Equal([]uint{memberId, otherId}))
+		})
+
+		It("should disband once too few members remain", func() {
+			mockRepository.EXPECT().RemoveMember(gomock.Any(), party.ID, otherId).Return(nil)
+			_, disbanded, err := partyService.Leave(ctx, otherId)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(disbanded).To(BeFalse())
+
+			mockRepository.EXPECT().RemoveMember(gomock.Any(), party.ID, memberId).Return(nil)
+			mockRepository.EXPECT().Delete(gomock.Any(), party).Return(nil)
+			_, disbanded, err = partyService.Leave(ctx, memberId)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(disbanded).To(BeTrue())
+		})
+
+		It("should error if not in a party", func() {
+			_, _, err := partyService.Leave(ctx, 20)
+			Expect(err).To(MatchError(service.ErrPartyNotMember))
+		})
+	})
+
+	Describe("Kick", func() {
+		It("should disband once too few members remain", func() {
+			mockRepository.EXPECT().RemoveMember(gomock.Any(), party.ID, memberId).Return(nil)
+			mockRepository.EXPECT().Delete(gomock.Any(), party).Return(nil)
+			_, disbanded, err := partyService.Kick(ctx, leaderId, memberId)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(disbanded).To(BeTrue())
+		})
+
+		It("should only allow the leader to kick", func() {
+			_, _, err := partyService.Kick(ctx, memberId, leaderId)
+			Expect(err).To(MatchError(service.ErrPartyNotLeader))
+		})
+
+		It("should error kicking yourself", func() {
+			_, _, err := partyService.Kick(ctx, leaderId, leaderId)
+			Expect(err).To(MatchError(service.ErrPartySelf))
+		})
+
+		It("should error kicking characters outside the party", func() {
+			_, _, err := partyService.Kick(ctx, leaderId, otherId)
+			Expect(err).To(MatchError(service.ErrPartyNotMember))
+		})
+
+		It("should error if removing fails", func() {
+			mockRepository.EXPECT().RemoveMember(gomock.Any(), party.ID, memberId).Return(fakeError)
+			_, _, err := partyService.Kick(ctx, leaderId, memberId)
+			Expect(err).To(MatchError(fakeError))
+		})
+	})
+
+	Describe("Promote", func() {
+		It("should change the leader", func() {
+			mockRepository.EXPECT().Save(gomock.Any(), party).Return(party, nil)
+			out, err := partyService.Promote(ctx, leaderId, memberId)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.LeaderId).To(Equal(memberId))
+		})
+
+		It("should only allow the leader to promote", func() {
+			_, err := partyService.Promote(ctx, memberId, leaderId)
+			Expect(err).To(MatchError(service.ErrPartyNotLeader))
+		})
+	})
+
+	Describe("SetChatChannel", func() {
+		It("should save the channel", func() {
+			mockRepository.EXPECT().Save(gomock.Any(), party).Return(party, nil)
+			out, err := partyService.SetChatChannel(ctx, party.ID, 5)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.ChatChannelId).To(BeEquivalentTo(5))
+		})
+
+		It("should error if the party does not exist", func() {
+			_, err := partyService.SetChatChannel(ctx, 2, 5)
+			Expect(err).To(MatchError(service.ErrPartyNotFound))
+		})
+	})
+})
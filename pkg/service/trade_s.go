@@ -0,0 +1,297 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ShatteredRealms/go-backend/pkg/config"
+	"github.com/ShatteredRealms/go-backend/pkg/model/character"
+	"github.com/ShatteredRealms/go-backend/pkg/repository"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.opentelemetry.io/otel"
+)
+
+var (
+	tradeTracer = otel.Tracer("Inner-TradeService")
+
+	// ErrTradeNotFound thrown when acting on a trade that does not exist or the character is not part of
+	ErrTradeNotFound = errors.New("trade not found")
+
+	// ErrTradeSelf thrown when a character opens a trade with itself
+	ErrTradeSelf = errors.New("cannot trade with yourself")
+
+	// ErrTradeWrongDimension thrown when opening a trade with a character in another dimension
+	ErrTradeWrongDimension = errors.New("partner is in another dimension")
+
+	// ErrTradeInProgress thrown when opening a trade while either character is already in an open trade
+	ErrTradeInProgress = errors.New("already in an open trade")
+
+	// ErrTradeChanged thrown when confirming offers that changed since they were seen
+	ErrTradeChanged = errors.New("trade offers changed")
+)
+
+type TradeService interface {
+	// Open opens a trade between the initiator and partner with empty offers
+	Open(ctx context.Context, initiator *character.Character, partner *character.Character) (*character.Trade, error)
+
+	Find(ctx context.Context, id primitive.ObjectID, characterId uint) (*character.Trade, error)
+	FindActive(ctx context.Context, characterId uint) (*character.Trade, error)
+	History(ctx context.Context, characterId uint) (character.Trades, error)
+
+	// SetOffer replaces the offer of the character. The offered items must be in the inventory of the character and
+	// be tradable.
+	SetOffer(
+		ctx context.Context,
+		id primitive.ObjectID,
+		characterId uint,
+		items character.TradeOfferItems,
+		gold uint64,
+	) (*character.Trade, error)
+
+	// Confirm confirms the offers of the revision for the character. Once both characters confirmed, the offers are
+	// exchanged and the trade is completed.
+	Confirm(ctx context.Context, id primitive.ObjectID, characterId uint, revision uint64) (*character.Trade, error)
+	Cancel(ctx context.Context, id primitive.ObjectID, characterId uint) (*character.Trade, error)
+}
+
+type tradeService struct {
+	repo      repository.TradeRepository
+	invRepo   repository.InventoryRepository
+	validator inventoryValidator
+}
+
+func NewTradeService(
+	ctx context.Context,
+	repo repository.TradeRepository,
+	invRepo repository.InventoryRepository,
+	itemRepo repository.ItemRepository,
+	conf config.InventoryConfig,
+) (TradeService, error) {
+	err := repo.Migrate(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("migrate db: %w", err)
+	}
+
+	return tradeService{
+		repo:      repo,
+		invRepo:   invRepo,
+		validator: inventoryValidator{itemRepo: itemRepo, conf: conf},
+	}, nil
+}
+
+// Open implements TradeService.
+func (s tradeService) Open(
+	ctx context.Context,
+	initiator *character.Character,
+	partner *character.Character,
+) (*character.Trade, error) {
+	ctx, span := tradeTracer.Start(ctx, "OpenTrade")
+	defer span.End()
+
+	if initiator.ID == partner.ID {
+		return nil, ErrTradeSelf
+	}
+
+	if initiator.Dimension != partner.Dimension {
+		return nil, ErrTradeWrongDimension
+	}
+
+	now := time.Now()
+	trade, err := s.repo.Create(ctx, &character.Trade{
+		Dimension: initiator.Dimension,
+		Status:    character.TradeStatusOpen,
+		Initiator: newTradeOffer(initiator),
+		Partner:   newTradeOffer(partner),
+		Traders:   []uint{initiator.ID, partner.ID},
+		CreatedAt: now,
+		UpdatedAt: now,
+	})
+	if mongo.IsDuplicateKeyError(err) {
+		return nil, ErrTradeInProgress
+	}
+
+	return trade, err
+}
+
+// Find implements TradeService.
+func (s tradeService) Find(ctx context.Context, id primitive.ObjectID, characterId uint) (*character.Trade, error) {
+	trade, err := s.repo.FindById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if trade == nil || !trade.IsTrader(characterId) {
+		return nil, ErrTradeNotFound
+	}
+
+	return trade, nil
+}
+
+// FindActive implements TradeService.
+func (s tradeService) FindActive(ctx context.Context, characterId uint) (*character.Trade, error) {
+	trade, err := s.repo.FindOpenForCharacter(ctx, characterId)
+	if err != nil {
+		return nil, err
+	}
+
+	if trade == nil {
+		return nil, ErrTradeNotFound
+	}
+
+	return trade, nil
+}
+
+// History implements TradeService.
+func (s tradeService) History(ctx context.Context, characterId uint) (character.Trades, error) {
+	return s.repo.FindCompletedForCharacter(ctx, characterId)
+}
+
+// SetOffer implements TradeService.
+func (s tradeService) SetOffer(
+	ctx context.Context,
+	id primitive.ObjectID,
+	characterId uint,
+	items character.TradeOfferItems,
+	gold uint64,
+) (*character.Trade, error) {
+	ctx, span := tradeTracer.Start(ctx, "SetTradeOffer")
+	defer span.End()
+
+	if len(items) > character.MaxTradeItems {
+		return nil, character.ErrTradeItems
+	}
+
+	trade, err := s.findOpen(ctx, id, characterId)
+	if err != nil {
+		return nil, err
+	}
+
+	inventory, err := s.invRepo.GetInventory(ctx, characterId)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		inventory = &character.Inventory{CharacterId: characterId}
+	} else if err != nil {
+		return nil, err
+	}
+
+	// Taking the items from the inventory validates they exist, including items offered from the same slot twice. The
+	// inventory is not saved, the items are only taken when the trade is completed.
+	offer := &character.TradeOffer{
+		CharacterId: characterId,
+		Items:       make(character.InventoryItems, len(items)),
+		Gold:        gold,
+	}
+	for idx, item := range items {
+		offer.Items[idx], err = inventory.Take(item.Slot, item.Quantity)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = inventory.TakeGold(gold)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.validator.ValidateTransfer(ctx, offer.Items, true)
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := s.repo.SetOffer(ctx, trade, offer)
+	if err != nil {
+		return nil, err
+	}
+	if updated == nil {
+		return nil, character.ErrTradeClosed
+	}
+
+	return updated, nil
+}
+
+// Confirm implements TradeService.
+func (s tradeService) Confirm(
+	ctx context.Context,
+	id primitive.ObjectID,
+	characterId uint,
+	revision uint64,
+) (*character.Trade, error) {
+	ctx, span := tradeTracer.Start(ctx, "ConfirmTrade")
+	defer span.End()
+
+	trade, err := s.findOpen(ctx, id, characterId)
+	if err != nil {
+		return nil, err
+	}
+
+	if trade.Revision != revision {
+		return nil, ErrTradeChanged
+	}
+
+	confirmed, err := s.repo.Confirm(ctx, trade, characterId, revision)
+	if err != nil {
+		return nil, err
+	}
+	if confirmed == nil {
+		// The trade was changed or closed after it was read
+		_, err = s.findOpen(ctx, id, characterId)
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, ErrTradeChanged
+	}
+
+	// Confirmations are saved one at a time, so only the last confirmation sees both and completes the trade
+	if !confirmed.Confirmed() {
+		return confirmed, nil
+	}
+
+	completed, err := s.invRepo.Trade(ctx, confirmed, s.validator)
+	if errors.Is(err, character.ErrTradeClosed) {
+		return nil, ErrTradeChanged
+	}
+
+	return completed, err
+}
+
+// Cancel implements TradeService.
+func (s tradeService) Cancel(ctx context.Context, id primitive.ObjectID, characterId uint) (*character.Trade, error) {
+	_, err := s.findOpen(ctx, id, characterId)
+	if err != nil {
+		return nil, err
+	}
+
+	trade, err := s.repo.Cancel(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if trade == nil {
+		return nil, character.ErrTradeClosed
+	}
+
+	return trade, nil
+}
+
+func (s tradeService) findOpen(ctx context.Context, id primitive.ObjectID, characterId uint) (*character.Trade, error) {
+	trade, err := s.Find(ctx, id, characterId)
+	if err != nil {
+		return nil, err
+	}
+
+	if !trade.IsOpen() {
+		return nil, character.ErrTradeClosed
+	}
+
+	return trade, nil
+}
+
+func newTradeOffer(char *character.Character) *character.TradeOffer {
+	return &character.TradeOffer{
+		CharacterId:   char.ID,
+		CharacterName: char.Name,
+		Items:         character.InventoryItems{},
+	}
+}
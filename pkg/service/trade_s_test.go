@@ -0,0 +1,290 @@
+package service_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ShatteredRealms/go-backend/pkg/config"
+	"github.com/ShatteredRealms/go-backend/pkg/log"
+	"github.com/ShatteredRealms/go-backend/pkg/mocks"
+	"github.com/ShatteredRealms/go-backend/pkg/model/character"
+	"github.com/ShatteredRealms/go-backend/pkg/repository"
+	"github.com/ShatteredRealms/go-backend/pkg/service"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/sirupsen/logrus/hooks/test"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("Trade service", func() {
+	var (
+		mockController *gomock.Controller
+		mockRepository *mocks.MockTradeRepository
+		mockInvRepo    *mocks.MockInventoryRepository
+		mockItemRepo   *mocks.MockItemRepository
+
+		tradeService service.TradeService
+
+		ctx           = context.Background()
+		fakeError     = fmt.Errorf("error")
+		inventoryConf = config.InventoryConfig{Capacity: 10, BankCapacity: 10}
+
+		initiator *character.Character
+		partner   *character.Character
+		trade     *character.Trade
+	)
+
+	BeforeEach(func() {
+		log.Logger, _ = test.NewNullLogger()
+		mockController = gomock.NewController(GinkgoT())
+		mockRepository = mocks.NewMockTradeRepository(mockController)
+		mockInvRepo = mocks.NewMockInventoryRepository(mockController)
+		mockItemRepo = mocks.NewMockItemRepository(mockController)
+
+		mockRepository.EXPECT().Migrate(gomock.Any()).Return(nil)
+		var err error
+		tradeService, err = service.NewTradeService(ctx, mockRepository, mockInvRepo, mockItemRepo, inventoryConf)
+		Expect(err).NotTo(HaveOccurred())
+
+		initiator = &character.Character{ID: 1, Name: "initiator", Dimension: "dimension"}
+		partner = &character.Character{ID: 2, Name: "partner", Dimension: "dimension"}
+		trade = &character.Trade{
+			Id:        primitive.NewObjectID(),
+			Status:    character.TradeStatusOpen,
+			Initiator: &character.TradeOffer{CharacterId: initiator.ID},
+			Partner:   &character.TradeOffer{CharacterId: partner.ID},
+			Traders:   []uint{initiator.ID, partner.ID},
+			Revision:  2,
+		}
+	})
+
+	Describe("NewTradeService", func() {
+		It("should error if migrating fails", func() {
+			mockRepository.EXPECT().Migrate(gomock.Any()).Return(fakeError)
+			out, err := service.NewTradeService(ctx, mockRepository, mockInvRepo, mockItemRepo, inventoryConf)
+			Expect(err).To(MatchError(fakeError))
+			Expect(out).To(BeNil())
+		})
+	})
+
+	Describe("Open", func() {
+		It("should not trade with itself", func() {
+			out, err := tradeService.Open(ctx, initiator, initiator)
+			Expect(err).To(MatchError(service.ErrTradeSelf))
+			Expect(out).To(BeNil())
+		})
+
+		It("should not trade with another dimension", func() {
+			partner.Dimension = "other"
+			out, err := tradeService.Open(ctx, initiator, partner)
+			Expect(err).To(MatchError(service.ErrTradeWrongDimension))
+			Expect(out).To(BeNil())
+		})
+
+		It("should error if either character is trading", func() {
+			mockRepository.EXPECT().Create(gomock.Any(), gomock.Any()).
+				Return(nil, mongo.WriteException{WriteErrors: mongo.WriteErrors{{Code: 11000}}})
+			out, err := tradeService.Open(ctx, initiator, partner)
+			Expect(err).To(MatchError(service.ErrTradeInProgress))
+			Expect(out).To(BeNil())
+		})
+
+		It("should open a trade with empty offers", func() {
+			mockRepository.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, t *character.Trade) (*character.Trade, error) {
+					return t, nil
+				})
+			out, err := tradeService.Open(ctx, initiator, partner)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.IsOpen()).To(BeTrue())
+			Expect(out.Traders).To(ConsistOf(initiator.ID, partner.ID))
+			Expect(out.Initiator.CharacterName).To(Equal(initiator.Name))
+			Expect(out.Partner.Items).To(BeEmpty())
+		})
+	})
+
+	Describe("Find", func() {
+		It("should not find trades of other characters", func() {
+			mockRepository.EXPECT().FindById(gomock.Any(), trade.Id).Return(trade, nil)
+			out, err := tradeService.Find(ctx, trade.Id, 3)
+			Expect(err).To(MatchError(service.ErrTradeNotFound))
+			Expect(out).To(BeNil())
+		})
+	})
+
+	Describe("SetOffer", func() {
+		var inventory *character.Inventory
+
+		BeforeEach(func() {
+			inventory = &character.Inventory{
+				CharacterId: initiator.ID,
+				Inventory:   character.InventoryItems{{Id: "potion", Slot: 1, Quantity: 5}},
+				Gold:        20,
+			}
+		})
+
+		It("should error for closed trades", func() {
+			trade.Status = character.TradeStatusCompleted
+			mockRepository.EXPECT().FindById(gomock.Any(), trade.Id).Return(trade, nil)
+			out, err := tradeService.SetOffer(ctx, trade.Id, initiator.ID, nil, 0)
+			Expect(err).To(MatchError(character.ErrTradeClosed))
+			Expect(out).To(BeNil())
+		})
+
+		It("should error for items not in the inventory", func() {
+			mockRepository.EXPECT().FindById(gomock.Any(), trade.Id).Return(trade, nil)
+			mockInvRepo.EXPECT().GetInventory(gomock.Any(), initiator.ID).Return(inventory, nil)
+			out, err := tradeService.SetOffer(ctx, trade.Id, initiator.ID, character.TradeOfferItems{
+				{Slot: 1, Quantity: 3},
+				{Slot: 1, Quantity: 3},
+			}, 0)
+			Expect(err).To(MatchError(character.ErrInventoryQuantity))
+			Expect(out).To(BeNil())
+		})
+
+		It("should error for gold not in the inventory", func() {
+			mockRepository.EXPECT().FindById(gomock.Any(), trade.Id).Return(trade, nil)
+			mockInvRepo.EXPECT().GetInventory(gomock.Any(), initiator.ID).Return(inventory, nil)
+			out, err := tradeService.SetOffer(ctx, trade.Id, initiator.ID, nil, 30)
+			Expect(err).To(MatchError(character.ErrInventoryGold))
+			Expect(out).To(BeNil())
+		})
+
+		It("should error for items that are not tradable", func() {
+			mockRepository.EXPECT().FindById(gomock.Any(), trade.Id).Return(trade, nil)
+			mockInvRepo.EXPECT().GetInventory(gomock.Any(), initiator.ID).Return(inventory, nil)
+			mockItemRepo.EXPECT().FindByIds(gomock.Any(), []string{"potion"}).
+				Return(character.ItemDefinitions{{Id: "potion", MaxStack: 10, Tradable: true, BindOnPickup: true}}, nil)
+			out, err := tradeService.SetOffer(ctx, trade.Id, initiator.ID, character.TradeOfferItems{{Slot: 1, Quantity: 2}}, 0)
+			Expect(err).To(MatchError(character.ErrItemNotTradable))
+			Expect(out).To(BeNil())
+		})
+
+		It("should error for items missing from the catalog", func() {
+			mockRepository.EXPECT().FindById(gomock.Any(), trade.Id).Return(trade, nil)
+			mockInvRepo.EXPECT().GetInventory(gomock.Any(), initiator.ID).Return(inventory, nil)
+			mockItemRepo.EXPECT().FindByIds(gomock.Any(), []string{"potion"}).Return(character.ItemDefinitions{}, nil)
+			out, err := tradeService.SetOffer(ctx, trade.Id, initiator.ID, character.TradeOfferItems{{Slot: 1, Quantity: 2}}, 0)
+			Expect(err).To(MatchError(character.ErrInventoryUnknownItem))
+			Expect(out).To(BeNil())
+		})
+
+		It("should save the offered items", func() {
+			mockRepository.EXPECT().FindById(gomock.Any(), trade.Id).Return(trade, nil)
+			mockInvRepo.EXPECT().GetInventory(gomock.Any(), initiator.ID).Return(inventory, nil)
+			mockItemRepo.EXPECT().FindByIds(gomock.Any(), []string{"potion"}).
+				Return(character.ItemDefinitions{{Id: "potion", MaxStack: 10, Tradable: true}}, nil)
+			mockRepository.EXPECT().SetOffer(gomock.Any(), trade, gomock.Any()).DoAndReturn(
+				func(_ context.Context, t *character.Trade, offer *character.TradeOffer) (*character.Trade, error) {
+					Expect(offer.CharacterId).To(Equal(initiator.ID))
+					Expect(offer.Gold).To(BeEquivalentTo(5))
+					Expect(offer.Items).To(HaveLen(1))
+					Expect(offer.Items[0].Id).To(Equal("potion"))
+					Expect(offer.Items[0].Slot).To(BeEquivalentTo(1))
+					Expect(offer.Items[0].Quantity).To(BeEquivalentTo(2))
+					return t, nil
+				})
+			out, err := tradeService.SetOffer(ctx, trade.Id, initiator.ID, character.TradeOfferItems{{Slot: 1, Quantity: 2}}, 5)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out).To(Equal(trade))
+		})
+	})
+
+	Describe("Confirm", func() {
+		It("should error if the revision changed", func() {
+			mockRepository.EXPECT().FindById(gomock.Any(), trade.Id).Return(trade, nil)
+			out, err := tradeService.Confirm(ctx, trade.Id, initiator.ID, 1)
+			Expect(err).To(MatchError(service.ErrTradeChanged))
+			Expect(out).To(BeNil())
+		})
+
+		It("should error if the revision changed while confirming", func() {
+			mockRepository.EXPECT().FindById(gomock.Any(), trade.Id).Return(trade, nil).Times(2)
+			mockRepository.EXPECT().Confirm(gomock.Any(), trade, initiator.ID, trade.Revision).Return(nil, nil)
+			out, err := tradeService.Confirm(ctx, trade.Id, initiator.ID, trade.Revision)
+			Expect(err).To(MatchError(service.ErrTradeChanged))
+			Expect(out).To(BeNil())
+		})
+
+		It("should wait for the other side", func() {
+			confirmed := *trade
+			confirmed.Initiator = &character.TradeOffer{CharacterId: initiator.ID, Confirmed: true}
+			mockRepository.EXPECT().FindById(gomock.Any(), trade.Id).Return(trade, nil)
+			mockRepository.EXPECT().Confirm(gomock.Any(), trade, initiator.ID, trade.Revision).Return(&confirmed, nil)
+			out, err := tradeService.Confirm(ctx, trade.Id, initiator.ID, trade.Revision)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.IsOpen()).To(BeTrue())
+		})
+
+		It("should complete the trade once both confirmed", func() {
+			confirmed := *trade
+			confirmed.Initiator = &character.TradeOffer{CharacterId: initiator.ID, Confirmed: true}
+			confirmed.Partner = &character.TradeOffer{CharacterId: partner.ID, Confirmed: true}
+			completed := confirmed
+			completed.Status = character.TradeStatusCompleted
+
+			mockRepository.EXPECT().FindById(gomock.Any(), trade.Id).Return(trade, nil)
+			mockRepository.EXPECT().Confirm(gomock.Any(), trade, partner.ID, trade.Revision).Return(&confirmed, nil)
+			mockInvRepo.EXPECT().Trade(gomock.Any(), &confirmed, gomock.Any()).Return(&completed, nil)
+			out, err := tradeService.Confirm(ctx, trade.Id, partner.ID, trade.Revision)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.Status).To(Equal(character.TradeStatusCompleted))
+		})
+
+		It("should error if the items changed before completing", func() {
+			confirmed := *trade
+			confirmed.Initiator = &character.TradeOffer{CharacterId: initiator.ID, Confirmed: true}
+			confirmed.Partner = &character.TradeOffer{CharacterId: partner.ID, Confirmed: true}
+
+			mockRepository.EXPECT().FindById(gomock.Any(), trade.Id).Return(trade, nil)
+			mockRepository.EXPECT().Confirm(gomock.Any(), trade, partner.ID, trade.Revision).Return(&confirmed, nil)
+			mockInvRepo.EXPECT().Trade(gomock.Any(), &confirmed, gomock.Any()).Return(nil, character.ErrTradeItemChanged)
+			out, err := tradeService.Confirm(ctx, trade.Id, partner.ID, trade.Revision)
+			Expect(err).To(MatchError(character.ErrTradeItemChanged))
+			Expect(out).To(BeNil())
+		})
+
+		It("should validate the traded inventories against the catalog and capacity", func() {
+			confirmed := *trade
+			confirmed.Initiator = &character.TradeOffer{CharacterId: initiator.ID, Confirmed: true}
+			confirmed.Partner = &character.TradeOffer{CharacterId: partner.ID, Confirmed: true}
+
+			mockRepository.EXPECT().FindById(gomock.Any(), trade.Id).Return(trade, nil)
+			mockRepository.EXPECT().Confirm(gomock.Any(), trade, partner.ID, trade.Revision).Return(&confirmed, nil)
+			mockItemRepo.EXPECT().FindByIds(gomock.Any(), []string{"unknown"}).Return(character.ItemDefinitions{}, nil)
+			mockInvRepo.EXPECT().Trade(gomock.Any(), &confirmed, gomock.Any()).DoAndReturn(
+				func(ctx context.Context, _ *character.Trade, validator repository.InventoryValidator) (*character.Trade, error) {
+					Expect(validator.Capacity()).To(Equal(inventoryConf.Capacity))
+					return nil, validator.Validate(ctx, &character.Inventory{
+						Inventory: character.InventoryItems{{Id: "unknown", Slot: 0, Quantity: 1}},
+					})
+				},
+			)
+			out, err := tradeService.Confirm(ctx, trade.Id, partner.ID, trade.Revision)
+			Expect(err).To(MatchError(character.ErrInventoryUnknownItem))
+			Expect(out).To(BeNil())
+		})
+	})
+
+	Describe("Cancel", func() {
+		It("should cancel open trades", func() {
+			cancelled := *trade
+			cancelled.Status = character.TradeStatusCancelled
+			mockRepository.EXPECT().FindById(gomock.Any(), trade.Id).Return(trade, nil)
+			mockRepository.EXPECT().Cancel(gomock.Any(), trade.Id).Return(&cancelled, nil)
+			out, err := tradeService.Cancel(ctx, trade.Id, partner.ID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.Status).To(Equal(character.TradeStatusCancelled))
+		})
+
+		It("should error if the trade closed while cancelling", func() {
+			mockRepository.EXPECT().FindById(gomock.Any(), trade.Id).Return(trade, nil)
+			mockRepository.EXPECT().Cancel(gomock.Any(), trade.Id).Return(nil, nil)
+			out, err := tradeService.Cancel(ctx, trade.Id, partner.ID)
+			Expect(err).To(MatchError(character.ErrTradeClosed))
+			Expect(out).To(BeNil())
+		})
+	})
+})
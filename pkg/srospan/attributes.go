@@ -1,7 +1,9 @@
 package srospan
 
 import (
+	"github.com/ShatteredRealms/go-backend/pkg/model/character"
 	"github.com/ShatteredRealms/go-backend/pkg/model/chat"
+	"github.com/ShatteredRealms/go-backend/pkg/model/gamebackend"
 	"go.opentelemetry.io/otel/attribute"
 )
 
@@ -37,10 +39,38 @@ func TargetCharacterName(val string) attribute.KeyValue {
 	return attribute.String("sro.target.character.username", val)
 }
 
+func ChatChannelId(val int) attribute.KeyValue {
+	return attribute.Int("sro.chat.id", val)
+}
+
 func ChatChannelAttributes(channel *chat.ChatChannel) []attribute.KeyValue {
 	return []attribute.KeyValue{
-		attribute.Int("sro.chat.id", int(channel.ID)),
+		ChatChannelId(int(channel.ID)),
 		attribute.String("sro.chat.name", channel.Name),
 		attribute.String("sro.chat.dimension", channel.Dimension),
 	}
 }
+
+func GuildId(val int) attribute.KeyValue {
+	return attribute.Int("sro.guild.id", val)
+}
+
+func GuildAttributes(guild *character.Guild) []attribute.KeyValue {
+	return []attribute.KeyValue{
+		GuildId(int(guild.ID)),
+		attribute.String("sro.guild.name", guild.Name),
+		attribute.String("sro.guild.dimension", guild.Dimension),
+	}
+}
+
+func PartyId(val int) attribute.KeyValue {
+	return attribute.Int("sro.party.id", val)
+}
+
+func PartyAttributes(party *gamebackend.Party) []attribute.KeyValue {
+	return []attribute.KeyValue{
+		PartyId(int(party.ID)),
+		attribute.Int("sro.party.leader.id", int(party.LeaderId)),
+		attribute.String("sro.party.dimension", party.Dimension),
+	}
+}
@@ -3,20 +3,24 @@ package srv
 import (
 	context "context"
 	"errors"
+	"fmt"
 	"reflect"
+	"strconv"
+	"time"
 
 	"github.com/ShatteredRealms/go-backend/pkg/auth"
 	"github.com/ShatteredRealms/go-backend/pkg/common"
 	"github.com/ShatteredRealms/go-backend/pkg/log"
 	"github.com/WilSimpson/gocloak/v13"
 	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	characterApp "github.com/ShatteredRealms/go-backend/cmd/character/app"
 	"github.com/ShatteredRealms/go-backend/pkg/model/character"
-	"github.com/ShatteredRealms/go-backend/pkg/model/game"
 	"github.com/ShatteredRealms/go-backend/pkg/pb"
+	"github.com/ShatteredRealms/go-backend/pkg/service"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
 )
 
@@ -33,6 +37,16 @@ var (
 		Description: gocloak.StringP("Allows adding playtime to any character"),
 	})
 
+	RoleAddCharacterExperience = registerCharacterRole(&gocloak.Role{
+		Name:        gocloak.StringP("experience"),
+		Description: gocloak.StringP("Allows adding experience to any character"),
+	})
+
+	RoleGrantCharacterSlots = registerCharacterRole(&gocloak.Role{
+		Name:        gocloak.StringP("slots_grant"),
+		Description: gocloak.StringP("Allows granting extra character slots to any account"),
+	})
+
 	RoleCharacterManagement = registerCharacterRole(&gocloak.Role{
 		Name:        gocloak.StringP("manage"),
 		Description: gocloak.StringP("Allows creating, reading and deleting of own characters"),
@@ -47,6 +61,11 @@ var (
 		Name:        gocloak.StringP("inventory_manage"),
 		Description: gocloak.StringP("Allows getting and updating character inventories"),
 	})
+
+	RoleInventoryAdmin = registerCharacterRole(&gocloak.Role{
+		Name:        gocloak.StringP("inventory_admin"),
+		Description: gocloak.StringP("Allows viewing inventory history and restoring inventories with inventory_manage"),
+	})
 )
 
 func registerCharacterRole(role *gocloak.Role) *gocloak.Role {
@@ -83,6 +102,42 @@ func (s *charactersServiceServer) AddCharacterPlayTime(
 	return &pb.PlayTimeResponse{Time: chara.PlayTime}, nil
 }
 
+// AddCharacterExperience implements pb.CharacterServiceServer
+func (s *charactersServiceServer) AddCharacterExperience(
+	ctx context.Context,
+	request *pb.AddExperienceRequest,
+) (*pb.ExperienceResponse, error) {
+	claims, ok := auth.RetrieveClaims(ctx)
+	if !ok {
+		return nil, common.ErrUnauthorized.Err()
+	}
+
+	// Validate requester has correct permission
+	if !claims.HasResourceRole(RoleAddCharacterExperience, auth.CharacterClientId) {
+		return nil, common.ErrUnauthorized.Err()
+	}
+
+	characterId, err := s.getCharacterTargetId(ctx, request.Character)
+	if err != nil {
+		return nil, err
+	}
+
+	chara, err := s.server.CharacterService.AddExperience(ctx, characterId, request.Experience)
+	if err != nil {
+		if errors.Is(err, service.ErrCharacterNotFound) {
+			return nil, common.ErrDoesNotExist.Err()
+		}
+		log.Logger.WithContext(ctx).Errorf("add experience: %v", err)
+		return nil, status.Error(codes.Internal, "could not update experience")
+	}
+
+	return &pb.ExperienceResponse{
+		Level:               chara.Level,
+		Experience:          chara.Experience,
+		NextLevelExperience: s.server.CharacterService.ExperienceCurve().Required(chara.Level),
+	}, nil
+}
+
 // CreateCharacter implements pb.CharacterServiceServer
 func (s *charactersServiceServer) CreateCharacter(
 	ctx context.Context,
@@ -130,22 +185,54 @@ func (s *charactersServiceServer) CreateCharacter(
 		return nil, ErrInternalCreateCharacter
 	}
 
+	var appearance *character.Appearance
+	if request.Appearance != nil {
+		appearance = character.AppearanceFromPb(request.Appearance)
+	}
+
 	// Create new character
-	char, err := s.server.CharacterService.Create(ctx, ownerId, request.Name, request.Gender, request.Realm, dimension.Id)
+	char, err := s.server.CharacterService.Create(
+		ctx,
+		ownerId,
+		request.Name,
+		request.Gender,
+		request.Realm,
+		dimension.Id,
+		appearance,
+	)
+	if isAppearanceError(err) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	var limitErr *service.CharacterLimitError
+	if errors.As(err, &limitErr) {
+		return nil, characterLimitError(ctx, limitErr)
+	}
+	if errors.Is(err, service.ErrCharacterNameReserved) {
+		return nil, status.Error(codes.AlreadyExists, err.Error())
+	}
 	if err != nil || char == nil {
 		log.Logger.WithContext(ctx).Errorf("create char: %v", err)
 		return nil, ErrInternalCreateCharacter
 	}
 
-	return &pb.CharacterDetails{
-		Id:       uint64(char.ID),
-		Owner:    char.OwnerId,
-		Name:     char.Name,
-		Gender:   char.Gender,
-		Realm:    char.Realm,
-		PlayTime: char.PlayTime,
-		Location: nil,
-	}, nil
+	realm := s.server.CharacterService.CreationRules().Realm(char.Realm)
+	if realm != nil && len(realm.StartingInventory) > 0 {
+		err = s.server.InventoryService.SetStartingInventory(ctx, char.ID, realm.StartingInventory)
+		if err != nil {
+			log.Logger.WithContext(ctx).Errorf("set starting inventory: %v", err)
+
+			// The character would take up a slot without the items it starts with, so undo its creation
+			err = s.server.CharacterService.Delete(ctx, char.ID)
+			if err != nil {
+				log.Logger.WithContext(ctx).Errorf("delete character without starting inventory: %v", err)
+			}
+			return nil, ErrInternalCreateCharacter
+		}
+	}
+
+	details := s.characterDetails(char)
+	details.Location = nil
+	return details, nil
 }
 
 // DeleteCharacter implements pb.CharacterServiceServer
@@ -183,6 +270,12 @@ func (s *charactersServiceServer) DeleteCharacter(
 		return nil, status.Error(codes.Internal, "unable to delete character")
 	}
 
+	// The deletion is kept if chat cannot be reached. The topic is removed by the chat topic reconciliation.
+	err = s.deleteChat(ctx, char.Name)
+	if err != nil {
+		log.Logger.WithContext(ctx).Errorf("delete chat for deleted character %s: %v", char.Name, err)
+	}
+
 	return &emptypb.Empty{}, nil
 }
 
@@ -206,38 +299,19 @@ func (s *charactersServiceServer) EditCharacter(
 		request.OptionalPlayTime = nil
 	}
 
-	char, err := s.server.CharacterService.FindByTarget(ctx, request.Target)
-	if err != nil {
-		log.Logger.WithContext(ctx).Errorf("edit character: %v", err)
-		return nil, ErrInternalEditCharacter
-	}
-
+	// The old name is needed to move the direct messages of a renamed character once the edit is saved
+	oldName := ""
 	if request.OptionalNewName != nil &&
 		request.GetNewName() != "" {
-		char.Name = request.GetNewName()
-	}
-	if request.OptionalOwnerId != nil &&
-		request.GetOwnerId() != "" {
-		char.OwnerId = request.GetOwnerId()
-	}
-
-	if request.OptionalPlayTime != nil {
-		char.PlayTime = request.GetPlayTime()
-	}
-
-	if request.OptionalGender != nil &&
-		request.GetGender() != "" {
-		char.Gender = request.GetGender()
-	}
-
-	if request.OptionalRealm != nil &&
-		request.GetRealm() != "" {
-		char.Realm = request.GetRealm()
-	}
-
-	if request.OptionalLocation != nil &&
-		request.GetLocation().World != "" {
-		char.Location = *game.LocationFromPb(request.GetLocation())
+		char, err := s.server.CharacterService.FindByTarget(ctx, request.Target)
+		if err != nil {
+			log.Logger.WithContext(ctx).Errorf("edit character: %v", err)
+			return nil, ErrInternalEditCharacter
+		}
+		if char == nil {
+			return nil, common.ErrDoesNotExist.Err()
+		}
+		oldName = char.Name
 	}
 
 	if request.OptionalDimension != nil && request.GetDimension() != nil {
@@ -263,12 +337,192 @@ func (s *charactersServiceServer) EditCharacter(
 			return nil, ErrInternalEditCharacter
 		}
 
-		char.Dimension = dimension.Name
+		request.OptionalDimension = &pb.EditCharacterRequest_Dimension{
+			Dimension: &pb.DimensionTarget{FindBy: &pb.DimensionTarget_Id{Id: dimension.Id}},
+		}
+	}
+
+	char, err := s.server.CharacterService.Edit(ctx, request)
+	if errors.Is(err, service.ErrCharacterNotFound) {
+		return nil, common.ErrDoesNotExist.Err()
+	}
+	if errors.Is(err, service.ErrCharacterNameTaken) || errors.Is(err, service.ErrCharacterNameReserved) {
+		return nil, status.Error(codes.AlreadyExists, err.Error())
+	}
+	if errors.Is(err, common.ErrInvalidGender) ||
+		errors.Is(err, common.ErrInvalidRealm) ||
+		isNameError(err) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	var limitErr *service.CharacterLimitError
+	if errors.As(err, &limitErr) {
+		return nil, characterLimitError(ctx, limitErr)
+	}
+	if err != nil {
+		log.Logger.WithContext(ctx).Errorf("edit character: %v", err)
+		return nil, ErrInternalEditCharacter
+	}
+
+	// The rename is kept if chat cannot be reached. The old topic is removed by the chat topic reconciliation.
+	if oldName != "" && oldName != char.Name {
+		err = s.migrateChatName(ctx, char.ID, oldName, char.Name)
+		if err != nil {
+			log.Logger.WithContext(ctx).Errorf("migrate chat for %s renamed to %s: %v", oldName, char.Name, err)
+		}
 	}
 
 	return &emptypb.Empty{}, nil
 }
 
+// GrantCharacterSlots implements pb.CharacterServiceServer
+func (s *charactersServiceServer) GrantCharacterSlots(
+	ctx context.Context,
+	request *pb.GrantCharacterSlotsRequest,
+) (*pb.CharacterSlots, error) {
+	claims, ok := auth.RetrieveClaims(ctx)
+	if !ok {
+		return nil, common.ErrUnauthorized.Err()
+	}
+
+	// Validate requester has correct permission
+	if !claims.HasResourceRole(RoleGrantCharacterSlots, auth.CharacterClientId) {
+		return nil, common.ErrUnauthorized.Err()
+	}
+
+	if request.Slots == 0 {
+		return nil, status.Error(codes.InvalidArgument, "slots must be positive")
+	}
+
+	ownerId, err := s.getUserIdFromTarget(ctx, request.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	slots, err := s.server.CharacterService.GrantSlots(ctx, ownerId, request.Slots)
+	if err != nil {
+		log.Logger.WithContext(ctx).Errorf("grant slots to %s: %v", ownerId, err)
+		return nil, status.Error(codes.Internal, "unable to grant character slots")
+	}
+
+	return slots.ToPb(), nil
+}
+
+// EditAppearance implements pb.CharacterServiceServer
+func (s *charactersServiceServer) EditAppearance(
+	ctx context.Context,
+	request *pb.EditAppearanceRequest,
+) (*pb.CharacterAppearance, error) {
+	claims, ok := auth.RetrieveClaims(ctx)
+	if !ok {
+		return nil, common.ErrUnauthorized.Err()
+	}
+
+	// Validate requester has correct permission
+	if !claims.HasResourceRole(RoleCharacterManagement, auth.CharacterClientId) {
+		return nil, common.ErrUnauthorized.Err()
+	}
+
+	if request.Appearance == nil {
+		return nil, status.Error(codes.InvalidArgument, "appearance is required")
+	}
+
+	char, err := s.getCharacterFromTarget(ctx, request.Target)
+	if err != nil {
+		return nil, err
+	}
+
+	if char.OwnerId != claims.Subject && !claims.HasResourceRole(RoleCharacterManagementOther, auth.CharacterClientId) {
+		return nil, common.ErrUnauthorized.Err()
+	}
+
+	char, err = s.server.CharacterService.EditAppearance(ctx, char.ID, character.AppearanceFromPb(request.Appearance))
+	if err != nil {
+		if isAppearanceError(err) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if errors.Is(err, service.ErrCharacterNotFound) {
+			return nil, common.ErrDoesNotExist.Err()
+		}
+		log.Logger.WithContext(ctx).Errorf("edit appearance: %v", err)
+		return nil, status.Error(codes.Internal, "unable to edit appearance")
+	}
+
+	return char.Appearance.ToPb(), nil
+}
+
+// RenameCharacter implements pb.CharacterServiceServer
+func (s *charactersServiceServer) RenameCharacter(
+	ctx context.Context,
+	request *pb.RenameCharacterRequest,
+) (*pb.CharacterDetails, error) {
+	claims, ok := auth.RetrieveClaims(ctx)
+	if !ok {
+		return nil, common.ErrUnauthorized.Err()
+	}
+
+	// Validate requester has correct permission
+	if !claims.HasResourceRole(RoleCharacterManagement, auth.CharacterClientId) {
+		return nil, common.ErrUnauthorized.Err()
+	}
+
+	char, err := s.getCharacterFromTarget(ctx, request.Target)
+	if err != nil {
+		return nil, err
+	}
+
+	if char.OwnerId != claims.Subject && !claims.HasResourceRole(RoleCharacterManagementOther, auth.CharacterClientId) {
+		return nil, common.ErrUnauthorized.Err()
+	}
+
+	char, err = s.renameCharacter(ctx, char, request.NewName)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.characterDetails(char), nil
+}
+
+// GetCharacterNameHistory implements pb.CharacterServiceServer
+func (s *charactersServiceServer) GetCharacterNameHistory(
+	ctx context.Context,
+	request *pb.CharacterTarget,
+) (*pb.CharacterNameHistory, error) {
+	claims, ok := auth.RetrieveClaims(ctx)
+	if !ok {
+		return nil, common.ErrUnauthorized.Err()
+	}
+
+	// Validate requester has correct permission
+	if !claims.HasResourceRole(RoleCharacterManagement, auth.CharacterClientId) {
+		return nil, common.ErrUnauthorized.Err()
+	}
+
+	char, err := s.getCharacterFromTarget(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	if char.OwnerId != claims.Subject && !claims.HasResourceRole(RoleCharacterManagementOther, auth.CharacterClientId) {
+		return nil, common.ErrUnauthorized.Err()
+	}
+
+	history, err := s.server.CharacterService.NameHistory(ctx, char.ID)
+	if err != nil {
+		log.Logger.WithContext(ctx).Errorf("name history: %v", err)
+		return nil, status.Error(codes.Internal, "unable to get name history")
+	}
+
+	return history.ToPb(), nil
+}
+
+// GetCharacterCreationOptions implements pb.CharacterServiceServer
+func (s *charactersServiceServer) GetCharacterCreationOptions(
+	ctx context.Context,
+	request *emptypb.Empty,
+) (*pb.CharacterCreationOptions, error) {
+	return s.server.CharacterService.CreationRules().ToPb(), nil
+}
+
 // GetCharacter implements pb.CharacterServiceServer
 func (s *charactersServiceServer) GetCharacter(
 	ctx context.Context,
@@ -300,7 +554,7 @@ func (s *charactersServiceServer) GetCharacter(
 		return nil, common.ErrUnauthorized.Err()
 	}
 
-	return char.ToPb(), nil
+	return s.characterDetails(char), nil
 }
 
 // GetAllCharactersForUser implements pb.CharacterServiceServer
@@ -336,7 +590,15 @@ func (s *charactersServiceServer) GetAllCharactersForUser(
 		return nil, status.Error(codes.Internal, "unable to find chars")
 	}
 
-	return chars.ToPb(), nil
+	slots, err := s.server.CharacterService.Slots(ctx, id)
+	if err != nil {
+		log.Logger.WithContext(ctx).Errorf("find slots of %s: %v", id, err)
+		return nil, status.Error(codes.Internal, "unable to find character slots")
+	}
+
+	resp := s.charactersDetails(chars)
+	resp.Slots = slots.ToPb()
+	return resp, nil
 }
 
 // GetCharacters implements pb.CharacterServiceServer
@@ -360,7 +622,7 @@ func (s *charactersServiceServer) GetCharacters(
 		return nil, status.Error(codes.Internal, "unable to find chars")
 	}
 
-	return chars.ToPb(), nil
+	return s.charactersDetails(chars), nil
 }
 
 // GetInventory implements pb.CharacterServiceServer.
@@ -427,6 +689,7 @@ func (s *charactersServiceServer) SetInventory(
 		CharacterId: char.ID,
 		Inventory:   character.InventoryItemsFromPb(request.InventoryItems),
 		Bank:        character.InventoryItemsFromPb(request.BankItems),
+		Gold:        request.Gold,
 	}
 	err = s.server.InventoryService.UpdateInventory(ctx, newInv)
 	if err != nil {
@@ -434,12 +697,235 @@ func (s *charactersServiceServer) SetInventory(
 			return &emptypb.Empty{}, nil
 		}
 
-		return nil, status.Errorf(codes.Internal, "set inventory for %s: %s", char.Name, err.Error())
+		return nil, inventoryError(ctx, "set inventory", err)
 	}
 
 	return &emptypb.Empty{}, nil
 }
 
+// AddInventoryItem implements pb.CharacterServiceServer.
+func (s *charactersServiceServer) AddInventoryItem(
+	ctx context.Context,
+	request *pb.AddInventoryItemRequest,
+) (*pb.Inventory, error) {
+	char, err := s.inventoryCharacter(ctx, request.Target)
+	if err != nil {
+		return nil, err
+	}
+
+	location, err := character.InventoryLocationFromPb(request.Location)
+	if err != nil {
+		return nil, inventoryError(ctx, "add inventory item", err)
+	}
+	if request.Item == nil {
+		return nil, status.Error(codes.InvalidArgument, "item is required")
+	}
+
+	inv, err := s.server.InventoryService.AddItem(
+		ctx,
+		char.ID,
+		request.Version,
+		location,
+		character.InventoryItemFromPb(request.Item),
+	)
+	if err != nil {
+		return nil, inventoryError(ctx, "add inventory item", err)
+	}
+
+	return inv.ToPb(), nil
+}
+
+// RemoveInventoryItem implements pb.CharacterServiceServer.
+func (s *charactersServiceServer) RemoveInventoryItem(
+	ctx context.Context,
+	request *pb.RemoveInventoryItemRequest,
+) (*pb.Inventory, error) {
+	char, err := s.inventoryCharacter(ctx, request.Target)
+	if err != nil {
+		return nil, err
+	}
+
+	location, err := character.InventoryLocationFromPb(request.Location)
+	if err != nil {
+		return nil, inventoryError(ctx, "remove inventory item", err)
+	}
+
+	inv, err := s.server.InventoryService.RemoveItem(
+		ctx,
+		char.ID,
+		request.Version,
+		location,
+		request.Slot,
+		request.Quantity,
+	)
+	if err != nil {
+		return nil, inventoryError(ctx, "remove inventory item", err)
+	}
+
+	return inv.ToPb(), nil
+}
+
+// MoveInventoryItem implements pb.CharacterServiceServer.
+func (s *charactersServiceServer) MoveInventoryItem(
+	ctx context.Context,
+	request *pb.MoveInventoryItemRequest,
+) (*pb.Inventory, error) {
+	char, err := s.inventoryCharacter(ctx, request.Target)
+	if err != nil {
+		return nil, err
+	}
+
+	location, err := character.InventoryLocationFromPb(request.Location)
+	if err != nil {
+		return nil, inventoryError(ctx, "move inventory item", err)
+	}
+
+	inv, err := s.server.InventoryService.MoveItem(
+		ctx,
+		char.ID,
+		request.Version,
+		location,
+		request.FromSlot,
+		request.ToSlot,
+	)
+	if err != nil {
+		return nil, inventoryError(ctx, "move inventory item", err)
+	}
+
+	return inv.ToPb(), nil
+}
+
+// SplitInventoryStack implements pb.CharacterServiceServer.
+func (s *charactersServiceServer) SplitInventoryStack(
+	ctx context.Context,
+	request *pb.SplitInventoryStackRequest,
+) (*pb.Inventory, error) {
+	char, err := s.inventoryCharacter(ctx, request.Target)
+	if err != nil {
+		return nil, err
+	}
+
+	location, err := character.InventoryLocationFromPb(request.Location)
+	if err != nil {
+		return nil, inventoryError(ctx, "split inventory stack", err)
+	}
+
+	inv, err := s.server.InventoryService.SplitStack(
+		ctx,
+		char.ID,
+		request.Version,
+		location,
+		request.FromSlot,
+		request.ToSlot,
+		request.Quantity,
+	)
+	if err != nil {
+		return nil, inventoryError(ctx, "split inventory stack", err)
+	}
+
+	return inv.ToPb(), nil
+}
+
+// MergeInventoryStack implements pb.CharacterServiceServer.
+func (s *charactersServiceServer) MergeInventoryStack(
+	ctx context.Context,
+	request *pb.MergeInventoryStackRequest,
+) (*pb.Inventory, error) {
+	char, err := s.inventoryCharacter(ctx, request.Target)
+	if err != nil {
+		return nil, err
+	}
+
+	location, err := character.InventoryLocationFromPb(request.Location)
+	if err != nil {
+		return nil, inventoryError(ctx, "merge inventory stack", err)
+	}
+
+	inv, err := s.server.InventoryService.MergeStack(
+		ctx,
+		char.ID,
+		request.Version,
+		location,
+		request.FromSlot,
+		request.ToSlot,
+	)
+	if err != nil {
+		return nil, inventoryError(ctx, "merge inventory stack", err)
+	}
+
+	return inv.ToPb(), nil
+}
+
+// TransferInventoryItem implements pb.CharacterServiceServer.
+func (s *charactersServiceServer) TransferInventoryItem(
+	ctx context.Context,
+	request *pb.TransferInventoryItemRequest,
+) (*pb.Inventory, error) {
+	char, err := s.inventoryCharacter(ctx, request.Target)
+	if err != nil {
+		return nil, err
+	}
+
+	from, err := character.InventoryLocationFromPb(request.From)
+	if err != nil {
+		return nil, inventoryError(ctx, "transfer inventory item", err)
+	}
+
+	inv, err := s.server.InventoryService.TransferItem(
+		ctx,
+		char.ID,
+		request.Version,
+		from,
+		request.FromSlot,
+		request.ToSlot,
+	)
+	if err != nil {
+		return nil, inventoryError(ctx, "transfer inventory item", err)
+	}
+
+	return inv.ToPb(), nil
+}
+
+// GetInventoryHistory implements pb.CharacterServiceServer.
+func (s *charactersServiceServer) GetInventoryHistory(
+	ctx context.Context,
+	request *pb.InventoryHistoryRequest,
+) (*pb.InventoryEvents, error) {
+	char, err := s.inventoryAdminCharacter(ctx, request.Target)
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := s.server.InventoryService.History(ctx, char.ID, request.Limit)
+	if err != nil {
+		return nil, inventoryError(ctx, "get inventory history", err)
+	}
+
+	return events.ToPb(), nil
+}
+
+// RestoreInventory implements pb.CharacterServiceServer.
+func (s *charactersServiceServer) RestoreInventory(
+	ctx context.Context,
+	request *pb.RestoreInventoryRequest,
+) (*pb.Inventory, error) {
+	char, err := s.inventoryAdminCharacter(ctx, request.Target)
+	if err != nil {
+		return nil, err
+	}
+
+	if request.Time <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "time is required")
+	}
+
+	inv, err := s.server.InventoryService.Restore(ctx, char.ID, time.Unix(request.Time, 0), request.Reason)
+	if err != nil {
+		return nil, inventoryError(ctx, "restore inventory", err)
+	}
+
+	return inv.ToPb(), nil
+}
+
 func NewCharacterServiceServer(
 	ctx context.Context,
 	server *characterApp.CharacterServerContext,
@@ -452,11 +938,138 @@ func NewCharacterServiceServer(
 		return nil, err
 	}
 
+	auth.RegisterPublicServiceMethods(pb.CharacterService_GetCharacterCreationOptions_FullMethodName)
+
 	return &charactersServiceServer{
 		server: server,
 	}, nil
 }
 
+// renameCharacter renames the character and has the chat service move its direct messages to the new name
+func (s charactersServiceServer) renameCharacter(
+	ctx context.Context,
+	char *character.Character,
+	name string,
+) (*character.Character, error) {
+	oldName := char.Name
+	renamed, err := s.server.CharacterService.Rename(ctx, char.ID, name)
+	if err != nil {
+		if errors.Is(err, service.ErrCharacterNotFound) {
+			return nil, common.ErrDoesNotExist.Err()
+		}
+		if errors.Is(err, service.ErrCharacterNameTaken) || errors.Is(err, service.ErrCharacterNameReserved) {
+			return nil, status.Error(codes.AlreadyExists, err.Error())
+		}
+		if isNameError(err) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		log.Logger.WithContext(ctx).Errorf("rename character %d: %v", char.ID, err)
+		return nil, status.Error(codes.Internal, "unable to rename character")
+	}
+
+	// The rename is kept if chat cannot be reached. The old topic is removed by the chat topic reconciliation.
+	err = s.migrateChatName(ctx, renamed.ID, oldName, renamed.Name)
+	if err != nil {
+		log.Logger.WithContext(ctx).Errorf("migrate chat for %s renamed to %s: %v", oldName, renamed.Name, err)
+	}
+
+	return renamed, nil
+}
+
+// migrateChatName tells the chat service the character was renamed using the servers credentials
+func (s charactersServiceServer) migrateChatName(ctx context.Context, characterId uint, oldName string, newName string) error {
+	chatClient, err := s.server.GetChatClient()
+	if err != nil {
+		return err
+	}
+
+	authCtx, err := s.server.OutgoingClientAuth(ctx)
+	if err != nil {
+		return fmt.Errorf("outgoing client auth: %w", err)
+	}
+
+	_, err = chatClient.MigrateCharacterName(authCtx, &pb.CharacterRename{
+		CharacterId: uint64(characterId),
+		OldName:     oldName,
+		NewName:     newName,
+	})
+	return err
+}
+
+// deleteChat tells the chat service the character was deleted using the servers credentials
+func (s charactersServiceServer) deleteChat(ctx context.Context, name string) error {
+	chatClient, err := s.server.GetChatClient()
+	if err != nil {
+		return err
+	}
+
+	authCtx, err := s.server.OutgoingClientAuth(ctx)
+	if err != nil {
+		return fmt.Errorf("outgoing client auth: %w", err)
+	}
+
+	_, err = chatClient.DeleteCharacterChat(authCtx, &pb.CharacterTarget{
+		Type: &pb.CharacterTarget_Name{Name: name},
+	})
+	return err
+}
+
+// characterLimitError creates a resource exhausted error with the reached limit in its details
+func characterLimitError(ctx context.Context, err *service.CharacterLimitError) error {
+	scope := "account"
+	metadata := map[string]string{"limit": strconv.FormatUint(uint64(err.Limit), 10)}
+	if err.Dimension != "" {
+		scope = "dimension"
+		metadata["dimension"] = err.Dimension
+	}
+	metadata["scope"] = scope
+
+	st, detailsErr := status.New(codes.ResourceExhausted, err.Error()).WithDetails(&errdetails.ErrorInfo{
+		Reason:   "CHARACTER_LIMIT",
+		Domain:   auth.CharacterClientId,
+		Metadata: metadata,
+	})
+	if detailsErr != nil {
+		log.Logger.WithContext(ctx).Errorf("character limit details: %v", detailsErr)
+		return status.Error(codes.ResourceExhausted, err.Error())
+	}
+
+	return st.Err()
+}
+
+func isAppearanceError(err error) bool {
+	return errors.Is(err, character.ErrAppearanceBodyType) ||
+		errors.Is(err, character.ErrAppearanceFacePreset) ||
+		errors.Is(err, character.ErrAppearanceColorPart) ||
+		errors.Is(err, character.ErrAppearanceColor) ||
+		errors.Is(err, character.ErrAppearanceMorph) ||
+		errors.Is(err, character.ErrAppearanceMorphWeight)
+}
+
+func isNameError(err error) bool {
+	return errors.Is(err, character.ErrCharacterNameToShort) ||
+		errors.Is(err, character.ErrCharacterNameToLong) ||
+		errors.Is(err, common.ErrInvalidName) ||
+		errors.Is(err, common.ErrNameProfane) ||
+		errors.Is(err, service.ErrCharacterNameUnchanged)
+}
+
+// characterDetails converts the character including the experience it requires for its next level
+func (s charactersServiceServer) characterDetails(char *character.Character) *pb.CharacterDetails {
+	details := char.ToPb()
+	details.NextLevelExperience = s.server.CharacterService.ExperienceCurve().Required(char.Level)
+	return details
+}
+
+func (s charactersServiceServer) charactersDetails(chars character.Characters) *pb.CharactersDetails {
+	resp := chars.ToPb()
+	for _, details := range resp.Characters {
+		details.NextLevelExperience = s.server.CharacterService.ExperienceCurve().Required(details.Level)
+	}
+
+	return resp
+}
+
 func (s charactersServiceServer) getCharacterTargetId(
 	ctx context.Context,
 	request *pb.CharacterTarget,
@@ -559,3 +1172,74 @@ func (s charactersServiceServer) getUserIdFromTarget(
 
 	return ownerId, nil
 }
+
+// inventoryCharacter validates the requester can manage inventories and gets the target character
+func (s charactersServiceServer) inventoryCharacter(
+	ctx context.Context,
+	request *pb.CharacterTarget,
+) (*character.Character, error) {
+	claims, ok := auth.RetrieveClaims(ctx)
+	if !ok {
+		return nil, common.ErrUnauthorized.Err()
+	}
+
+	// Validate requester has correct permission
+	if !claims.HasResourceRole(RoleInventoryManagement, auth.CharacterClientId) {
+		return nil, common.ErrUnauthorized.Err()
+	}
+
+	char, err := s.getCharacterFromTarget(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	if char == nil {
+		return nil, common.ErrDoesNotExist.Err()
+	}
+
+	return char, nil
+}
+
+// inventoryAdminCharacter validates the requester can manage inventories and their history and gets the target
+// character
+func (s charactersServiceServer) inventoryAdminCharacter(
+	ctx context.Context,
+	request *pb.CharacterTarget,
+) (*character.Character, error) {
+	claims, ok := auth.RetrieveClaims(ctx)
+	if !ok {
+		return nil, common.ErrUnauthorized.Err()
+	}
+
+	if !claims.HasResourceRole(RoleInventoryAdmin, auth.CharacterClientId) {
+		return nil, common.ErrUnauthorized.Err()
+	}
+
+	return s.inventoryCharacter(ctx, request)
+}
+
+func inventoryError(ctx context.Context, action string, err error) error {
+	switch {
+	case errors.Is(err, character.ErrInventoryVersion):
+		return status.Error(codes.Aborted, err.Error())
+	case errors.Is(err, service.ErrInventoryHistoryNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, character.ErrInventorySlotEmpty),
+		errors.Is(err, character.ErrInventorySlotOccupied),
+		errors.Is(err, character.ErrInventoryQuantity),
+		errors.Is(err, character.ErrInventoryMerge):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, character.ErrInventoryUnknownItem),
+		errors.Is(err, character.ErrInventoryEmptyItem),
+		errors.Is(err, character.ErrInventoryOverStacked),
+		errors.Is(err, character.ErrInventoryDuplicateSlot),
+		errors.Is(err, character.ErrInventorySlotCapacity),
+		errors.Is(err, character.ErrInventorySameSlot),
+		errors.Is(err, character.ErrInventoryLocation),
+		errors.Is(err, service.ErrInventoryRestoreReason):
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	log.Logger.WithContext(ctx).Errorf("%s: %v", action, err)
+	return status.Errorf(codes.Internal, "unable to %s", action)
+}
@@ -5,12 +5,14 @@ import (
 	"time"
 
 	characterApp "github.com/ShatteredRealms/go-backend/cmd/character/app"
+	"github.com/ShatteredRealms/go-backend/pkg/common"
 	"github.com/ShatteredRealms/go-backend/pkg/config"
 	"github.com/ShatteredRealms/go-backend/pkg/log"
 	"github.com/ShatteredRealms/go-backend/pkg/mocks"
 	"github.com/ShatteredRealms/go-backend/pkg/model/character"
 	"github.com/ShatteredRealms/go-backend/pkg/model/game"
 	"github.com/ShatteredRealms/go-backend/pkg/pb"
+	"github.com/ShatteredRealms/go-backend/pkg/service"
 	"github.com/ShatteredRealms/go-backend/pkg/srv"
 	"github.com/bxcodec/faker/v4"
 	. "github.com/onsi/ginkgo/v2"
@@ -19,6 +21,9 @@ import (
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.opentelemetry.io/otel"
 	"go.uber.org/mock/gomock"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
@@ -33,7 +38,8 @@ var _ = Describe("Character server", func() {
 		server pb.CharacterServiceServer
 		ctx    = context.Background()
 
-		char *character.Character
+		char     *character.Character
+		creation character.CreationRules
 	)
 
 	BeforeEach(func() {
@@ -42,6 +48,9 @@ var _ = Describe("Character server", func() {
 
 		mockCharService = mocks.NewMockCharacterService(mockController)
 		mockInvService = mocks.NewMockInventoryService(mockController)
+		mockCharService.EXPECT().ExperienceCurve().Return(globalConfig.Character.Progression.ExperienceCurve).AnyTimes()
+		creation = globalConfig.Character.Creation
+		mockCharService.EXPECT().CreationRules().Return(&creation).AnyTimes()
 
 		charCtx = &characterApp.CharacterServerContext{
 			ServerContext: &config.ServerContext{
@@ -163,6 +172,130 @@ var _ = Describe("Character server", func() {
 		})
 	})
 
+	Describe("GetCharacterCreationOptions", func() {
+		It("should work without claims", func() {
+			out, err := server.GetCharacterCreationOptions(context.Background(), &emptypb.Empty{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.Genders).To(Equal(globalConfig.Character.Creation.Genders))
+			Expect(out.Realms).To(HaveLen(len(globalConfig.Character.Creation.Realms)))
+		})
+	})
+
+	Describe("EditAppearance", func() {
+		var req *pb.EditAppearanceRequest
+
+		BeforeEach(func() {
+			char.OwnerId = *player.ID
+			req = &pb.EditAppearanceRequest{
+				Target:     &pb.CharacterTarget{Type: &pb.CharacterTarget_Id{Id: uint64(char.ID)}},
+				Appearance: &pb.CharacterAppearance{BodyType: faker.Username()},
+			}
+		})
+
+		It("should work for the owner", func() {
+			mockCharService.EXPECT().FindById(gomock.Any(), char.ID).Return(char, nil)
+			mockCharService.EXPECT().EditAppearance(gomock.Any(), char.ID, gomock.Any()).DoAndReturn(
+				func(_ context.Context, _ uint, appearance *character.Appearance) (*character.Character, error) {
+					char.Appearance = *appearance
+					return char, nil
+				},
+			)
+			out, err := server.EditAppearance(incPlayerCtx, req)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.BodyType).To(Equal(req.Appearance.BodyType))
+		})
+
+		It("should error on invalid appearances", func() {
+			mockCharService.EXPECT().FindById(gomock.Any(), char.ID).Return(char, nil)
+			mockCharService.EXPECT().EditAppearance(gomock.Any(), char.ID, gomock.Any()).
+				Return(nil, character.ErrAppearanceBodyType)
+			out, err := server.EditAppearance(incPlayerCtx, req)
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+			Expect(out).To(BeNil())
+		})
+
+		It("should error for characters of other users", func() {
+			char.OwnerId = faker.Username()
+			mockCharService.EXPECT().FindById(gomock.Any(), char.ID).Return(char, nil)
+			out, err := server.EditAppearance(incPlayerCtx, req)
+			Expect(err).To(MatchError(common.ErrUnauthorized.Err()))
+			Expect(out).To(BeNil())
+		})
+
+		It("should error if claims are invalid", func() {
+			out, err := server.EditAppearance(context.Background(), req)
+			Expect(err).To(MatchError(common.ErrUnauthorized.Err()))
+			Expect(out).To(BeNil())
+		})
+	})
+
+	Describe("RenameCharacter", func() {
+		var req *pb.RenameCharacterRequest
+
+		BeforeEach(func() {
+			char.OwnerId = *player.ID
+			req = &pb.RenameCharacterRequest{
+				Target:  &pb.CharacterTarget{Type: &pb.CharacterTarget_Id{Id: uint64(char.ID)}},
+				NewName: "renamed",
+			}
+		})
+
+		It("should work for the owner", func() {
+			renamed := *char
+			renamed.Name = req.NewName
+			mockCharService.EXPECT().FindById(gomock.Any(), char.ID).Return(char, nil)
+			mockCharService.EXPECT().Rename(gomock.Any(), char.ID, req.NewName).Return(&renamed, nil)
+			out, err := server.RenameCharacter(incPlayerCtx, req)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.Name).To(Equal(req.NewName))
+		})
+
+		It("should error if the name is reserved", func() {
+			mockCharService.EXPECT().FindById(gomock.Any(), char.ID).Return(char, nil)
+			mockCharService.EXPECT().Rename(gomock.Any(), char.ID, req.NewName).Return(nil, service.ErrCharacterNameReserved)
+			out, err := server.RenameCharacter(incPlayerCtx, req)
+			Expect(status.Code(err)).To(Equal(codes.AlreadyExists))
+			Expect(out).To(BeNil())
+		})
+
+		It("should error on invalid names", func() {
+			mockCharService.EXPECT().FindById(gomock.Any(), char.ID).Return(char, nil)
+			mockCharService.EXPECT().Rename(gomock.Any(), char.ID, req.NewName).Return(nil, common.ErrNameProfane)
+			out, err := server.RenameCharacter(incPlayerCtx, req)
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+			Expect(out).To(BeNil())
+		})
+
+		It("should error for characters of other users", func() {
+			char.OwnerId = faker.Username()
+			mockCharService.EXPECT().FindById(gomock.Any(), char.ID).Return(char, nil)
+			out, err := server.RenameCharacter(incPlayerCtx, req)
+			Expect(err).To(MatchError(common.ErrUnauthorized.Err()))
+			Expect(out).To(BeNil())
+		})
+
+		It("should error if claims are invalid", func() {
+			out, err := server.RenameCharacter(context.Background(), req)
+			Expect(err).To(MatchError(common.ErrUnauthorized.Err()))
+			Expect(out).To(BeNil())
+		})
+	})
+
+	Describe("GetCharacterNameHistory", func() {
+		It("should work for the owner", func() {
+			char.OwnerId = *player.ID
+			history := character.NameChanges{{CharacterId: char.ID, OldName: faker.Username(), NewName: char.Name}}
+			mockCharService.EXPECT().FindById(gomock.Any(), char.ID).Return(char, nil)
+			mockCharService.EXPECT().NameHistory(gomock.Any(), char.ID).Return(history, nil)
+			out, err := server.GetCharacterNameHistory(incPlayerCtx, &pb.CharacterTarget{
+				Type: &pb.CharacterTarget_Id{Id: uint64(char.ID)},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.Changes).To(HaveLen(1))
+			Expect(out.Changes[0].OldName).To(Equal(history[0].OldName))
+		})
+	})
+
 	Describe("CreateCharacter", func() {
 		var req *pb.CreateCharacterRequest
 		BeforeEach(func() {
@@ -175,18 +308,18 @@ var _ = Describe("Character server", func() {
 				Name:      faker.Username(),
 				Gender:    char.Gender,
 				Realm:     char.Realm,
-				Dimension: char.Dimension,
+				Dimension: &pb.DimensionTarget{FindBy: &pb.DimensionTarget_Id{Id: char.Dimension}},
 			}
 		})
 		When("given valid input", func() {
 			It("should work for players creating for themselves", func() {
-				mockCharService.EXPECT().Create(gomock.Any(), *player.ID, req.Name, req.Gender, req.Realm, req.Dimension).Return(char, nil)
+				mockCharService.EXPECT().Create(gomock.Any(), *player.ID, req.Name, req.Gender, req.Realm, char.Dimension, gomock.Any()).Return(char, nil)
 				out, err := server.CreateCharacter(incPlayerCtx, req)
 				Expect(err).NotTo(HaveOccurred())
 				Expect(out).NotTo(BeNil())
 
 				req.Owner.Target = &pb.UserTarget_Username{Username: *player.Username}
-				mockCharService.EXPECT().Create(gomock.Any(), *player.ID, req.Name, req.Gender, req.Realm, req.Dimension).Return(char, nil)
+				mockCharService.EXPECT().Create(gomock.Any(), *player.ID, req.Name, req.Gender, req.Realm, char.Dimension, gomock.Any()).Return(char, nil)
 				out, err = server.CreateCharacter(incPlayerCtx, req)
 				Expect(err).NotTo(HaveOccurred())
 				Expect(out).NotTo(BeNil())
@@ -194,26 +327,26 @@ var _ = Describe("Character server", func() {
 
 			It("should work for admins creating for themselves", func() {
 				req.Owner.Target = &pb.UserTarget_Id{Id: *admin.ID}
-				mockCharService.EXPECT().Create(gomock.Any(), *admin.ID, req.Name, req.Gender, req.Realm, req.Dimension).Return(char, nil)
+				mockCharService.EXPECT().Create(gomock.Any(), *admin.ID, req.Name, req.Gender, req.Realm, char.Dimension, gomock.Any()).Return(char, nil)
 				out, err := server.CreateCharacter(incAdminCtx, req)
 				Expect(err).NotTo(HaveOccurred())
 				Expect(out).NotTo(BeNil())
 
 				req.Owner.Target = &pb.UserTarget_Username{Username: *admin.Username}
-				mockCharService.EXPECT().Create(gomock.Any(), *admin.ID, req.Name, req.Gender, req.Realm, req.Dimension).Return(char, nil)
+				mockCharService.EXPECT().Create(gomock.Any(), *admin.ID, req.Name, req.Gender, req.Realm, char.Dimension, gomock.Any()).Return(char, nil)
 				out, err = server.CreateCharacter(incAdminCtx, req)
 				Expect(err).NotTo(HaveOccurred())
 				Expect(out).NotTo(BeNil())
 			})
 
 			It("should work for admins creating for others", func() {
-				mockCharService.EXPECT().Create(gomock.Any(), *player.ID, req.Name, req.Gender, req.Realm, req.Dimension).Return(char, nil)
+				mockCharService.EXPECT().Create(gomock.Any(), *player.ID, req.Name, req.Gender, req.Realm, char.Dimension, gomock.Any()).Return(char, nil)
 				out, err := server.CreateCharacter(incAdminCtx, req)
 				Expect(err).NotTo(HaveOccurred())
 				Expect(out).NotTo(BeNil())
 
 				req.Owner.Target = &pb.UserTarget_Username{Username: *player.Username}
-				mockCharService.EXPECT().Create(gomock.Any(), *player.ID, req.Name, req.Gender, req.Realm, req.Dimension).Return(char, nil)
+				mockCharService.EXPECT().Create(gomock.Any(), *player.ID, req.Name, req.Gender, req.Realm, char.Dimension, gomock.Any()).Return(char, nil)
 				out, err = server.CreateCharacter(incAdminCtx, req)
 				Expect(err).NotTo(HaveOccurred())
 				Expect(out).NotTo(BeNil())
@@ -248,16 +381,38 @@ var _ = Describe("Character server", func() {
 			})
 
 			It("should error if creating fails", func() {
-				mockCharService.EXPECT().Create(gomock.Any(), *player.ID, req.Name, req.Gender, req.Realm, req.Dimension).Return(char, fakeErr)
+				mockCharService.EXPECT().Create(gomock.Any(), *player.ID, req.Name, req.Gender, req.Realm, char.Dimension, gomock.Any()).Return(char, fakeErr)
 				out, err := server.CreateCharacter(incPlayerCtx, req)
 				Expect(err).To(HaveOccurred())
 				Expect(out).To(BeNil())
 
-				mockCharService.EXPECT().Create(gomock.Any(), *player.ID, req.Name, req.Gender, req.Realm, req.Dimension).Return(nil, nil)
+				mockCharService.EXPECT().Create(gomock.Any(), *player.ID, req.Name, req.Gender, req.Realm, char.Dimension, gomock.Any()).Return(nil, nil)
 				out, err = server.CreateCharacter(incPlayerCtx, req)
 				Expect(err).To(HaveOccurred())
 				Expect(out).To(BeNil())
 			})
+
+			It("should delete the character if its starting inventory cannot be set", func() {
+				items := character.InventoryItems{{Id: "sword", Quantity: 1}}
+				creation.Realms = character.RealmRules{{Name: char.Realm, StartingInventory: items}}
+				mockCharService.EXPECT().Create(gomock.Any(), *player.ID, req.Name, req.Gender, req.Realm, char.Dimension, gomock.Any()).Return(char, nil)
+				mockInvService.EXPECT().SetStartingInventory(gomock.Any(), char.ID, items).Return(fakeErr)
+				mockCharService.EXPECT().Delete(gomock.Any(), char.ID).Return(nil)
+				out, err := server.CreateCharacter(incPlayerCtx, req)
+				Expect(err).To(MatchError(srv.ErrInternalCreateCharacter))
+				Expect(out).To(BeNil())
+			})
+
+			It("should error with the limit if no slots are left", func() {
+				mockCharService.EXPECT().Create(gomock.Any(), *player.ID, req.Name, req.Gender, req.Realm, char.Dimension, gomock.Any()).
+					Return(nil, &service.CharacterLimitError{Limit: 3})
+				out, err := server.CreateCharacter(incPlayerCtx, req)
+				Expect(out).To(BeNil())
+				st := status.Convert(err)
+				Expect(st.Code()).To(Equal(codes.ResourceExhausted))
+				Expect(st.Details()).To(HaveLen(1))
+				Expect(st.Details()[0].(*errdetails.ErrorInfo).Metadata).To(HaveKeyWithValue("limit", "3"))
+			})
 		})
 	})
 
@@ -386,6 +541,24 @@ var _ = Describe("Character server", func() {
 				Expect(err).To(HaveOccurred())
 				Expect(out).To(BeNil())
 			})
+
+			It("should not rename the character if the edit fails", func() {
+				req.OptionalNewName = &pb.EditCharacterRequest_NewName{NewName: "renamed"}
+				mockCharService.EXPECT().FindByTarget(gomock.Any(), req.Target).Return(char, nil)
+				mockCharService.EXPECT().Edit(gomock.Any(), req).Return(nil, common.ErrInvalidRealm)
+				out, err := server.EditCharacter(incAdminCtx, req)
+				Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+				Expect(out).To(BeNil())
+			})
+
+			It("should error if the new name is reserved", func() {
+				req.OptionalNewName = &pb.EditCharacterRequest_NewName{NewName: "renamed"}
+				mockCharService.EXPECT().FindByTarget(gomock.Any(), req.Target).Return(char, nil)
+				mockCharService.EXPECT().Edit(gomock.Any(), req).Return(nil, service.ErrCharacterNameReserved)
+				out, err := server.EditCharacter(incAdminCtx, req)
+				Expect(status.Code(err)).To(Equal(codes.AlreadyExists))
+				Expect(out).To(BeNil())
+			})
 		})
 	})
 
@@ -469,7 +642,9 @@ var _ = Describe("Character server", func() {
 	Describe("GetAllCharactersForUser", func() {
 		var req *pb.UserTarget
 		var characters character.Characters
+		var slots *character.CharacterSlots
 		BeforeEach(func() {
+			slots = &character.CharacterSlots{Used: 1, Max: 10}
 			req = &pb.UserTarget{
 				Target: &pb.UserTarget_Id{
 					Id: *player.ID,
@@ -479,6 +654,18 @@ var _ = Describe("Character server", func() {
 		})
 
 		When("given valid input", func() {
+			BeforeEach(func() {
+				mockCharService.EXPECT().Slots(gomock.Any(), gomock.Any()).Return(slots, nil)
+			})
+
+			It("should include the slots of the user", func() {
+				mockCharService.EXPECT().FindAllByOwner(gomock.Any(), *player.ID).Return(characters, nil)
+				out, err := server.GetAllCharactersForUser(incPlayerCtx, req)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(out.Slots.Used).To(BeEquivalentTo(1))
+				Expect(out.Slots.Available).To(BeEquivalentTo(9))
+			})
+
 			It("should work getting self by id (admin)", func() {
 				req.Target = &pb.UserTarget_Id{
 					Id: *admin.ID,
@@ -545,6 +732,52 @@ var _ = Describe("Character server", func() {
 				Expect(err).To(HaveOccurred())
 				Expect(out).To(BeNil())
 			})
+
+			It("should error if getting slots fails", func() {
+				mockCharService.EXPECT().FindAllByOwner(gomock.Any(), *player.ID).Return(characters, nil)
+				mockCharService.EXPECT().Slots(gomock.Any(), *player.ID).Return(nil, fakeErr)
+				out, err := server.GetAllCharactersForUser(incPlayerCtx, req)
+				Expect(err).To(HaveOccurred())
+				Expect(out).To(BeNil())
+			})
+		})
+	})
+
+	Describe("GrantCharacterSlots", func() {
+		var req *pb.GrantCharacterSlotsRequest
+		BeforeEach(func() {
+			req = &pb.GrantCharacterSlotsRequest{
+				Owner: &pb.UserTarget{Target: &pb.UserTarget_Id{Id: *player.ID}},
+				Slots: 2,
+			}
+		})
+
+		It("should work for admins", func() {
+			mockCharService.EXPECT().GrantSlots(gomock.Any(), *player.ID, req.Slots).
+				Return(&character.CharacterSlots{Max: 12, Extra: 2}, nil)
+			out, err := server.GrantCharacterSlots(incAdminCtx, req)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.Extra).To(BeEquivalentTo(2))
+		})
+
+		It("should error without slots", func() {
+			req.Slots = 0
+			out, err := server.GrantCharacterSlots(incAdminCtx, req)
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+			Expect(out).To(BeNil())
+		})
+
+		It("should error if granting fails", func() {
+			mockCharService.EXPECT().GrantSlots(gomock.Any(), *player.ID, req.Slots).Return(nil, fakeErr)
+			out, err := server.GrantCharacterSlots(incAdminCtx, req)
+			Expect(status.Code(err)).To(Equal(codes.Internal))
+			Expect(out).To(BeNil())
+		})
+
+		It("should error with invalid permissions", func() {
+			out, err := server.GrantCharacterSlots(incPlayerCtx, req)
+			Expect(err).To(MatchError(common.ErrUnauthorized.Err()))
+			Expect(out).To(BeNil())
 		})
 	})
 
@@ -783,4 +1016,130 @@ var _ = Describe("Character server", func() {
 			})
 		})
 	})
+
+	Describe("inventory operations", func() {
+		var target *pb.CharacterTarget
+
+		BeforeEach(func() {
+			target = &pb.CharacterTarget{
+				Type: &pb.CharacterTarget_Id{
+					Id: uint64(char.ID),
+				},
+			}
+		})
+
+		It("should error (player)", func() {
+			out, err := server.MoveInventoryItem(incPlayerCtx, &pb.MoveInventoryItemRequest{Target: target})
+			Expect(err).To(HaveOccurred())
+			Expect(out).To(BeNil())
+		})
+
+		It("should abort if the inventory changed", func() {
+			mockCharService.EXPECT().FindById(gomock.Any(), char.ID).Return(char, nil)
+			mockInvService.EXPECT().
+				MoveItem(gomock.Any(), char.ID, uint64(2), character.InventoryLocationBank, uint32(1), uint32(3)).
+				Return(nil, character.ErrInventoryVersion)
+			out, err := server.MoveInventoryItem(incAdminCtx, &pb.MoveInventoryItemRequest{
+				Target:   target,
+				Version:  2,
+				Location: pb.InventoryLocation_LOCATION_BANK,
+				FromSlot: 1,
+				ToSlot:   3,
+			})
+			Expect(status.Code(err)).To(Equal(codes.Aborted))
+			Expect(out).To(BeNil())
+		})
+
+		It("should error on invalid operations", func() {
+			mockCharService.EXPECT().FindById(gomock.Any(), char.ID).Return(char, nil)
+			mockInvService.EXPECT().
+				SplitStack(gomock.Any(), char.ID, uint64(0), character.InventoryLocationInventory, uint32(0), uint32(1), uint64(5)).
+				Return(nil, character.ErrInventorySlotOccupied)
+			out, err := server.SplitInventoryStack(incAdminCtx, &pb.SplitInventoryStackRequest{
+				Target:   target,
+				ToSlot:   1,
+				Quantity: 5,
+			})
+			Expect(status.Code(err)).To(Equal(codes.FailedPrecondition))
+			Expect(out).To(BeNil())
+		})
+
+		It("should error on unknown locations", func() {
+			mockCharService.EXPECT().FindById(gomock.Any(), char.ID).Return(char, nil)
+			out, err := server.TransferInventoryItem(incAdminCtx, &pb.TransferInventoryItemRequest{
+				Target: target,
+				From:   pb.InventoryLocation(5),
+			})
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+			Expect(out).To(BeNil())
+		})
+
+		It("should return the changed inventory", func() {
+			inv := &character.Inventory{
+				CharacterId: char.ID,
+				Inventory:   character.InventoryItems{{Id: "potion", Slot: 0, Quantity: 1}},
+				Version:     4,
+			}
+			mockCharService.EXPECT().FindById(gomock.Any(), char.ID).Return(char, nil)
+			mockInvService.EXPECT().
+				AddItem(gomock.Any(), char.ID, uint64(3), character.InventoryLocationInventory, inv.Inventory[0]).
+				Return(inv, nil)
+			out, err := server.AddInventoryItem(incAdminCtx, &pb.AddInventoryItemRequest{
+				Target:  target,
+				Version: 3,
+				Item:    inv.Inventory[0].ToPb(),
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.Version).To(BeEquivalentTo(4))
+		})
+	})
+
+	Describe("inventory history", func() {
+		var target *pb.CharacterTarget
+
+		BeforeEach(func() {
+			target = &pb.CharacterTarget{
+				Type: &pb.CharacterTarget_Id{
+					Id: uint64(char.ID),
+				},
+			}
+		})
+
+		It("should error without the inventory admin role (player)", func() {
+			out, err := server.GetInventoryHistory(incPlayerCtx, &pb.InventoryHistoryRequest{Target: target})
+			Expect(err).To(MatchError(common.ErrUnauthorized.Err()))
+			Expect(out).To(BeNil())
+		})
+
+		It("should list the history (admin)", func() {
+			mockCharService.EXPECT().FindById(gomock.Any(), char.ID).Return(char, nil)
+			mockInvService.EXPECT().History(gomock.Any(), char.ID, uint32(5)).Return(character.InventoryEvents{
+				{Reason: "set inventory", After: &character.Inventory{Version: 2}},
+			}, nil)
+			out, err := server.GetInventoryHistory(incAdminCtx, &pb.InventoryHistoryRequest{Target: target, Limit: 5})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.Events).To(HaveLen(1))
+			Expect(out.Events[0].Version).To(BeEquivalentTo(2))
+		})
+
+		It("should require a time to restore", func() {
+			mockCharService.EXPECT().FindById(gomock.Any(), char.ID).Return(char, nil)
+			out, err := server.RestoreInventory(incAdminCtx, &pb.RestoreInventoryRequest{Target: target, Reason: "lost"})
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+			Expect(out).To(BeNil())
+		})
+
+		It("should error restoring without history", func() {
+			mockCharService.EXPECT().FindById(gomock.Any(), char.ID).Return(char, nil)
+			mockInvService.EXPECT().Restore(gomock.Any(), char.ID, time.Unix(100, 0), "lost").
+				Return(nil, service.ErrInventoryHistoryNotFound)
+			out, err := server.RestoreInventory(incAdminCtx, &pb.RestoreInventoryRequest{
+				Target: target,
+				Time:   100,
+				Reason: "lost",
+			})
+			Expect(status.Code(err)).To(Equal(codes.NotFound))
+			Expect(out).To(BeNil())
+		})
+	})
 })
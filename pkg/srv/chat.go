@@ -3,16 +3,27 @@ package srv
 import (
 	"context"
 	"errors"
+	"math"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
 
 	chatApp "github.com/ShatteredRealms/go-backend/cmd/chat/app"
 	"github.com/ShatteredRealms/go-backend/pkg/auth"
+	"github.com/ShatteredRealms/go-backend/pkg/chatfilter"
 	"github.com/ShatteredRealms/go-backend/pkg/common"
 	"github.com/ShatteredRealms/go-backend/pkg/helpers"
 	"github.com/ShatteredRealms/go-backend/pkg/log"
+	"github.com/ShatteredRealms/go-backend/pkg/messagebus"
 	"github.com/ShatteredRealms/go-backend/pkg/model/chat"
 	"github.com/ShatteredRealms/go-backend/pkg/pb"
+	"github.com/ShatteredRealms/go-backend/pkg/ratelimit"
+	"github.com/ShatteredRealms/go-backend/pkg/service"
 	"github.com/WilSimpson/gocloak/v13"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"gorm.io/gorm"
@@ -20,7 +31,8 @@ import (
 
 type chatServiceServer struct {
 	pb.UnimplementedChatServiceServer
-	server *chatApp.ChatServerContext
+	server  *chatApp.ChatServerContext
+	filters *blockFilters
 }
 
 var (
@@ -35,6 +47,24 @@ var (
 		Name:        gocloak.StringP("chat_manage"),
 		Description: gocloak.StringP("Allows viewing, creation, editing and deletion of chat channels."),
 	})
+
+	RoleChatCharacterRename = registerChatRole(&gocloak.Role{
+		Name:        gocloak.StringP("character_rename"),
+		Description: gocloak.StringP("Allows moving the direct messages of renamed characters to their new name."),
+	})
+
+	RoleChatCharacterDelete = registerChatRole(&gocloak.Role{
+		Name:        gocloak.StringP("character_delete"),
+		Description: gocloak.StringP("Allows deleting the direct messages of deleted characters."),
+	})
+
+	// errRemovedFromChannel thrown when the channel stream of a kicked or banned user is closed
+	errRemovedFromChannel = errors.New("removed from channel")
+)
+
+const (
+	// blockChangesRetryDelay time waited before subscribing to block changes again after the subscription failed
+	blockChangesRetryDelay = 5 * time.Second
 )
 
 func registerChatRole(role *gocloak.Role) *gocloak.Role {
@@ -43,7 +73,7 @@ func registerChatRole(role *gocloak.Role) *gocloak.Role {
 }
 
 func (s chatServiceServer) ConnectChannel(
-	request *pb.ChatChannelTarget,
+	request *pb.ConnectChannelRequest,
 	server pb.ChatService_ConnectChannelServer,
 ) error {
 	claims, ok := auth.RetrieveClaims(server.Context())
@@ -65,8 +95,20 @@ func (s chatServiceServer) ConnectChannel(
 		}
 	}
 
-	r := s.server.ChatService.ChannelMessagesReader(server.Context(), uint(request.Id))
+	// Removal events sent before connecting may be read again when starting after a message, and are ignored
+	connectedAt := time.Now().UnixMilli()
+	r, err := s.server.ChatService.ChannelMessagesReader(server.Context(), uint(request.Id), uint(request.AfterMessageId))
+	if err != nil {
+		if errors.Is(err, service.ErrMessageNotFound) {
+			return status.Error(codes.NotFound, err.Error())
+		}
 
+		log.Logger.WithContext(server.Context()).Errorf("channel messages reader: %v", err)
+		return status.Error(codes.Internal, "unable to connect to channel")
+	}
+	filter := s.newBlockFilter(claims.Subject)
+	unregister := s.filters.register(filter)
+	defer unregister()
 	for {
 		msg, err := r.ReadMessage(server.Context())
 		if err != nil {
@@ -74,10 +116,31 @@ func (s chatServiceServer) ConnectChannel(
 			return err
 		}
 
-		err = server.Send(&pb.ChatMessage{
-			CharacterName: string(msg.Key),
-			Message:       string(msg.Value),
-		})
+		chatMessage, err := service.DecodeChatMessage(msg)
+		if err != nil {
+			log.Logger.WithContext(server.Context()).Errorf("channel %d: %v", request.Id, err)
+			continue
+		}
+
+		// Kicks and bans are sent on the channel so the streams of the user are closed on every replica
+		if chatMessage.Type == pb.ChatMessageType_CHARACTER_REMOVED {
+			if chatMessage.SentAt >= connectedAt &&
+				s.isOwnCharacter(server.Context(), claims.Subject, chatMessage.CharacterId) {
+				_ = r.Close()
+				return status.Error(codes.PermissionDenied, errRemovedFromChannel.Error())
+			}
+			continue
+		}
+
+		if request.AfterMessageId > 0 && chatMessage.Id <= request.AfterMessageId {
+			continue
+		}
+
+		if filter.isBlocked(server.Context(), chatMessage.CharacterName) {
+			continue
+		}
+
+		err = server.Send(chatMessage)
 
 		if err != nil {
 			_ = r.Close()
@@ -109,7 +172,14 @@ func (s chatServiceServer) ConnectDirectMessage(
 		return common.ErrUnauthorized.Err()
 	}
 
-	r := s.server.ChatService.DirectMessagesReader(server.Context(), char.Name)
+	r, err := s.server.ChatService.DirectMessagesReader(server.Context(), char.Name)
+	if err != nil {
+		log.Logger.WithContext(server.Context()).Errorf("direct messages reader: %v", err)
+		return status.Error(codes.Internal, "unable to connect to direct messages")
+	}
+	filter := s.newBlockFilter(char.Owner)
+	unregister := s.filters.register(filter)
+	defer unregister()
 	for {
 		msg, err := r.ReadMessage(server.Context())
 		if err != nil {
@@ -117,10 +187,17 @@ func (s chatServiceServer) ConnectDirectMessage(
 			return err
 		}
 
-		err = server.Send(&pb.ChatMessage{
-			CharacterName: string(msg.Key),
-			Message:       string(msg.Value),
-		})
+		chatMessage, err := service.DecodeChatMessage(msg)
+		if err != nil {
+			log.Logger.WithContext(server.Context()).Errorf("direct messages for %s: %v", char.Name, err)
+			continue
+		}
+
+		if filter.isBlocked(server.Context(), chatMessage.CharacterName) {
+			continue
+		}
+
+		err = server.Send(chatMessage)
 
 		if err != nil {
 			_ = r.Close()
@@ -143,6 +220,14 @@ func (s chatServiceServer) SendChatMessage(
 		log.Logger.WithContext(ctx).Infof("unauthorized request")
 		return nil, common.ErrUnauthorized.Err()
 	}
+
+	// System messages and announcements can only be sent by chat managers
+	if request.ChatMessage.Type == pb.ChatMessageType_SYSTEM || request.ChatMessage.Type == pb.ChatMessageType_ANNOUNCEMENT {
+		if !claims.HasResourceRole(RoleChatChannelManage, auth.ChatClientId) {
+			return nil, common.ErrUnauthorized.Err()
+		}
+	}
+
 	character, err := s.verifyUserOwnsCharacter(
 		ctx,
 		&pb.CharacterTarget{Type: &pb.CharacterTarget_Name{Name: request.ChatMessage.CharacterName}},
@@ -152,6 +237,15 @@ func (s chatServiceServer) SendChatMessage(
 		return nil, err
 	}
 
+	mute, err := s.server.ChatService.ActiveModeration(ctx, uint(request.ChannelId), uint(character.Id), pb.ChatModerationType_MUTE)
+	if err != nil {
+		log.Logger.WithContext(ctx).Errorf("find active mute: %v", err)
+		return nil, common.ErrHandleRequest.Err()
+	}
+	if mute != nil {
+		return nil, status.Error(codes.PermissionDenied, "muted in channel")
+	}
+
 	// Validate requester has chat channel permissions
 	// @TODO: Optimize by only checking specific channel (cache results)
 	if !claims.HasResourceRole(RoleChatChannelManage, auth.ChatClientId) {
@@ -180,15 +274,13 @@ func (s chatServiceServer) SendChatMessage(
 		}
 	}
 
-	err = s.server.ChatService.SendChannelMessage(
+	_, err = s.server.ChatService.SendChannelMessage(
 		ctx,
-		request.ChatMessage.CharacterName,
-		request.ChatMessage.Message,
 		uint(request.ChannelId),
+		newChatMessage(character, request.ChatMessage),
 	)
 	if err != nil {
-		log.Logger.WithContext(ctx).Errorf("send channel chat message: %v", err)
-		return nil, status.Errorf(codes.Internal, "unable to send message")
+		return nil, sendMessageError(ctx, err)
 	}
 
 	return &emptypb.Empty{}, nil
@@ -208,36 +300,279 @@ func (s chatServiceServer) SendDirectMessage(
 		return nil, common.ErrUnauthorized.Err()
 	}
 
-	if _, err := s.verifyUserOwnsCharacter(
+	sender, err := s.verifyUserOwnsCharacter(
 		ctx,
 		&pb.CharacterTarget{Type: &pb.CharacterTarget_Name{Name: request.ChatMessage.CharacterName}},
-	); err != nil {
+	)
+	if err != nil {
 		return nil, err
 	}
 
-	srvCtx, err := s.serverContext(ctx)
+	target, err := s.targetCharacter(ctx, request.Target)
 	if err != nil {
-		log.Logger.WithContext(ctx).Errorf("create server context: %v", err)
-		return nil, common.ErrHandleRequest.Err()
+		return nil, err
 	}
-	targetCharacterName, err := s.server.GetCharacterNameFromTarget(srvCtx, request.Target)
-	if err != nil {
+
+	if err := s.checkDirectMessageBlocks(ctx, sender, target); err != nil {
 		return nil, err
 	}
 
-	if err := s.server.ChatService.SendDirectMessage(
+	if _, err := s.server.ChatService.SendDirectMessage(
 		ctx,
-		request.ChatMessage.CharacterName,
-		request.ChatMessage.Message,
-		targetCharacterName,
+		target.Name,
+		newChatMessage(sender, request.ChatMessage),
 	); err != nil {
-		log.Logger.WithContext(ctx).Errorf("send direct chat message: %v", err)
-		return nil, status.Errorf(codes.Internal, "unable to send message")
+		return nil, sendMessageError(ctx, err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s chatServiceServer) GetChannelHistory(
+	ctx context.Context,
+	request *pb.ChannelHistoryRequest,
+) (*pb.ChatMessages, error) {
+	claims, ok := auth.RetrieveClaims(ctx)
+	if !ok {
+		return nil, common.ErrUnauthorized.Err()
+	}
+
+	// Validate requester has correct permission
+	if !claims.HasResourceRole(RoleChat, auth.ChatClientId) {
+		return nil, common.ErrUnauthorized.Err()
+	}
+
+	// Validate requester has chat channel permissions
+	if !claims.HasResourceRole(RoleChatChannelManage, auth.ChatClientId) {
+		err := s.checkUserChannelAuth(ctx, claims.Subject, uint(request.ChannelId))
+		if err != nil {
+			log.Logger.WithContext(ctx).Infof("verify auth failed for %s on channel %d: %v", claims.Subject, request.ChannelId, err)
+			return nil, err
+		}
+	}
+
+	messages, err := s.server.ChatService.GetChannelHistory(
+		ctx,
+		uint(request.ChannelId),
+		chat.NewHistoryCursor(request.Before, request.After, request.Limit),
+	)
+	if err != nil {
+		log.Logger.WithContext(ctx).Errorf("get channel history: %v", err)
+		return nil, status.Error(codes.Internal, "unable to get channel history")
+	}
+
+	filter := s.newBlockFilter(claims.Subject)
+	resp := &pb.ChatMessages{Messages: make([]*pb.ChatMessage, 0, len(messages))}
+	for _, message := range messages {
+		if !filter.isBlocked(ctx, message.SenderCharacterName) {
+			resp.Messages = append(resp.Messages, message.ToPb())
+		}
+	}
+
+	return resp, nil
+}
+
+func (s chatServiceServer) GetDirectMessageHistory(
+	ctx context.Context,
+	request *pb.DirectMessageHistoryRequest,
+) (*pb.ChatMessages, error) {
+	claims, ok := auth.RetrieveClaims(ctx)
+	if !ok {
+		return nil, common.ErrUnauthorized.Err()
+	}
+
+	// Validate requester has correct permission
+	if !claims.HasResourceRole(RoleChat, auth.ChatClientId) {
+		return nil, common.ErrUnauthorized.Err()
+	}
+
+	char, err := s.verifyUserOwnsCharacter(ctx, request.Character)
+	if err == common.ErrNotOwner {
+		if !claims.HasResourceRole(RoleChatChannelManage, auth.ChatClientId) {
+			return nil, common.ErrUnauthorized.Err()
+		}
+	} else if err != nil {
+		return nil, err
+	}
+
+	messages, err := s.server.ChatService.GetDirectMessageHistory(
+		ctx,
+		char.Name,
+		request.OtherCharacterName,
+		chat.NewHistoryCursor(request.Before, request.After, request.Limit),
+	)
+	if err != nil {
+		log.Logger.WithContext(ctx).Errorf("get direct message history: %v", err)
+		return nil, status.Error(codes.Internal, "unable to get direct message history")
+	}
+
+	return messages.ToPb(), nil
+}
+
+func (s chatServiceServer) NotifyTyping(
+	ctx context.Context,
+	request *pb.TypingRequest,
+) (*emptypb.Empty, error) {
+	claims, ok := auth.RetrieveClaims(ctx)
+	if !ok {
+		return nil, common.ErrUnauthorized.Err()
+	}
+
+	// Validate requester has correct permission
+	if !claims.HasResourceRole(RoleChat, auth.ChatClientId) {
+		return nil, common.ErrUnauthorized.Err()
+	}
+
+	sender, err := s.verifyUserOwnsCharacter(ctx, request.Character)
+	if err != nil {
+		return nil, common.ErrUnauthorized.Err()
+	}
+
+	target, err := s.targetCharacter(ctx, request.Target)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.checkDirectMessageBlocks(ctx, sender, target); err != nil {
+		return nil, err
+	}
+
+	err = s.server.ChatService.NotifyTyping(ctx, uint(sender.Id), sender.Name, target.Name)
+	if err != nil {
+		log.Logger.WithContext(ctx).Errorf("notify typing: %v", err)
+		return nil, common.ErrHandleRequest.Err()
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s chatServiceServer) MarkRead(
+	ctx context.Context,
+	request *pb.MarkReadRequest,
+) (*emptypb.Empty, error) {
+	claims, ok := auth.RetrieveClaims(ctx)
+	if !ok {
+		return nil, common.ErrUnauthorized.Err()
+	}
+
+	// Validate requester has correct permission
+	if !claims.HasResourceRole(RoleChat, auth.ChatClientId) {
+		return nil, common.ErrUnauthorized.Err()
+	}
+
+	character, err := s.verifyUserOwnsCharacter(ctx, request.Character)
+	if err != nil {
+		return nil, common.ErrUnauthorized.Err()
+	}
+
+	err = s.server.ChatService.MarkRead(ctx, uint(character.Id), character.Name, uint(request.MessageId))
+	if errors.Is(err, service.ErrMessageNotFound) {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		log.Logger.WithContext(ctx).Errorf("mark read: %v", err)
+		return nil, common.ErrHandleRequest.Err()
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s chatServiceServer) MigrateCharacterName(
+	ctx context.Context,
+	request *pb.CharacterRename,
+) (*emptypb.Empty, error) {
+	claims, ok := auth.RetrieveClaims(ctx)
+	if !ok {
+		return nil, common.ErrUnauthorized.Err()
+	}
+
+	// Validate requester has correct permission
+	if !claims.HasResourceRole(RoleChatCharacterRename, auth.ChatClientId) {
+		return nil, common.ErrUnauthorized.Err()
+	}
+
+	if request.OldName == "" || request.NewName == "" || request.OldName == request.NewName {
+		return nil, status.Error(codes.InvalidArgument, "old and new name are required and must differ")
+	}
+
+	err := s.server.ChatService.MigrateCharacterName(ctx, uint(request.CharacterId), request.OldName, request.NewName)
+	if err != nil {
+		log.Logger.WithContext(ctx).Errorf("migrate character name: %v", err)
+		return nil, status.Error(codes.Internal, "unable to migrate character name")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s chatServiceServer) DeleteCharacterChat(
+	ctx context.Context,
+	request *pb.CharacterTarget,
+) (*emptypb.Empty, error) {
+	claims, ok := auth.RetrieveClaims(ctx)
+	if !ok {
+		return nil, common.ErrUnauthorized.Err()
+	}
+
+	// Validate requester has correct permission
+	if !claims.HasResourceRole(RoleChatCharacterDelete, auth.ChatClientId) {
+		return nil, common.ErrUnauthorized.Err()
+	}
+
+	name := request.GetName()
+	if name == "" {
+		return nil, status.Error(codes.InvalidArgument, "character name is required")
+	}
+
+	err := s.server.ChatService.UnregisterCharacterChatTopic(ctx, name)
+	if err != nil {
+		log.Logger.WithContext(ctx).Errorf("delete character chat %s: %v", name, err)
+		return nil, status.Error(codes.Internal, "unable to delete character chat")
 	}
 
 	return &emptypb.Empty{}, nil
 }
 
+func (s chatServiceServer) GetUnreadDirectMessages(
+	ctx context.Context,
+	request *pb.CharacterTarget,
+) (*pb.UnreadDirectMessages, error) {
+	claims, ok := auth.RetrieveClaims(ctx)
+	if !ok {
+		return nil, common.ErrUnauthorized.Err()
+	}
+
+	// Validate requester has correct permission
+	if !claims.HasResourceRole(RoleChat, auth.ChatClientId) {
+		return nil, common.ErrUnauthorized.Err()
+	}
+
+	char, err := s.verifyUserOwnsCharacter(ctx, request)
+	if err == common.ErrNotOwner {
+		if !claims.HasResourceRole(RoleChatChannelManage, auth.ChatClientId) {
+			return nil, common.ErrUnauthorized.Err()
+		}
+	} else if err != nil {
+		return nil, err
+	}
+
+	conversations, err := s.server.ChatService.UnreadDirectMessages(ctx, char.Name)
+	if err != nil {
+		log.Logger.WithContext(ctx).Errorf("get unread direct messages: %v", err)
+		return nil, status.Error(codes.Internal, "unable to get unread direct messages")
+	}
+
+	// Messages from blocked users are hidden from the stream so they should not be counted either
+	filter := s.newBlockFilter(char.Owner)
+	unblocked := make(chat.UnreadConversations, 0, len(conversations))
+	for _, conversation := range conversations {
+		if !filter.isBlocked(ctx, conversation.CharacterName) {
+			unblocked = append(unblocked, conversation)
+		}
+	}
+
+	return unblocked.ToPb(), nil
+}
+
 func (s chatServiceServer) GetChannel(
 	ctx context.Context,
 	request *pb.ChatChannelTarget,
@@ -268,24 +603,55 @@ func (s chatServiceServer) GetChannel(
 func (s chatServiceServer) CreateChannel(
 	ctx context.Context,
 	request *pb.CreateChannelMessage,
-) (*emptypb.Empty, error) {
+) (*pb.ChatChannel, error) {
 	claims, ok := auth.RetrieveClaims(ctx)
 	if !ok {
 		return nil, common.ErrUnauthorized.Err()
 	}
 
 	// Validate requester has correct permission
-	if !claims.HasResourceRole(RoleChatChannelManage, auth.ChatClientId) {
+	if !claims.HasResourceRole(RoleChat, auth.ChatClientId) &&
+		!claims.HasResourceRole(RoleChatChannelManage, auth.ChatClientId) {
 		return nil, common.ErrUnauthorized.Err()
 	}
 
-	_, err := s.server.ChatService.CreateChannel(
-		ctx,
-		&chat.ChatChannel{
-			Name:      request.Name,
-			Dimension: request.Dimension,
-		},
-	)
+	channel := &chat.ChatChannel{
+		Name:          request.Name,
+		Dimension:     request.Dimension,
+		FilterProfile: request.FilterProfile,
+		Type:          request.Type,
+	}
+
+	// Players can only create their own public and private channels in their character's dimension
+	if !claims.HasResourceRole(RoleChatChannelManage, auth.ChatClientId) {
+		if request.Owner == nil {
+			return nil, status.Error(codes.InvalidArgument, "owner is required")
+		}
+
+		if request.Type == pb.ChatChannelType_CHANNEL_SYSTEM {
+			return nil, common.ErrUnauthorized.Err()
+		}
+
+		owner, err := s.verifyUserOwnsCharacter(ctx, request.Owner)
+		if err != nil {
+			return nil, common.ErrUnauthorized.Err()
+		}
+
+		ownerId := uint(owner.Id)
+		channel.OwnerId = &ownerId
+		channel.Dimension = owner.Dimension
+		channel.FilterProfile = ""
+	} else if request.Owner != nil {
+		owner, err := s.targetCharacter(ctx, request.Owner)
+		if err != nil {
+			return nil, err
+		}
+
+		ownerId := uint(owner.Id)
+		channel.OwnerId = &ownerId
+	}
+
+	channel, err := s.server.ChatService.CreateChannel(ctx, channel)
 
 	if err != nil {
 		if errors.Is(err, gorm.ErrDuplicatedKey) {
@@ -296,7 +662,7 @@ func (s chatServiceServer) CreateChannel(
 		return nil, status.Error(codes.Internal, "unable to create chat channel")
 	}
 
-	return &emptypb.Empty{}, nil
+	return channel.ToPb(), nil
 }
 
 func (s chatServiceServer) DeleteChannel(
@@ -344,8 +710,8 @@ func (s chatServiceServer) EditChannel(
 
 	_, err := s.server.ChatService.UpdateChannel(ctx, request)
 	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, common.ErrDoesNotExist.Err()
+		if errors.Is(err, gorm.ErrRecordNotFound) || errors.Is(err, service.ErrChannelNotFound) {
+			return nil, status.Error(codes.NotFound, service.ErrChannelNotFound.Error())
 		}
 
 		log.Logger.WithContext(ctx).Errorf("edit channel: %v", err)
@@ -446,6 +812,10 @@ func (s chatServiceServer) UpdateUserChatChannelAuthorizations(
 			return nil, status.Error(codes.InvalidArgument, "existing chat authorization conflict")
 		}
 
+		if errors.Is(err, service.ErrBanned) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+
 		log.Logger.WithContext(ctx).Errorf("get authorized channels: %v", err)
 		return nil, status.Error(codes.Internal, "unable to change authorization")
 	}
@@ -453,10 +823,428 @@ func (s chatServiceServer) UpdateUserChatChannelAuthorizations(
 	return &emptypb.Empty{}, nil
 }
 
-func NewChatServiceServer(
+func (s chatServiceServer) JoinChannel(
 	ctx context.Context,
-	server *chatApp.ChatServerContext,
-) (pb.ChatServiceServer, error) {
+	request *pb.ChannelMembershipRequest,
+) (*emptypb.Empty, error) {
+	claims, ok := auth.RetrieveClaims(ctx)
+	if !ok {
+		return nil, common.ErrUnauthorized.Err()
+	}
+
+	// Validate requester has correct permission
+	if !claims.HasResourceRole(RoleChat, auth.ChatClientId) {
+		return nil, common.ErrUnauthorized.Err()
+	}
+
+	character, err := s.verifyUserOwnsCharacter(ctx, request.Character)
+	if err != nil {
+		return nil, common.ErrUnauthorized.Err()
+	}
+
+	err = s.server.ChatService.JoinChannel(ctx, uint(request.ChannelId), uint(character.Id), character.Dimension)
+	if err != nil {
+		return nil, channelMembershipError(ctx, "join channel", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s chatServiceServer) LeaveChannel(
+	ctx context.Context,
+	request *pb.ChannelMembershipRequest,
+) (*emptypb.Empty, error) {
+	claims, ok := auth.RetrieveClaims(ctx)
+	if !ok {
+		return nil, common.ErrUnauthorized.Err()
+	}
+
+	// Validate requester has correct permission
+	if !claims.HasResourceRole(RoleChat, auth.ChatClientId) {
+		return nil, common.ErrUnauthorized.Err()
+	}
+
+	character, err := s.verifyUserOwnsCharacter(ctx, request.Character)
+	if err != nil {
+		return nil, common.ErrUnauthorized.Err()
+	}
+
+	err = s.server.ChatService.LeaveChannel(ctx, uint(request.ChannelId), uint(character.Id))
+	if err != nil {
+		return nil, channelMembershipError(ctx, "leave channel", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s chatServiceServer) InviteToChannel(
+	ctx context.Context,
+	request *pb.ChannelInviteRequest,
+) (*emptypb.Empty, error) {
+	claims, ok := auth.RetrieveClaims(ctx)
+	if !ok {
+		return nil, common.ErrUnauthorized.Err()
+	}
+
+	// Validate requester has correct permission
+	if !claims.HasResourceRole(RoleChat, auth.ChatClientId) {
+		return nil, common.ErrUnauthorized.Err()
+	}
+
+	inviterId := uint(0)
+	if !claims.HasResourceRole(RoleChatChannelManage, auth.ChatClientId) || request.Character != nil {
+		inviter, err := s.verifyUserOwnsCharacter(ctx, request.Character)
+		if err != nil {
+			return nil, common.ErrUnauthorized.Err()
+		}
+		inviterId = uint(inviter.Id)
+	}
+
+	if !claims.HasResourceRole(RoleChatChannelManage, auth.ChatClientId) {
+		channel, err := s.server.ChatService.GetChannel(ctx, uint(request.ChannelId))
+		if err != nil {
+			log.Logger.WithContext(ctx).Errorf("get chat channel: %v", err)
+			return nil, common.ErrHandleRequest.Err()
+		}
+		if channel == nil {
+			return nil, common.ErrDoesNotExist.Err()
+		}
+
+		if !channel.IsOwner(inviterId) {
+			moderator, err := s.server.ChatService.IsChannelModerator(ctx, channel.ID, inviterId)
+			if err != nil {
+				log.Logger.WithContext(ctx).Errorf("is channel moderator: %v", err)
+				return nil, common.ErrHandleRequest.Err()
+			}
+			if !moderator {
+				return nil, common.ErrUnauthorized.Err()
+			}
+		}
+	}
+
+	target, err := s.targetCharacter(ctx, request.Target)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.server.ChatService.InviteToChannel(ctx, uint(request.ChannelId), uint(target.Id), inviterId)
+	if err != nil {
+		return nil, channelMembershipError(ctx, "invite to channel", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s chatServiceServer) GetChannelMembers(
+	ctx context.Context,
+	request *pb.ChatChannelTarget,
+) (*pb.ChannelMembers, error) {
+	claims, ok := auth.RetrieveClaims(ctx)
+	if !ok {
+		return nil, common.ErrUnauthorized.Err()
+	}
+
+	// Validate requester has correct permission
+	if !claims.HasResourceRole(RoleChat, auth.ChatClientId) {
+		return nil, common.ErrUnauthorized.Err()
+	}
+
+	// Validate requester has chat channel permissions
+	if !claims.HasResourceRole(RoleChatChannelManage, auth.ChatClientId) {
+		if err := s.checkUserChannelAuth(ctx, claims.Subject, uint(request.Id)); err != nil {
+			return nil, err
+		}
+	}
+
+	channel, err := s.server.ChatService.GetChannel(ctx, uint(request.Id))
+	if err != nil {
+		log.Logger.WithContext(ctx).Errorf("get chat channel: %v", err)
+		return nil, status.Error(codes.Internal, "unable to get chat channel")
+	}
+	if channel == nil {
+		return nil, common.ErrDoesNotExist.Err()
+	}
+
+	members, err := s.server.ChatService.ChannelMembers(ctx, channel.ID)
+	if err != nil {
+		log.Logger.WithContext(ctx).Errorf("get channel members: %v", err)
+		return nil, status.Error(codes.Internal, "unable to get channel members")
+	}
+
+	resp := members.ToPb(channel)
+	if err := s.addMemberNames(ctx, resp.Members); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s chatServiceServer) ModerateCharacter(
+	ctx context.Context,
+	request *pb.ChatModerationRequest,
+) (*emptypb.Empty, error) {
+	claims, ok := auth.RetrieveClaims(ctx)
+	if !ok {
+		return nil, common.ErrUnauthorized.Err()
+	}
+
+	// Validate requester has correct permission
+	if !claims.HasResourceRole(RoleChat, auth.ChatClientId) {
+		return nil, common.ErrUnauthorized.Err()
+	}
+
+	switch request.Type {
+	case pb.ChatModerationType_MUTE, pb.ChatModerationType_KICK, pb.ChatModerationType_BAN:
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unknown moderation type %d", request.Type)
+	}
+
+	target, err := s.moderationTarget(ctx, uint(request.ChannelId), request.Character)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.server.ChatService.ModerateCharacter(
+		ctx,
+		&chat.ChatChannelModeration{
+			ChannelId:   uint(request.ChannelId),
+			CharacterId: uint(target.Id),
+			Type:        request.Type,
+			Reason:      request.Reason,
+			ModeratorId: claims.Subject,
+		},
+		time.Duration(request.Duration)*time.Second,
+	)
+	if err != nil {
+		log.Logger.WithContext(ctx).Errorf("moderate character: %v", err)
+		return nil, status.Error(codes.Internal, "unable to moderate character")
+	}
+
+	log.Logger.WithContext(ctx).Infof(
+		"%s moderated %s in channel %d: %s",
+		claims.Subject, target.Name, request.ChannelId, request.Type.String(),
+	)
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s chatServiceServer) RevokeModeration(
+	ctx context.Context,
+	request *pb.RevokeChatModerationRequest,
+) (*emptypb.Empty, error) {
+	claims, ok := auth.RetrieveClaims(ctx)
+	if !ok {
+		return nil, common.ErrUnauthorized.Err()
+	}
+
+	// Validate requester has correct permission
+	if !claims.HasResourceRole(RoleChat, auth.ChatClientId) {
+		return nil, common.ErrUnauthorized.Err()
+	}
+
+	target, err := s.moderationTarget(ctx, uint(request.ChannelId), request.Character)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.server.ChatService.RevokeModeration(ctx, uint(request.ChannelId), uint(target.Id), request.Type)
+	if err != nil {
+		log.Logger.WithContext(ctx).Errorf("revoke moderation: %v", err)
+		return nil, status.Error(codes.Internal, "unable to revoke moderation")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s chatServiceServer) GetChannelModerations(
+	ctx context.Context,
+	request *pb.ChatChannelTarget,
+) (*pb.ChatModerations, error) {
+	claims, ok := auth.RetrieveClaims(ctx)
+	if !ok {
+		return nil, common.ErrUnauthorized.Err()
+	}
+
+	// Validate requester has correct permission
+	if !claims.HasResourceRole(RoleChat, auth.ChatClientId) {
+		return nil, common.ErrUnauthorized.Err()
+	}
+
+	if err := s.verifyChannelModerator(ctx, uint(request.Id)); err != nil {
+		return nil, err
+	}
+
+	moderations, err := s.server.ChatService.ActiveModerations(ctx, uint(request.Id))
+	if err != nil {
+		log.Logger.WithContext(ctx).Errorf("get channel moderations: %v", err)
+		return nil, status.Error(codes.Internal, "unable to get channel moderations")
+	}
+
+	return moderations.ToPb(), nil
+}
+
+func (s chatServiceServer) SetChannelModerator(
+	ctx context.Context,
+	request *pb.SetChannelModeratorRequest,
+) (*emptypb.Empty, error) {
+	claims, ok := auth.RetrieveClaims(ctx)
+	if !ok {
+		return nil, common.ErrUnauthorized.Err()
+	}
+
+	// Validate requester has correct permission
+	if !claims.HasResourceRole(RoleChatChannelManage, auth.ChatClientId) {
+		return nil, common.ErrUnauthorized.Err()
+	}
+
+	target, err := s.targetCharacter(ctx, request.Character)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.server.ChatService.SetChannelModerator(ctx, uint(request.ChannelId), uint(target.Id), request.Moderator)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Error(codes.FailedPrecondition, "character is not authorized for the channel")
+		}
+
+		log.Logger.WithContext(ctx).Errorf("set channel moderator: %v", err)
+		return nil, status.Error(codes.Internal, "unable to set channel moderator")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s chatServiceServer) GetFriends(
+	ctx context.Context,
+	_ *emptypb.Empty,
+) (*pb.SocialUsers, error) {
+	claims, ok := auth.RetrieveClaims(ctx)
+	if !ok {
+		return nil, common.ErrUnauthorized.Err()
+	}
+
+	// Validate requester has correct permission
+	if !claims.HasResourceRole(RoleChat, auth.ChatClientId) {
+		return nil, common.ErrUnauthorized.Err()
+	}
+
+	friends, err := s.server.ChatService.GetFriends(ctx, claims.Subject)
+	if err != nil {
+		log.Logger.WithContext(ctx).Errorf("get friends: %v", err)
+		return nil, status.Error(codes.Internal, "unable to get friends")
+	}
+
+	resp := friends.ToPb()
+	if err := s.addCharacterNames(ctx, resp.Users); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s chatServiceServer) GetFriendRequests(
+	ctx context.Context,
+	_ *emptypb.Empty,
+) (*pb.FriendRequests, error) {
+	claims, ok := auth.RetrieveClaims(ctx)
+	if !ok {
+		return nil, common.ErrUnauthorized.Err()
+	}
+
+	// Validate requester has correct permission
+	if !claims.HasResourceRole(RoleChat, auth.ChatClientId) {
+		return nil, common.ErrUnauthorized.Err()
+	}
+
+	requests, err := s.server.ChatService.GetFriendRequests(ctx, claims.Subject)
+	if err != nil {
+		log.Logger.WithContext(ctx).Errorf("get friend requests: %v", err)
+		return nil, status.Error(codes.Internal, "unable to get friend requests")
+	}
+
+	resp := requests.ToPb(claims.Subject)
+	if err := s.addCharacterNames(ctx, append(resp.Incoming, resp.Outgoing...)); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s chatServiceServer) SendFriendRequest(
+	ctx context.Context,
+	request *pb.CharacterTarget,
+) (*emptypb.Empty, error) {
+	return s.socialAction(ctx, request, "send friend request", s.server.ChatService.SendFriendRequest)
+}
+
+func (s chatServiceServer) AcceptFriendRequest(
+	ctx context.Context,
+	request *pb.CharacterTarget,
+) (*emptypb.Empty, error) {
+	return s.socialAction(ctx, request, "accept friend request", s.server.ChatService.AcceptFriendRequest)
+}
+
+func (s chatServiceServer) DeclineFriendRequest(
+	ctx context.Context,
+	request *pb.CharacterTarget,
+) (*emptypb.Empty, error) {
+	return s.socialAction(ctx, request, "decline friend request", s.server.ChatService.DeclineFriendRequest)
+}
+
+func (s chatServiceServer) RemoveFriend(
+	ctx context.Context,
+	request *pb.CharacterTarget,
+) (*emptypb.Empty, error) {
+	return s.socialAction(ctx, request, "remove friend", s.server.ChatService.RemoveFriend)
+}
+
+func (s chatServiceServer) GetBlockedUsers(
+	ctx context.Context,
+	_ *emptypb.Empty,
+) (*pb.SocialUsers, error) {
+	claims, ok := auth.RetrieveClaims(ctx)
+	if !ok {
+		return nil, common.ErrUnauthorized.Err()
+	}
+
+	// Validate requester has correct permission
+	if !claims.HasResourceRole(RoleChat, auth.ChatClientId) {
+		return nil, common.ErrUnauthorized.Err()
+	}
+
+	blocks, err := s.server.ChatService.GetBlockedUsers(ctx, claims.Subject)
+	if err != nil {
+		log.Logger.WithContext(ctx).Errorf("get blocked users: %v", err)
+		return nil, status.Error(codes.Internal, "unable to get blocked users")
+	}
+
+	resp := blocks.ToPb()
+	if err := s.addCharacterNames(ctx, resp.Users); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s chatServiceServer) BlockUser(
+	ctx context.Context,
+	request *pb.CharacterTarget,
+) (*emptypb.Empty, error) {
+	return s.socialAction(ctx, request, "block user", s.server.ChatService.BlockUser)
+}
+
+func (s chatServiceServer) UnblockUser(
+	ctx context.Context,
+	request *pb.CharacterTarget,
+) (*emptypb.Empty, error) {
+	return s.socialAction(ctx, request, "unblock user", s.server.ChatService.UnblockUser)
+}
+
+func NewChatServiceServer(
+	ctx context.Context,
+	server *chatApp.ChatServerContext,
+) (pb.ChatServiceServer, error) {
 	err := createRoles(ctx,
 		server.ServerContext,
 		&ChatRoles,
@@ -465,8 +1253,12 @@ func NewChatServiceServer(
 		return nil, err
 	}
 
+	filters := newBlockFilters()
+	go filters.watch(ctx, server.ChatService)
+
 	return &chatServiceServer{
-		server: server,
+		server:  server,
+		filters: filters,
 	}, nil
 }
 
@@ -555,3 +1347,495 @@ func (s chatServiceServer) checkUserChannelAuth(ctx context.Context, userId stri
 
 	return common.ErrUnauthorized.Err()
 }
+
+// verifyChannelModerator checks if the requester can moderate the channel. Chat managers can moderate every channel,
+// otherwise one of the requester's characters must be a moderator of the channel.
+func (s chatServiceServer) verifyChannelModerator(ctx context.Context, channelId uint) error {
+	claims, ok := auth.RetrieveClaims(ctx)
+	if !ok {
+		return common.ErrUnauthorized.Err()
+	}
+
+	if claims.HasResourceRole(RoleChatChannelManage, auth.ChatClientId) {
+		return nil
+	}
+
+	srvCtx, err := s.serverContext(ctx)
+	if err != nil {
+		log.Logger.WithContext(ctx).Errorf("create server context: %v", err)
+		return common.ErrHandleRequest.Err()
+	}
+
+	charClient, err := s.server.GetCharacterClient()
+	if err != nil {
+		log.Logger.WithContext(ctx).Errorf("character client: %v", err)
+		return common.ErrHandleRequest.Err()
+	}
+
+	characters, err := charClient.GetAllCharactersForUser(srvCtx, &pb.UserTarget{Target: &pb.UserTarget_Id{Id: claims.Subject}})
+	if err != nil {
+		log.Logger.WithContext(ctx).Errorf("get characters: %v", err)
+		return common.ErrHandleRequest.Err()
+	}
+
+	for _, character := range characters.Characters {
+		moderator, err := s.server.ChatService.IsChannelModerator(ctx, channelId, uint(character.Id))
+		if err != nil {
+			log.Logger.WithContext(ctx).Errorf("is channel moderator: %v", err)
+			return common.ErrHandleRequest.Err()
+		}
+
+		if moderator {
+			return nil
+		}
+	}
+
+	return common.ErrUnauthorized.Err()
+}
+
+// moderationTarget verifies the requester can moderate the channel and gets the target character. Moderators that
+// are not chat managers cannot take actions against other moderators.
+func (s chatServiceServer) moderationTarget(
+	ctx context.Context,
+	channelId uint,
+	request *pb.CharacterTarget,
+) (*pb.CharacterDetails, error) {
+	if err := s.verifyChannelModerator(ctx, channelId); err != nil {
+		return nil, err
+	}
+
+	target, err := s.targetCharacter(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	claims, _ := auth.RetrieveClaims(ctx)
+	if claims.HasResourceRole(RoleChatChannelManage, auth.ChatClientId) {
+		return target, nil
+	}
+
+	moderator, err := s.server.ChatService.IsChannelModerator(ctx, channelId, uint(target.Id))
+	if err != nil {
+		log.Logger.WithContext(ctx).Errorf("is channel moderator: %v", err)
+		return nil, common.ErrHandleRequest.Err()
+	}
+	if moderator {
+		return nil, status.Error(codes.PermissionDenied, "cannot moderate another moderator")
+	}
+
+	return target, nil
+}
+
+// socialAction resolves the owner of the target character and performs the given action between the requesting
+// user and that owner.
+func (s chatServiceServer) socialAction(
+	ctx context.Context,
+	request *pb.CharacterTarget,
+	action string,
+	fn func(ctx context.Context, userId string, targetId string) error,
+) (*emptypb.Empty, error) {
+	claims, ok := auth.RetrieveClaims(ctx)
+	if !ok {
+		return nil, common.ErrUnauthorized.Err()
+	}
+
+	// Validate requester has correct permission
+	if !claims.HasResourceRole(RoleChat, auth.ChatClientId) {
+		return nil, common.ErrUnauthorized.Err()
+	}
+
+	target, err := s.targetCharacter(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	err = fn(ctx, claims.Subject, target.Owner)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrFriendSelf):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, service.ErrFriendRequestNotFound),
+			errors.Is(err, service.ErrNotFriends),
+			errors.Is(err, service.ErrNotBlocked):
+			return nil, status.Error(codes.NotFound, err.Error())
+		case errors.Is(err, service.ErrAlreadyFriends),
+			errors.Is(err, service.ErrFriendRequestExists),
+			errors.Is(err, service.ErrAlreadyBlocked):
+			return nil, status.Error(codes.AlreadyExists, err.Error())
+		case errors.Is(err, service.ErrBlocked):
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+
+		log.Logger.WithContext(ctx).Errorf("%s: %v", action, err)
+		return nil, status.Errorf(codes.Internal, "unable to %s", action)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// checkDirectMessageBlocks checks neither the sender nor target blocked the other. Blocks apply to the owning
+// accounts so all characters of a blocked user are covered.
+func (s chatServiceServer) checkDirectMessageBlocks(
+	ctx context.Context,
+	sender *pb.CharacterDetails,
+	target *pb.CharacterDetails,
+) error {
+	blocked, err := s.server.ChatService.HasBlocked(ctx, target.Owner, sender.Owner)
+	if err != nil {
+		log.Logger.WithContext(ctx).Errorf("check blocked: %v", err)
+		return common.ErrHandleRequest.Err()
+	}
+	if blocked {
+		return status.Error(codes.PermissionDenied, "recipient is not accepting messages from you")
+	}
+
+	blocked, err = s.server.ChatService.HasBlocked(ctx, sender.Owner, target.Owner)
+	if err != nil {
+		log.Logger.WithContext(ctx).Errorf("check blocked: %v", err)
+		return common.ErrHandleRequest.Err()
+	}
+	if blocked {
+		return status.Error(codes.FailedPrecondition, "cannot message a blocked user")
+	}
+
+	return nil
+}
+
+// targetCharacter gets the details of the target character using the server context
+func (s chatServiceServer) targetCharacter(ctx context.Context, request *pb.CharacterTarget) (*pb.CharacterDetails, error) {
+	srvCtx, err := s.serverContext(ctx)
+	if err != nil {
+		log.Logger.WithContext(ctx).Errorf("create server context: %v", err)
+		return nil, common.ErrHandleRequest.Err()
+	}
+
+	charClient, err := s.server.GetCharacterClient()
+	if err != nil {
+		log.Logger.WithContext(ctx).Errorf("character client: %v", err)
+		return nil, common.ErrHandleRequest.Err()
+	}
+
+	character, err := charClient.GetCharacter(srvCtx, request)
+	if err != nil {
+		log.Logger.WithContext(ctx).Infof("get target character: %v", err)
+		return nil, common.ErrDoesNotExist.Err()
+	}
+
+	return character, nil
+}
+
+// addCharacterNames fills in the character names for each of the given users
+func (s chatServiceServer) addCharacterNames(ctx context.Context, users []*pb.SocialUser) error {
+	if len(users) == 0 {
+		return nil
+	}
+
+	srvCtx, err := s.serverContext(ctx)
+	if err != nil {
+		log.Logger.WithContext(ctx).Errorf("create server context: %v", err)
+		return common.ErrHandleRequest.Err()
+	}
+
+	charClient, err := s.server.GetCharacterClient()
+	if err != nil {
+		log.Logger.WithContext(ctx).Errorf("character client: %v", err)
+		return common.ErrHandleRequest.Err()
+	}
+
+	for _, user := range users {
+		characters, err := charClient.GetAllCharactersForUser(srvCtx, &pb.UserTarget{Target: &pb.UserTarget_Id{Id: user.UserId}})
+		if err != nil {
+			log.Logger.WithContext(ctx).Errorf("get characters: %v", err)
+			return common.ErrHandleRequest.Err()
+		}
+
+		user.CharacterNames = make([]string, len(characters.Characters))
+		for idx, character := range characters.Characters {
+			user.CharacterNames[idx] = character.Name
+		}
+	}
+
+	return nil
+}
+
+// addMemberNames fills in the character name of each channel member. Members whose character can't be found are
+// left without a name.
+func (s chatServiceServer) addMemberNames(ctx context.Context, members []*pb.ChannelMember) error {
+	if len(members) == 0 {
+		return nil
+	}
+
+	srvCtx, err := s.serverContext(ctx)
+	if err != nil {
+		log.Logger.WithContext(ctx).Errorf("create server context: %v", err)
+		return common.ErrHandleRequest.Err()
+	}
+
+	charClient, err := s.server.GetCharacterClient()
+	if err != nil {
+		log.Logger.WithContext(ctx).Errorf("character client: %v", err)
+		return common.ErrHandleRequest.Err()
+	}
+
+	for _, member := range members {
+		character, err := charClient.GetCharacter(
+			srvCtx,
+			&pb.CharacterTarget{Type: &pb.CharacterTarget_Id{Id: member.CharacterId}},
+		)
+		if err != nil {
+			log.Logger.WithContext(ctx).Warnf("get channel member %d: %v", member.CharacterId, err)
+			continue
+		}
+
+		member.CharacterName = character.Name
+	}
+
+	return nil
+}
+
+func channelMembershipError(ctx context.Context, action string, err error) error {
+	switch {
+	case errors.Is(err, service.ErrChannelNotFound),
+		errors.Is(err, service.ErrNotMember):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, service.ErrAlreadyMember),
+		errors.Is(err, service.ErrAlreadyInvited):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, service.ErrChannelNotJoinable),
+		errors.Is(err, service.ErrNotInvited),
+		errors.Is(err, service.ErrBanned):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, service.ErrWrongDimension),
+		errors.Is(err, service.ErrChannelNotInvitable):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	log.Logger.WithContext(ctx).Errorf("%s: %v", action, err)
+	return status.Errorf(codes.Internal, "unable to %s", action)
+}
+
+// newChatMessage creates a chat message to send from the given character. Only the message body, type and reply
+// are taken from the request while the sender details come from the character.
+func newChatMessage(character *pb.CharacterDetails, request *pb.ChatMessage) *chat.ChatMessage {
+	message := &chat.ChatMessage{
+		SenderCharacterId:   uint(character.Id),
+		SenderCharacterName: character.Name,
+		Dimension:           character.Dimension,
+		Type:                request.Type,
+		Message:             request.Message,
+	}
+
+	if request.ReplyToId > 0 {
+		replyToId := uint(request.ReplyToId)
+		message.ReplyToId = &replyToId
+	}
+
+	return message
+}
+
+func sendMessageError(ctx context.Context, err error) error {
+	if errors.Is(err, service.ErrEmptyMessage) || errors.Is(err, service.ErrInvalidMessageType) {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if errors.Is(err, service.ErrMessageNotFound) {
+		return status.Error(codes.InvalidArgument, "reply to message not found")
+	}
+
+	if errors.Is(err, service.ErrChannelNotFound) {
+		return status.Error(codes.NotFound, err.Error())
+	}
+
+	if errors.Is(err, service.ErrChannelReadOnly) {
+		return status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	if errors.Is(err, chatfilter.ErrMessageTooLong) {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if errors.Is(err, chatfilter.ErrSpam) {
+		return status.Error(codes.ResourceExhausted, err.Error())
+	}
+
+	var rateLimitErr *ratelimit.Error
+	if errors.As(err, &rateLimitErr) {
+		retryAfter := int(math.Ceil(rateLimitErr.RetryAfter.Seconds()))
+		if err := grpc.SetHeader(ctx, metadata.Pairs("retry-after", strconv.Itoa(retryAfter))); err != nil {
+			log.Logger.WithContext(ctx).Warnf("set retry-after header: %v", err)
+		}
+		return status.Error(codes.ResourceExhausted, "sending messages too quickly")
+	}
+
+	log.Logger.WithContext(ctx).Errorf("send chat message: %v", err)
+	return status.Errorf(codes.Internal, "unable to send message")
+}
+
+// isOwnCharacter checks if the character is owned by the user. If the owner cannot be determined, the character is not
+// considered owned by the user.
+func (s chatServiceServer) isOwnCharacter(ctx context.Context, userId string, characterId uint64) bool {
+	character, err := s.targetCharacter(ctx, &pb.CharacterTarget{Type: &pb.CharacterTarget_Id{Id: characterId}})
+	if err != nil {
+		log.Logger.WithContext(ctx).Warnf("unable to find owner of character %d: %v", characterId, err)
+		return false
+	}
+
+	return character.Owner == userId
+}
+
+// blockFilter determines whether messages sent by a character should be hidden from a user because the user
+// blocked the owner of the sending character. Owners are cached for the lifetime of the filter. The blocked users are
+// loaded when the filter is first used and again after they changed.
+type blockFilter struct {
+	s       chatServiceServer
+	userId  string
+	owners  map[string]string
+	blocked map[string]struct{}
+
+	// stale set when the blocked users need to be loaded again
+	stale atomic.Bool
+}
+
+func (s chatServiceServer) newBlockFilter(userId string) *blockFilter {
+	filter := &blockFilter{
+		s:      s,
+		userId: userId,
+		owners: make(map[string]string),
+	}
+	filter.stale.Store(true)
+
+	return filter
+}
+
+// isBlocked checks if the owner of the given character is blocked. If the owner cannot be determined, the
+// message is not considered blocked.
+func (f *blockFilter) isBlocked(ctx context.Context, characterName string) bool {
+	owner, ok := f.owners[characterName]
+	if !ok {
+		character, err := f.s.targetCharacter(
+			ctx,
+			&pb.CharacterTarget{Type: &pb.CharacterTarget_Name{Name: characterName}},
+		)
+		if err != nil {
+			log.Logger.WithContext(ctx).Warnf("unable to find owner of %s: %v", characterName, err)
+			return false
+		}
+
+		owner = character.Owner
+		f.owners[characterName] = owner
+	}
+
+	if owner == f.userId {
+		return false
+	}
+
+	if f.stale.Swap(false) {
+		f.loadBlocked(ctx)
+	}
+
+	_, blocked := f.blocked[owner]
+	return blocked
+}
+
+// loadBlocked loads the users blocked by the user. If they cannot be loaded, the previously loaded users are kept and
+// loading is tried again on the next message.
+func (f *blockFilter) loadBlocked(ctx context.Context) {
+	blocks, err := f.s.server.ChatService.GetBlockedUsers(ctx, f.userId)
+	if err != nil {
+		log.Logger.WithContext(ctx).Errorf("get blocked users: %v", err)
+		f.stale.Store(true)
+		return
+	}
+
+	f.blocked = make(map[string]struct{}, len(blocks))
+	for _, block := range blocks {
+		f.blocked[block.BlockedId] = struct{}{}
+	}
+}
+
+// blockFilters tracks the block filters of the streams on this replica so they load the blocked users of their user
+// again when the user blocks or unblocks someone on any replica
+type blockFilters struct {
+	mu      sync.Mutex
+	filters map[string]map[*blockFilter]struct{}
+}
+
+func newBlockFilters() *blockFilters {
+	return &blockFilters{
+		filters: make(map[string]map[*blockFilter]struct{}),
+	}
+}
+
+// register tracks the filter of a stream until the returned function is called when the stream ends
+func (b *blockFilters) register(filter *blockFilter) func() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.filters[filter.userId] == nil {
+		b.filters[filter.userId] = make(map[*blockFilter]struct{})
+	}
+	b.filters[filter.userId][filter] = struct{}{}
+
+	return func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		delete(b.filters[filter.userId], filter)
+		if len(b.filters[filter.userId]) == 0 {
+			delete(b.filters, filter.userId)
+		}
+	}
+}
+
+// invalidate marks the filters of the user stale
+func (b *blockFilters) invalidate(userId string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for filter := range b.filters[userId] {
+		filter.stale.Store(true)
+	}
+}
+
+// invalidateAll marks every filter stale
+func (b *blockFilters) invalidateAll() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for _, filters := range b.filters {
+		for filter := range filters {
+			filter.stale.Store(true)
+		}
+	}
+}
+
+// watch invalidates the filters of users whose blocked users changed until the context is done. Changes may be
+// missed while not subscribed, so every filter is invalidated when subscribing again.
+func (b *blockFilters) watch(ctx context.Context, chatService service.ChatService) {
+	for {
+		sub, err := chatService.BlockChangesReader(ctx)
+		if err == nil {
+			b.invalidateAll()
+			err = b.read(ctx, sub)
+			_ = sub.Close()
+		}
+		if ctx.Err() != nil {
+			return
+		}
+
+		log.Logger.WithContext(ctx).Errorf("block changes: %v", err)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(blockChangesRetryDelay):
+		}
+	}
+}
+
+// read invalidates the filters of the user each block change is keyed by until reading fails
+func (b *blockFilters) read(ctx context.Context, sub messagebus.Subscription) error {
+	for {
+		msg, err := sub.ReadMessage(ctx)
+		if err != nil {
+			return err
+		}
+
+		b.invalidate(string(msg.Key))
+	}
+}
@@ -2,7 +2,6 @@ package srv_test
 
 import (
 	"context"
-	"fmt"
 	"io"
 	"time"
 
@@ -11,20 +10,24 @@ import (
 	"github.com/ShatteredRealms/go-backend/pkg/config"
 	"github.com/ShatteredRealms/go-backend/pkg/helpers"
 	"github.com/ShatteredRealms/go-backend/pkg/log"
+	"github.com/ShatteredRealms/go-backend/pkg/messagebus"
 	"github.com/ShatteredRealms/go-backend/pkg/mocks"
 	"github.com/ShatteredRealms/go-backend/pkg/model/character"
 	"github.com/ShatteredRealms/go-backend/pkg/model/chat"
 	"github.com/ShatteredRealms/go-backend/pkg/model/game"
 	"github.com/ShatteredRealms/go-backend/pkg/pb"
-	"github.com/ShatteredRealms/go-backend/pkg/repository"
+	"github.com/ShatteredRealms/go-backend/pkg/service"
 	"github.com/ShatteredRealms/go-backend/pkg/srv"
 	"github.com/bxcodec/faker/v4"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
-	"github.com/segmentio/kafka-go"
 	"github.com/sirupsen/logrus/hooks/test"
 	"go.opentelemetry.io/otel"
 	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"gorm.io/gorm"
 )
@@ -42,13 +45,7 @@ var _ = Describe("Chat", func() {
 		chatCtx *app.ChatServerContext
 		server  pb.ChatServiceServer
 
-		kafkaConn    *kafka.Conn
-		readerConfig = kafka.ReaderConfig{
-			Topic:    topicName,
-			MinBytes: 1,
-			MaxBytes: 10e3,
-		}
-		kafkaWriter *kafka.Writer
+		bus messagebus.Bus
 
 		chatChannel *chat.ChatChannel
 		char        *character.Character
@@ -70,8 +67,20 @@ var _ = Describe("Chat", func() {
 			ChatService: mockChatService,
 		}
 
+		// Block changes are read until the server context is cancelled
+		blockChanges := messagebus.NewMemoryBus()
+		Expect(blockChanges.CreateTopics(ctx, messagebus.Topic{Name: "blocks"})).To(Succeed())
+		mockChatService.EXPECT().
+			BlockChangesReader(gomock.Any()).
+			DoAndReturn(func(ctx context.Context) (messagebus.Subscription, error) {
+				return blockChanges.Subscribe(ctx, "blocks", time.Time{})
+			}).
+			AnyTimes()
+		serverCtx, cancel := context.WithCancel(ctx)
+		DeferCleanup(cancel)
+
 		var err error
-		server, err = srv.NewChatServiceServer(ctx, chatCtx)
+		server, err = srv.NewChatServiceServer(serverCtx, chatCtx)
 		Expect(err).NotTo(HaveOccurred())
 		Expect(server).NotTo(BeNil())
 
@@ -108,50 +117,28 @@ var _ = Describe("Chat", func() {
 		hook.Reset()
 	})
 
-	Context("with kafka", func() {
+	Context("with a message bus", func() {
 		var (
 			msg              *pb.ChatMessage
 			writeMessageFunc = func(g Gomega) error {
-				err := kafkaConn.CreateTopics(kafka.TopicConfig{
-					Topic:             topicName,
-					NumPartitions:     1,
-					ReplicationFactor: 1,
-				})
+				value, err := proto.Marshal(msg)
 				if err != nil {
 					return err
 				}
-				return kafkaWriter.WriteMessages(context.Background(), kafka.Message{
+				return bus.Publish(context.Background(), topicName, messagebus.Message{
 					Key:   []byte(msg.CharacterName),
-					Value: []byte(msg.Message),
+					Value: value,
 				})
 			}
+			subscribe = func() messagebus.Subscription {
+				sub, err := bus.Subscribe(context.Background(), topicName, time.Time{})
+				Expect(err).NotTo(HaveOccurred())
+				return sub
+			}
 		)
 		BeforeEach(func() {
-			Eventually(func(g Gomega) error {
-				var err error
-				kafkaConn, err = repository.ConnectKafka(config.ServerAddress{
-					Port: kafkaPort,
-					Host: "127.0.0.1",
-				})
-				if err != nil {
-					return err
-				}
-				Expect(kafkaConn).NotTo(BeNil())
-
-				readerConfig.Brokers = []string{fmt.Sprintf("127.0.0.1:%s", kafkaPort)}
-				kafkaWriter = &kafka.Writer{
-					Addr:     kafkaConn.RemoteAddr(),
-					Topic:    topicName,
-					Balancer: &kafka.LeastBytes{},
-					Async:    true,
-				}
-
-				return kafkaConn.CreateTopics(kafka.TopicConfig{
-					Topic:             topicName,
-					NumPartitions:     1,
-					ReplicationFactor: 1,
-				})
-			}).Within(time.Minute).Should(Succeed())
+			bus = messagebus.NewMemoryBus()
+			Expect(bus.CreateTopics(context.Background(), messagebus.Topic{Name: topicName})).To(Succeed())
 			msg = &pb.ChatMessage{
 				Message:       faker.Username(),
 				CharacterName: char.Name,
@@ -159,10 +146,10 @@ var _ = Describe("Chat", func() {
 		})
 
 		Describe("ConnectChannel", func() {
-			var req *pb.ChatChannelTarget
+			var req *pb.ConnectChannelRequest
 			var mockInSrv *mocks.MockChatService_ConnectChannelServer
 			BeforeEach(func() {
-				req = &pb.ChatChannelTarget{
+				req = &pb.ConnectChannelRequest{
 					Id: 1,
 				}
 				mockInSrv = mocks.NewMockChatService_ConnectChannelServer(mockController)
@@ -170,15 +157,46 @@ var _ = Describe("Chat", func() {
 
 			When("given valid input", func() {
 				It("should work for users with chat manager permissions (admin)", func() {
-					mockChatService.EXPECT().ChannelMessagesReader(gomock.Any(), uint(req.Id)).Return(kafka.NewReader(readerConfig))
+					mockChatService.EXPECT().ChannelMessagesReader(gomock.Any(), uint(req.Id), uint(0)).Return(subscribe(), nil)
 					mockInSrv.EXPECT().Context().Return(incAdminCtx).AnyTimes()
 					mockInSrv.EXPECT().Send(gomock.Any()).Return(io.EOF)
 					Eventually(writeMessageFunc).Within(time.Second * 15).Should(Succeed())
 					Expect(server.ConnectChannel(req, mockInSrv)).To(MatchError(io.EOF))
 				})
 
+				It("should hide messages from blocked users", func() {
+					blocked := &pb.CharacterDetails{Name: faker.Username(), Owner: faker.UUIDHyphenated()}
+					own := &pb.CharacterDetails{Name: faker.Username(), Owner: *admin.ID}
+					mockChatService.EXPECT().ChannelMessagesReader(gomock.Any(), uint(req.Id), uint(0)).Return(subscribe(), nil)
+					mockCharService.EXPECT().
+						GetCharacter(gomock.Any(), gomock.Any()).
+						DoAndReturn(func(_ context.Context, target *pb.CharacterTarget, _ ...grpc.CallOption) (*pb.CharacterDetails, error) {
+							if target.GetName() == blocked.Name {
+								return blocked, nil
+							}
+							return own, nil
+						}).
+						Times(2)
+					mockChatService.EXPECT().
+						GetBlockedUsers(gomock.Any(), *admin.ID).
+						Return(chat.UserBlocks{{OwningId: *admin.ID, BlockedId: blocked.Owner}}, nil)
+					mockInSrv.EXPECT().Context().Return(incAdminCtx).AnyTimes()
+					mockInSrv.EXPECT().
+						Send(gomock.Any()).
+						DoAndReturn(func(message *pb.ChatMessage) error {
+							Expect(message.CharacterName).To(Equal(own.Name))
+							return io.EOF
+						})
+
+					msg.CharacterName = blocked.Name
+					Expect(writeMessageFunc(Default)).To(Succeed())
+					msg.CharacterName = own.Name
+					Expect(writeMessageFunc(Default)).To(Succeed())
+					Expect(server.ConnectChannel(req, mockInSrv)).To(MatchError(io.EOF))
+				})
+
 				It("should work for users with chat permissions (player)", func() {
-					mockChatService.EXPECT().ChannelMessagesReader(gomock.Any(), uint(req.Id)).Return(kafka.NewReader(readerConfig))
+					mockChatService.EXPECT().ChannelMessagesReader(gomock.Any(), uint(req.Id), uint(0)).Return(subscribe(), nil)
 					mockCharService.EXPECT().
 						GetAllCharactersForUser(gomock.Any(), gomock.Any()).
 						Return(&pb.CharactersDetails{Characters: []*pb.CharacterDetails{char.ToPb()}}, nil)
@@ -196,6 +214,31 @@ var _ = Describe("Chat", func() {
 					Expect(server.ConnectChannel(req, mockInSrv)).To(MatchError(common.ErrUnauthorized.Err()))
 				})
 
+				It("should close the stream when the user is removed from the channel", func() {
+					char.OwnerId = *admin.ID
+					msg = &pb.ChatMessage{
+						Type:        pb.ChatMessageType_CHARACTER_REMOVED,
+						CharacterId: uint64(char.ID),
+						ChannelId:   req.Id,
+						SentAt:      time.Now().Add(time.Minute).UnixMilli(),
+					}
+					mockChatService.EXPECT().ChannelMessagesReader(gomock.Any(), uint(req.Id), uint(0)).Return(subscribe(), nil)
+					mockCharService.EXPECT().GetCharacter(gomock.Any(), gomock.Any()).Return(char.ToPb(), nil)
+					mockInSrv.EXPECT().Context().Return(incAdminCtx).AnyTimes()
+					Expect(writeMessageFunc(Default)).To(Succeed())
+					err := server.ConnectChannel(req, mockInSrv)
+					Expect(status.Code(err)).To(Equal(codes.PermissionDenied))
+				})
+
+				It("should error if resuming from an unknown message", func() {
+					req.AfterMessageId = 10
+					mockChatService.EXPECT().
+						ChannelMessagesReader(gomock.Any(), uint(req.Id), uint(req.AfterMessageId)).
+						Return(nil, service.ErrMessageNotFound)
+					mockInSrv.EXPECT().Context().Return(incAdminCtx).AnyTimes()
+					Expect(server.ConnectChannel(req, mockInSrv)).To(HaveOccurred())
+				})
+
 				It("should error on no permissions (guest)", func() {
 					mockInSrv.EXPECT().Context().Return(incGuestCtx).AnyTimes()
 					Expect(server.ConnectChannel(req, mockInSrv)).To(MatchError(common.ErrUnauthorized.Err()))
@@ -242,7 +285,7 @@ var _ = Describe("Chat", func() {
 			When("given valid input", func() {
 				It("should work for users with chat manager permissions (admin)", func() {
 					char.OwnerId = *admin.ID
-					mockChatService.EXPECT().DirectMessagesReader(gomock.Any(), char.Name).Return(kafka.NewReader(readerConfig))
+					mockChatService.EXPECT().DirectMessagesReader(gomock.Any(), char.Name).Return(subscribe(), nil)
 					mockCharService.EXPECT().
 						GetCharacter(gomock.Any(), gomock.Any()).
 						Return(char.ToPb(), nil)
@@ -253,7 +296,7 @@ var _ = Describe("Chat", func() {
 				})
 
 				It("should work for users with chat manager permissions (admin other)", func() {
-					mockChatService.EXPECT().DirectMessagesReader(gomock.Any(), char.Name).Return(kafka.NewReader(readerConfig))
+					mockChatService.EXPECT().DirectMessagesReader(gomock.Any(), char.Name).Return(subscribe(), nil)
 					mockCharService.EXPECT().
 						GetCharacter(gomock.Any(), gomock.Any()).
 						Return(char.ToPb(), nil)
@@ -264,7 +307,7 @@ var _ = Describe("Chat", func() {
 				})
 
 				It("should work for users with chat permissions (player)", func() {
-					mockChatService.EXPECT().DirectMessagesReader(gomock.Any(), char.Name).Return(kafka.NewReader(readerConfig))
+					mockChatService.EXPECT().DirectMessagesReader(gomock.Any(), char.Name).Return(subscribe(), nil)
 					mockCharService.EXPECT().
 						GetCharacter(gomock.Any(), gomock.Any()).
 						Return(char.ToPb(), nil)
@@ -331,8 +374,11 @@ var _ = Describe("Chat", func() {
 						GetCharacter(gomock.Any(), gomock.Any()).
 						Return(char.ToPb(), nil)
 					mockChatService.EXPECT().
-						SendChannelMessage(gomock.Any(), gomock.Any(), gomock.Any(), uint(req.ChannelId)).
-						Return(nil)
+						ActiveModeration(gomock.Any(), uint(req.ChannelId), char.ID, pb.ChatModerationType_MUTE).
+						Return(nil, nil)
+					mockChatService.EXPECT().
+						SendChannelMessage(gomock.Any(), uint(req.ChannelId), gomock.Any()).
+						Return(&chat.ChatMessage{}, nil)
 					out, err := server.SendChatMessage(incAdminCtx, req)
 					Expect(err).NotTo(HaveOccurred())
 					Expect(out).NotTo(BeNil())
@@ -343,12 +389,15 @@ var _ = Describe("Chat", func() {
 					mockCharService.EXPECT().
 						GetCharacter(gomock.Any(), gomock.Any()).
 						Return(char.ToPb(), nil)
+					mockChatService.EXPECT().
+						ActiveModeration(gomock.Any(), uint(req.ChannelId), char.ID, pb.ChatModerationType_MUTE).
+						Return(nil, nil)
 					mockChatService.EXPECT().
 						AuthorizedChannelsForCharacter(gomock.Any(), char.ID).
 						Return(chat.ChatChannels{chatChannel}, nil)
 					mockChatService.EXPECT().
-						SendChannelMessage(gomock.Any(), gomock.Any(), gomock.Any(), uint(req.ChannelId)).
-						Return(nil)
+						SendChannelMessage(gomock.Any(), uint(req.ChannelId), gomock.Any()).
+						Return(&chat.ChatMessage{}, nil)
 					out, err := server.SendChatMessage(incPlayerCtx, req)
 					Expect(err).NotTo(HaveOccurred())
 					Expect(out).NotTo(BeNil())
@@ -372,6 +421,13 @@ var _ = Describe("Chat", func() {
 					Expect(out).To(BeNil())
 				})
 
+				It("should err sending announcements without permission (player)", func() {
+					req.ChatMessage.Type = pb.ChatMessageType_ANNOUNCEMENT
+					out, err := server.SendChatMessage(incPlayerCtx, req)
+					Expect(err).To(MatchError(common.ErrUnauthorized.Err()))
+					Expect(out).To(BeNil())
+				})
+
 				It("should err if not owner (admin)", func() {
 					mockCharService.EXPECT().
 						GetCharacter(gomock.Any(), gomock.Any()).
@@ -391,10 +447,25 @@ var _ = Describe("Chat", func() {
 					Expect(out).To(BeNil())
 				})
 
+				It("should err if muted (player)", func() {
+					mockCharService.EXPECT().
+						GetCharacter(gomock.Any(), gomock.Any()).
+						Return(char.ToPb(), nil)
+					mockChatService.EXPECT().
+						ActiveModeration(gomock.Any(), uint(req.ChannelId), char.ID, pb.ChatModerationType_MUTE).
+						Return(&chat.ChatChannelModeration{Type: pb.ChatModerationType_MUTE}, nil)
+					out, err := server.SendChatMessage(incPlayerCtx, req)
+					Expect(err).To(HaveOccurred())
+					Expect(out).To(BeNil())
+				})
+
 				It("should err if no channel permission (player)", func() {
 					mockCharService.EXPECT().
 						GetCharacter(gomock.Any(), gomock.Any()).
 						Return(char.ToPb(), nil)
+					mockChatService.EXPECT().
+						ActiveModeration(gomock.Any(), uint(req.ChannelId), char.ID, pb.ChatModerationType_MUTE).
+						Return(nil, nil)
 					mockChatService.EXPECT().
 						AuthorizedChannelsForCharacter(gomock.Any(), char.ID).
 						Return(chat.ChatChannels{}, nil)
@@ -416,6 +487,9 @@ var _ = Describe("Chat", func() {
 					mockCharService.EXPECT().
 						GetCharacter(gomock.Any(), gomock.Any()).
 						Return(char.ToPb(), nil)
+					mockChatService.EXPECT().
+						ActiveModeration(gomock.Any(), uint(req.ChannelId), char.ID, pb.ChatModerationType_MUTE).
+						Return(nil, nil)
 					mockChatService.EXPECT().
 						AuthorizedChannelsForCharacter(gomock.Any(), char.ID).
 						Return(nil, fakeErr)
@@ -428,12 +502,15 @@ var _ = Describe("Chat", func() {
 					mockCharService.EXPECT().
 						GetCharacter(gomock.Any(), gomock.Any()).
 						Return(char.ToPb(), nil)
+					mockChatService.EXPECT().
+						ActiveModeration(gomock.Any(), uint(req.ChannelId), char.ID, pb.ChatModerationType_MUTE).
+						Return(nil, nil)
 					mockChatService.EXPECT().
 						AuthorizedChannelsForCharacter(gomock.Any(), char.ID).
 						Return(chat.ChatChannels{chatChannel}, nil)
 					mockChatService.EXPECT().
-						SendChannelMessage(gomock.Any(), gomock.Any(), gomock.Any(), uint(req.ChannelId)).
-						Return(fakeErr)
+						SendChannelMessage(gomock.Any(), uint(req.ChannelId), gomock.Any()).
+						Return(nil, fakeErr)
 					out, err := server.SendChatMessage(incPlayerCtx, req)
 					Expect(err).To(HaveOccurred())
 					Expect(out).To(BeNil())
@@ -460,10 +537,15 @@ var _ = Describe("Chat", func() {
 					char.OwnerId = *admin.ID
 					mockCharService.EXPECT().
 						GetCharacter(gomock.Any(), gomock.Any()).
-						Return(char.ToPb(), nil)
+						Return(char.ToPb(), nil).
+						Times(2)
 					mockChatService.EXPECT().
-						SendDirectMessage(gomock.Any(), gomock.Any(), gomock.Any(), char.Name).
-						Return(nil)
+						HasBlocked(gomock.Any(), gomock.Any(), gomock.Any()).
+						Return(false, nil).
+						Times(2)
+					mockChatService.EXPECT().
+						SendDirectMessage(gomock.Any(), char.Name, gomock.Any()).
+						Return(&chat.ChatMessage{}, nil)
 					out, err := server.SendDirectMessage(incAdminCtx, req)
 					Expect(err).NotTo(HaveOccurred())
 					Expect(out).NotTo(BeNil())
@@ -473,10 +555,15 @@ var _ = Describe("Chat", func() {
 					char.OwnerId = *player.ID
 					mockCharService.EXPECT().
 						GetCharacter(gomock.Any(), gomock.Any()).
-						Return(char.ToPb(), nil)
+						Return(char.ToPb(), nil).
+						Times(2)
+					mockChatService.EXPECT().
+						HasBlocked(gomock.Any(), gomock.Any(), gomock.Any()).
+						Return(false, nil).
+						Times(2)
 					mockChatService.EXPECT().
-						SendDirectMessage(gomock.Any(), gomock.Any(), gomock.Any(), char.Name).
-						Return(nil)
+						SendDirectMessage(gomock.Any(), char.Name, gomock.Any()).
+						Return(&chat.ChatMessage{}, nil)
 					out, err := server.SendDirectMessage(incPlayerCtx, req)
 					Expect(err).NotTo(HaveOccurred())
 					Expect(out).NotTo(BeNil())
@@ -524,13 +611,31 @@ var _ = Describe("Chat", func() {
 					Expect(out).To(BeNil())
 				})
 
+				It("should err if the recipient blocked the sender (player)", func() {
+					mockCharService.EXPECT().
+						GetCharacter(gomock.Any(), gomock.Any()).
+						Return(char.ToPb(), nil).
+						Times(2)
+					mockChatService.EXPECT().
+						HasBlocked(gomock.Any(), gomock.Any(), gomock.Any()).
+						Return(true, nil)
+					out, err := server.SendDirectMessage(incPlayerCtx, req)
+					Expect(err).To(HaveOccurred())
+					Expect(out).To(BeNil())
+				})
+
 				It("should error if sending message fails", func() {
 					mockCharService.EXPECT().
 						GetCharacter(gomock.Any(), gomock.Any()).
-						Return(char.ToPb(), nil)
+						Return(char.ToPb(), nil).
+						Times(2)
+					mockChatService.EXPECT().
+						HasBlocked(gomock.Any(), gomock.Any(), gomock.Any()).
+						Return(false, nil).
+						Times(2)
 					mockChatService.EXPECT().
-						SendDirectMessage(gomock.Any(), gomock.Any(), gomock.Any(), char.Name).
-						Return(fakeErr)
+						SendDirectMessage(gomock.Any(), char.Name, gomock.Any()).
+						Return(nil, fakeErr)
 					out, err := server.SendDirectMessage(incPlayerCtx, req)
 					Expect(err).To(HaveOccurred())
 					Expect(out).To(BeNil())
@@ -612,10 +717,15 @@ var _ = Describe("Chat", func() {
 			It("should work (admin)", func() {
 				mockChatService.EXPECT().
 					CreateChannel(gomock.Any(), gomock.Eq(&chat.ChatChannel{Name: req.Name, Dimension: req.Dimension})).
-					Return(nil, nil)
+					DoAndReturn(func(_ context.Context, channel *chat.ChatChannel) (*chat.ChatChannel, error) {
+						channel.ID = 10
+						return channel, nil
+					})
 				out, err := server.CreateChannel(incAdminCtx, req)
 				Expect(err).NotTo(HaveOccurred())
 				Expect(out).NotTo(BeNil())
+				Expect(out.Id).To(BeEquivalentTo(10))
+				Expect(out.Name).To(Equal(req.Name))
 			})
 		})
 
@@ -790,7 +900,16 @@ var _ = Describe("Chat", func() {
 					UpdateChannel(gomock.Any(), req).
 					Return(nil, gorm.ErrRecordNotFound)
 				out, err := server.EditChannel(incAdminCtx, req)
-				Expect(err).To(HaveOccurred())
+				Expect(status.Code(err)).To(Equal(codes.NotFound))
+				Expect(out).To(BeNil())
+			})
+
+			It("should error if the channel does not exist", func() {
+				mockChatService.EXPECT().
+					UpdateChannel(gomock.Any(), req).
+					Return(nil, service.ErrChannelNotFound)
+				out, err := server.EditChannel(incAdminCtx, req)
+				Expect(status.Code(err)).To(Equal(codes.NotFound))
 				Expect(out).To(BeNil())
 			})
 		})
@@ -1022,6 +1141,15 @@ var _ = Describe("Chat", func() {
 				Expect(out).To(BeNil())
 			})
 
+			It("should error if banned from a channel", func() {
+				mockChatService.EXPECT().
+					ChangeAuthorizationForCharacter(gomock.Any(), char.ID, *helpers.ArrayOfUint64ToUint(&req.Ids), req.Add).
+					Return(service.ErrBanned)
+				out, err := server.UpdateUserChatChannelAuthorizations(incAdminCtx, req)
+				Expect(err).To(HaveOccurred())
+				Expect(out).To(BeNil())
+			})
+
 			It("should unique error if conflict exists", func() {
 				mockChatService.EXPECT().
 					ChangeAuthorizationForCharacter(gomock.Any(), char.ID, *helpers.ArrayOfUint64ToUint(&req.Ids), req.Add).
@@ -1032,4 +1160,200 @@ var _ = Describe("Chat", func() {
 			})
 		})
 	})
+
+	Describe("MarkRead", func() {
+		var (
+			req *pb.MarkReadRequest
+		)
+		BeforeEach(func() {
+			req = &pb.MarkReadRequest{
+				Character: &pb.CharacterTarget{
+					Type: &pb.CharacterTarget_Id{
+						Id: uint64(char.ID),
+					},
+				},
+				MessageId: 10,
+			}
+		})
+		When("given valid input", func() {
+			It("should work (player)", func() {
+				mockCharService.EXPECT().
+					GetCharacter(gomock.Any(), gomock.Any()).
+					Return(char.ToPb(), nil)
+				mockChatService.EXPECT().
+					MarkRead(gomock.Any(), char.ID, char.Name, uint(req.MessageId)).
+					Return(nil)
+				out, err := server.MarkRead(incPlayerCtx, req)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(out).NotTo(BeNil())
+			})
+		})
+
+		When("given invalid input", func() {
+			It("should error if invalid permission (guest)", func() {
+				out, err := server.MarkRead(incGuestCtx, req)
+				Expect(err).To(HaveOccurred())
+				Expect(out).To(BeNil())
+			})
+
+			It("should error if the message was not sent to the character", func() {
+				mockCharService.EXPECT().
+					GetCharacter(gomock.Any(), gomock.Any()).
+					Return(char.ToPb(), nil)
+				mockChatService.EXPECT().
+					MarkRead(gomock.Any(), char.ID, char.Name, uint(req.MessageId)).
+					Return(service.ErrMessageNotFound)
+				out, err := server.MarkRead(incPlayerCtx, req)
+				Expect(status.Code(err)).To(Equal(codes.NotFound))
+				Expect(out).To(BeNil())
+			})
+		})
+	})
+
+	Describe("JoinChannel", func() {
+		var (
+			req *pb.ChannelMembershipRequest
+		)
+		BeforeEach(func() {
+			req = &pb.ChannelMembershipRequest{
+				ChannelId: uint64(chatChannel.ID),
+				Character: &pb.CharacterTarget{
+					Type: &pb.CharacterTarget_Id{
+						Id: uint64(char.ID),
+					},
+				},
+			}
+		})
+		When("given valid input", func() {
+			It("should work (player)", func() {
+				mockCharService.EXPECT().
+					GetCharacter(gomock.Any(), gomock.Any()).
+					Return(char.ToPb(), nil)
+				mockChatService.EXPECT().
+					JoinChannel(gomock.Any(), chatChannel.ID, char.ID, char.Dimension).
+					Return(nil)
+				out, err := server.JoinChannel(incPlayerCtx, req)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(out).NotTo(BeNil())
+			})
+		})
+
+		When("given invalid input", func() {
+			It("should error if invalid context (no claims)", func() {
+				out, err := server.JoinChannel(context.Background(), req)
+				Expect(err).To(HaveOccurred())
+				Expect(out).To(BeNil())
+			})
+
+			It("should error if invalid permission (guest)", func() {
+				out, err := server.JoinChannel(incGuestCtx, req)
+				Expect(err).To(HaveOccurred())
+				Expect(out).To(BeNil())
+			})
+
+			It("should error if the character is not owned", func() {
+				char.OwnerId = *admin.ID
+				mockCharService.EXPECT().
+					GetCharacter(gomock.Any(), gomock.Any()).
+					Return(char.ToPb(), nil)
+				out, err := server.JoinChannel(incPlayerCtx, req)
+				Expect(err).To(MatchError(common.ErrUnauthorized.Err()))
+				Expect(out).To(BeNil())
+			})
+
+			It("should error if not invited", func() {
+				mockCharService.EXPECT().
+					GetCharacter(gomock.Any(), gomock.Any()).
+					Return(char.ToPb(), nil)
+				mockChatService.EXPECT().
+					JoinChannel(gomock.Any(), chatChannel.ID, char.ID, char.Dimension).
+					Return(service.ErrNotInvited)
+				out, err := server.JoinChannel(incPlayerCtx, req)
+				Expect(status.Code(err)).To(Equal(codes.PermissionDenied))
+				Expect(out).To(BeNil())
+			})
+		})
+	})
+
+	Describe("ModerateCharacter", func() {
+		var (
+			req *pb.ChatModerationRequest
+		)
+		BeforeEach(func() {
+			req = &pb.ChatModerationRequest{
+				ChannelId: uint64(chatChannel.ID),
+				Character: &pb.CharacterTarget{
+					Type: &pb.CharacterTarget_Id{
+						Id: uint64(char.ID),
+					},
+				},
+				Type:     pb.ChatModerationType_MUTE,
+				Reason:   faker.Sentence(),
+				Duration: 60,
+			}
+		})
+		When("given valid input", func() {
+			It("should work (admin)", func() {
+				mockCharService.EXPECT().
+					GetCharacter(gomock.Any(), gomock.Any()).
+					Return(char.ToPb(), nil)
+				mockChatService.EXPECT().
+					ModerateCharacter(gomock.Any(), gomock.Any(), time.Minute).
+					DoAndReturn(func(_ context.Context, moderation *chat.ChatChannelModeration, _ time.Duration) (*chat.ChatChannelModeration, error) {
+						Expect(moderation.ChannelId).To(Equal(chatChannel.ID))
+						Expect(moderation.CharacterId).To(Equal(char.ID))
+						Expect(moderation.ModeratorId).To(Equal(*admin.ID))
+						return moderation, nil
+					})
+				out, err := server.ModerateCharacter(incAdminCtx, req)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(out).NotTo(BeNil())
+			})
+		})
+
+		When("given invalid input", func() {
+			It("should error if invalid context (no claims)", func() {
+				out, err := server.ModerateCharacter(context.Background(), req)
+				Expect(err).To(HaveOccurred())
+				Expect(out).To(BeNil())
+			})
+
+			It("should error if invalid permission (guest)", func() {
+				out, err := server.ModerateCharacter(incGuestCtx, req)
+				Expect(err).To(HaveOccurred())
+				Expect(out).To(BeNil())
+			})
+
+			It("should error if the moderation type is unknown", func() {
+				req.Type = pb.ChatModerationType(10)
+				out, err := server.ModerateCharacter(incAdminCtx, req)
+				Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+				Expect(out).To(BeNil())
+			})
+
+			It("should error if not a channel moderator (player)", func() {
+				mockCharService.EXPECT().
+					GetAllCharactersForUser(gomock.Any(), gomock.Any()).
+					Return(&pb.CharactersDetails{Characters: []*pb.CharacterDetails{char.ToPb()}}, nil)
+				mockChatService.EXPECT().
+					IsChannelModerator(gomock.Any(), chatChannel.ID, char.ID).
+					Return(false, nil)
+				out, err := server.ModerateCharacter(incPlayerCtx, req)
+				Expect(err).To(MatchError(common.ErrUnauthorized.Err()))
+				Expect(out).To(BeNil())
+			})
+
+			It("should error if moderating fails", func() {
+				mockCharService.EXPECT().
+					GetCharacter(gomock.Any(), gomock.Any()).
+					Return(char.ToPb(), nil)
+				mockChatService.EXPECT().
+					ModerateCharacter(gomock.Any(), gomock.Any(), time.Minute).
+					Return(nil, fakeErr)
+				out, err := server.ModerateCharacter(incAdminCtx, req)
+				Expect(err).To(HaveOccurred())
+				Expect(out).To(BeNil())
+			})
+		})
+	})
 })
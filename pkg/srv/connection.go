@@ -3,6 +3,7 @@ package srv
 import (
 	"context"
 	"fmt"
+	"maps"
 
 	v1 "agones.dev/agones/pkg/apis/agones/v1"
 	aav1 "agones.dev/agones/pkg/apis/allocation/v1"
@@ -12,6 +13,7 @@ import (
 	"github.com/ShatteredRealms/go-backend/pkg/common"
 	"github.com/ShatteredRealms/go-backend/pkg/config"
 	"github.com/ShatteredRealms/go-backend/pkg/log"
+	model "github.com/ShatteredRealms/go-backend/pkg/model/gamebackend"
 	"github.com/ShatteredRealms/go-backend/pkg/pb"
 	"github.com/WilSimpson/gocloak/v13"
 	"github.com/google/uuid"
@@ -85,6 +87,10 @@ func (s connectionServiceServer) ConnectGameServer(
 
 	// Check if player is playing
 	out, err := s.agones.AgonesV1().GameServers("sro").List(ctx, metav1.ListOptions{})
+	if err != nil {
+		log.Logger.WithContext(ctx).Errorf("list gameservers: %v", err)
+		return nil, status.Error(codes.Internal, "unable to check if character is playing")
+	}
 	for _, gs := range out.Items {
 		for _, pId := range gs.Status.Players.IDs {
 			if character.GetOwner() == pId {
@@ -152,9 +158,42 @@ func (s connectionServiceServer) VerifyConnect(
 		return nil, status.Errorf(codes.Internal, "unable to find character")
 	}
 
+	// The character is online once the server accepts the connection. Failing to report it should not prevent the
+	// character from playing.
+	presence := &model.Presence{
+		CharacterId: uint(character.Id),
+		Status:      pb.PresenceStatus_ONLINE,
+		Dimension:   character.Dimension,
+	}
+	if character.Location != nil {
+		presence.Map = character.Location.World
+	}
+	err = s.server.PresenceStore.Set(ctx, presence, s.server.GlobalConfig.GameBackend.Presence.TTL)
+	if err != nil {
+		log.Logger.WithContext(ctx).Errorf("set presence: %v", err)
+	}
+
 	return character, nil
 }
 
+func (s connectionServiceServer) IsPlaying(
+	ctx context.Context,
+	request *pb.CharacterTarget,
+) (*pb.ConnectionStatus, error) {
+	characters, err := viewablePresenceCharacters(ctx, s.server, []*pb.CharacterTarget{request})
+	if err != nil {
+		return nil, err
+	}
+
+	presence, err := s.server.PresenceStore.Get(ctx, uint(characters[0].Id))
+	if err != nil {
+		log.Logger.WithContext(ctx).Errorf("get presence: %v", err)
+		return nil, status.Error(codes.Internal, "unable to check if character is playing")
+	}
+
+	return &pb.ConnectionStatus{Online: presence.IsOnline()}, nil
+}
+
 func (s connectionServiceServer) TransferPlayer(
 	ctx context.Context,
 	request *pb.TransferPlayerRequest,
@@ -224,45 +263,38 @@ func (s connectionServiceServer) requestConnection(
 		return nil, common.ErrHandleRequest.Err()
 	}
 
-	allocatedState := v1.GameServerStateAllocated
-	readyState := v1.GameServerStateReady
 	log.Logger.WithContext(ctx).Debugf("requesting allocation for %s with world %s in dimension %s", character.Name, location.World, character.Dimension)
-	gsAlloc, err := s.agones.AllocationV1().GameServerAllocations(s.server.GlobalConfig.Agones.Namespace).Create(
-		srvCtx,
-		&aav1.GameServerAllocation{
-			TypeMeta:   metav1.TypeMeta{},
-			ObjectMeta: metav1.ObjectMeta{},
-			Spec: aav1.GameServerAllocationSpec{
-				Selectors: []aav1.GameServerSelector{
-					{
-						GameServerState: &allocatedState,
-						Players: &aav1.PlayerSelector{
-							MinAvailable: 1,
-							MaxAvailable: 1000,
-						},
-						LabelSelector: metav1.LabelSelector{
-							MatchLabels: map[string]string{
-								"world":     location.World,
-								"dimension": character.Dimension,
-							},
-						},
-					},
-					{
-						GameServerState: &readyState,
-						Players: &aav1.PlayerSelector{
-							MinAvailable: 1,
-							MaxAvailable: 1000,
-						},
-						LabelSelector: metav1.LabelSelector{
-							MatchLabels: map[string]string{
-								"world":     location.World,
-								"dimension": character.Dimension,
-							},
-						},
-					},
-				},
+	labels := map[string]string{
+		"world":     location.World,
+		"dimension": character.Dimension,
+	}
+	allocation := &aav1.GameServerAllocation{
+		Spec: aav1.GameServerAllocationSpec{
+			Selectors: []aav1.GameServerSelector{
+				gameServerSelector(v1.GameServerStateAllocated, labels),
+				gameServerSelector(v1.GameServerStateReady, labels),
 			},
 		},
+	}
+
+	// Prefer game servers already hosting other party members, and label the allocated game server so the rest of the
+	// party can find it
+	party, err := s.server.PartyService.FindByCharacter(ctx, uint(character.Id))
+	if err != nil {
+		log.Logger.WithContext(ctx).Warnf("find party: %v", err)
+	} else if party != nil {
+		partyLabels := maps.Clone(labels)
+		maps.Copy(partyLabels, party.AllocationLabels())
+		allocation.Spec.Selectors = append(
+			[]aav1.GameServerSelector{gameServerSelector(v1.GameServerStateAllocated, partyLabels)},
+			allocation.Spec.Selectors...,
+		)
+		allocation.Spec.MetaPatch.Labels = party.AllocationLabels()
+	}
+
+	gsAlloc, err := s.agones.AllocationV1().GameServerAllocations(s.server.GlobalConfig.Agones.Namespace).Create(
+		srvCtx,
+		allocation,
 		metav1.CreateOptions{},
 	)
 	if err != nil {
@@ -312,6 +344,20 @@ func (s connectionServiceServer) requestConnection(
 
 }
 
+// gameServerSelector selects game servers in the state with the labels that have room for another player
+func gameServerSelector(state v1.GameServerState, labels map[string]string) aav1.GameServerSelector {
+	return aav1.GameServerSelector{
+		GameServerState: &state,
+		Players: &aav1.PlayerSelector{
+			MinAvailable: 1,
+			MaxAvailable: 1000,
+		},
+		LabelSelector: metav1.LabelSelector{
+			MatchLabels: labels,
+		},
+	}
+}
+
 func NewConnectionServiceServer(
 	ctx context.Context,
 	server *gamebackend.GameBackendServerContext,
@@ -12,6 +12,7 @@ import (
 	"github.com/ShatteredRealms/go-backend/pkg/model/game"
 	"github.com/ShatteredRealms/go-backend/pkg/model/gamebackend"
 	"github.com/ShatteredRealms/go-backend/pkg/pb"
+	"github.com/ShatteredRealms/go-backend/pkg/presence"
 	"github.com/ShatteredRealms/go-backend/pkg/srv"
 	"github.com/bxcodec/faker/v4"
 	"github.com/google/uuid"
@@ -54,6 +55,7 @@ var _ = Describe("Connection server (local)", func() {
 				RefSROServer:   &globalConfig.GameBackend.SROServer,
 			},
 			GamebackendService: mockService,
+			PresenceStore:      presence.NewMemoryStore(),
 		}
 
 		server, err = srv.NewConnectionServiceServer(ctx, conf)
@@ -187,6 +189,10 @@ var _ = Describe("Connection server (local)", func() {
 				out, err := server.VerifyConnect(incClientCtx, req)
 				Expect(err).NotTo(HaveOccurred())
 				Expect(out).To(Equal(char.ToPb()))
+
+				playing, err := conf.PresenceStore.Get(ctx, char.ID)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(playing.IsOnline()).To(BeTrue())
 			})
 		})
 
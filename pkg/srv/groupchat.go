@@ -0,0 +1,125 @@
+package srv
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ShatteredRealms/go-backend/pkg/config"
+	"github.com/ShatteredRealms/go-backend/pkg/log"
+	"github.com/ShatteredRealms/go-backend/pkg/pb"
+)
+
+// createGroupChat creates a private chat channel for a group of characters, such as a guild or party, and authorizes
+// the characters to use it. Failing to authorize a character is only logged since syncGroupChat retries it the next
+// time the group changes.
+func createGroupChat(
+	ctx context.Context,
+	srvCtx *config.ServerContext,
+	name string,
+	dimension string,
+	characterIds []uint,
+) (uint64, error) {
+	client, err := srvCtx.GetChatClient()
+	if err != nil {
+		return 0, fmt.Errorf("get chat client: %w", err)
+	}
+
+	authCtx, err := srvCtx.OutgoingClientAuth(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("outgoing client auth: %w", err)
+	}
+
+	channel, err := client.CreateChannel(authCtx, &pb.CreateChannelMessage{
+		Name:      name,
+		Dimension: dimension,
+		Type:      pb.ChatChannelType_CHANNEL_PRIVATE,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("create channel: %w", err)
+	}
+
+	for _, characterId := range characterIds {
+		changeGroupChatAuthorization(ctx, client, authCtx, channel.Id, characterId, true)
+	}
+
+	return channel.Id, nil
+}
+
+// syncGroupChat authorizes exactly the characters of the group to use its chat channel. The whole membership of the
+// channel is compared with the group instead of applying only the latest change, so changes that failed before are
+// retried. Failures are logged and retried the next time the group changes.
+func syncGroupChat(ctx context.Context, srvCtx *config.ServerContext, channelId uint64, characterIds []uint) {
+	client, err := srvCtx.GetChatClient()
+	if err != nil {
+		log.Logger.WithContext(ctx).Warnf("sync group chat %d: get chat client: %v", channelId, err)
+		return
+	}
+
+	authCtx, err := srvCtx.OutgoingClientAuth(ctx)
+	if err != nil {
+		log.Logger.WithContext(ctx).Warnf("sync group chat %d: outgoing client auth: %v", channelId, err)
+		return
+	}
+
+	members, err := client.GetChannelMembers(authCtx, &pb.ChatChannelTarget{Id: channelId})
+	if err != nil {
+		log.Logger.WithContext(ctx).Warnf("sync group chat %d: get channel members: %v", channelId, err)
+		return
+	}
+
+	removed := make(map[uint]struct{}, len(members.Members))
+	for _, member := range members.Members {
+		removed[uint(member.CharacterId)] = struct{}{}
+	}
+
+	for _, characterId := range characterIds {
+		if _, ok := removed[characterId]; ok {
+			delete(removed, characterId)
+			continue
+		}
+
+		changeGroupChatAuthorization(ctx, client, authCtx, channelId, characterId, true)
+	}
+
+	for characterId := range removed {
+		changeGroupChatAuthorization(ctx, client, authCtx, channelId, characterId, false)
+	}
+}
+
+// deleteGroupChat deletes the chat channel of a group that no longer exists
+func deleteGroupChat(ctx context.Context, srvCtx *config.ServerContext, channelId uint64) {
+	client, err := srvCtx.GetChatClient()
+	if err != nil {
+		log.Logger.WithContext(ctx).Warnf("delete group chat %d: get chat client: %v", channelId, err)
+		return
+	}
+
+	authCtx, err := srvCtx.OutgoingClientAuth(ctx)
+	if err != nil {
+		log.Logger.WithContext(ctx).Warnf("delete group chat %d: outgoing client auth: %v", channelId, err)
+		return
+	}
+
+	_, err = client.DeleteChannel(authCtx, &pb.ChatChannelTarget{Id: channelId})
+	if err != nil {
+		log.Logger.WithContext(ctx).Warnf("delete group chat %d: %v", channelId, err)
+	}
+}
+
+func changeGroupChatAuthorization(
+	ctx context.Context,
+	client pb.ChatServiceClient,
+	authCtx context.Context,
+	channelId uint64,
+	characterId uint,
+	add bool,
+) {
+	_, err := client.UpdateUserChatChannelAuthorizations(authCtx, &pb.RequestChatChannelAuthChange{
+		Character: &pb.CharacterTarget{Type: &pb.CharacterTarget_Id{Id: uint64(characterId)}},
+		Add:       add,
+		Ids:       []uint64{channelId},
+	})
+	if err != nil {
+		log.Logger.WithContext(ctx).Warnf("change group chat %d authorization for %d: %v", channelId, characterId, err)
+	}
+}
@@ -0,0 +1,674 @@
+package srv
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	characterApp "github.com/ShatteredRealms/go-backend/cmd/character/app"
+	"github.com/ShatteredRealms/go-backend/pkg/auth"
+	"github.com/ShatteredRealms/go-backend/pkg/common"
+	"github.com/ShatteredRealms/go-backend/pkg/log"
+	"github.com/ShatteredRealms/go-backend/pkg/model/character"
+	"github.com/ShatteredRealms/go-backend/pkg/pb"
+	"github.com/ShatteredRealms/go-backend/pkg/service"
+	"github.com/WilSimpson/gocloak/v13"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"gorm.io/gorm"
+)
+
+type guildServiceServer struct {
+	pb.UnimplementedGuildServiceServer
+	server *characterApp.CharacterServerContext
+}
+
+var (
+	RoleGuild = registerCharacterRole(&gocloak.Role{
+		Name:        gocloak.StringP("guild"),
+		Description: gocloak.StringP("Allows creating, joining and managing guilds with own characters"),
+	})
+
+	RoleGuildManagementOther = registerCharacterRole(&gocloak.Role{
+		Name:        gocloak.StringP("guild_manage_other"),
+		Description: gocloak.StringP("Allows managing any guild regardless of guild rank"),
+	})
+)
+
+// CreateGuild implements pb.GuildServiceServer.
+func (s *guildServiceServer) CreateGuild(
+	ctx context.Context,
+	request *pb.CreateGuildRequest,
+) (*pb.Guild, error) {
+	claims, ok := auth.RetrieveClaims(ctx)
+	if !ok {
+		return nil, common.ErrUnauthorized.Err()
+	}
+
+	// Validate requester has correct permission
+	if !claims.HasResourceRole(RoleGuild, auth.CharacterClientId) {
+		return nil, common.ErrUnauthorized.Err()
+	}
+
+	leader, err := s.ownedCharacter(ctx, claims, request.Leader)
+	if err != nil {
+		return nil, err
+	}
+
+	guild, err := s.server.GuildService.Create(ctx, leader, request.Name)
+	if err != nil {
+		return nil, guildError(ctx, "create guild", err)
+	}
+
+	return s.provisionGuildChat(ctx, guild).ToPb(), nil
+}
+
+// GetGuild implements pb.GuildServiceServer.
+func (s *guildServiceServer) GetGuild(
+	ctx context.Context,
+	request *pb.GuildTarget,
+) (*pb.Guild, error) {
+	claims, ok := auth.RetrieveClaims(ctx)
+	if !ok {
+		return nil, common.ErrUnauthorized.Err()
+	}
+
+	// Validate requester has correct permission
+	if !claims.HasResourceRole(RoleGuild, auth.CharacterClientId) &&
+		!claims.HasResourceRole(RoleGuildManagementOther, auth.CharacterClientId) {
+		return nil, common.ErrUnauthorized.Err()
+	}
+
+	guild, err := s.server.GuildService.FindById(ctx, uint(request.Id))
+	if err != nil {
+		log.Logger.WithContext(ctx).Errorf("find guild: %v", err)
+		return nil, status.Error(codes.Internal, "unable to get guild")
+	}
+	if guild == nil {
+		return nil, common.ErrDoesNotExist.Err()
+	}
+
+	return guild.ToPb(), nil
+}
+
+// GetCharacterGuild implements pb.GuildServiceServer.
+func (s *guildServiceServer) GetCharacterGuild(
+	ctx context.Context,
+	request *pb.CharacterTarget,
+) (*pb.Guild, error) {
+	claims, ok := auth.RetrieveClaims(ctx)
+	if !ok {
+		return nil, common.ErrUnauthorized.Err()
+	}
+
+	// Validate requester has correct permission
+	if !claims.HasResourceRole(RoleGuild, auth.CharacterClientId) &&
+		!claims.HasResourceRole(RoleGuildManagementOther, auth.CharacterClientId) {
+		return nil, common.ErrUnauthorized.Err()
+	}
+
+	char, err := s.ownedCharacter(ctx, claims, request)
+	if err != nil {
+		return nil, err
+	}
+
+	guild, err := s.server.GuildService.FindByCharacter(ctx, char.ID)
+	if err != nil {
+		log.Logger.WithContext(ctx).Errorf("find character guild: %v", err)
+		return nil, status.Error(codes.Internal, "unable to get guild")
+	}
+	if guild == nil {
+		return nil, status.Error(codes.NotFound, "character is not in a guild")
+	}
+
+	return guild.ToPb(), nil
+}
+
+// DisbandGuild implements pb.GuildServiceServer.
+func (s *guildServiceServer) DisbandGuild(
+	ctx context.Context,
+	request *pb.GuildActionRequest,
+) (*emptypb.Empty, error) {
+	claims, ok := auth.RetrieveClaims(ctx)
+	if !ok {
+		return nil, common.ErrUnauthorized.Err()
+	}
+
+	actorId, err := s.guildActor(ctx, claims, request.Character)
+	if err != nil {
+		return nil, err
+	}
+
+	guild, err := s.server.GuildService.Disband(ctx, uint(request.GuildId), actorId)
+	if err != nil {
+		return nil, guildError(ctx, "disband guild", err)
+	}
+
+	s.deleteGuildChat(ctx, guild)
+	return &emptypb.Empty{}, nil
+}
+
+// GetGuildMembers implements pb.GuildServiceServer.
+func (s *guildServiceServer) GetGuildMembers(
+	ctx context.Context,
+	request *pb.GuildTarget,
+) (*pb.GuildMembers, error) {
+	claims, ok := auth.RetrieveClaims(ctx)
+	if !ok {
+		return nil, common.ErrUnauthorized.Err()
+	}
+
+	// Validate requester has correct permission
+	if !claims.HasResourceRole(RoleGuild, auth.CharacterClientId) &&
+		!claims.HasResourceRole(RoleGuildManagementOther, auth.CharacterClientId) {
+		return nil, common.ErrUnauthorized.Err()
+	}
+
+	guild, err := s.server.GuildService.FindById(ctx, uint(request.Id))
+	if err != nil {
+		log.Logger.WithContext(ctx).Errorf("find guild: %v", err)
+		return nil, status.Error(codes.Internal, "unable to get guild members")
+	}
+	if guild == nil {
+		return nil, common.ErrDoesNotExist.Err()
+	}
+
+	members, err := s.server.GuildService.Members(ctx, guild.ID)
+	if err != nil {
+		log.Logger.WithContext(ctx).Errorf("get guild members: %v", err)
+		return nil, status.Error(codes.Internal, "unable to get guild members")
+	}
+
+	resp := members.ToPb()
+	for _, member := range resp.Members {
+		char, err := s.server.CharacterService.FindById(ctx, uint(member.CharacterId))
+		if err != nil || char == nil {
+			log.Logger.WithContext(ctx).Warnf("get guild member %d: %v", member.CharacterId, err)
+			continue
+		}
+
+		member.CharacterName = char.Name
+	}
+
+	return resp, nil
+}
+
+// InviteToGuild implements pb.GuildServiceServer.
+func (s *guildServiceServer) InviteToGuild(
+	ctx context.Context,
+	request *pb.GuildInviteRequest,
+) (*emptypb.Empty, error) {
+	claims, ok := auth.RetrieveClaims(ctx)
+	if !ok {
+		return nil, common.ErrUnauthorized.Err()
+	}
+
+	actorId, err := s.guildActor(ctx, claims, request.Character)
+	if err != nil {
+		return nil, err
+	}
+
+	target, err := s.targetCharacter(ctx, request.Target)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.server.GuildService.Invite(ctx, uint(request.GuildId), actorId, target)
+	if err != nil {
+		return nil, guildError(ctx, "invite to guild", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// GetGuildInvites implements pb.GuildServiceServer.
+func (s *guildServiceServer) GetGuildInvites(
+	ctx context.Context,
+	request *pb.CharacterTarget,
+) (*pb.GuildInvites, error) {
+	claims, ok := auth.RetrieveClaims(ctx)
+	if !ok {
+		return nil, common.ErrUnauthorized.Err()
+	}
+
+	// Validate requester has correct permission
+	if !claims.HasResourceRole(RoleGuild, auth.CharacterClientId) &&
+		!claims.HasResourceRole(RoleGuildManagementOther, auth.CharacterClientId) {
+		return nil, common.ErrUnauthorized.Err()
+	}
+
+	char, err := s.ownedCharacter(ctx, claims, request)
+	if err != nil {
+		return nil, err
+	}
+
+	invites, err := s.server.GuildService.Invites(ctx, char.ID)
+	if err != nil {
+		log.Logger.WithContext(ctx).Errorf("get guild invites: %v", err)
+		return nil, status.Error(codes.Internal, "unable to get guild invites")
+	}
+
+	return invites.ToPb(), nil
+}
+
+// AcceptGuildInvite implements pb.GuildServiceServer.
+func (s *guildServiceServer) AcceptGuildInvite(
+	ctx context.Context,
+	request *pb.GuildActionRequest,
+) (*emptypb.Empty, error) {
+	claims, ok := auth.RetrieveClaims(ctx)
+	if !ok {
+		return nil, common.ErrUnauthorized.Err()
+	}
+
+	// Validate requester has correct permission
+	if !claims.HasResourceRole(RoleGuild, auth.CharacterClientId) {
+		return nil, common.ErrUnauthorized.Err()
+	}
+
+	char, err := s.ownedCharacter(ctx, claims, request.Character)
+	if err != nil {
+		return nil, err
+	}
+
+	guild, err := s.server.GuildService.AcceptInvite(ctx, uint(request.GuildId), char.ID)
+	if err != nil {
+		return nil, guildError(ctx, "accept guild invite", err)
+	}
+
+	s.updateGuildChat(ctx, guild)
+	return &emptypb.Empty{}, nil
+}
+
+// DeclineGuildInvite implements pb.GuildServiceServer.
+func (s *guildServiceServer) DeclineGuildInvite(
+	ctx context.Context,
+	request *pb.GuildActionRequest,
+) (*emptypb.Empty, error) {
+	claims, ok := auth.RetrieveClaims(ctx)
+	if !ok {
+		return nil, common.ErrUnauthorized.Err()
+	}
+
+	// Validate requester has correct permission
+	if !claims.HasResourceRole(RoleGuild, auth.CharacterClientId) {
+		return nil, common.ErrUnauthorized.Err()
+	}
+
+	char, err := s.ownedCharacter(ctx, claims, request.Character)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.server.GuildService.DeclineInvite(ctx, uint(request.GuildId), char.ID)
+	if err != nil {
+		return nil, guildError(ctx, "decline guild invite", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// LeaveGuild implements pb.GuildServiceServer.
+func (s *guildServiceServer) LeaveGuild(
+	ctx context.Context,
+	request *pb.GuildActionRequest,
+) (*emptypb.Empty, error) {
+	claims, ok := auth.RetrieveClaims(ctx)
+	if !ok {
+		return nil, common.ErrUnauthorized.Err()
+	}
+
+	// Validate requester has correct permission
+	if !claims.HasResourceRole(RoleGuild, auth.CharacterClientId) {
+		return nil, common.ErrUnauthorized.Err()
+	}
+
+	char, err := s.ownedCharacter(ctx, claims, request.Character)
+	if err != nil {
+		return nil, err
+	}
+
+	guild, err := s.server.GuildService.Leave(ctx, uint(request.GuildId), char.ID)
+	if err != nil {
+		return nil, guildError(ctx, "leave guild", err)
+	}
+
+	s.updateGuildChat(ctx, guild)
+	return &emptypb.Empty{}, nil
+}
+
+// KickFromGuild implements pb.GuildServiceServer.
+func (s *guildServiceServer) KickFromGuild(
+	ctx context.Context,
+	request *pb.GuildTargetMemberRequest,
+) (*emptypb.Empty, error) {
+	claims, ok := auth.RetrieveClaims(ctx)
+	if !ok {
+		return nil, common.ErrUnauthorized.Err()
+	}
+
+	actorId, err := s.guildActor(ctx, claims, request.Character)
+	if err != nil {
+		return nil, err
+	}
+
+	target, err := s.targetCharacter(ctx, request.Target)
+	if err != nil {
+		return nil, err
+	}
+
+	guild, err := s.server.GuildService.Kick(ctx, uint(request.GuildId), actorId, target.ID)
+	if err != nil {
+		return nil, guildError(ctx, "kick from guild", err)
+	}
+
+	s.updateGuildChat(ctx, guild)
+	return &emptypb.Empty{}, nil
+}
+
+// SetGuildMotd implements pb.GuildServiceServer.
+func (s *guildServiceServer) SetGuildMotd(
+	ctx context.Context,
+	request *pb.SetGuildMotdRequest,
+) (*emptypb.Empty, error) {
+	claims, ok := auth.RetrieveClaims(ctx)
+	if !ok {
+		return nil, common.ErrUnauthorized.Err()
+	}
+
+	actorId, err := s.guildActor(ctx, claims, request.Character)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.server.GuildService.SetMotd(ctx, uint(request.GuildId), actorId, request.Motd)
+	if err != nil {
+		return nil, guildError(ctx, "set guild motd", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// CreateGuildRank implements pb.GuildServiceServer.
+func (s *guildServiceServer) CreateGuildRank(
+	ctx context.Context,
+	request *pb.GuildRankRequest,
+) (*pb.GuildRank, error) {
+	claims, ok := auth.RetrieveClaims(ctx)
+	if !ok {
+		return nil, common.ErrUnauthorized.Err()
+	}
+
+	actorId, err := s.guildActor(ctx, claims, request.Character)
+	if err != nil {
+		return nil, err
+	}
+
+	if request.Rank == nil {
+		return nil, status.Error(codes.InvalidArgument, "rank is required")
+	}
+
+	rank, err := s.server.GuildService.CreateRank(ctx, uint(request.GuildId), actorId, character.GuildRankFromPb(request.Rank))
+	if err != nil {
+		return nil, guildError(ctx, "create guild rank", err)
+	}
+
+	return rank.ToPb(), nil
+}
+
+// EditGuildRank implements pb.GuildServiceServer.
+func (s *guildServiceServer) EditGuildRank(
+	ctx context.Context,
+	request *pb.GuildRankRequest,
+) (*emptypb.Empty, error) {
+	claims, ok := auth.RetrieveClaims(ctx)
+	if !ok {
+		return nil, common.ErrUnauthorized.Err()
+	}
+
+	actorId, err := s.guildActor(ctx, claims, request.Character)
+	if err != nil {
+		return nil, err
+	}
+
+	if request.Rank == nil {
+		return nil, status.Error(codes.InvalidArgument, "rank is required")
+	}
+
+	_, err = s.server.GuildService.EditRank(ctx, uint(request.GuildId), actorId, character.GuildRankFromPb(request.Rank))
+	if err != nil {
+		return nil, guildError(ctx, "edit guild rank", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// DeleteGuildRank implements pb.GuildServiceServer.
+func (s *guildServiceServer) DeleteGuildRank(
+	ctx context.Context,
+	request *pb.DeleteGuildRankRequest,
+) (*emptypb.Empty, error) {
+	claims, ok := auth.RetrieveClaims(ctx)
+	if !ok {
+		return nil, common.ErrUnauthorized.Err()
+	}
+
+	actorId, err := s.guildActor(ctx, claims, request.Character)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.server.GuildService.DeleteRank(ctx, uint(request.GuildId), actorId, uint(request.RankId))
+	if err != nil {
+		return nil, guildError(ctx, "delete guild rank", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// SetGuildMemberRank implements pb.GuildServiceServer.
+func (s *guildServiceServer) SetGuildMemberRank(
+	ctx context.Context,
+	request *pb.GuildTargetMemberRequest,
+) (*emptypb.Empty, error) {
+	claims, ok := auth.RetrieveClaims(ctx)
+	if !ok {
+		return nil, common.ErrUnauthorized.Err()
+	}
+
+	actorId, err := s.guildActor(ctx, claims, request.Character)
+	if err != nil {
+		return nil, err
+	}
+
+	target, err := s.targetCharacter(ctx, request.Target)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.server.GuildService.SetMemberRank(ctx, uint(request.GuildId), actorId, target.ID, uint(request.RankId))
+	if err != nil {
+		return nil, guildError(ctx, "set guild member rank", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// NewGuildServiceServer creates the guild service server. The guild roles are registered with the character roles,
+// so they are created by NewCharacterServiceServer.
+func NewGuildServiceServer(
+	ctx context.Context,
+	server *characterApp.CharacterServerContext,
+) (pb.GuildServiceServer, error) {
+	return &guildServiceServer{
+		server: server,
+	}, nil
+}
+
+// guildActor gets the id of the character performing a guild action. Guild managers act as service.GuildAdmin, which
+// skips the guild rank checks, otherwise the requester must own the character.
+func (s guildServiceServer) guildActor(
+	ctx context.Context,
+	claims *auth.SROClaims,
+	request *pb.CharacterTarget,
+) (uint, error) {
+	if claims.HasResourceRole(RoleGuildManagementOther, auth.CharacterClientId) {
+		return service.GuildAdmin, nil
+	}
+
+	// Validate requester has correct permission
+	if !claims.HasResourceRole(RoleGuild, auth.CharacterClientId) {
+		return 0, common.ErrUnauthorized.Err()
+	}
+
+	char, err := s.ownedCharacter(ctx, claims, request)
+	if err != nil {
+		return 0, err
+	}
+
+	return char.ID, nil
+}
+
+// ownedCharacter gets the target character and verifies it is owned by the requester unless the requester is a guild
+// manager
+func (s guildServiceServer) ownedCharacter(
+	ctx context.Context,
+	claims *auth.SROClaims,
+	request *pb.CharacterTarget,
+) (*character.Character, error) {
+	char, err := s.targetCharacter(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	if char.OwnerId != claims.Subject && !claims.HasResourceRole(RoleGuildManagementOther, auth.CharacterClientId) {
+		return nil, common.ErrUnauthorized.Err()
+	}
+
+	return char, nil
+}
+
+func (s guildServiceServer) targetCharacter(ctx context.Context, request *pb.CharacterTarget) (*character.Character, error) {
+	if request == nil || request.Type == nil {
+		return nil, status.Error(codes.InvalidArgument, "character is required")
+	}
+
+	char, err := s.server.CharacterService.FindByTarget(ctx, request)
+	if err != nil {
+		log.Logger.WithContext(ctx).Errorf("find character: %v", err)
+		return nil, common.ErrHandleRequest.Err()
+	}
+	if char == nil {
+		return nil, common.ErrDoesNotExist.Err()
+	}
+
+	return char, nil
+}
+
+// provisionGuildChat creates the private chat channel for the guild and authorizes its current members. Failures are
+// logged and retried the next time the roster changes, so the guild is returned even if the channel is not created.
+func (s guildServiceServer) provisionGuildChat(ctx context.Context, guild *character.Guild) *character.Guild {
+	characterIds, err := s.guildCharacterIds(ctx, guild)
+	if err != nil {
+		log.Logger.WithContext(ctx).Warnf("provision guild chat: get members: %v", err)
+		return guild
+	}
+
+	channelId, err := createGroupChat(
+		ctx,
+		s.server.ServerContext,
+		guildChatChannelName(guild),
+		guild.Dimension,
+		characterIds,
+	)
+	if err != nil {
+		log.Logger.WithContext(ctx).Warnf("provision guild chat: %v", err)
+		return guild
+	}
+
+	updated, err := s.server.GuildService.SetChatChannel(ctx, guild.ID, uint(channelId))
+	if err != nil {
+		log.Logger.WithContext(ctx).Errorf("provision guild chat: set chat channel %d: %v", channelId, err)
+		return guild
+	}
+
+	return updated
+}
+
+// updateGuildChat syncs the guild chat channel with the guild roster, provisioning the channel if it does not exist yet
+func (s guildServiceServer) updateGuildChat(ctx context.Context, guild *character.Guild) {
+	if guild.ChatChannelId == 0 {
+		s.provisionGuildChat(ctx, guild)
+		return
+	}
+
+	characterIds, err := s.guildCharacterIds(ctx, guild)
+	if err != nil {
+		log.Logger.WithContext(ctx).Warnf("update guild chat: get members: %v", err)
+		return
+	}
+
+	syncGroupChat(ctx, s.server.ServerContext, uint64(guild.ChatChannelId), characterIds)
+}
+
+// guildCharacterIds ids of the characters in the guild
+func (s guildServiceServer) guildCharacterIds(ctx context.Context, guild *character.Guild) ([]uint, error) {
+	members, err := s.server.GuildService.Members(ctx, guild.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	characterIds := make([]uint, len(members))
+	for idx, member := range members {
+		characterIds[idx] = member.CharacterId
+	}
+
+	return characterIds, nil
+}
+
+// deleteGuildChat deletes the chat channel of a disbanded guild
+func (s guildServiceServer) deleteGuildChat(ctx context.Context, guild *character.Guild) {
+	if guild.ChatChannelId != 0 {
+		deleteGroupChat(ctx, s.server.ServerContext, uint64(guild.ChatChannelId))
+	}
+}
+
+// guildChatChannelName name of the guild chat channel. The guild id is used since player channels could already use
+// the guild name.
+func guildChatChannelName(guild *character.Guild) string {
+	return fmt.Sprintf("guild-%d", guild.ID)
+}
+
+func guildError(ctx context.Context, action string, err error) error {
+	switch {
+	case errors.Is(err, service.ErrGuildNotFound),
+		errors.Is(err, service.ErrGuildNotMember),
+		errors.Is(err, service.ErrGuildNotInvited),
+		errors.Is(err, service.ErrGuildRankNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, service.ErrGuildNameTaken),
+		errors.Is(err, service.ErrGuildAlreadyMember),
+		errors.Is(err, service.ErrGuildAlreadyInvited),
+		errors.Is(err, gorm.ErrDuplicatedKey):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, service.ErrGuildPermission):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, service.ErrGuildWrongDimension),
+		errors.Is(err, service.ErrGuildLeaderLeave),
+		errors.Is(err, service.ErrGuildRankProtected),
+		errors.Is(err, service.ErrGuildRankInUse):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, service.ErrGuildRankLevel),
+		errors.Is(err, character.ErrGuildNameToShort),
+		errors.Is(err, character.ErrGuildNameToLong),
+		errors.Is(err, character.ErrGuildRankName),
+		errors.Is(err, character.ErrGuildMotdToLong),
+		errors.Is(err, common.ErrInvalidName),
+		errors.Is(err, common.ErrNameProfane):
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	log.Logger.WithContext(ctx).Errorf("%s: %v", action, err)
+	return status.Errorf(codes.Internal, "unable to %s", action)
+}
@@ -0,0 +1,167 @@
+package srv_test
+
+import (
+	"context"
+
+	characterApp "github.com/ShatteredRealms/go-backend/cmd/character/app"
+	"github.com/ShatteredRealms/go-backend/pkg/common"
+	"github.com/ShatteredRealms/go-backend/pkg/config"
+	"github.com/ShatteredRealms/go-backend/pkg/log"
+	"github.com/ShatteredRealms/go-backend/pkg/mocks"
+	"github.com/ShatteredRealms/go-backend/pkg/model/character"
+	"github.com/ShatteredRealms/go-backend/pkg/pb"
+	"github.com/ShatteredRealms/go-backend/pkg/service"
+	"github.com/ShatteredRealms/go-backend/pkg/srv"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/sirupsen/logrus/hooks/test"
+	"go.opentelemetry.io/otel"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ = Describe("Guild server", func() {
+	var (
+		mockController   *gomock.Controller
+		mockCharService  *mocks.MockCharacterService
+		mockGuildService *mocks.MockGuildService
+
+		server pb.GuildServiceServer
+		ctx    = context.Background()
+
+		guild  *character.Guild
+		target *pb.CharacterTarget
+	)
+
+	BeforeEach(func() {
+		log.Logger, _ = test.NewNullLogger()
+		mockController = gomock.NewController(GinkgoT())
+
+		mockCharService = mocks.NewMockCharacterService(mockController)
+		mockGuildService = mocks.NewMockGuildService(mockController)
+
+		var err error
+		server, err = srv.NewGuildServiceServer(ctx, &characterApp.CharacterServerContext{
+			ServerContext: &config.ServerContext{
+				GlobalConfig:   globalConfig,
+				KeycloakClient: keycloak,
+				Tracer:         otel.Tracer("test-guild"),
+				RefSROServer:   &globalConfig.Character.SROServer,
+			},
+			CharacterService: mockCharService,
+			GuildService:     mockGuildService,
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(server).NotTo(BeNil())
+
+		guild = &character.Guild{
+			ID:        1,
+			Name:      "The Guild",
+			Dimension: "dimension",
+			LeaderId:  2,
+			Ranks:     character.DefaultGuildRanks(),
+		}
+		target = &pb.CharacterTarget{
+			Type: &pb.CharacterTarget_Id{
+				Id: 2,
+			},
+		}
+	})
+
+	Describe("CreateGuild", func() {
+		It("should error for empty context", func() {
+			out, err := server.CreateGuild(context.Background(), &pb.CreateGuildRequest{Leader: target})
+			Expect(err).To(MatchError(common.ErrUnauthorized.Err()))
+			Expect(out).To(BeNil())
+		})
+
+		It("should error for invalid permission (guest)", func() {
+			out, err := server.CreateGuild(incGuestCtx, &pb.CreateGuildRequest{Leader: target})
+			Expect(err).To(MatchError(common.ErrUnauthorized.Err()))
+			Expect(out).To(BeNil())
+		})
+
+		It("should error if the leader is not owned by the requester", func() {
+			mockCharService.EXPECT().FindByTarget(gomock.Any(), gomock.Any()).Return(&character.Character{
+				ID:      2,
+				OwnerId: *admin.ID,
+			}, nil)
+			out, err := server.CreateGuild(incPlayerCtx, &pb.CreateGuildRequest{Leader: target})
+			Expect(err).To(MatchError(common.ErrUnauthorized.Err()))
+			Expect(out).To(BeNil())
+		})
+
+		It("should error if the name is taken", func() {
+			mockCharService.EXPECT().FindByTarget(gomock.Any(), gomock.Any()).Return(&character.Character{
+				ID:      2,
+				OwnerId: *player.ID,
+			}, nil)
+			mockGuildService.EXPECT().Create(gomock.Any(), gomock.Any(), guild.Name).Return(nil, service.ErrGuildNameTaken)
+			out, err := server.CreateGuild(incPlayerCtx, &pb.CreateGuildRequest{Leader: target, Name: guild.Name})
+			Expect(status.Code(err)).To(Equal(codes.AlreadyExists))
+			Expect(out).To(BeNil())
+		})
+	})
+
+	Describe("GetGuild", func() {
+		It("should error for invalid permission (guest)", func() {
+			out, err := server.GetGuild(incGuestCtx, &pb.GuildTarget{Id: 1})
+			Expect(err).To(MatchError(common.ErrUnauthorized.Err()))
+			Expect(out).To(BeNil())
+		})
+
+		It("should error if the guild does not exist", func() {
+			mockGuildService.EXPECT().FindById(gomock.Any(), uint(1)).Return(nil, nil)
+			out, err := server.GetGuild(incPlayerCtx, &pb.GuildTarget{Id: 1})
+			Expect(err).To(MatchError(common.ErrDoesNotExist.Err()))
+			Expect(out).To(BeNil())
+		})
+
+		It("should get the guild", func() {
+			mockGuildService.EXPECT().FindById(gomock.Any(), uint(1)).Return(guild, nil)
+			out, err := server.GetGuild(incPlayerCtx, &pb.GuildTarget{Id: 1})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.Name).To(Equal(guild.Name))
+			Expect(out.Ranks).To(HaveLen(len(guild.Ranks)))
+		})
+	})
+
+	Describe("KickFromGuild", func() {
+		var req *pb.GuildTargetMemberRequest
+
+		BeforeEach(func() {
+			req = &pb.GuildTargetMemberRequest{
+				GuildId:   1,
+				Character: target,
+				Target:    &pb.CharacterTarget{Type: &pb.CharacterTarget_Id{Id: 3}},
+			}
+		})
+
+		It("should error for invalid permission (guest)", func() {
+			out, err := server.KickFromGuild(incGuestCtx, req)
+			Expect(err).To(MatchError(common.ErrUnauthorized.Err()))
+			Expect(out).To(BeNil())
+		})
+
+		It("should error if the actor is outranked", func() {
+			mockCharService.EXPECT().FindByTarget(gomock.Any(), target).Return(&character.Character{
+				ID:      2,
+				OwnerId: *player.ID,
+			}, nil)
+			mockCharService.EXPECT().FindByTarget(gomock.Any(), req.Target).Return(&character.Character{ID: 3}, nil)
+			mockGuildService.EXPECT().Kick(gomock.Any(), uint(1), uint(2), uint(3)).Return(nil, service.ErrGuildPermission)
+			out, err := server.KickFromGuild(incPlayerCtx, req)
+			Expect(status.Code(err)).To(Equal(codes.PermissionDenied))
+			Expect(out).To(BeNil())
+		})
+
+		It("should act as a guild admin for guild managers", func() {
+			mockCharService.EXPECT().FindByTarget(gomock.Any(), req.Target).Return(&character.Character{ID: 3}, nil)
+			mockGuildService.EXPECT().Kick(gomock.Any(), uint(1), service.GuildAdmin, uint(3)).Return(nil, service.ErrGuildNotMember)
+			out, err := server.KickFromGuild(incAdminCtx, req)
+			Expect(status.Code(err)).To(Equal(codes.NotFound))
+			Expect(out).To(BeNil())
+		})
+	})
+})
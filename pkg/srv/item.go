@@ -0,0 +1,165 @@
+package srv
+
+import (
+	"context"
+	"errors"
+
+	characterApp "github.com/ShatteredRealms/go-backend/cmd/character/app"
+	"github.com/ShatteredRealms/go-backend/pkg/auth"
+	"github.com/ShatteredRealms/go-backend/pkg/common"
+	"github.com/ShatteredRealms/go-backend/pkg/log"
+	"github.com/ShatteredRealms/go-backend/pkg/model/character"
+	"github.com/ShatteredRealms/go-backend/pkg/pb"
+	"github.com/ShatteredRealms/go-backend/pkg/service"
+	"github.com/WilSimpson/gocloak/v13"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type itemServiceServer struct {
+	pb.UnimplementedItemServiceServer
+	server *characterApp.CharacterServerContext
+}
+
+var (
+	RoleItemManage = registerCharacterRole(&gocloak.Role{
+		Name:        gocloak.StringP("item_manage"),
+		Description: gocloak.StringP("Allows creating, editing and deleting item definitions"),
+	})
+)
+
+// GetItems implements pb.ItemServiceServer.
+func (s *itemServiceServer) GetItems(
+	ctx context.Context,
+	request *emptypb.Empty,
+) (*pb.ItemDefinitions, error) {
+	_, ok := auth.RetrieveClaims(ctx)
+	if !ok {
+		return nil, common.ErrUnauthorized.Err()
+	}
+
+	items, err := s.server.ItemService.FindAll(ctx)
+	if err != nil {
+		return nil, itemError(ctx, "get items", err)
+	}
+
+	return items.ToPb(), nil
+}
+
+// GetItem implements pb.ItemServiceServer.
+func (s *itemServiceServer) GetItem(
+	ctx context.Context,
+	request *pb.ItemTarget,
+) (*pb.ItemDefinition, error) {
+	_, ok := auth.RetrieveClaims(ctx)
+	if !ok {
+		return nil, common.ErrUnauthorized.Err()
+	}
+
+	item, err := s.server.ItemService.FindById(ctx, request.Id)
+	if err != nil {
+		return nil, itemError(ctx, "get item", err)
+	}
+	if item == nil {
+		return nil, common.ErrDoesNotExist.Err()
+	}
+
+	return item.ToPb(), nil
+}
+
+// CreateItem implements pb.ItemServiceServer.
+func (s *itemServiceServer) CreateItem(
+	ctx context.Context,
+	request *pb.ItemDefinition,
+) (*pb.ItemDefinition, error) {
+	err := s.manageClaims(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	item, err := s.server.ItemService.Create(ctx, character.ItemDefinitionFromPb(request))
+	if err != nil {
+		return nil, itemError(ctx, "create item", err)
+	}
+
+	return item.ToPb(), nil
+}
+
+// EditItem implements pb.ItemServiceServer.
+func (s *itemServiceServer) EditItem(
+	ctx context.Context,
+	request *pb.ItemDefinition,
+) (*pb.ItemDefinition, error) {
+	err := s.manageClaims(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	item, err := s.server.ItemService.Edit(ctx, character.ItemDefinitionFromPb(request))
+	if err != nil {
+		return nil, itemError(ctx, "edit item", err)
+	}
+
+	return item.ToPb(), nil
+}
+
+// DeleteItem implements pb.ItemServiceServer.
+func (s *itemServiceServer) DeleteItem(
+	ctx context.Context,
+	request *pb.ItemTarget,
+) (*emptypb.Empty, error) {
+	err := s.manageClaims(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.server.ItemService.Delete(ctx, request.Id)
+	if err != nil {
+		return nil, itemError(ctx, "delete item", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// NewItemServiceServer creates the item service server. The item roles are registered with the character roles, so
+// they are created by NewCharacterServiceServer.
+func NewItemServiceServer(
+	ctx context.Context,
+	server *characterApp.CharacterServerContext,
+) (pb.ItemServiceServer, error) {
+	return &itemServiceServer{
+		server: server,
+	}, nil
+}
+
+// manageClaims validates the requester can manage item definitions
+func (s itemServiceServer) manageClaims(ctx context.Context) error {
+	claims, ok := auth.RetrieveClaims(ctx)
+	if !ok {
+		return common.ErrUnauthorized.Err()
+	}
+
+	// Validate requester has correct permission
+	if !claims.HasResourceRole(RoleItemManage, auth.CharacterClientId) {
+		return common.ErrUnauthorized.Err()
+	}
+
+	return nil
+}
+
+func itemError(ctx context.Context, action string, err error) error {
+	switch {
+	case errors.Is(err, service.ErrItemNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, service.ErrItemExists):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, character.ErrItemId),
+		errors.Is(err, character.ErrItemName),
+		errors.Is(err, character.ErrItemMaxStack):
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	log.Logger.WithContext(ctx).Errorf("%s: %v", action, err)
+	return status.Errorf(codes.Internal, "unable to %s", action)
+}
@@ -0,0 +1,137 @@
+package srv_test
+
+import (
+	"context"
+
+	characterApp "github.com/ShatteredRealms/go-backend/cmd/character/app"
+	"github.com/ShatteredRealms/go-backend/pkg/common"
+	"github.com/ShatteredRealms/go-backend/pkg/config"
+	"github.com/ShatteredRealms/go-backend/pkg/log"
+	"github.com/ShatteredRealms/go-backend/pkg/mocks"
+	"github.com/ShatteredRealms/go-backend/pkg/model/character"
+	"github.com/ShatteredRealms/go-backend/pkg/pb"
+	"github.com/ShatteredRealms/go-backend/pkg/service"
+	"github.com/ShatteredRealms/go-backend/pkg/srv"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/sirupsen/logrus/hooks/test"
+	"go.opentelemetry.io/otel"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+var _ = Describe("Item server", func() {
+	var (
+		mockController  *gomock.Controller
+		mockItemService *mocks.MockItemService
+
+		server pb.ItemServiceServer
+		ctx    = context.Background()
+
+		item *character.ItemDefinition
+	)
+
+	BeforeEach(func() {
+		log.Logger, _ = test.NewNullLogger()
+		mockController = gomock.NewController(GinkgoT())
+		mockItemService = mocks.NewMockItemService(mockController)
+
+		var err error
+		server, err = srv.NewItemServiceServer(ctx, &characterApp.CharacterServerContext{
+			ServerContext: &config.ServerContext{
+				GlobalConfig:   globalConfig,
+				KeycloakClient: keycloak,
+				Tracer:         otel.Tracer("test-item"),
+				RefSROServer:   &globalConfig.Character.SROServer,
+			},
+			ItemService: mockItemService,
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(server).NotTo(BeNil())
+
+		item = &character.ItemDefinition{
+			Id:       "health_potion",
+			Name:     "Health Potion",
+			MaxStack: 20,
+		}
+	})
+
+	Describe("GetItems", func() {
+		It("should error for empty context", func() {
+			out, err := server.GetItems(context.Background(), &emptypb.Empty{})
+			Expect(err).To(MatchError(common.ErrUnauthorized.Err()))
+			Expect(out).To(BeNil())
+		})
+
+		It("should work for players", func() {
+			mockItemService.EXPECT().FindAll(gomock.Any()).Return(character.ItemDefinitions{item}, nil)
+			out, err := server.GetItems(incPlayerCtx, &emptypb.Empty{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.Items).To(HaveLen(1))
+		})
+	})
+
+	Describe("GetItem", func() {
+		It("should error if the item does not exist", func() {
+			mockItemService.EXPECT().FindById(gomock.Any(), item.Id).Return(nil, nil)
+			out, err := server.GetItem(incPlayerCtx, &pb.ItemTarget{Id: item.Id})
+			Expect(err).To(MatchError(common.ErrDoesNotExist.Err()))
+			Expect(out).To(BeNil())
+		})
+	})
+
+	Describe("CreateItem", func() {
+		It("should error for invalid permission (player)", func() {
+			out, err := server.CreateItem(incPlayerCtx, item.ToPb())
+			Expect(err).To(MatchError(common.ErrUnauthorized.Err()))
+			Expect(out).To(BeNil())
+		})
+
+		It("should error if the item exists", func() {
+			mockItemService.EXPECT().Create(gomock.Any(), item).Return(nil, service.ErrItemExists)
+			out, err := server.CreateItem(incAdminCtx, item.ToPb())
+			Expect(status.Code(err)).To(Equal(codes.AlreadyExists))
+			Expect(out).To(BeNil())
+		})
+
+		It("should error on invalid items", func() {
+			mockItemService.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil, character.ErrItemId)
+			out, err := server.CreateItem(incAdminCtx, item.ToPb())
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+			Expect(out).To(BeNil())
+		})
+
+		It("should work for admins", func() {
+			mockItemService.EXPECT().Create(gomock.Any(), item).Return(item, nil)
+			out, err := server.CreateItem(incAdminCtx, item.ToPb())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.Id).To(Equal(item.Id))
+		})
+	})
+
+	Describe("EditItem", func() {
+		It("should error if the item does not exist", func() {
+			mockItemService.EXPECT().Edit(gomock.Any(), item).Return(nil, service.ErrItemNotFound)
+			out, err := server.EditItem(incAdminCtx, item.ToPb())
+			Expect(status.Code(err)).To(Equal(codes.NotFound))
+			Expect(out).To(BeNil())
+		})
+	})
+
+	Describe("DeleteItem", func() {
+		It("should error for invalid permission (player)", func() {
+			out, err := server.DeleteItem(incPlayerCtx, &pb.ItemTarget{Id: item.Id})
+			Expect(err).To(MatchError(common.ErrUnauthorized.Err()))
+			Expect(out).To(BeNil())
+		})
+
+		It("should work for admins", func() {
+			mockItemService.EXPECT().Delete(gomock.Any(), item.Id).Return(nil)
+			out, err := server.DeleteItem(incAdminCtx, &pb.ItemTarget{Id: item.Id})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out).NotTo(BeNil())
+		})
+	})
+})
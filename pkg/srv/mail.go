@@ -0,0 +1,303 @@
+package srv
+
+import (
+	"context"
+	"errors"
+
+	characterApp "github.com/ShatteredRealms/go-backend/cmd/character/app"
+	"github.com/ShatteredRealms/go-backend/pkg/auth"
+	"github.com/ShatteredRealms/go-backend/pkg/common"
+	"github.com/ShatteredRealms/go-backend/pkg/log"
+	"github.com/ShatteredRealms/go-backend/pkg/model/character"
+	"github.com/ShatteredRealms/go-backend/pkg/pb"
+	"github.com/ShatteredRealms/go-backend/pkg/service"
+	"github.com/WilSimpson/gocloak/v13"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type mailServiceServer struct {
+	pb.UnimplementedMailServiceServer
+	server *characterApp.CharacterServerContext
+}
+
+var (
+	RoleMail = registerCharacterRole(&gocloak.Role{
+		Name:        gocloak.StringP("mail"),
+		Description: gocloak.StringP("Allows sending, reading and claiming mail with own characters"),
+	})
+
+	RoleMailSystem = registerCharacterRole(&gocloak.Role{
+		Name:        gocloak.StringP("mail_system"),
+		Description: gocloak.StringP("Allows sending system mail and managing the mail of any character"),
+	})
+)
+
+// SendMail implements pb.MailServiceServer.
+func (s *mailServiceServer) SendMail(
+	ctx context.Context,
+	request *pb.SendMailRequest,
+) (*pb.Mail, error) {
+	claims, ok := auth.RetrieveClaims(ctx)
+	if !ok {
+		return nil, common.ErrUnauthorized.Err()
+	}
+
+	// Validate requester has correct permission
+	if !claims.HasResourceRole(RoleMail, auth.CharacterClientId) {
+		return nil, common.ErrUnauthorized.Err()
+	}
+
+	sender, err := s.ownedCharacter(ctx, claims, request.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	recipient, err := s.targetCharacter(ctx, request.Recipient)
+	if err != nil {
+		return nil, err
+	}
+
+	mail, err := s.server.MailService.Send(
+		ctx,
+		sender,
+		recipient,
+		request.Subject,
+		request.Body,
+		character.MailAttachmentsFromPb(request.Attachments),
+		request.Gold,
+	)
+	if err != nil {
+		return nil, mailError(ctx, "send mail", err)
+	}
+
+	return mail.ToPb(), nil
+}
+
+// SendSystemMail implements pb.MailServiceServer.
+func (s *mailServiceServer) SendSystemMail(
+	ctx context.Context,
+	request *pb.SendSystemMailRequest,
+) (*pb.Mail, error) {
+	claims, ok := auth.RetrieveClaims(ctx)
+	if !ok {
+		return nil, common.ErrUnauthorized.Err()
+	}
+
+	// Validate requester has correct permission
+	if !claims.HasResourceRole(RoleMailSystem, auth.CharacterClientId) {
+		return nil, common.ErrUnauthorized.Err()
+	}
+
+	recipient, err := s.targetCharacter(ctx, request.Recipient)
+	if err != nil {
+		return nil, err
+	}
+
+	mail, err := s.server.MailService.SendSystem(
+		ctx,
+		recipient,
+		request.SenderName,
+		request.Subject,
+		request.Body,
+		character.InventoryItemsFromPb(request.Attachments),
+		request.Gold,
+	)
+	if err != nil {
+		return nil, mailError(ctx, "send system mail", err)
+	}
+
+	return mail.ToPb(), nil
+}
+
+// GetMail implements pb.MailServiceServer.
+func (s *mailServiceServer) GetMail(
+	ctx context.Context,
+	request *pb.CharacterTarget,
+) (*pb.Mails, error) {
+	claims, ok := auth.RetrieveClaims(ctx)
+	if !ok {
+		return nil, common.ErrUnauthorized.Err()
+	}
+
+	// Validate requester has correct permission
+	if !claims.HasResourceRole(RoleMail, auth.CharacterClientId) &&
+		!claims.HasResourceRole(RoleMailSystem, auth.CharacterClientId) {
+		return nil, common.ErrUnauthorized.Err()
+	}
+
+	recipient, err := s.ownedCharacter(ctx, claims, request)
+	if err != nil {
+		return nil, err
+	}
+
+	mails, err := s.server.MailService.Inbox(ctx, recipient.ID)
+	if err != nil {
+		return nil, mailError(ctx, "get mail", err)
+	}
+
+	return mails.ToPb(), nil
+}
+
+// ReadMail implements pb.MailServiceServer.
+func (s *mailServiceServer) ReadMail(
+	ctx context.Context,
+	request *pb.MailTarget,
+) (*pb.Mail, error) {
+	id, recipient, err := s.mailTarget(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	mail, err := s.server.MailService.Read(ctx, id, recipient.ID)
+	if err != nil {
+		return nil, mailError(ctx, "read mail", err)
+	}
+
+	return mail.ToPb(), nil
+}
+
+// ClaimMail implements pb.MailServiceServer.
+func (s *mailServiceServer) ClaimMail(
+	ctx context.Context,
+	request *pb.MailTarget,
+) (*pb.Mail, error) {
+	id, recipient, err := s.mailTarget(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	mail, err := s.server.MailService.Claim(ctx, id, recipient.ID)
+	if err != nil {
+		return nil, mailError(ctx, "claim mail", err)
+	}
+
+	return mail.ToPb(), nil
+}
+
+// DeleteMail implements pb.MailServiceServer.
+func (s *mailServiceServer) DeleteMail(
+	ctx context.Context,
+	request *pb.MailTarget,
+) (*emptypb.Empty, error) {
+	id, recipient, err := s.mailTarget(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.server.MailService.Delete(ctx, id, recipient.ID)
+	if err != nil {
+		return nil, mailError(ctx, "delete mail", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// NewMailServiceServer creates the mail service server. The mail roles are registered with the character roles, so
+// they are created by NewCharacterServiceServer.
+func NewMailServiceServer(
+	ctx context.Context,
+	server *characterApp.CharacterServerContext,
+) (pb.MailServiceServer, error) {
+	return &mailServiceServer{
+		server: server,
+	}, nil
+}
+
+// mailTarget validates the requester can act on the mail of the recipient and parses the mail id
+func (s mailServiceServer) mailTarget(
+	ctx context.Context,
+	request *pb.MailTarget,
+) (primitive.ObjectID, *character.Character, error) {
+	claims, ok := auth.RetrieveClaims(ctx)
+	if !ok {
+		return primitive.NilObjectID, nil, common.ErrUnauthorized.Err()
+	}
+
+	// Validate requester has correct permission
+	if !claims.HasResourceRole(RoleMail, auth.CharacterClientId) &&
+		!claims.HasResourceRole(RoleMailSystem, auth.CharacterClientId) {
+		return primitive.NilObjectID, nil, common.ErrUnauthorized.Err()
+	}
+
+	id, err := primitive.ObjectIDFromHex(request.Id)
+	if err != nil {
+		return primitive.NilObjectID, nil, status.Error(codes.InvalidArgument, "invalid mail id")
+	}
+
+	recipient, err := s.ownedCharacter(ctx, claims, request.Character)
+	if err != nil {
+		return primitive.NilObjectID, nil, err
+	}
+
+	return id, recipient, nil
+}
+
+// ownedCharacter gets the target character and verifies it is owned by the requester unless the requester can manage
+// all mail
+func (s mailServiceServer) ownedCharacter(
+	ctx context.Context,
+	claims *auth.SROClaims,
+	request *pb.CharacterTarget,
+) (*character.Character, error) {
+	char, err := s.targetCharacter(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	if char.OwnerId != claims.Subject && !claims.HasResourceRole(RoleMailSystem, auth.CharacterClientId) {
+		return nil, common.ErrUnauthorized.Err()
+	}
+
+	return char, nil
+}
+
+func (s mailServiceServer) targetCharacter(ctx context.Context, request *pb.CharacterTarget) (*character.Character, error) {
+	if request == nil || request.Type == nil {
+		return nil, status.Error(codes.InvalidArgument, "character is required")
+	}
+
+	char, err := s.server.CharacterService.FindByTarget(ctx, request)
+	if err != nil {
+		log.Logger.WithContext(ctx).Errorf("find character: %v", err)
+		return nil, common.ErrHandleRequest.Err()
+	}
+	if char == nil {
+		return nil, common.ErrDoesNotExist.Err()
+	}
+
+	return char, nil
+}
+
+func mailError(ctx context.Context, action string, err error) error {
+	switch {
+	case errors.Is(err, service.ErrMailNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, service.ErrMailClaimed),
+		errors.Is(err, service.ErrMailNothingToClaim),
+		errors.Is(err, service.ErrMailHasAttachments),
+		errors.Is(err, service.ErrMailWrongDimension),
+		errors.Is(err, character.ErrInventorySlotEmpty),
+		errors.Is(err, character.ErrInventoryQuantity),
+		errors.Is(err, character.ErrInventoryGold),
+		errors.Is(err, character.ErrInventoryFull),
+		errors.Is(err, character.ErrInventoryGoldLimit),
+		errors.Is(err, character.ErrInventoryUnknownItem),
+		errors.Is(err, character.ErrInventoryOverStacked),
+		errors.Is(err, character.ErrInventorySlotCapacity),
+		errors.Is(err, character.ErrItemNotTradable):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, service.ErrMailConflict):
+		return status.Error(codes.Aborted, err.Error())
+	case errors.Is(err, service.ErrMailSelf),
+		errors.Is(err, character.ErrMailSubject),
+		errors.Is(err, character.ErrMailBodyToLong),
+		errors.Is(err, character.ErrMailAttachments):
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	log.Logger.WithContext(ctx).Errorf("%s: %v", action, err)
+	return status.Errorf(codes.Internal, "unable to %s", action)
+}
@@ -0,0 +1,174 @@
+package srv_test
+
+import (
+	"context"
+
+	characterApp "github.com/ShatteredRealms/go-backend/cmd/character/app"
+	"github.com/ShatteredRealms/go-backend/pkg/common"
+	"github.com/ShatteredRealms/go-backend/pkg/config"
+	"github.com/ShatteredRealms/go-backend/pkg/log"
+	"github.com/ShatteredRealms/go-backend/pkg/mocks"
+	"github.com/ShatteredRealms/go-backend/pkg/model/character"
+	"github.com/ShatteredRealms/go-backend/pkg/pb"
+	"github.com/ShatteredRealms/go-backend/pkg/service"
+	"github.com/ShatteredRealms/go-backend/pkg/srv"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/sirupsen/logrus/hooks/test"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.opentelemetry.io/otel"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ = Describe("Mail server", func() {
+	var (
+		mockController  *gomock.Controller
+		mockCharService *mocks.MockCharacterService
+		mockMailService *mocks.MockMailService
+
+		server pb.MailServiceServer
+		ctx    = context.Background()
+
+		mail   *character.Mail
+		target *pb.CharacterTarget
+	)
+
+	BeforeEach(func() {
+		log.Logger, _ = test.NewNullLogger()
+		mockController = gomock.NewController(GinkgoT())
+
+		mockCharService = mocks.NewMockCharacterService(mockController)
+		mockMailService = mocks.NewMockMailService(mockController)
+
+		var err error
+		server, err = srv.NewMailServiceServer(ctx, &characterApp.CharacterServerContext{
+			ServerContext: &config.ServerContext{
+				GlobalConfig:   globalConfig,
+				KeycloakClient: keycloak,
+				Tracer:         otel.Tracer("test-mail"),
+				RefSROServer:   &globalConfig.Character.SROServer,
+			},
+			CharacterService: mockCharService,
+			MailService:      mockMailService,
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(server).NotTo(BeNil())
+
+		mail = &character.Mail{
+			Id:          primitive.NewObjectID(),
+			SenderId:    3,
+			RecipientId: 2,
+			Subject:     "subject",
+		}
+		target = &pb.CharacterTarget{
+			Type: &pb.CharacterTarget_Id{
+				Id: 2,
+			},
+		}
+	})
+
+	Describe("SendMail", func() {
+		It("should error for empty context", func() {
+			out, err := server.SendMail(context.Background(), &pb.SendMailRequest{Sender: target})
+			Expect(err).To(MatchError(common.ErrUnauthorized.Err()))
+			Expect(out).To(BeNil())
+		})
+
+		It("should error for invalid permission (guest)", func() {
+			out, err := server.SendMail(incGuestCtx, &pb.SendMailRequest{Sender: target})
+			Expect(err).To(MatchError(common.ErrUnauthorized.Err()))
+			Expect(out).To(BeNil())
+		})
+
+		It("should error if the sender is not owned by the requester", func() {
+			mockCharService.EXPECT().FindByTarget(gomock.Any(), target).Return(&character.Character{
+				ID:      2,
+				OwnerId: *admin.ID,
+			}, nil)
+			out, err := server.SendMail(incPlayerCtx, &pb.SendMailRequest{Sender: target})
+			Expect(err).To(MatchError(common.ErrUnauthorized.Err()))
+			Expect(out).To(BeNil())
+		})
+
+		It("should error if the sender does not have the items", func() {
+			recipient := &pb.CharacterTarget{Type: &pb.CharacterTarget_Id{Id: 3}}
+			mockCharService.EXPECT().FindByTarget(gomock.Any(), target).Return(&character.Character{
+				ID:      2,
+				OwnerId: *player.ID,
+			}, nil)
+			mockCharService.EXPECT().FindByTarget(gomock.Any(), recipient).Return(&character.Character{ID: 3}, nil)
+			mockMailService.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), "subject", "", gomock.Any(), uint64(0)).
+				Return(nil, character.ErrInventorySlotEmpty)
+			out, err := server.SendMail(incPlayerCtx, &pb.SendMailRequest{
+				Sender:      target,
+				Recipient:   recipient,
+				Subject:     "subject",
+				Attachments: []*pb.MailAttachment{{Slot: 1, Quantity: 1}},
+			})
+			Expect(status.Code(err)).To(Equal(codes.FailedPrecondition))
+			Expect(out).To(BeNil())
+		})
+	})
+
+	Describe("SendSystemMail", func() {
+		It("should error for players", func() {
+			out, err := server.SendSystemMail(incPlayerCtx, &pb.SendSystemMailRequest{Recipient: target})
+			Expect(err).To(MatchError(common.ErrUnauthorized.Err()))
+			Expect(out).To(BeNil())
+		})
+
+		It("should send system mail", func() {
+			mockCharService.EXPECT().FindByTarget(gomock.Any(), target).Return(&character.Character{ID: 2}, nil)
+			mockMailService.EXPECT().SendSystem(gomock.Any(), gomock.Any(), "Realm", "subject", "", gomock.Any(), uint64(5)).
+				Return(mail, nil)
+			out, err := server.SendSystemMail(incAdminCtx, &pb.SendSystemMailRequest{
+				Recipient:  target,
+				SenderName: "Realm",
+				Subject:    "subject",
+				Gold:       5,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.Id).To(Equal(mail.Id.Hex()))
+		})
+	})
+
+	Describe("ClaimMail", func() {
+		It("should error for invalid permission (guest)", func() {
+			out, err := server.ClaimMail(incGuestCtx, &pb.MailTarget{Id: mail.Id.Hex(), Character: target})
+			Expect(err).To(MatchError(common.ErrUnauthorized.Err()))
+			Expect(out).To(BeNil())
+		})
+
+		It("should error for an invalid mail id", func() {
+			out, err := server.ClaimMail(incPlayerCtx, &pb.MailTarget{Id: "invalid", Character: target})
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+			Expect(out).To(BeNil())
+		})
+
+		It("should error if the mail was claimed", func() {
+			mockCharService.EXPECT().FindByTarget(gomock.Any(), target).Return(&character.Character{
+				ID:      2,
+				OwnerId: *player.ID,
+			}, nil)
+			mockMailService.EXPECT().Claim(gomock.Any(), mail.Id, uint(2)).Return(nil, service.ErrMailClaimed)
+			out, err := server.ClaimMail(incPlayerCtx, &pb.MailTarget{Id: mail.Id.Hex(), Character: target})
+			Expect(status.Code(err)).To(Equal(codes.FailedPrecondition))
+			Expect(out).To(BeNil())
+		})
+	})
+
+	Describe("DeleteMail", func() {
+		It("should error if the mail does not exist", func() {
+			mockCharService.EXPECT().FindByTarget(gomock.Any(), target).Return(&character.Character{
+				ID:      2,
+				OwnerId: *player.ID,
+			}, nil)
+			mockMailService.EXPECT().Delete(gomock.Any(), mail.Id, uint(2)).Return(service.ErrMailNotFound)
+			out, err := server.DeleteMail(incPlayerCtx, &pb.MailTarget{Id: mail.Id.Hex(), Character: target})
+			Expect(status.Code(err)).To(Equal(codes.NotFound))
+			Expect(out).To(BeNil())
+		})
+	})
+})
@@ -0,0 +1,450 @@
+package srv
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	gamebackend "github.com/ShatteredRealms/go-backend/cmd/gamebackend/app"
+	"github.com/ShatteredRealms/go-backend/pkg/auth"
+	"github.com/ShatteredRealms/go-backend/pkg/common"
+	"github.com/ShatteredRealms/go-backend/pkg/log"
+	model "github.com/ShatteredRealms/go-backend/pkg/model/gamebackend"
+	"github.com/ShatteredRealms/go-backend/pkg/pb"
+	"github.com/ShatteredRealms/go-backend/pkg/service"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"gorm.io/gorm"
+)
+
+type partyServiceServer struct {
+	pb.UnimplementedPartyServiceServer
+	server *gamebackend.GameBackendServerContext
+}
+
+// GetParty implements pb.PartyServiceServer.
+func (s partyServiceServer) GetParty(
+	ctx context.Context,
+	request *pb.PartyTarget,
+) (*pb.Party, error) {
+	claims, ok := auth.RetrieveClaims(ctx)
+	if !ok {
+		return nil, common.ErrUnauthorized.Err()
+	}
+
+	// Validate requester has correct permission
+	manager := claims.HasResourceRole(RoleManageConnections, auth.GamebackendClientId)
+	if !manager && !claims.HasResourceRole(RoleConnect, auth.GamebackendClientId) {
+		return nil, common.ErrUnauthorized.Err()
+	}
+
+	party, err := s.server.PartyService.FindById(ctx, uint(request.Id))
+	if err != nil {
+		log.Logger.WithContext(ctx).Errorf("find party: %v", err)
+		return nil, status.Error(codes.Internal, "unable to get party")
+	}
+	if party == nil {
+		return nil, common.ErrDoesNotExist.Err()
+	}
+
+	members := s.partyCharacters(ctx, party.CharacterIds())
+
+	// Players can only view parties one of their characters is in
+	if !manager && !ownsAny(claims.Subject, members) {
+		return nil, common.ErrUnauthorized.Err()
+	}
+
+	return partyToPb(party, members), nil
+}
+
+// GetCharacterParty implements pb.PartyServiceServer.
+func (s partyServiceServer) GetCharacterParty(
+	ctx context.Context,
+	request *pb.CharacterTarget,
+) (*pb.Party, error) {
+	character, err := s.ownedCharacter(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	party, err := s.server.PartyService.FindByCharacter(ctx, uint(character.Id))
+	if err != nil {
+		log.Logger.WithContext(ctx).Errorf("find character party: %v", err)
+		return nil, status.Error(codes.Internal, "unable to get party")
+	}
+	if party == nil {
+		return nil, status.Error(codes.NotFound, "character is not in a party")
+	}
+
+	return partyToPb(party, s.partyCharacters(ctx, party.CharacterIds())), nil
+}
+
+// InviteToParty implements pb.PartyServiceServer.
+func (s partyServiceServer) InviteToParty(
+	ctx context.Context,
+	request *pb.PartyMemberRequest,
+) (*pb.Party, error) {
+	inviter, err := s.ownedCharacter(ctx, request.Character)
+	if err != nil {
+		return nil, err
+	}
+
+	target, err := s.targetCharacter(ctx, request.Target)
+	if err != nil {
+		return nil, err
+	}
+
+	party, err := s.server.PartyService.Invite(ctx, inviter, target)
+	if err != nil {
+		return nil, partyError(ctx, "invite to party", err)
+	}
+
+	if party.ChatChannelId == 0 {
+		party = s.provisionPartyChat(ctx, party)
+	}
+
+	return partyToPb(party, s.partyCharacters(ctx, party.CharacterIds())), nil
+}
+
+// GetPartyInvites implements pb.PartyServiceServer.
+func (s partyServiceServer) GetPartyInvites(
+	ctx context.Context,
+	request *pb.CharacterTarget,
+) (*pb.PartyInvites, error) {
+	character, err := s.ownedCharacter(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	invites, err := s.server.PartyService.Invites(ctx, uint(character.Id))
+	if err != nil {
+		log.Logger.WithContext(ctx).Errorf("get party invites: %v", err)
+		return nil, status.Error(codes.Internal, "unable to get party invites")
+	}
+
+	inviterIds := make([]uint, len(invites))
+	for idx, invite := range invites {
+		inviterIds[idx] = invite.InviterId
+	}
+	inviters := s.partyCharacters(ctx, inviterIds)
+
+	resp := invites.ToPb()
+	for _, invite := range resp.Invites {
+		if inviter, ok := inviters[uint(invite.InviterId)]; ok {
+			invite.InviterName = inviter.Name
+		}
+	}
+
+	return resp, nil
+}
+
+// AcceptPartyInvite implements pb.PartyServiceServer.
+func (s partyServiceServer) AcceptPartyInvite(
+	ctx context.Context,
+	request *pb.PartyActionRequest,
+) (*pb.Party, error) {
+	character, err := s.ownedCharacter(ctx, request.Character)
+	if err != nil {
+		return nil, err
+	}
+
+	party, err := s.server.PartyService.AcceptInvite(ctx, uint(request.PartyId), uint(character.Id))
+	if err != nil {
+		return nil, partyError(ctx, "accept party invite", err)
+	}
+
+	if party.ChatChannelId == 0 {
+		party = s.provisionPartyChat(ctx, party)
+	} else {
+		syncGroupChat(ctx, s.server.ServerContext, uint64(party.ChatChannelId), party.CharacterIds())
+	}
+
+	return partyToPb(party, s.partyCharacters(ctx, party.CharacterIds())), nil
+}
+
+// DeclinePartyInvite implements pb.PartyServiceServer.
+func (s partyServiceServer) DeclinePartyInvite(
+	ctx context.Context,
+	request *pb.PartyActionRequest,
+) (*emptypb.Empty, error) {
+	character, err := s.ownedCharacter(ctx, request.Character)
+	if err != nil {
+		return nil, err
+	}
+
+	party, disbanded, err := s.server.PartyService.DeclineInvite(ctx, uint(request.PartyId), uint(character.Id))
+	if err != nil {
+		return nil, partyError(ctx, "decline party invite", err)
+	}
+
+	if disbanded {
+		s.updatePartyChat(ctx, party, disbanded)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// LeaveParty implements pb.PartyServiceServer.
+func (s partyServiceServer) LeaveParty(
+	ctx context.Context,
+	request *pb.CharacterTarget,
+) (*emptypb.Empty, error) {
+	character, err := s.ownedCharacter(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	party, disbanded, err := s.server.PartyService.Leave(ctx, uint(character.Id))
+	if err != nil {
+		return nil, partyError(ctx, "leave party", err)
+	}
+
+	s.updatePartyChat(ctx, party, disbanded)
+	return &emptypb.Empty{}, nil
+}
+
+// KickFromParty implements pb.PartyServiceServer.
+func (s partyServiceServer) KickFromParty(
+	ctx context.Context,
+	request *pb.PartyMemberRequest,
+) (*emptypb.Empty, error) {
+	leader, err := s.ownedCharacter(ctx, request.Character)
+	if err != nil {
+		return nil, err
+	}
+
+	target, err := s.targetCharacter(ctx, request.Target)
+	if err != nil {
+		return nil, err
+	}
+
+	party, disbanded, err := s.server.PartyService.Kick(ctx, uint(leader.Id), uint(target.Id))
+	if err != nil {
+		return nil, partyError(ctx, "kick from party", err)
+	}
+
+	s.updatePartyChat(ctx, party, disbanded)
+	return &emptypb.Empty{}, nil
+}
+
+// PromotePartyLeader implements pb.PartyServiceServer.
+func (s partyServiceServer) PromotePartyLeader(
+	ctx context.Context,
+	request *pb.PartyMemberRequest,
+) (*pb.Party, error) {
+	leader, err := s.ownedCharacter(ctx, request.Character)
+	if err != nil {
+		return nil, err
+	}
+
+	target, err := s.targetCharacter(ctx, request.Target)
+	if err != nil {
+		return nil, err
+	}
+
+	party, err := s.server.PartyService.Promote(ctx, uint(leader.Id), uint(target.Id))
+	if err != nil {
+		return nil, partyError(ctx, "promote party leader", err)
+	}
+
+	return partyToPb(party, s.partyCharacters(ctx, party.CharacterIds())), nil
+}
+
+func NewPartyServiceServer(
+	ctx context.Context,
+	server *gamebackend.GameBackendServerContext,
+) (pb.PartyServiceServer, error) {
+	err := createRoles(ctx,
+		server.ServerContext,
+		&ConnectionRoles,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &partyServiceServer{
+		server: server,
+	}, nil
+}
+
+// ownedCharacter gets the character performing a party action. Players can only act with their own characters.
+func (s partyServiceServer) ownedCharacter(
+	ctx context.Context,
+	target *pb.CharacterTarget,
+) (*pb.CharacterDetails, error) {
+	claims, ok := auth.RetrieveClaims(ctx)
+	if !ok {
+		return nil, common.ErrUnauthorized.Err()
+	}
+
+	// Validate requester has correct permission
+	manager := claims.HasResourceRole(RoleManageConnections, auth.GamebackendClientId)
+	if !manager && !claims.HasResourceRole(RoleConnect, auth.GamebackendClientId) {
+		return nil, common.ErrUnauthorized.Err()
+	}
+
+	if target == nil || target.Type == nil {
+		return nil, status.Error(codes.InvalidArgument, "character is required")
+	}
+
+	character, err := presenceCharacter(ctx, s.server, target)
+	if err != nil {
+		return nil, err
+	}
+
+	if !manager && character.Owner != claims.Subject {
+		return nil, common.ErrUnauthorized.Err()
+	}
+
+	return character, nil
+}
+
+// targetCharacter gets the character targeted by a party action using the servers credentials since the target is
+// usually owned by another player
+func (s partyServiceServer) targetCharacter(
+	ctx context.Context,
+	target *pb.CharacterTarget,
+) (*pb.CharacterDetails, error) {
+	if target == nil || target.Type == nil {
+		return nil, status.Error(codes.InvalidArgument, "target is required")
+	}
+
+	charClient, err := s.server.GetCharacterClient()
+	if err != nil {
+		log.Logger.WithContext(ctx).Errorf("character client: %v", err)
+		return nil, common.ErrHandleRequest.Err()
+	}
+
+	authCtx, err := s.server.OutgoingClientAuth(ctx)
+	if err != nil {
+		log.Logger.WithContext(ctx).Errorf("outgoing client auth: %v", err)
+		return nil, common.ErrHandleRequest.Err()
+	}
+
+	character, err := charClient.GetCharacter(authCtx, target)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, common.ErrDoesNotExist.Err()
+		}
+
+		log.Logger.WithContext(ctx).Errorf("unable to get character %v: %s", target.Type, err)
+		return nil, common.ErrHandleRequest.Err()
+	}
+	if character == nil {
+		return nil, common.ErrDoesNotExist.Err()
+	}
+
+	return character, nil
+}
+
+// partyCharacters gets the details of the characters by their id. Characters that cannot be found are skipped.
+func (s partyServiceServer) partyCharacters(ctx context.Context, characterIds []uint) map[uint]*pb.CharacterDetails {
+	characters := make(map[uint]*pb.CharacterDetails, len(characterIds))
+	for _, characterId := range characterIds {
+		if _, ok := characters[characterId]; ok {
+			continue
+		}
+
+		character, err := s.targetCharacter(ctx, &pb.CharacterTarget{
+			Type: &pb.CharacterTarget_Id{Id: uint64(characterId)},
+		})
+		if err != nil {
+			log.Logger.WithContext(ctx).Warnf("get party character %d: %v", characterId, err)
+			continue
+		}
+
+		characters[characterId] = character
+	}
+
+	return characters
+}
+
+// provisionPartyChat creates the private chat channel for the party and authorizes its current members. Failures are
+// logged and retried the next time a member joins, so the party is returned even if the channel is not created.
+func (s partyServiceServer) provisionPartyChat(ctx context.Context, party *model.Party) *model.Party {
+	channelId, err := createGroupChat(
+		ctx,
+		s.server.ServerContext,
+		partyChatChannelName(party),
+		party.Dimension,
+		party.CharacterIds(),
+	)
+	if err != nil {
+		log.Logger.WithContext(ctx).Warnf("provision party chat: %v", err)
+		return party
+	}
+
+	updated, err := s.server.PartyService.SetChatChannel(ctx, party.ID, uint(channelId))
+	if err != nil {
+		log.Logger.WithContext(ctx).Errorf("provision party chat: set chat channel %d: %v", channelId, err)
+		return party
+	}
+
+	return updated
+}
+
+// updatePartyChat syncs the party chat channel with the remaining members, or deletes the channel if the party disbanded
+func (s partyServiceServer) updatePartyChat(ctx context.Context, party *model.Party, disbanded bool) {
+	if party.ChatChannelId == 0 {
+		return
+	}
+
+	if disbanded {
+		deleteGroupChat(ctx, s.server.ServerContext, uint64(party.ChatChannelId))
+		return
+	}
+
+	syncGroupChat(ctx, s.server.ServerContext, uint64(party.ChatChannelId), party.CharacterIds())
+}
+
+// partyChatChannelName name of the party chat channel
+func partyChatChannelName(party *model.Party) string {
+	return fmt.Sprintf("party-%d", party.ID)
+}
+
+// partyToPb converts the party to its protobuf representation with the names of its members
+func partyToPb(party *model.Party, characters map[uint]*pb.CharacterDetails) *pb.Party {
+	resp := party.ToPb()
+	for _, member := range resp.Members {
+		if character, ok := characters[uint(member.CharacterId)]; ok {
+			member.CharacterName = character.Name
+		}
+	}
+
+	return resp
+}
+
+// ownsAny checks if any of the characters are owned by the user
+func ownsAny(ownerId string, characters map[uint]*pb.CharacterDetails) bool {
+	for _, character := range characters {
+		if character.Owner == ownerId {
+			return true
+		}
+	}
+
+	return false
+}
+
+func partyError(ctx context.Context, action string, err error) error {
+	switch {
+	case errors.Is(err, service.ErrPartyNotFound),
+		errors.Is(err, service.ErrPartyNotMember),
+		errors.Is(err, service.ErrPartyNotInvited):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, service.ErrPartyAlreadyMember),
+		errors.Is(err, service.ErrPartyAlreadyInvited),
+		errors.Is(err, gorm.ErrDuplicatedKey):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, service.ErrPartyNotLeader):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, service.ErrPartyFull),
+		errors.Is(err, service.ErrPartyWrongDimension):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, service.ErrPartySelf):
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	log.Logger.WithContext(ctx).Errorf("%s: %v", action, err)
+	return status.Errorf(codes.Internal, "unable to %s", action)
+}
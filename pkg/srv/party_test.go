@@ -0,0 +1,120 @@
+package srv_test
+
+import (
+	"context"
+
+	app "github.com/ShatteredRealms/go-backend/cmd/gamebackend/app"
+	"github.com/ShatteredRealms/go-backend/pkg/common"
+	"github.com/ShatteredRealms/go-backend/pkg/config"
+	"github.com/ShatteredRealms/go-backend/pkg/log"
+	"github.com/ShatteredRealms/go-backend/pkg/mocks"
+	"github.com/ShatteredRealms/go-backend/pkg/pb"
+	"github.com/ShatteredRealms/go-backend/pkg/srv"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/sirupsen/logrus/hooks/test"
+	"go.opentelemetry.io/otel"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ = Describe("Party server", func() {
+	var (
+		mockController   *gomock.Controller
+		mockPartyService *mocks.MockPartyService
+		ctx              context.Context
+
+		server pb.PartyServiceServer
+		target *pb.CharacterTarget
+	)
+
+	BeforeEach(func() {
+		var err error
+		ctx = context.Background()
+		log.Logger, _ = test.NewNullLogger()
+		mockController = gomock.NewController(GinkgoT())
+		mockPartyService = mocks.NewMockPartyService(mockController)
+
+		server, err = srv.NewPartyServiceServer(ctx, &app.GameBackendServerContext{
+			ServerContext: &config.ServerContext{
+				GlobalConfig:   globalConfig,
+				KeycloakClient: keycloak,
+				Tracer:         otel.Tracer("test-party"),
+				RefSROServer:   &globalConfig.GameBackend.SROServer,
+			},
+			GamebackendService: mocks.NewMockGamebackendService(mockController),
+			PartyService:       mockPartyService,
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(server).NotTo(BeNil())
+
+		target = &pb.CharacterTarget{
+			Type: &pb.CharacterTarget_Id{
+				Id: 1,
+			},
+		}
+	})
+
+	Describe("GetParty", func() {
+		It("should error for empty context", func() {
+			out, err := server.GetParty(context.Background(), &pb.PartyTarget{Id: 1})
+			Expect(err).To(MatchError(common.ErrUnauthorized.Err()))
+			Expect(out).To(BeNil())
+		})
+
+		It("should error for invalid permission (guest)", func() {
+			out, err := server.GetParty(incGuestCtx, &pb.PartyTarget{Id: 1})
+			Expect(err).To(MatchError(common.ErrUnauthorized.Err()))
+			Expect(out).To(BeNil())
+		})
+
+		It("should error if the party does not exist", func() {
+			mockPartyService.EXPECT().FindById(gomock.Any(), uint(1)).Return(nil, nil)
+			out, err := server.GetParty(incAdminCtx, &pb.PartyTarget{Id: 1})
+			Expect(err).To(MatchError(common.ErrDoesNotExist.Err()))
+			Expect(out).To(BeNil())
+		})
+
+		It("should error if finding the party fails", func() {
+			mockPartyService.EXPECT().FindById(gomock.Any(), uint(1)).Return(nil, fakeErr)
+			out, err := server.GetParty(incAdminCtx, &pb.PartyTarget{Id: 1})
+			Expect(status.Code(err)).To(Equal(codes.Internal))
+			Expect(out).To(BeNil())
+		})
+	})
+
+	Describe("InviteToParty", func() {
+		It("should error for empty context", func() {
+			out, err := server.InviteToParty(context.Background(), &pb.PartyMemberRequest{Character: target, Target: target})
+			Expect(err).To(MatchError(common.ErrUnauthorized.Err()))
+			Expect(out).To(BeNil())
+		})
+
+		It("should error for invalid permission (guest)", func() {
+			out, err := server.InviteToParty(incGuestCtx, &pb.PartyMemberRequest{Character: target, Target: target})
+			Expect(err).To(MatchError(common.ErrUnauthorized.Err()))
+			Expect(out).To(BeNil())
+		})
+
+		It("should require a character", func() {
+			out, err := server.InviteToParty(incPlayerCtx, &pb.PartyMemberRequest{Target: target})
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+			Expect(out).To(BeNil())
+		})
+	})
+
+	Describe("LeaveParty", func() {
+		It("should error for empty context", func() {
+			out, err := server.LeaveParty(context.Background(), target)
+			Expect(err).To(MatchError(common.ErrUnauthorized.Err()))
+			Expect(out).To(BeNil())
+		})
+
+		It("should error for invalid permission (guest)", func() {
+			out, err := server.LeaveParty(incGuestCtx, target)
+			Expect(err).To(MatchError(common.ErrUnauthorized.Err()))
+			Expect(out).To(BeNil())
+		})
+	})
+})
@@ -0,0 +1,237 @@
+package srv
+
+import (
+	"context"
+
+	gamebackend "github.com/ShatteredRealms/go-backend/cmd/gamebackend/app"
+	"github.com/ShatteredRealms/go-backend/pkg/auth"
+	"github.com/ShatteredRealms/go-backend/pkg/common"
+	"github.com/ShatteredRealms/go-backend/pkg/log"
+	model "github.com/ShatteredRealms/go-backend/pkg/model/gamebackend"
+	"github.com/ShatteredRealms/go-backend/pkg/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type presenceServiceServer struct {
+	pb.UnimplementedPresenceServiceServer
+	server *gamebackend.GameBackendServerContext
+}
+
+func (s presenceServiceServer) SetPresence(
+	ctx context.Context,
+	request *pb.SetPresenceRequest,
+) (*emptypb.Empty, error) {
+	claims, ok := auth.RetrieveClaims(ctx)
+	if !ok {
+		return nil, common.ErrUnauthorized.Err()
+	}
+
+	// Validate requester has correct permission. Only game servers report presence since it is trusted by other
+	// services.
+	if !claims.HasResourceRole(RoleManageConnections, auth.GamebackendClientId) {
+		return nil, common.ErrUnauthorized.Err()
+	}
+
+	character, err := presenceCharacter(ctx, s.server, request.Character)
+	if err != nil {
+		return nil, err
+	}
+
+	presence := &model.Presence{
+		CharacterId: uint(character.Id),
+		Status:      request.Status,
+		Dimension:   request.Dimension,
+		Map:         request.Map,
+	}
+	if presence.Dimension == "" {
+		presence.Dimension = character.Dimension
+	}
+	if presence.Map == "" && character.Location != nil {
+		presence.Map = character.Location.World
+	}
+
+	err = s.server.PresenceStore.Set(ctx, presence, s.server.GlobalConfig.GameBackend.Presence.TTL)
+	if err != nil {
+		log.Logger.WithContext(ctx).Errorf("set presence: %v", err)
+		return nil, status.Error(codes.Internal, "unable to set presence")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s presenceServiceServer) GetPresence(
+	ctx context.Context,
+	request *pb.CharacterTarget,
+) (*pb.Presence, error) {
+	characters, err := viewablePresenceCharacters(ctx, s.server, []*pb.CharacterTarget{request})
+	if err != nil {
+		return nil, err
+	}
+
+	presence, err := s.server.PresenceStore.Get(ctx, uint(characters[0].Id))
+	if err != nil {
+		log.Logger.WithContext(ctx).Errorf("get presence: %v", err)
+		return nil, status.Error(codes.Internal, "unable to get presence")
+	}
+
+	resp := presence.ToPb()
+	resp.CharacterName = characters[0].Name
+	return resp, nil
+}
+
+func (s presenceServiceServer) WatchPresence(
+	request *pb.WatchPresenceRequest,
+	server pb.PresenceService_WatchPresenceServer,
+) error {
+	ctx := server.Context()
+	if len(request.Targets) == 0 {
+		return status.Error(codes.InvalidArgument, "no targets")
+	}
+
+	characters, err := viewablePresenceCharacters(ctx, s.server, request.Targets)
+	if err != nil {
+		return err
+	}
+
+	names := make(map[uint64]string, len(characters))
+	characterIds := make([]uint, len(characters))
+	for idx, character := range characters {
+		names[character.Id] = character.Name
+		characterIds[idx] = uint(character.Id)
+	}
+
+	updates, err := s.server.PresenceStore.Watch(ctx, characterIds)
+	if err != nil {
+		log.Logger.WithContext(ctx).Errorf("watch presence: %v", err)
+		return status.Error(codes.Internal, "unable to watch presence")
+	}
+
+	for presence := range updates {
+		resp := presence.ToPb()
+		resp.CharacterName = names[resp.CharacterId]
+		if err := server.Send(resp); err != nil {
+			log.Logger.WithContext(ctx).Errorf("send presence: %v", err)
+			return err
+		}
+	}
+
+	return nil
+}
+
+func NewPresenceServiceServer(
+	ctx context.Context,
+	server *gamebackend.GameBackendServerContext,
+) (pb.PresenceServiceServer, error) {
+	err := createRoles(ctx,
+		server.ServerContext,
+		&ConnectionRoles,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &presenceServiceServer{
+		server: server,
+	}, nil
+}
+
+// presenceCharacter gets the character for the target using the servers credentials. Callers are responsible for
+// authorizing the requester to view the character.
+func presenceCharacter(
+	ctx context.Context,
+	server *gamebackend.GameBackendServerContext,
+	target *pb.CharacterTarget,
+) (*pb.CharacterDetails, error) {
+	charClient, err := server.GetCharacterClient()
+	if err != nil {
+		log.Logger.WithContext(ctx).Errorf("character client: %v", err)
+		return nil, common.ErrHandleRequest.Err()
+	}
+
+	authCtx, err := server.OutgoingClientAuth(ctx)
+	if err != nil {
+		log.Logger.WithContext(ctx).Errorf("outgoing client auth: %v", err)
+		return nil, common.ErrHandleRequest.Err()
+	}
+
+	character, err := charClient.GetCharacter(authCtx, target)
+	if err != nil {
+		log.Logger.WithContext(ctx).Errorf("unable to get character %v: %s", target.Type, err)
+		return nil, err
+	}
+	if character == nil {
+		return nil, common.ErrDoesNotExist.Err()
+	}
+
+	return character, nil
+}
+
+// viewablePresenceCharacters gets the characters for the targets. Players can only view the presence of their own
+// characters and the characters of their friends.
+func viewablePresenceCharacters(
+	ctx context.Context,
+	server *gamebackend.GameBackendServerContext,
+	targets []*pb.CharacterTarget,
+) ([]*pb.CharacterDetails, error) {
+	claims, ok := auth.RetrieveClaims(ctx)
+	if !ok {
+		return nil, common.ErrUnauthorized.Err()
+	}
+
+	// Validate requester has correct permission
+	manager := claims.HasResourceRole(RoleManageConnections, auth.GamebackendClientId)
+	if !manager && !claims.HasResourceRole(RoleConnect, auth.GamebackendClientId) {
+		return nil, common.ErrUnauthorized.Err()
+	}
+
+	var friends map[string]struct{}
+	characters := make([]*pb.CharacterDetails, len(targets))
+	for idx, target := range targets {
+		character, err := presenceCharacter(ctx, server, target)
+		if err != nil {
+			return nil, err
+		}
+		characters[idx] = character
+
+		if manager || character.Owner == claims.Subject {
+			continue
+		}
+
+		if friends == nil {
+			friends, err = presenceFriends(ctx, server)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if _, ok := friends[character.Owner]; !ok {
+			return nil, common.ErrUnauthorized.Err()
+		}
+	}
+
+	return characters, nil
+}
+
+// presenceFriends gets the user ids of the requesters friends
+func presenceFriends(ctx context.Context, server *gamebackend.GameBackendServerContext) (map[string]struct{}, error) {
+	chatClient, err := server.GetChatClient()
+	if err != nil {
+		log.Logger.WithContext(ctx).Errorf("chat client: %v", err)
+		return nil, common.ErrHandleRequest.Err()
+	}
+
+	resp, err := chatClient.GetFriends(auth.PassOutgoing(ctx), &emptypb.Empty{})
+	if err != nil {
+		log.Logger.WithContext(ctx).Errorf("get friends: %v", err)
+		return nil, common.ErrHandleRequest.Err()
+	}
+
+	friends := make(map[string]struct{}, len(resp.Users))
+	for _, user := range resp.Users {
+		friends[user.UserId] = struct{}{}
+	}
+
+	return friends, nil
+}
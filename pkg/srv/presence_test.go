@@ -0,0 +1,92 @@
+package srv_test
+
+import (
+	"context"
+
+	app "github.com/ShatteredRealms/go-backend/cmd/gamebackend/app"
+	"github.com/ShatteredRealms/go-backend/pkg/common"
+	"github.com/ShatteredRealms/go-backend/pkg/config"
+	"github.com/ShatteredRealms/go-backend/pkg/log"
+	"github.com/ShatteredRealms/go-backend/pkg/mocks"
+	"github.com/ShatteredRealms/go-backend/pkg/pb"
+	"github.com/ShatteredRealms/go-backend/pkg/presence"
+	"github.com/ShatteredRealms/go-backend/pkg/srv"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/sirupsen/logrus/hooks/test"
+	"go.opentelemetry.io/otel"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("Presence server", func() {
+	var (
+		mockController *gomock.Controller
+		ctx            context.Context
+
+		conf   *app.GameBackendServerContext
+		server pb.PresenceServiceServer
+		target *pb.CharacterTarget
+	)
+
+	BeforeEach(func() {
+		var err error
+		ctx = context.Background()
+		log.Logger, _ = test.NewNullLogger()
+		mockController = gomock.NewController(GinkgoT())
+
+		conf = &app.GameBackendServerContext{
+			ServerContext: &config.ServerContext{
+				GlobalConfig:   globalConfig,
+				KeycloakClient: keycloak,
+				Tracer:         otel.Tracer("test-presence"),
+				RefSROServer:   &globalConfig.GameBackend.SROServer,
+			},
+			GamebackendService: mocks.NewMockGamebackendService(mockController),
+			PresenceStore:      presence.NewMemoryStore(),
+		}
+
+		server, err = srv.NewPresenceServiceServer(ctx, conf)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(server).NotTo(BeNil())
+
+		target = &pb.CharacterTarget{
+			Type: &pb.CharacterTarget_Id{
+				Id: 1,
+			},
+		}
+	})
+
+	Describe("SetPresence", func() {
+		It("should error for empty context", func() {
+			out, err := server.SetPresence(context.Background(), &pb.SetPresenceRequest{Character: target})
+			Expect(err).To(MatchError(common.ErrUnauthorized.Err()))
+			Expect(out).To(BeNil())
+		})
+
+		It("should error for invalid permission (guest)", func() {
+			out, err := server.SetPresence(incGuestCtx, &pb.SetPresenceRequest{Character: target})
+			Expect(err).To(MatchError(common.ErrUnauthorized.Err()))
+			Expect(out).To(BeNil())
+		})
+
+		It("should error for players reporting their own presence", func() {
+			out, err := server.SetPresence(incPlayerCtx, &pb.SetPresenceRequest{Character: target})
+			Expect(err).To(MatchError(common.ErrUnauthorized.Err()))
+			Expect(out).To(BeNil())
+		})
+	})
+
+	Describe("GetPresence", func() {
+		It("should error for empty context", func() {
+			out, err := server.GetPresence(context.Background(), target)
+			Expect(err).To(MatchError(common.ErrUnauthorized.Err()))
+			Expect(out).To(BeNil())
+		})
+
+		It("should error for invalid permission (guest)", func() {
+			out, err := server.GetPresence(incGuestCtx, target)
+			Expect(err).To(MatchError(common.ErrUnauthorized.Err()))
+			Expect(out).To(BeNil())
+		})
+	})
+})
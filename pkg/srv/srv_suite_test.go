@@ -3,7 +3,6 @@ package srv_test
 import (
 	"context"
 	"fmt"
-	"strings"
 	"testing"
 
 	"github.com/ShatteredRealms/go-backend/pkg/auth"
@@ -89,14 +88,10 @@ var (
 	incPlayerCtx context.Context
 	incClientCtx context.Context
 	incGuestCtx  context.Context
-
-	// Kafka
-	kafkaPort string
 )
 
 func TestSrv(t *testing.T) {
 	var keycloakCloseFunc func()
-	var kafkaCloseFunc func()
 	var err error
 
 	SynchronizedBeforeSuite(func() []byte {
@@ -148,19 +143,10 @@ func TestSrv(t *testing.T) {
 		)
 		Expect(err).NotTo(HaveOccurred())
 
-		kafkaCloseFunc, kafkaPort = testdb.SetupKafkaWithDocker()
-
-		out := fmt.Sprintf("%s\n%s", host, kafkaPort)
-
-		return []byte(out)
+		return []byte(host)
 	}, func(data []byte) {
 		log.Logger, _ = test.NewNullLogger()
-		splitData := strings.Split(string(data), "\n")
-		Expect(splitData).To(HaveLen(2))
-
-		host := splitData[0]
-		kafkaPort = splitData[1]
-		Expect(err).NotTo(HaveOccurred())
+		host := string(data)
 
 		keycloak = gocloak.NewClient(string(host))
 		globalConfig, err = config.NewGlobalConfig(context.Background())
@@ -268,9 +254,6 @@ func TestSrv(t *testing.T) {
 		if keycloakCloseFunc != nil {
 			keycloakCloseFunc()
 		}
-		if kafkaCloseFunc != nil {
-			kafkaCloseFunc()
-		}
 	})
 
 	RegisterFailHandler(Fail)
@@ -0,0 +1,304 @@
+package srv
+
+import (
+	"context"
+	"errors"
+
+	characterApp "github.com/ShatteredRealms/go-backend/cmd/character/app"
+	"github.com/ShatteredRealms/go-backend/pkg/auth"
+	"github.com/ShatteredRealms/go-backend/pkg/common"
+	"github.com/ShatteredRealms/go-backend/pkg/log"
+	"github.com/ShatteredRealms/go-backend/pkg/model/character"
+	"github.com/ShatteredRealms/go-backend/pkg/pb"
+	"github.com/ShatteredRealms/go-backend/pkg/service"
+	"github.com/WilSimpson/gocloak/v13"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type tradeServiceServer struct {
+	pb.UnimplementedTradeServiceServer
+	server *characterApp.CharacterServerContext
+}
+
+var (
+	RoleTrade = registerCharacterRole(&gocloak.Role{
+		Name:        gocloak.StringP("trade"),
+		Description: gocloak.StringP("Allows trading with own characters"),
+	})
+
+	RoleTradeOther = registerCharacterRole(&gocloak.Role{
+		Name:        gocloak.StringP("trade_other"),
+		Description: gocloak.StringP("Allows trading for any character"),
+	})
+)
+
+// OpenTrade implements pb.TradeServiceServer.
+func (s *tradeServiceServer) OpenTrade(
+	ctx context.Context,
+	request *pb.OpenTradeRequest,
+) (*pb.Trade, error) {
+	claims, err := s.tradeClaims(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	initiator, err := s.ownedCharacter(ctx, claims, request.Character)
+	if err != nil {
+		return nil, err
+	}
+
+	partner, err := s.targetCharacter(ctx, request.Partner)
+	if err != nil {
+		return nil, err
+	}
+
+	trade, err := s.server.TradeService.Open(ctx, initiator, partner)
+	if err != nil {
+		return nil, tradeError(ctx, "open trade", err)
+	}
+
+	return trade.ToPb(), nil
+}
+
+// GetTrade implements pb.TradeServiceServer.
+func (s *tradeServiceServer) GetTrade(
+	ctx context.Context,
+	request *pb.TradeTarget,
+) (*pb.Trade, error) {
+	id, char, err := s.tradeTarget(ctx, request.Id, request.Character)
+	if err != nil {
+		return nil, err
+	}
+
+	trade, err := s.server.TradeService.Find(ctx, id, char.ID)
+	if err != nil {
+		return nil, tradeError(ctx, "get trade", err)
+	}
+
+	return trade.ToPb(), nil
+}
+
+// GetActiveTrade implements pb.TradeServiceServer.
+func (s *tradeServiceServer) GetActiveTrade(
+	ctx context.Context,
+	request *pb.CharacterTarget,
+) (*pb.Trade, error) {
+	claims, err := s.tradeClaims(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	char, err := s.ownedCharacter(ctx, claims, request)
+	if err != nil {
+		return nil, err
+	}
+
+	trade, err := s.server.TradeService.FindActive(ctx, char.ID)
+	if err != nil {
+		return nil, tradeError(ctx, "get active trade", err)
+	}
+
+	return trade.ToPb(), nil
+}
+
+// SetTradeOffer implements pb.TradeServiceServer.
+func (s *tradeServiceServer) SetTradeOffer(
+	ctx context.Context,
+	request *pb.SetTradeOfferRequest,
+) (*pb.Trade, error) {
+	id, char, err := s.tradeTarget(ctx, request.Id, request.Character)
+	if err != nil {
+		return nil, err
+	}
+
+	trade, err := s.server.TradeService.SetOffer(
+		ctx,
+		id,
+		char.ID,
+		character.TradeOfferItemsFromPb(request.Items),
+		request.Gold,
+	)
+	if err != nil {
+		return nil, tradeError(ctx, "set trade offer", err)
+	}
+
+	return trade.ToPb(), nil
+}
+
+// ConfirmTrade implements pb.TradeServiceServer.
+func (s *tradeServiceServer) ConfirmTrade(
+	ctx context.Context,
+	request *pb.ConfirmTradeRequest,
+) (*pb.Trade, error) {
+	id, char, err := s.tradeTarget(ctx, request.Id, request.Character)
+	if err != nil {
+		return nil, err
+	}
+
+	trade, err := s.server.TradeService.Confirm(ctx, id, char.ID, request.Revision)
+	if err != nil {
+		return nil, tradeError(ctx, "confirm trade", err)
+	}
+
+	return trade.ToPb(), nil
+}
+
+// CancelTrade implements pb.TradeServiceServer.
+func (s *tradeServiceServer) CancelTrade(
+	ctx context.Context,
+	request *pb.TradeTarget,
+) (*pb.Trade, error) {
+	id, char, err := s.tradeTarget(ctx, request.Id, request.Character)
+	if err != nil {
+		return nil, err
+	}
+
+	trade, err := s.server.TradeService.Cancel(ctx, id, char.ID)
+	if err != nil {
+		return nil, tradeError(ctx, "cancel trade", err)
+	}
+
+	return trade.ToPb(), nil
+}
+
+// GetTradeHistory implements pb.TradeServiceServer.
+func (s *tradeServiceServer) GetTradeHistory(
+	ctx context.Context,
+	request *pb.CharacterTarget,
+) (*pb.Trades, error) {
+	claims, err := s.tradeClaims(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	char, err := s.ownedCharacter(ctx, claims, request)
+	if err != nil {
+		return nil, err
+	}
+
+	trades, err := s.server.TradeService.History(ctx, char.ID)
+	if err != nil {
+		return nil, tradeError(ctx, "get trade history", err)
+	}
+
+	return trades.ToPb(), nil
+}
+
+// NewTradeServiceServer creates the trade service server. The trade roles are registered with the character roles,
+// so they are created by NewCharacterServiceServer.
+func NewTradeServiceServer(
+	ctx context.Context,
+	server *characterApp.CharacterServerContext,
+) (pb.TradeServiceServer, error) {
+	return &tradeServiceServer{
+		server: server,
+	}, nil
+}
+
+// tradeClaims gets the claims of the requester and validates it can trade
+func (s tradeServiceServer) tradeClaims(ctx context.Context) (*auth.SROClaims, error) {
+	claims, ok := auth.RetrieveClaims(ctx)
+	if !ok {
+		return nil, common.ErrUnauthorized.Err()
+	}
+
+	// Validate requester has correct permission
+	if !claims.HasResourceRole(RoleTrade, auth.CharacterClientId) &&
+		!claims.HasResourceRole(RoleTradeOther, auth.CharacterClientId) {
+		return nil, common.ErrUnauthorized.Err()
+	}
+
+	return claims, nil
+}
+
+// tradeTarget validates the requester can act on trades for the character and parses the trade id
+func (s tradeServiceServer) tradeTarget(
+	ctx context.Context,
+	tradeId string,
+	request *pb.CharacterTarget,
+) (primitive.ObjectID, *character.Character, error) {
+	claims, err := s.tradeClaims(ctx)
+	if err != nil {
+		return primitive.NilObjectID, nil, err
+	}
+
+	id, err := primitive.ObjectIDFromHex(tradeId)
+	if err != nil {
+		return primitive.NilObjectID, nil, status.Error(codes.InvalidArgument, "invalid trade id")
+	}
+
+	char, err := s.ownedCharacter(ctx, claims, request)
+	if err != nil {
+		return primitive.NilObjectID, nil, err
+	}
+
+	return id, char, nil
+}
+
+// ownedCharacter gets the target character and verifies it is owned by the requester unless the requester can trade
+// for any character
+func (s tradeServiceServer) ownedCharacter(
+	ctx context.Context,
+	claims *auth.SROClaims,
+	request *pb.CharacterTarget,
+) (*character.Character, error) {
+	char, err := s.targetCharacter(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	if char.OwnerId != claims.Subject && !claims.HasResourceRole(RoleTradeOther, auth.CharacterClientId) {
+		return nil, common.ErrUnauthorized.Err()
+	}
+
+	return char, nil
+}
+
+func (s tradeServiceServer) targetCharacter(ctx context.Context, request *pb.CharacterTarget) (*character.Character, error) {
+	if request == nil || request.Type == nil {
+		return nil, status.Error(codes.InvalidArgument, "character is required")
+	}
+
+	char, err := s.server.CharacterService.FindByTarget(ctx, request)
+	if err != nil {
+		log.Logger.WithContext(ctx).Errorf("find character: %v", err)
+		return nil, common.ErrHandleRequest.Err()
+	}
+	if char == nil {
+		return nil, common.ErrDoesNotExist.Err()
+	}
+
+	return char, nil
+}
+
+func tradeError(ctx context.Context, action string, err error) error {
+	switch {
+	case errors.Is(err, service.ErrTradeNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, service.ErrTradeInProgress):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, service.ErrTradeChanged):
+		return status.Error(codes.Aborted, err.Error())
+	case errors.Is(err, service.ErrTradeWrongDimension),
+		errors.Is(err, character.ErrTradeClosed),
+		errors.Is(err, character.ErrTradeItemChanged),
+		errors.Is(err, character.ErrInventorySlotEmpty),
+		errors.Is(err, character.ErrInventoryQuantity),
+		errors.Is(err, character.ErrInventoryGold),
+		errors.Is(err, character.ErrInventoryFull),
+		errors.Is(err, character.ErrInventoryGoldLimit),
+		errors.Is(err, character.ErrInventoryUnknownItem),
+		errors.Is(err, character.ErrInventoryOverStacked),
+		errors.Is(err, character.ErrInventorySlotCapacity),
+		errors.Is(err, character.ErrItemNotTradable):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, service.ErrTradeSelf),
+		errors.Is(err, character.ErrTradeItems):
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	log.Logger.WithContext(ctx).Errorf("%s: %v", action, err)
+	return status.Errorf(codes.Internal, "unable to %s", action)
+}
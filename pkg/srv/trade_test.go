@@ -0,0 +1,155 @@
+package srv_test
+
+import (
+	"context"
+
+	characterApp "github.com/ShatteredRealms/go-backend/cmd/character/app"
+	"github.com/ShatteredRealms/go-backend/pkg/common"
+	"github.com/ShatteredRealms/go-backend/pkg/config"
+	"github.com/ShatteredRealms/go-backend/pkg/log"
+	"github.com/ShatteredRealms/go-backend/pkg/mocks"
+	"github.com/ShatteredRealms/go-backend/pkg/model/character"
+	"github.com/ShatteredRealms/go-backend/pkg/pb"
+	"github.com/ShatteredRealms/go-backend/pkg/service"
+	"github.com/ShatteredRealms/go-backend/pkg/srv"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/sirupsen/logrus/hooks/test"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.opentelemetry.io/otel"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ = Describe("Trade server", func() {
+	var (
+		mockController   *gomock.Controller
+		mockCharService  *mocks.MockCharacterService
+		mockTradeService *mocks.MockTradeService
+
+		server pb.TradeServiceServer
+		ctx    = context.Background()
+
+		trade  *character.Trade
+		target *pb.CharacterTarget
+	)
+
+	BeforeEach(func() {
+		log.Logger, _ = test.NewNullLogger()
+		mockController = gomock.NewController(GinkgoT())
+
+		mockCharService = mocks.NewMockCharacterService(mockController)
+		mockTradeService = mocks.NewMockTradeService(mockController)
+
+		var err error
+		server, err = srv.NewTradeServiceServer(ctx, &characterApp.CharacterServerContext{
+			ServerContext: &config.ServerContext{
+				GlobalConfig:   globalConfig,
+				KeycloakClient: keycloak,
+				Tracer:         otel.Tracer("test-trade"),
+				RefSROServer:   &globalConfig.Character.SROServer,
+			},
+			CharacterService: mockCharService,
+			TradeService:     mockTradeService,
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(server).NotTo(BeNil())
+
+		trade = &character.Trade{
+			Id:        primitive.NewObjectID(),
+			Status:    character.TradeStatusOpen,
+			Initiator: &character.TradeOffer{CharacterId: 2},
+			Partner:   &character.TradeOffer{CharacterId: 3},
+			Revision:  1,
+		}
+		target = &pb.CharacterTarget{
+			Type: &pb.CharacterTarget_Id{
+				Id: 2,
+			},
+		}
+	})
+
+	Describe("OpenTrade", func() {
+		It("should error for empty context", func() {
+			out, err := server.OpenTrade(context.Background(), &pb.OpenTradeRequest{Character: target})
+			Expect(err).To(MatchError(common.ErrUnauthorized.Err()))
+			Expect(out).To(BeNil())
+		})
+
+		It("should error for invalid permission (guest)", func() {
+			out, err := server.OpenTrade(incGuestCtx, &pb.OpenTradeRequest{Character: target})
+			Expect(err).To(MatchError(common.ErrUnauthorized.Err()))
+			Expect(out).To(BeNil())
+		})
+
+		It("should error if the character is not owned by the requester", func() {
+			mockCharService.EXPECT().FindByTarget(gomock.Any(), target).Return(&character.Character{
+				ID:      2,
+				OwnerId: *admin.ID,
+			}, nil)
+			out, err := server.OpenTrade(incPlayerCtx, &pb.OpenTradeRequest{Character: target})
+			Expect(err).To(MatchError(common.ErrUnauthorized.Err()))
+			Expect(out).To(BeNil())
+		})
+
+		It("should error if already trading", func() {
+			partner := &pb.CharacterTarget{Type: &pb.CharacterTarget_Id{Id: 3}}
+			mockCharService.EXPECT().FindByTarget(gomock.Any(), target).Return(&character.Character{
+				ID:      2,
+				OwnerId: *player.ID,
+			}, nil)
+			mockCharService.EXPECT().FindByTarget(gomock.Any(), partner).Return(&character.Character{ID: 3}, nil)
+			mockTradeService.EXPECT().Open(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, service.ErrTradeInProgress)
+			out, err := server.OpenTrade(incPlayerCtx, &pb.OpenTradeRequest{Character: target, Partner: partner})
+			Expect(status.Code(err)).To(Equal(codes.AlreadyExists))
+			Expect(out).To(BeNil())
+		})
+	})
+
+	Describe("ConfirmTrade", func() {
+		It("should error for an invalid trade id", func() {
+			out, err := server.ConfirmTrade(incPlayerCtx, &pb.ConfirmTradeRequest{Id: "invalid", Character: target})
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+			Expect(out).To(BeNil())
+		})
+
+		It("should error if the offers changed", func() {
+			mockCharService.EXPECT().FindByTarget(gomock.Any(), target).Return(&character.Character{
+				ID:      2,
+				OwnerId: *player.ID,
+			}, nil)
+			mockTradeService.EXPECT().Confirm(gomock.Any(), trade.Id, uint(2), uint64(1)).Return(nil, service.ErrTradeChanged)
+			out, err := server.ConfirmTrade(incPlayerCtx, &pb.ConfirmTradeRequest{
+				Id:        trade.Id.Hex(),
+				Character: target,
+				Revision:  1,
+			})
+			Expect(status.Code(err)).To(Equal(codes.Aborted))
+			Expect(out).To(BeNil())
+		})
+
+		It("should confirm for any character as a trade manager", func() {
+			mockCharService.EXPECT().FindByTarget(gomock.Any(), target).Return(&character.Character{
+				ID:      2,
+				OwnerId: *player.ID,
+			}, nil)
+			mockTradeService.EXPECT().Confirm(gomock.Any(), trade.Id, uint(2), uint64(1)).Return(trade, nil)
+			out, err := server.ConfirmTrade(incAdminCtx, &pb.ConfirmTradeRequest{
+				Id:        trade.Id.Hex(),
+				Character: target,
+				Revision:  1,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out.Id).To(Equal(trade.Id.Hex()))
+		})
+	})
+
+	Describe("GetTradeHistory", func() {
+		It("should error for invalid permission (guest)", func() {
+			out, err := server.GetTradeHistory(incGuestCtx, target)
+			Expect(err).To(MatchError(common.ErrUnauthorized.Err()))
+			Expect(out).To(BeNil())
+		})
+	})
+})